@@ -0,0 +1,50 @@
+// Command cleanup-guests removes guest accounts whose session expired before
+// they were claimed, together with their predictions and files. Run it
+// periodically, e.g. from cron.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/filestore"
+	"github.com/trashscanner/trashscanner_api/internal/guests"
+	"github.com/trashscanner/trashscanner_api/internal/logging"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+)
+
+func main() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	logger := logging.NewLogger(cfg)
+
+	store, err := store.CreatePgStore(cfg)
+	if err != nil {
+		logger.Fatalf("failed to create store: %v", err)
+	}
+
+	fileStore, err := filestore.NewMinioStore(cfg)
+	if err != nil {
+		store.Close()
+		logger.Fatalf("failed to create file store: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	startedAt := time.Now()
+	count, err := guests.PurgeExpired(ctx, store, fileStore, startedAt.Add(-cfg.Guest.SessionTTL))
+	store.Close()
+	if err != nil {
+		logger.Fatalf("guest cleanup stopped after %d guests: %v", count, err)
+	}
+	logger.Infof("%d expired guests removed in %s", count, time.Since(startedAt))
+}
@@ -0,0 +1,49 @@
+// Command recompute-stats rebuilds the stats of every user from their
+// predictions with the scoring rules of the current configuration. Run it
+// after changing the rules; it is also available as
+// POST /api/v1/admin/stats/recompute.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/logging"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+)
+
+func main() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	logger := logging.NewLogger(cfg)
+
+	rules, err := stats.NewRules(cfg)
+	if err != nil {
+		logger.Fatalf("failed to load stats rules: %v", err)
+	}
+
+	store, err := store.CreatePgStore(cfg)
+	if err != nil {
+		logger.Fatalf("failed to create store: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	startedAt := time.Now()
+	count, err := stats.RecomputeAll(ctx, store, rules, startedAt)
+	store.Close()
+	if err != nil {
+		logger.Fatalf("stats recompute stopped after %d users: %v", count, err)
+	}
+	logger.Infof("stats of %d users recomputed in %s", count, time.Since(startedAt))
+}
@@ -9,12 +9,16 @@ import (
 	"syscall"
 
 	"github.com/trashscanner/trashscanner_api/internal/api"
+	"github.com/trashscanner/trashscanner_api/internal/audit"
 	"github.com/trashscanner/trashscanner_api/internal/auth"
 	"github.com/trashscanner/trashscanner_api/internal/config"
 	"github.com/trashscanner/trashscanner_api/internal/database"
 	"github.com/trashscanner/trashscanner_api/internal/filestore"
 	"github.com/trashscanner/trashscanner_api/internal/logging"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
 	"github.com/trashscanner/trashscanner_api/internal/predictor"
+	"github.com/trashscanner/trashscanner_api/internal/rbac"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
 	"github.com/trashscanner/trashscanner_api/internal/store"
 )
 
@@ -52,9 +56,31 @@ func main() {
 		return
 	}
 
-	predictor := predictor.NewPredictor(logger, store, cfg.Predictor)
+	mailer, err := mailer.New(cfg.Mail, logger)
+	if err != nil {
+		logger.Errorf("failed to create mailer: %v", err)
+		store.Close()
+		return
+	}
+
+	policy, err := rbac.NewPolicy(cfg.RBAC)
+	if err != nil {
+		logger.Errorf("failed to load rbac policy: %v", err)
+		store.Close()
+		return
+	}
+
+	rules, err := stats.NewRules(cfg)
+	if err != nil {
+		logger.Errorf("failed to load stats rules: %v", err)
+		store.Close()
+		return
+	}
+
+	predictor := predictor.NewPredictor(logger, store, cfg.Predictor, rules)
 
-	server := api.NewServer(cfg, store, fileStore, auth, predictor, logger)
+	server := api.NewServer(cfg, store, fileStore, auth, predictor, mailer, audit.New(cfg.Audit), policy,
+		rules, logger)
 
 	errCh := make(chan error, 1)
 	signCh := make(chan os.Signal, 1)
@@ -0,0 +1,160 @@
+package achievements
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// DefaultRules is the achievement catalog unless the configuration replaces it.
+var DefaultRules = []models.AchievementRule{
+	{ID: "first_scan", Name: "First scan", Description: "Scan your first item",
+		Metric: models.AchievementMetricScans, Threshold: 1},
+	{ID: "scans_10", Name: "Getting started", Description: "Scan 10 items",
+		Metric: models.AchievementMetricScans, Threshold: 10},
+	{ID: "scans_100", Name: "Regular", Description: "Scan 100 items",
+		Metric: models.AchievementMetricScans, Threshold: 100},
+	{ID: "scans_1000", Name: "Sorting machine", Description: "Scan 1000 items",
+		Metric: models.AchievementMetricScans, Threshold: 1000},
+	{ID: "cardboard_25", Name: "Box breaker", Description: "Scan 25 cardboard items",
+		Metric: models.AchievementMetricTrashType, TrashType: models.TrashTypeCardboard, Threshold: 25},
+	{ID: "glass_25", Name: "Glass collector", Description: "Scan 25 glass items",
+		Metric: models.AchievementMetricTrashType, TrashType: models.TrashTypeGlass, Threshold: 25},
+	{ID: "metal_25", Name: "Metalhead", Description: "Scan 25 metal items",
+		Metric: models.AchievementMetricTrashType, TrashType: models.TrashTypeMetal, Threshold: 25},
+	{ID: "paper_25", Name: "Paper trail", Description: "Scan 25 paper items",
+		Metric: models.AchievementMetricTrashType, TrashType: models.TrashTypePaper, Threshold: 25},
+	{ID: "plastic_25", Name: "Plastic hunter", Description: "Scan 25 plastic items",
+		Metric: models.AchievementMetricTrashType, TrashType: models.TrashTypePlastic, Threshold: 25},
+	{ID: "trash_25", Name: "Nothing wasted", Description: "Scan 25 items of general trash",
+		Metric: models.AchievementMetricTrashType, TrashType: models.TrashTypeTrash, Threshold: 25},
+	{ID: "all_types", Name: "Full set", Description: "Scan items of all six trash types",
+		Metric: models.AchievementMetricTypesCollected, Threshold: 6},
+	{ID: "streak_7", Name: "Week in a row", Description: "Scan every day for 7 days",
+		Metric: models.AchievementMetricStreak, Threshold: 7},
+	{ID: "streak_30", Name: "Habit formed", Description: "Scan every day for 30 days",
+		Metric: models.AchievementMetricStreak, Threshold: 30},
+}
+
+// Catalog evaluates the achievement rules against the stats of users.
+type Catalog struct {
+	rules []models.AchievementRule
+}
+
+// NewCatalog builds the catalog from the rules in cfg, or from DefaultRules
+// when there are none. Unknown metrics or trash types and duplicate IDs are
+// rejected so a typo does not make an achievement unreachable.
+func NewCatalog(cfg config.AchievementsConfig) (*Catalog, error) {
+	if len(cfg.Rules) == 0 {
+		return &Catalog{rules: DefaultRules}, nil
+	}
+
+	rules := make([]models.AchievementRule, 0, len(cfg.Rules))
+	ids := make(map[string]struct{}, len(cfg.Rules))
+	for _, ruleCfg := range cfg.Rules {
+		rule := models.AchievementRule{
+			ID:          ruleCfg.ID,
+			Name:        ruleCfg.Name,
+			Description: ruleCfg.Description,
+			Metric:      models.AchievementMetric(ruleCfg.Metric),
+			TrashType:   ruleCfg.TrashType,
+			Threshold:   ruleCfg.Threshold,
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return nil, fmt.Errorf("achievements: duplicate id %q", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+
+		if !rule.Metric.IsValid() {
+			return nil, fmt.Errorf("achievements: unknown metric %q for %q", ruleCfg.Metric, rule.ID)
+		}
+		if rule.Metric == models.AchievementMetricTrashType {
+			if models.NewTrashType(rule.TrashType) == models.Undefined {
+				return nil, fmt.Errorf("achievements: unknown trash type %q for %q", rule.TrashType, rule.ID)
+			}
+		} else if rule.TrashType != "" {
+			return nil, fmt.Errorf("achievements: trash type set for %q, which uses metric %q", rule.ID, rule.Metric)
+		}
+		rules = append(rules, rule)
+	}
+
+	return &Catalog{rules: rules}, nil
+}
+
+// DefaultCatalog returns the catalog of DefaultRules.
+func DefaultCatalog() *Catalog {
+	catalog, _ := NewCatalog(config.AchievementsConfig{})
+	return catalog
+}
+
+// Unlock adds the achievements stat now qualifies for to stat.Achievements,
+// unlocked at now, and returns them. Unlocked achievements are never taken
+// away, even if the stats go down again.
+func (c *Catalog) Unlock(stat *models.Stat, now time.Time) []models.Achievement {
+	unlocked := unlockedAt(stat)
+
+	var added []models.Achievement
+	for _, rule := range c.rules {
+		if _, ok := unlocked[rule.ID]; ok || value(rule, stat) < rule.Threshold {
+			continue
+		}
+		achievement := models.Achievement{ID: rule.ID, UnlockedAt: now}
+		stat.Achievements = append(stat.Achievements, achievement)
+		added = append(added, achievement)
+	}
+
+	return added
+}
+
+// Progress returns every achievement of the catalog with the progress of stat
+// toward it.
+func (c *Catalog) Progress(stat *models.Stat) []models.AchievementProgress {
+	unlocked := unlockedAt(stat)
+
+	progress := make([]models.AchievementProgress, len(c.rules))
+	for i, rule := range c.rules {
+		progress[i] = models.AchievementProgress{
+			AchievementRule: rule,
+			Progress:        min(value(rule, stat), rule.Threshold),
+		}
+		if at, ok := unlocked[rule.ID]; ok {
+			progress[i].Progress = rule.Threshold
+			progress[i].Unlocked = true
+			progress[i].UnlockedAt = &at
+		}
+	}
+
+	return progress
+}
+
+func unlockedAt(stat *models.Stat) map[string]time.Time {
+	unlocked := make(map[string]time.Time, len(stat.Achievements))
+	for _, achievement := range stat.Achievements {
+		unlocked[achievement.ID] = achievement.UnlockedAt
+	}
+	return unlocked
+}
+
+func value(rule models.AchievementRule, stat *models.Stat) int {
+	switch rule.Metric {
+	case models.AchievementMetricScans:
+		return stat.FilesScanned
+	case models.AchievementMetricRating:
+		return stat.Rating
+	case models.AchievementMetricTrashType:
+		return stat.TrashByTypes[rule.TrashType]
+	case models.AchievementMetricTypesCollected:
+		collected := 0
+		for trashType, count := range stat.TrashByTypes {
+			if count > 0 && models.NewTrashType(trashType) != models.Undefined {
+				collected++
+			}
+		}
+		return collected
+	case models.AchievementMetricStreak:
+		return stat.LongestStreak
+	}
+	return 0
+}
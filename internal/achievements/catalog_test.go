@@ -0,0 +1,108 @@
+package achievements
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestNewCatalog(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		catalog, err := NewCatalog(config.AchievementsConfig{})
+		require.NoError(t, err)
+
+		assert.Len(t, catalog.Progress(&models.Stat{}), len(DefaultRules))
+	})
+
+	t.Run("rules replace defaults", func(t *testing.T) {
+		catalog, err := NewCatalog(config.AchievementsConfig{Rules: []config.AchievementRuleConfig{
+			{ID: "glass_5", Name: "Glass", Metric: "trash_type", TrashType: "glass", Threshold: 5},
+		}})
+		require.NoError(t, err)
+
+		progress := catalog.Progress(&models.Stat{TrashByTypes: map[string]int{"glass": 2}})
+		require.Len(t, progress, 1)
+		assert.Equal(t, "glass_5", progress[0].ID)
+		assert.Equal(t, 2, progress[0].Progress)
+	})
+
+	tests := []struct {
+		name  string
+		rules []config.AchievementRuleConfig
+	}{
+		{"unknown metric", []config.AchievementRuleConfig{
+			{ID: "a", Name: "A", Metric: "distance", Threshold: 1},
+		}},
+		{"unknown trash type", []config.AchievementRuleConfig{
+			{ID: "a", Name: "A", Metric: "trash_type", TrashType: "wood", Threshold: 1},
+		}},
+		{"trash type on another metric", []config.AchievementRuleConfig{
+			{ID: "a", Name: "A", Metric: "files_scanned", TrashType: "glass", Threshold: 1},
+		}},
+		{"duplicate id", []config.AchievementRuleConfig{
+			{ID: "a", Name: "A", Metric: "files_scanned", Threshold: 1},
+			{ID: "a", Name: "B", Metric: "rating", Threshold: 100},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCatalog(config.AchievementsConfig{Rules: tt.rules})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	catalog := DefaultCatalog()
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	stat := &models.Stat{
+		FilesScanned:  10,
+		LongestStreak: 7,
+		TrashByTypes: map[string]int{
+			"cardboard": 1, "glass": 1, "metal": 1, "paper": 1, "plastic": 1, "trash": 1,
+		},
+		Achievements: []models.Achievement{{ID: "first_scan", UnlockedAt: earlier}},
+	}
+
+	added := catalog.Unlock(stat, now)
+
+	ids := make([]string, len(added))
+	for i, achievement := range added {
+		ids[i] = achievement.ID
+		assert.Equal(t, now, achievement.UnlockedAt)
+	}
+	assert.ElementsMatch(t, []string{"scans_10", "all_types", "streak_7"}, ids)
+	assert.Len(t, stat.Achievements, 4)
+	assert.Equal(t, earlier, stat.Achievements[0].UnlockedAt, "unlocked achievements keep their time")
+
+	assert.Empty(t, catalog.Unlock(stat, now.Add(time.Hour)), "achievements are unlocked once")
+}
+
+func TestProgress(t *testing.T) {
+	catalog, err := NewCatalog(config.AchievementsConfig{Rules: []config.AchievementRuleConfig{
+		{ID: "scans_10", Name: "Ten", Metric: "files_scanned", Threshold: 10},
+		{ID: "rating_100", Name: "Hundred", Metric: "rating", Threshold: 100},
+	}})
+	require.NoError(t, err)
+	unlockedAt := time.Now()
+
+	progress := catalog.Progress(&models.Stat{
+		FilesScanned: 4,
+		Rating:       250,
+		Achievements: []models.Achievement{{ID: "rating_100", UnlockedAt: unlockedAt}},
+	})
+
+	require.Len(t, progress, 2)
+	assert.Equal(t, 4, progress[0].Progress)
+	assert.False(t, progress[0].Unlocked)
+	assert.Nil(t, progress[0].UnlockedAt)
+	assert.Equal(t, 100, progress[1].Progress, "progress is capped at the threshold")
+	assert.True(t, progress[1].Unlocked)
+	assert.Equal(t, unlockedAt, *progress[1].UnlockedAt)
+}
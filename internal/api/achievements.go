@@ -0,0 +1,30 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+// ListAchievements godoc
+// @Summary List achievements
+// @Description Get the full achievement catalog with the progress of the current user toward each achievement.
+// @Description Unlocked achievements carry the time they were unlocked.
+// @Tags users
+// @Produce json
+// @Success 200 {object} dto.AchievementListResponse "Achievements"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 404 {object} errlocal.ErrNotFound "User not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/achievements [get]
+func (s *Server) listAchievements(w http.ResponseWriter, r *http.Request) {
+	stat := utils.GetUser(r.Context()).Stat
+	if stat == nil {
+		stat = &models.Stat{}
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewAchievementListResponse(s.rules.Achievements.Progress(stat)))
+}
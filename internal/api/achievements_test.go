@@ -0,0 +1,39 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/achievements"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestListAchievements(t *testing.T) {
+	server, _, _, _, _ := newTestServer(t)
+	user := &models.User{ID: uuid.New(), Stat: &models.Stat{
+		FilesScanned: 3,
+		Achievements: []models.Achievement{{ID: "first_scan", UnlockedAt: time.Now()}},
+	}}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/achievements", nil)
+	req = req.WithContext(utils.SetUser(req.Context(), user))
+	rr := httptest.NewRecorder()
+	server.listAchievements(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp dto.AchievementListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	assert.Equal(t, len(achievements.DefaultRules), resp.Total)
+	assert.Equal(t, 1, resp.Unlocked)
+	assert.True(t, resp.Achievements[0].Unlocked)
+	assert.Equal(t, "scans_10", resp.Achievements[1].ID)
+	assert.Equal(t, 3, resp.Achievements[1].Progress)
+}
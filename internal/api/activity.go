@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+// GetActivity godoc
+// @Summary Get scan activity
+// @Description Get the number of scans the current user made on each day of the range, for a contribution graph.
+// @Description Days are calendar days in the user's time zone; days without scans have a zero count.
+// @Tags users
+// @Produce json
+// @Param from query string false "First day, inclusive (YYYY-MM-DD); defaults to 364 days before to"
+// @Param to query string false "Last day, inclusive (YYYY-MM-DD); defaults to today"
+// @Success 200 {object} dto.ActivityResponse "Daily activity"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid range"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 404 {object} errlocal.ErrNotFound "User not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/activity [get]
+func (s *Server) getActivity(w http.ResponseWriter, r *http.Request) {
+	user := utils.GetUser(r.Context())
+	now := time.Now()
+	rng, err := dto.NewActivityRange(r.URL.Query(), now.In(user.Location()))
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid range", err.Error(), nil))
+		return
+	}
+
+	activity, err := s.store.GetActivity(r.Context(), user.ID, rng.From, rng.To)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewActivityResponse(user, rng, activity, now))
+}
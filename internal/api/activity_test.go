@@ -0,0 +1,100 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestGetActivity(t *testing.T) {
+	newRequest := func(user *models.User, query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/activity?"+query, nil)
+		return req.WithContext(utils.SetUser(req.Context(), user))
+	}
+
+	t.Run("range with zero days filled in", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Timezone: "Europe/Berlin", Stat: &models.Stat{LongestStreak: 4}}
+		from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+
+		storeMock.EXPECT().GetActivity(mock.Anything, user.ID, from, to).Return([]models.ActivityDay{
+			{Day: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), Scans: 2},
+			{Day: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), Scans: 1},
+		}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getActivity(rr, newRequest(user, "from=2026-03-01&to=2026-03-07"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.ActivityResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "Europe/Berlin", resp.Timezone)
+		assert.Equal(t, 3, resp.Total)
+		assert.Equal(t, 4, resp.LongestStreak)
+		assert.Equal(t, 0, resp.CurrentStreak)
+		require.Len(t, resp.Days, 7)
+		assert.Equal(t, dto.ActivityDayResponse{Date: "2026-03-01", Count: 0}, resp.Days[0])
+		assert.Equal(t, dto.ActivityDayResponse{Date: "2026-03-02", Count: 2}, resp.Days[1])
+		assert.Equal(t, dto.ActivityDayResponse{Date: "2026-03-05", Count: 1}, resp.Days[4])
+	})
+
+	t.Run("defaults to the year up to today", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Stat: &models.Stat{
+			CurrentStreak: 3, LongestStreak: 3, LastScannedAt: time.Now(),
+		}}
+		today := models.Day(time.Now().UTC())
+
+		storeMock.EXPECT().GetActivity(mock.Anything, user.ID, today.AddDate(0, 0, -364), today).
+			Return([]models.ActivityDay{}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getActivity(rr, newRequest(user, ""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.ActivityResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "UTC", resp.Timezone)
+		assert.Len(t, resp.Days, 365)
+		assert.Equal(t, 3, resp.CurrentStreak)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		for _, query := range []string{
+			"from=yesterday",
+			"from=2026-03-07&to=2026-03-01",
+			"from=2024-01-01&to=2026-01-01",
+		} {
+			server, _, _, _, _ := newTestServer(t)
+			rr := httptest.NewRecorder()
+			server.getActivity(rr, newRequest(&models.User{ID: uuid.New()}, query))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New()}
+
+		storeMock.EXPECT().GetActivity(mock.Anything, user.ID, mock.Anything, mock.Anything).
+			Return(nil, errlocal.NewErrInternal("db error", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.getActivity(rr, newRequest(user, ""))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
@@ -1,23 +1,36 @@
 package api
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/trashscanner/trashscanner_api/internal/api/dto"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
 // getUsersList godoc
 // @Summary      Get users list
-// @Description  Get paginated list of all users with their stats
+// @Description  Get paginated list of users with their stats, optionally searched, filtered and sorted
 // @Tags         admin
 // @Accept       json
 // @Produce      json
-// @Param        offset  query     int  false  "Offset"            default(0)
-// @Param        limit   query     int  false  "Limit"             default(100)
+// @Param        offset           query  int     false  "Offset"                                  default(0)
+// @Param        limit            query  int     false  "Limit"                                   default(100)
+// @Param        search           query  string  false  "Substring of the login or the name"
+// @Param        role             query  string  false  "Role"  Enums(admin,moderator,user,anonymous)
+// @Param        deleted          query  bool    false  "Only deleted (true) or not deleted (false) users"
+// @Param        banned           query  bool    false  "Only banned (true) or not banned (false) users"
+// @Param        status           query  string  false  "Stats status"
+// @Param        last_login_from  query  string  false  "Last login at or after (RFC 3339)"
+// @Param        last_login_to    query  string  false  "Last login before (RFC 3339)"
+// @Param        sort             query  string  false  "Sort field"  Enums(created_at,rating,files_scanned,last_login)
+// @Param        order            query  string  false  "Sort order"  Enums(asc,desc)  default(desc)
 // @Success      200     {object}  dto.AdminUserListResponse
 // @Failure      400     {object}  errlocal.ErrBadRequest
 // @Failure      401     {object}  errlocal.ErrUnauthorized
@@ -32,13 +45,19 @@ func (s *Server) getUsersList(w http.ResponseWriter, r *http.Request) {
 		limit = defaultLimit
 	}
 
-	users, err := s.store.GetAdminUsers(r.Context(), int32(limit), int32(offset))
+	filter, err := dto.NewUserFilter(r.URL.Query())
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid filter", err.Error(), nil))
+		return
+	}
+
+	users, err := s.store.GetAdminUsers(r.Context(), filter, int32(limit), int32(offset))
 	if err != nil {
 		s.WriteError(w, r, err)
 		return
 	}
 
-	totalCount, err := s.store.CountUsers(r.Context())
+	totalCount, err := s.store.CountAdminUsers(r.Context(), filter)
 	if err != nil {
 		s.WriteError(w, r, err)
 		return
@@ -74,6 +93,8 @@ func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
 		s.WriteError(w, r, err)
 		return
 	}
+	s.audit(r, userEvent(models.AuditUserCreate, model.ID), nil,
+		map[string]any{"login": model.Login, "name": model.Name, "role": model.Role})
 
 	s.WriteResponse(w, r, http.StatusCreated, dto.UserResponse(*model))
 }
@@ -122,3 +143,366 @@ func (s *Server) getAdminUser(w http.ResponseWriter, r *http.Request) {
 
 	s.WriteResponse(w, r, http.StatusOK, dto.NewAdminUserDetailResponse(*user, predictions, limit, offset))
 }
+
+// unlockUser godoc
+// @Summary      Unlock user login
+// @Description  Clear the brute-force lock of a user so they can log in again immediately
+// @Tags         admin
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Success      204
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/unlock [post]
+func (s *Server) unlockUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+
+	if err := s.store.UnlockUserLogin(r.Context(), userID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditUserUnlock, userID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// updateAdminUser godoc
+// @Summary      Update user as admin
+// @Description  Change the name and role of a user. Admins cannot change their own role.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Param        request body      dto.UpdateAdminUserRequest true "Fields to change"
+// @Success      200     {object}  dto.AdminUserResponse
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id} [patch]
+func (s *Server) updateAdminUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+	req, err := dto.GetRequestBody[dto.UpdateAdminUserRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body or validation failed", err.Error(), nil))
+		return
+	}
+	if req.Name == nil && req.Role == nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("nothing to update", "", nil))
+		return
+	}
+	if req.Role != nil && userID == utils.GetUser(r.Context()).ID {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("cannot change your own role", "", nil))
+		return
+	}
+
+	before, err := s.store.GetAdminUserByID(r.Context(), userID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if err := s.store.UpdateUserByAdmin(r.Context(), userID, req.Name, req.Role); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	user, err := s.store.GetAdminUserByID(r.Context(), userID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditUserUpdate, userID),
+		map[string]any{"name": before.Name, "role": before.Role},
+		map[string]any{"name": user.Name, "role": user.Role})
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewAdminUserResponse(*user))
+}
+
+// checkAdminTarget refuses non-admins acting on an admin, so that staff
+// cannot turn their permissions against those who granted them.
+func (s *Server) checkAdminTarget(ctx context.Context, actor *models.User, userID uuid.UUID) error {
+	target, err := s.store.GetAdminUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if target.Role == models.RoleAdmin && actor.Role != models.RoleAdmin {
+		return errlocal.NewErrForbidden("only admins can act on admins", "",
+			map[string]any{"user_id": userID.String()})
+	}
+	return nil
+}
+
+// banUser godoc
+// @Summary      Ban user
+// @Description  Block a user from logging in and from using the API, optionally until a given time.
+// @Description  Active sessions are revoked. Only admins can ban other admins.
+// @Tags         admin
+// @Accept       json
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Param        request body      dto.BanUserRequest true "Reason and optional expiry"
+// @Success      204
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/ban [post]
+func (s *Server) banUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+	req, err := dto.GetRequestBody[dto.BanUserRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body or validation failed", err.Error(), nil))
+		return
+	}
+	if req.Until != nil && !req.Until.After(time.Now()) {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("ban must end in the future", "", nil))
+		return
+	}
+
+	actor := utils.GetUser(r.Context())
+	if userID == actor.ID {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("cannot ban yourself", "", nil))
+		return
+	}
+	if err := s.checkAdminTarget(r.Context(), actor, userID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	if err := s.store.BanUser(r.Context(), userID, req.Until, req.Reason); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditUserBan, userID), nil,
+		map[string]any{"reason": req.Reason, "until": req.Until})
+	if err := s.authManager.RevokeAllUserTokens(r.Context(), userID); err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to revoke user sessions", err.Error(),
+			map[string]any{"user_id": userID.String()}))
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// unbanUser godoc
+// @Summary      Lift user ban
+// @Description  Lift the ban of a user before it expires. Only admins can lift the ban of an admin.
+// @Tags         admin
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Success      204
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/ban [delete]
+func (s *Server) unbanUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+	if err := s.checkAdminTarget(r.Context(), utils.GetUser(r.Context()), userID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	if err := s.store.UnbanUser(r.Context(), userID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditUserUnban, userID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// restoreUser godoc
+// @Summary      Restore deleted user
+// @Description  Undo the deletion of a user account
+// @Tags         admin
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Success      204
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      409     {object}  errlocal.ErrConflict "Email taken by another account"
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/restore [post]
+func (s *Server) restoreUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+
+	if err := s.store.RestoreUser(r.Context(), userID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditUserRestore, userID),
+		map[string]any{"deleted": true}, map[string]any{"deleted": false})
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// getUserLogins godoc
+// @Summary      Get user login history
+// @Description  Get the login attempts of a user, newest first
+// @Tags         admin
+// @Produce      json
+// @Param        user_id  path   string  true   "User ID (UUID)"
+// @Param        offset   query  int     false  "Offset"  default(0)
+// @Param        limit    query  int     false  "Limit"   default(100)
+// @Param        success  query  bool    false  "Only successful (true) or failed (false) attempts"
+// @Param        from     query  string  false  "Attempts at or after (RFC 3339)"
+// @Param        to       query  string  false  "Attempts before (RFC 3339)"
+// @Success      200      {object}  dto.LoginHistoryListResponse
+// @Failure      400      {object}  errlocal.ErrBadRequest
+// @Failure      401      {object}  errlocal.ErrUnauthorized
+// @Failure      403      {object}  errlocal.ErrForbidden
+// @Failure      500      {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/logins [get]
+func (s *Server) getUserLogins(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+
+	s.writeLoginHistoryPage(w, r, userID)
+}
+
+// getUserSessions godoc
+// @Summary      Get user sessions
+// @Description  Get the active sessions (unexpired refresh tokens) of a user, newest first
+// @Tags         admin
+// @Produce      json
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Success      200     {array}   dto.SessionResponse
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/sessions [get]
+func (s *Server) getUserSessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+
+	sessions, err := s.store.GetActiveSessions(r.Context(), userID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewSessionResponses(sessions))
+}
+
+// revokeUserSession godoc
+// @Summary      Revoke user session
+// @Description  Revoke a session of a user so it cannot be refreshed. The access token
+// @Description  already issued for it stays valid until it expires. Only admins can revoke the sessions of an admin.
+// @Tags         admin
+// @Param        user_id    path      string true  "User ID (UUID)"
+// @Param        session_id path      string true  "Session ID (UUID)"
+// @Success      204
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/sessions/{session_id} [delete]
+func (s *Server) revokeUserSession(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+	sessionID, err := uuid.Parse(mux.Vars(r)[sessionIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid session ID", err.Error(), nil))
+		return
+	}
+	if err := s.checkAdminTarget(r.Context(), utils.GetUser(r.Context()), userID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	if err := s.store.RevokeSession(r.Context(), userID, sessionID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, models.AuditEvent{
+		Action:     models.AuditSessionRevoke,
+		TargetType: models.AuditTargetSession,
+		TargetID:   sessionID.String(),
+	}, nil, map[string]any{"user_id": userID.String()})
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// purgeUser godoc
+// @Summary      Purge user
+// @Description  Permanently delete a user with their predictions, stats and stored files. This cannot be undone.
+// @Description  The only owner of an organization cannot be purged.
+// @Tags         admin
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Success      204
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      409     {object}  errlocal.ErrConflict
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id} [delete]
+func (s *Server) purgeUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+	if userID == utils.GetUser(r.Context()).ID {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("cannot purge yourself", "", nil))
+		return
+	}
+
+	// Storage objects are removed last so that a failure there rolls the
+	// database back and the purge can be retried.
+	if err := s.store.ExecTx(r.Context(), func(tx store.Store) error {
+		if err := checkSoleOwner(r.Context(), tx, userID); err != nil {
+			return err
+		}
+		if err := tx.PurgeUser(r.Context(), userID); err != nil {
+			return err
+		}
+		if err := s.fileStore.DeleteUserObjects(r.Context(), userID.String()); err != nil {
+			return errlocal.NewErrInternal("failed to delete user files", err.Error(),
+				map[string]any{"user_id": userID.String()})
+		}
+		return nil
+	}); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditUserPurge, userID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
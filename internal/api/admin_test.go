@@ -4,19 +4,27 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	storemocks "github.com/trashscanner/trashscanner_api/internal/store/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
 func TestGetUsersList(t *testing.T) {
@@ -46,8 +54,8 @@ func TestGetUsersList(t *testing.T) {
 			},
 		}
 
-		storeMock.EXPECT().GetAdminUsers(mock.Anything, int32(10), int32(0)).Return(dbUsers, nil)
-		storeMock.EXPECT().CountUsers(mock.Anything).Return(int64(1), nil)
+		storeMock.EXPECT().GetAdminUsers(mock.Anything, models.UserFilter{}, int32(10), int32(0)).Return(dbUsers, nil)
+		storeMock.EXPECT().CountAdminUsers(mock.Anything, models.UserFilter{}).Return(int64(1), nil)
 
 		server.getUsersList(rr, req)
 
@@ -80,8 +88,9 @@ func TestGetUsersList(t *testing.T) {
 
 		// limit=0 is overridden to 100
 		// offset=-1 is overridden by utils.GetQueryParam to 0
-		storeMock.EXPECT().GetAdminUsers(mock.Anything, int32(100), int32(0)).Return([]models.User{}, nil)
-		storeMock.EXPECT().CountUsers(mock.Anything).Return(int64(0), nil)
+		storeMock.EXPECT().GetAdminUsers(mock.Anything, models.UserFilter{}, int32(100), int32(0)).
+			Return([]models.User{}, nil)
+		storeMock.EXPECT().CountAdminUsers(mock.Anything, models.UserFilter{}).Return(int64(0), nil)
 
 		server.getUsersList(rr, req)
 
@@ -94,12 +103,66 @@ func TestGetUsersList(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users?limit=10&offset=0", nil)
 		rr := httptest.NewRecorder()
 
-		storeMock.EXPECT().GetAdminUsers(mock.Anything, int32(10), int32(0)).Return(nil, assert.AnError)
+		storeMock.EXPECT().GetAdminUsers(mock.Anything, models.UserFilter{}, int32(10), int32(0)).Return(nil, assert.AnError)
 
 		server.getUsersList(rr, req)
 
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
+
+	t.Run("search_filters_and_sort", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users?search=%20ann%20&role=moderator"+
+			"&deleted=false&banned=true&status=eco_scout&last_login_from=2026-01-01T00:00:00Z"+
+			"&last_login_to=2026-02-01T00:00:00Z&sort=files_scanned&order=asc", nil)
+		rr := httptest.NewRecorder()
+
+		role := models.RoleModerator
+		status := models.UserStatusEcoScout
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+		filter := models.UserFilter{
+			Search:        "ann",
+			Role:          &role,
+			Deleted:       utils.Ptr(false),
+			Banned:        utils.Ptr(true),
+			Status:        &status,
+			LastLoginFrom: &from,
+			LastLoginTo:   &to,
+			SortBy:        models.UserSortFilesScanned,
+			SortAsc:       true,
+		}
+		storeMock.EXPECT().GetAdminUsers(mock.Anything, filter, int32(defaultLimit), int32(defaultOffset)).
+			Return([]models.User{}, nil).Once()
+		storeMock.EXPECT().CountAdminUsers(mock.Anything, filter).Return(int64(7), nil).Once()
+
+		server.getUsersList(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var res dto.AdminUserListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		assert.Equal(t, int64(7), res.TotalCount)
+	})
+
+	for name, query := range map[string]string{
+		"unknown role":     "role=root",
+		"unknown status":   "status=hero",
+		"bad boolean":      "banned=maybe",
+		"bad time":         "last_login_from=yesterday",
+		"empty time range": "last_login_from=2026-02-01T00:00:00Z&last_login_to=2026-01-01T00:00:00Z",
+		"unknown sort":     "sort=password",
+		"unknown order":    "order=up",
+	} {
+		t.Run("invalid_filter_"+name, func(t *testing.T) {
+			server, _, _, _, _ := newTestServer(t)
+
+			rr := httptest.NewRecorder()
+			server.getUsersList(rr, httptest.NewRequest(http.MethodGet, "/api/v1/admin/users?"+query, nil))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
 }
 
 func TestGetAdminUser(t *testing.T) {
@@ -269,8 +332,10 @@ func TestCreateUser_Admin(t *testing.T) {
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users", bytes.NewBuffer(body))
 		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(utils.SetUser(req.Context(), &models.User{ID: uuid.New(), Role: models.RoleAdmin}))
 		rr := httptest.NewRecorder()
 
+		expectAudit(storeMock, models.AuditUserCreate)
 		storeMock.EXPECT().CreateUser(mock.Anything, mock.AnythingOfType("*models.User")).RunAndReturn(
 			func(ctx context.Context, u *models.User) error {
 				assert.NotEmpty(t, u.HashedPassword)
@@ -342,6 +407,7 @@ func TestCreateUser_Admin(t *testing.T) {
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users", bytes.NewBuffer(body))
 		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(utils.SetUser(req.Context(), &models.User{ID: uuid.New(), Role: models.RoleAdmin}))
 		rr := httptest.NewRecorder()
 
 		storeMock.EXPECT().CreateUser(mock.Anything, mock.AnythingOfType("*models.User")).Return(assert.AnError)
@@ -351,3 +417,447 @@ func TestCreateUser_Admin(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
 }
+
+func TestUnlockUser(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		userID := uuid.New()
+
+		storeMock.EXPECT().UnlockUserLogin(mock.Anything, userID).Return(nil)
+		expectAudit(storeMock, models.AuditUserUnlock)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/"+userID.String()+"/unlock", nil)
+		req = mux.SetURLVars(req, map[string]string{userIDTag: userID.String()})
+		req = req.WithContext(utils.SetUser(req.Context(), &models.User{ID: uuid.New(), Role: models.RoleAdmin}))
+		rr := httptest.NewRecorder()
+
+		server.unlockUser(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/invalid/unlock", nil)
+		req = mux.SetURLVars(req, map[string]string{userIDTag: "invalid"})
+		rr := httptest.NewRecorder()
+
+		server.unlockUser(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		userID := uuid.New()
+
+		storeMock.EXPECT().UnlockUserLogin(mock.Anything, userID).
+			Return(errlocal.NewErrNotFound("user not found", "", nil))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/"+userID.String()+"/unlock", nil)
+		req = mux.SetURLVars(req, map[string]string{userIDTag: userID.String()})
+		rr := httptest.NewRecorder()
+
+		server.unlockUser(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestUpdateAdminUser(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		userID := uuid.New()
+		role := models.RoleModerator
+
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, userID).
+			Return(&models.User{ID: userID, Name: "user", Role: models.RoleUser}, nil).Once()
+		storeMock.EXPECT().UpdateUserByAdmin(mock.Anything, userID, (*string)(nil), &role).Return(nil).Once()
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, userID).
+			Return(&models.User{ID: userID, Name: "user", Role: role}, nil).Once()
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditUserUpdate && e.ActorID == admin.ID && e.TargetID == userID.String() &&
+				string(e.Before) == `{"role":"user"}` && string(e.After) == `{"role":"moderator"}`
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.updateAdminUser(rr, newRequest(http.MethodPatch, "/api/v1/admin/users/"+userID.String(),
+			`{"role":"moderator"}`, admin, map[string]string{userIDTag: userID.String()}))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.AdminUserResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, role, resp.Role)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		userID := uuid.NewString()
+
+		rr := httptest.NewRecorder()
+		server.updateAdminUser(rr, newRequest(http.MethodPatch, "/api/v1/admin/users/"+userID,
+			`{"role":"anonymous"}`, admin, map[string]string{userIDTag: userID}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("own role", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.updateAdminUser(rr, newRequest(http.MethodPatch, "/api/v1/admin/users/"+admin.ID.String(),
+			`{"role":"user"}`, admin, map[string]string{userIDTag: admin.ID.String()}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestBanUser(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	moderator := &models.User{ID: uuid.New(), Role: models.RoleModerator}
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		userID := uuid.New()
+		until := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		body := `{"reason":"spam","until":"` + until.Format(time.RFC3339) + `"}`
+
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, userID).
+			Return(&models.User{ID: userID, Role: models.RoleUser}, nil).Once()
+		storeMock.EXPECT().BanUser(mock.Anything, userID, mock.MatchedBy(func(u *time.Time) bool {
+			return u != nil && u.Equal(until)
+		}), "spam").Return(nil).Once()
+		expectAudit(storeMock, models.AuditUserBan)
+		authMock.EXPECT().RevokeAllUserTokens(mock.Anything, userID).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.banUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+userID.String(), body, moderator,
+			map[string]string{userIDTag: userID.String()}))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("expiry in the past", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		body := `{"reason":"spam","until":"2000-01-01T00:00:00Z"}`
+		userID := uuid.NewString()
+
+		rr := httptest.NewRecorder()
+		server.banUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+userID, body, admin,
+			map[string]string{userIDTag: userID}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.banUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+admin.ID.String(),
+			`{"reason":"x"}`, admin, map[string]string{userIDTag: admin.ID.String()}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("moderator cannot ban admin", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, admin.ID).Return(admin, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.banUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+admin.ID.String(),
+			`{"reason":"x"}`, moderator, map[string]string{userIDTag: admin.ID.String()}))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestUnbanAndRestoreUser(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	userID := uuid.New()
+
+	t.Run("unban", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, userID).
+			Return(&models.User{ID: userID, Role: models.RoleUser}, nil).Once()
+		storeMock.EXPECT().UnbanUser(mock.Anything, userID).Return(nil).Once()
+		expectAudit(storeMock, models.AuditUserUnban)
+
+		rr := httptest.NewRecorder()
+		server.unbanUser(rr, newRequest(http.MethodDelete, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()}))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("moderator cannot unban admin", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		moderator := &models.User{ID: uuid.New(), Role: models.RoleModerator}
+		banned := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, banned.ID).Return(banned, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.unbanUser(rr, newRequest(http.MethodDelete, "/api/v1/admin/users/"+banned.ID.String(), "", moderator,
+			map[string]string{userIDTag: banned.ID.String()}))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("restore conflict", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		storeMock.EXPECT().RestoreUser(mock.Anything, userID).
+			Return(errlocal.NewErrConflict("email taken", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.restoreUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()}))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestGetUserLogins(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	userID := uuid.New()
+
+	t.Run("filtered page", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		filter := models.LoginHistoryFilter{Success: utils.Ptr(false)}
+		ip := netip.MustParseAddr("203.0.113.7")
+
+		storeMock.EXPECT().ListLoginHistory(mock.Anything, userID, filter, int32(5), int32(10)).
+			Return([]models.LoginHistory{
+				{ID: uuid.New(), UserID: pgtype.UUID{Bytes: userID, Valid: true}, IpAddress: &ip},
+			}, nil).Once()
+		storeMock.EXPECT().CountLoginHistory(mock.Anything, userID, filter).Return(int64(11), nil).Once()
+
+		req := newRequest(http.MethodGet, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()})
+		req.URL.RawQuery = "success=false&limit=5&offset=10"
+		rr := httptest.NewRecorder()
+		server.getUserLogins(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var res dto.LoginHistoryListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		assert.Equal(t, int64(11), res.TotalCount)
+		require.Len(t, res.Logins, 1)
+		require.NotNil(t, res.Logins[0].IPAddress)
+		assert.Equal(t, "203.0.113.7", *res.Logins[0].IPAddress)
+	})
+
+	t.Run("invalid user id", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getUserLogins(rr, newRequest(http.MethodGet, "/api/v1/admin/users/"+"nope", "", admin,
+			map[string]string{userIDTag: "nope"}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestUserSessions(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	sessionRequest := func(session string) *http.Request {
+		req := newRequest(http.MethodDelete, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()})
+		return mux.SetURLVars(req, map[string]string{userIDTag: userID.String(), sessionIDTag: session})
+	}
+	expectTarget := func(storeMock *storemocks.Store) {
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, userID).
+			Return(&models.User{ID: userID, Role: models.RoleUser}, nil).Once()
+	}
+
+	t.Run("list", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		storeMock.EXPECT().GetActiveSessions(mock.Anything, userID).
+			Return([]models.RefreshToken{{ID: sessionID, UserID: userID, TokenHash: "secret"}}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getUserSessions(rr, newRequest(http.MethodGet, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()}))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "secret")
+		var res []dto.SessionResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Len(t, res, 1)
+		assert.Equal(t, sessionID, res[0].ID)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		expectTarget(storeMock)
+		storeMock.EXPECT().RevokeSession(mock.Anything, userID, sessionID).Return(nil).Once()
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditSessionRevoke && e.TargetType == models.AuditTargetSession &&
+				e.TargetID == sessionID.String()
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.revokeUserSession(rr, sessionRequest(sessionID.String()))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("revoke unknown session", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		expectTarget(storeMock)
+		storeMock.EXPECT().RevokeSession(mock.Anything, userID, sessionID).
+			Return(errlocal.NewErrNotFound("session not found", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.revokeUserSession(rr, sessionRequest(sessionID.String()))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("invalid session id", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.revokeUserSession(rr, sessionRequest("nope"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("moderator cannot revoke admin sessions", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		moderator := &models.User{ID: uuid.New(), Role: models.RoleModerator}
+		target := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+
+		storeMock.EXPECT().GetAdminUserByID(mock.Anything, target.ID).Return(target, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.revokeUserSession(rr, newRequest(http.MethodDelete, "/api/v1/admin/users/"+target.ID.String(), "",
+			moderator, map[string]string{userIDTag: target.ID.String(), sessionIDTag: sessionID.String()}))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestPurgeUser(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, fileStoreMock, _ := newTestServer(t)
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().CountSoleOwnedOrganizations(mock.Anything, userID).Return(0, nil).Once()
+		storeMock.EXPECT().PurgeUser(mock.Anything, userID).Return(nil).Once()
+		fileStoreMock.EXPECT().DeleteUserObjects(mock.Anything, userID.String()).Return(nil).Once()
+		expectAudit(storeMock, models.AuditUserPurge)
+
+		rr := httptest.NewRecorder()
+		server.purgeUser(rr, newRequest(http.MethodDelete, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()}))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("storage failure", func(t *testing.T) {
+		server, storeMock, _, fileStoreMock, _ := newTestServer(t)
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().CountSoleOwnedOrganizations(mock.Anything, userID).Return(0, nil).Once()
+		storeMock.EXPECT().PurgeUser(mock.Anything, userID).Return(nil).Once()
+		fileStoreMock.EXPECT().DeleteUserObjects(mock.Anything, userID.String()).Return(errors.New("minio down")).Once()
+
+		rr := httptest.NewRecorder()
+		server.purgeUser(rr, newRequest(http.MethodDelete, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()}))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("only owner of an organization", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().CountSoleOwnedOrganizations(mock.Anything, userID).Return(1, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.purgeUser(rr, newRequest(http.MethodDelete, "/api/v1/admin/users/"+userID.String(), "", admin,
+			map[string]string{userIDTag: userID.String()}))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.purgeUser(rr, newRequest(http.MethodDelete, "/api/v1/admin/users/"+admin.ID.String(), "", admin,
+			map[string]string{userIDTag: admin.ID.String()}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestAdminRoutes_Permissions(t *testing.T) {
+	staffServer := func(t *testing.T, role models.Role) (*Server, *storemocks.Store) {
+		t.Helper()
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		server.initRouter()
+
+		staff := &models.User{ID: uuid.New(), Login: "staff", Role: role}
+		authMock.EXPECT().Parse("token").Return(&auth.Claims{
+			UserID: staff.ID.String(),
+			Login:  staff.Login,
+			Role:   string(role),
+		}, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, staff.ID, false).Return(staff, nil).Once()
+		return server, storeMock
+	}
+	serve := func(server *Server, method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(`{}`))
+		req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "token"})
+		rr := httptest.NewRecorder()
+		server.router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("moderator reads users", func(t *testing.T) {
+		server, storeMock := staffServer(t, models.RoleModerator)
+		storeMock.EXPECT().GetAdminUsers(mock.Anything, models.UserFilter{}, int32(defaultLimit), int32(defaultOffset)).
+			Return([]models.User{}, nil).Once()
+		storeMock.EXPECT().CountAdminUsers(mock.Anything, models.UserFilter{}).Return(0, nil).Once()
+
+		rr := serve(server, http.MethodGet, "/api/v1/admin/users")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("moderator cannot create users", func(t *testing.T) {
+		server, _ := staffServer(t, models.RoleModerator)
+
+		rr := serve(server, http.MethodPost, "/api/v1/admin/users")
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("moderator cannot unlock users", func(t *testing.T) {
+		server, _ := staffServer(t, models.RoleModerator)
+
+		rr := serve(server, http.MethodPost, "/api/v1/admin/users/"+uuid.NewString()+"/unlock")
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("user cannot read users", func(t *testing.T) {
+		server, _ := staffServer(t, models.RoleUser)
+
+		rr := serve(server, http.MethodGet, "/api/v1/admin/users")
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
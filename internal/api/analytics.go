@@ -0,0 +1,112 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// analyticsCacheSize bounds the number of filters cached at once. The range of
+// a filter comes from the request, so the keys are not limited otherwise.
+const analyticsCacheSize = 32
+
+// analyticsCache keeps computed analytics for a short time so that dashboards
+// polling the endpoint don't rerun the aggregates on every request.
+type analyticsCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[models.AnalyticsFilter]analyticsEntry
+}
+
+type analyticsEntry struct {
+	analytics *models.Analytics
+	expiresAt time.Time
+}
+
+func newAnalyticsCache(ttl time.Duration) *analyticsCache {
+	return &analyticsCache{ttl: ttl, entries: make(map[models.AnalyticsFilter]analyticsEntry)}
+}
+
+func (c *analyticsCache) get(filter models.AnalyticsFilter, now time.Time) (*models.Analytics, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[filter]
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.analytics, true
+}
+
+func (c *analyticsCache) put(filter models.AnalyticsFilter, analytics *models.Analytics, now time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if _, ok := c.entries[filter]; !ok && len(c.entries) >= analyticsCacheSize {
+		c.evictOldest()
+	}
+	c.entries[filter] = analyticsEntry{analytics: analytics, expiresAt: now.Add(c.ttl)}
+}
+
+// evictOldest drops the entry that expires first. It must be called with mu
+// held.
+func (c *analyticsCache) evictOldest() {
+	var oldest models.AnalyticsFilter
+	var oldestExpiry time.Time
+	for key, entry := range c.entries {
+		if oldestExpiry.IsZero() || entry.expiresAt.Before(oldestExpiry) {
+			oldest, oldestExpiry = key, entry.expiresAt
+		}
+	}
+	delete(c.entries, oldest)
+}
+
+// getAnalytics godoc
+// @Summary      Get analytics
+// @Description  Get registrations, logins and predictions grouped by time bucket, and predictor performance
+// @Tags         admin
+// @Produce      json
+// @Param        bucket  query  string  false  "Bucket width"  Enums(day,week,month)  default(day)
+// @Param        from    query  string  false  "Range start, inclusive (RFC 3339); defaults to 30 days before to"
+// @Param        to      query  string  false  "Range end, exclusive (RFC 3339); defaults to the end of today (UTC)"
+// @Success      200     {object}  models.Analytics
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/analytics [get]
+func (s *Server) getAnalytics(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	filter, err := dto.NewAnalyticsFilter(r.URL.Query(), now)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid filter", err.Error(), nil))
+		return
+	}
+
+	if analytics, ok := s.analytics.get(filter, now); ok {
+		s.WriteResponse(w, r, http.StatusOK, analytics)
+		return
+	}
+
+	analytics, err := s.store.GetAnalytics(r.Context(), filter)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.analytics.put(filter, analytics, now)
+
+	s.WriteResponse(w, r, http.StatusOK, analytics)
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestGetAnalytics(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/analytics"+query, nil)
+		return req.WithContext(utils.SetUser(req.Context(), admin))
+	}
+
+	t.Run("explicit range", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		filter := models.AnalyticsFilter{
+			Bucket: models.AnalyticsBucketWeek,
+			From:   time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+			To:     time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC),
+		}
+
+		storeMock.EXPECT().GetAnalytics(mock.Anything, filter).Return(&models.Analytics{
+			Bucket:        filter.Bucket,
+			From:          filter.From,
+			To:            filter.To,
+			Registrations: []models.CountBucket{{Start: filter.From, Count: 4}},
+			Predictor:     models.PredictorPerformance{Completed: 3, Failed: 1, FailureRate: 0.25},
+		}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getAnalytics(rr, newRequest("?bucket=week&from=2026-01-05T00:00:00Z&to=2026-02-02T00:00:00Z"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp models.Analytics
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, models.AnalyticsBucketWeek, resp.Bucket)
+		require.Len(t, resp.Registrations, 1)
+		assert.Equal(t, int64(4), resp.Registrations[0].Count)
+		assert.Equal(t, 0.25, resp.Predictor.FailureRate)
+	})
+
+	t.Run("defaults to the last 30 days", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetAnalytics(mock.Anything, mock.MatchedBy(func(f models.AnalyticsFilter) bool {
+			return f.Bucket == models.AnalyticsBucketDay && f.To.After(time.Now()) &&
+				f.To.Sub(f.From) == 30*24*time.Hour
+		})).Return(&models.Analytics{}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getAnalytics(rr, newRequest(""))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("served from cache", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetAnalytics(mock.Anything, mock.Anything).Return(&models.Analytics{}, nil).Once()
+
+		for range 2 {
+			rr := httptest.NewRecorder()
+			server.getAnalytics(rr, newRequest("?bucket=month"))
+			assert.Equal(t, http.StatusOK, rr.Code)
+		}
+	})
+
+	t.Run("cache disabled", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		server.analytics = newAnalyticsCache(0)
+
+		storeMock.EXPECT().GetAnalytics(mock.Anything, mock.Anything).Return(&models.Analytics{}, nil).Twice()
+
+		for range 2 {
+			rr := httptest.NewRecorder()
+			server.getAnalytics(rr, newRequest(""))
+			assert.Equal(t, http.StatusOK, rr.Code)
+		}
+	})
+
+	t.Run("invalid bucket", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getAnalytics(rr, newRequest("?bucket=year"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("inverted range", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getAnalytics(rr, newRequest("?from=2026-02-01T00:00:00Z&to=2026-01-01T00:00:00Z"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestAnalyticsCache(t *testing.T) {
+	now := time.Now()
+	cache := newAnalyticsCache(time.Minute)
+	filterAt := func(i int) models.AnalyticsFilter {
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * time.Hour)
+		return models.AnalyticsFilter{Bucket: models.AnalyticsBucketDay, From: from, To: from.Add(24 * time.Hour)}
+	}
+
+	for i := range analyticsCacheSize + 1 {
+		cache.put(filterAt(i), &models.Analytics{}, now.Add(time.Duration(i)*time.Second))
+	}
+
+	assert.Len(t, cache.entries, analyticsCacheSize)
+	_, ok := cache.get(filterAt(0), now)
+	assert.False(t, ok, "oldest entry is evicted")
+	_, ok = cache.get(filterAt(analyticsCacheSize), now)
+	assert.True(t, ok)
+}
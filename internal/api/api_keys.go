@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	apiKeyHeader    = "X-API-Key"
+	apiKeyIDTag     = "api_key_id"
+	apiKeyPrefix    = "tsk_"
+	apiKeySize      = 32
+	apiKeyShownSize = 8
+)
+
+// authenticateAPIKey resolves the owner of a raw API key. Revoked, expired and
+// unknown keys as well as keys of deleted users are rejected alike.
+func (s *Server) authenticateAPIKey(ctx context.Context, rawKey string) (*models.User, *models.APIKey, error) {
+	invalid := errlocal.NewErrUnauthorized("invalid api key", "", nil)
+
+	key, err := s.store.GetAPIKeyByHash(ctx, utils.HashToken(rawKey))
+	if err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil, nil, invalid
+		}
+		return nil, nil, err
+	}
+	if key.Expired(time.Now()) {
+		return nil, nil, errlocal.NewErrUnauthorized("api key expired", "",
+			map[string]any{"api_key_id": key.ID.String()})
+	}
+
+	user, err := s.store.GetUser(ctx, key.UserID, false)
+	if err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil, nil, invalid
+		}
+		return nil, nil, err
+	}
+	if err := checkBan(user); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.store.TouchAPIKey(ctx, key.ID); err != nil {
+		s.logger.WithContext(ctx).WithField("api_key_id", key.ID.String()).
+			Warnf("failed to record api key use: %v", err)
+	}
+
+	return user, key, nil
+}
+
+// requireScope limits requests authenticated with an API key to keys granting
+// read for safe methods and write otherwise. Session requests pass through.
+func (s *Server) requireScope(read, write models.APIKeyScope) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := utils.GetAPIKey(r.Context())
+			if key == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scope := write
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				scope = read
+			}
+			if !key.Allows(scope) {
+				s.WriteError(w, r, errlocal.NewErrForbidden("api key lacks required scope", "",
+					map[string]any{"scope": scope, "api_key_id": key.ID.String()}))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requireSession denies requests authenticated with an API key, so a key can
+// neither manage keys nor reach admin endpoints.
+func (s *Server) requireSession(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if utils.GetAPIKey(r.Context()) != nil {
+			s.WriteError(w, r, errlocal.NewErrForbidden("not allowed with an api key", "", nil))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// CreateAPIKey godoc
+// @Summary Create API key
+// @Description Create a personal API key for scripts and devices. The key is returned only once;
+// @Description send it in the X-API-Key header. At least one scope is required.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateAPIKeyRequest true "Key name, scopes and optional expiry"
+// @Success 201 {object} dto.CreateAPIKeyResponse "Created key"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not allowed with an API key or while impersonating"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/api-keys [post]
+func (s *Server) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.CreateAPIKeyRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+	for _, scope := range b.Scopes {
+		if !scope.IsValid() {
+			s.WriteError(w, r, errlocal.NewErrBadRequest("unknown scope", "", map[string]any{"scope": scope}))
+			return
+		}
+	}
+	if b.ExpiresAt != nil && !b.ExpiresAt.After(time.Now()) {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("expires_at must be in the future", "", nil))
+		return
+	}
+
+	token, err := utils.GenerateToken(apiKeySize)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create api key", err.Error(), nil))
+		return
+	}
+	rawKey := apiKeyPrefix + token
+
+	user := utils.GetUser(r.Context())
+	key := &models.APIKey{
+		UserID:    user.ID,
+		Name:      b.Name,
+		Prefix:    rawKey[:len(apiKeyPrefix)+apiKeyShownSize],
+		KeyHash:   utils.HashToken(rawKey),
+		Scopes:    b.Scopes,
+		ExpiresAt: b.ExpiresAt,
+	}
+	if err := s.store.CreateAPIKey(r.Context(), key); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, apiKeyEvent(models.AuditAPIKeyCreate, key.ID), nil,
+		map[string]any{"name": key.Name, "prefix": key.Prefix, "scopes": key.Scopes})
+
+	s.WriteResponse(w, r, http.StatusCreated, dto.CreateAPIKeyResponse{
+		Key:            rawKey,
+		APIKeyResponse: dto.APIKeyResponse(*key),
+	})
+}
+
+// ListAPIKeys godoc
+// @Summary List API keys
+// @Description List active API keys of the current user. Only the key prefix is shown.
+// @Tags users
+// @Produce json
+// @Success 200 {array} dto.APIKeyResponse "Active keys"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not allowed with an API key or while impersonating"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/api-keys [get]
+func (s *Server) listAPIKeys(w http.ResponseWriter, r *http.Request) {
+	user := utils.GetUser(r.Context())
+
+	keys, err := s.store.ListAPIKeys(r.Context(), user.ID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	resp := make([]dto.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		resp[i] = dto.APIKeyResponse(key)
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, resp)
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke API key
+// @Description Revoke an API key of the current user
+// @Tags users
+// @Param api_key_id path string true "API key ID"
+// @Success 204 "Key revoked"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid key ID"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not allowed with an API key or while impersonating"
+// @Failure 404 {object} errlocal.ErrNotFound "Key not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/api-keys/{api_key_id} [delete]
+func (s *Server) revokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(mux.Vars(r)[apiKeyIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid api key id", err.Error(), nil))
+		return
+	}
+	user := utils.GetUser(r.Context())
+
+	if err := s.store.RevokeAPIKey(r.Context(), user.ID, id); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, apiKeyEvent(models.AuditAPIKeyRevoke, id), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
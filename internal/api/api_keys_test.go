@@ -0,0 +1,289 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	storemocks "github.com/trashscanner/trashscanner_api/internal/store/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	t.Run("key returned once and stored hashed", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		var stored *models.APIKey
+		storeMock.EXPECT().CreateAPIKey(mock.Anything, mock.MatchedBy(func(k *models.APIKey) bool {
+			return k.UserID == testdata.User1.ID && k.Name == "kitchen bin" &&
+				len(k.Scopes) == 1 && k.Scopes[0] == models.ScopePredictionsWrite
+		})).RunAndReturn(func(_ context.Context, k *models.APIKey) error {
+			k.ID = uuid.New()
+			stored = k
+			return nil
+		}).Once()
+		expectAudit(storeMock, models.AuditAPIKeyCreate)
+
+		body := `{"name":"kitchen bin","scopes":["predictions:write"]}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/api-keys", strings.NewReader(body))
+		req = req.WithContext(utils.SetUser(req.Context(), &testdata.User1))
+		rr := httptest.NewRecorder()
+		server.createAPIKey(rr, req)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var resp dto.CreateAPIKeyResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.True(t, strings.HasPrefix(resp.Key, apiKeyPrefix))
+		assert.Equal(t, utils.HashToken(resp.Key), stored.KeyHash)
+		assert.Equal(t, resp.Key[:len(stored.Prefix)], stored.Prefix)
+		assert.NotContains(t, rr.Body.String(), stored.KeyHash)
+	})
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "unknown scope", body: `{"name":"bin","scopes":["admin:all"]}`},
+		{name: "no scopes", body: `{"name":"bin"}`},
+		{name: "empty scopes", body: `{"name":"bin","scopes":[]}`},
+		{name: "expiry in the past", body: `{"name":"bin","scopes":["profile:read"],"expires_at":"2000-01-01T00:00:00Z"}`},
+		{name: "missing name", body: `{"scopes":["predictions:write"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, _, _, _ := newTestServer(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/api-keys", strings.NewReader(tt.body))
+			req = req.WithContext(utils.SetUser(req.Context(), &testdata.User1))
+			rr := httptest.NewRecorder()
+			server.createAPIKey(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestListAPIKeys(t *testing.T) {
+	server, storeMock, _, _, _ := newTestServer(t)
+
+	storeMock.EXPECT().ListAPIKeys(mock.Anything, testdata.User1.ID).Return([]models.APIKey{
+		{ID: uuid.New(), Name: "bin", Prefix: "tsk_abcdefgh", KeyHash: "secret-hash"},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/api-keys", nil)
+	req = req.WithContext(utils.SetUser(req.Context(), &testdata.User1))
+	rr := httptest.NewRecorder()
+	server.listAPIKeys(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp []dto.APIKeyResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "tsk_abcdefgh", resp[0].Prefix)
+	assert.NotContains(t, rr.Body.String(), "secret-hash")
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	t.Run("revoked", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		id := uuid.New()
+		storeMock.EXPECT().RevokeAPIKey(mock.Anything, testdata.User1.ID, id).Return(nil).Once()
+		expectAudit(storeMock, models.AuditAPIKeyRevoke)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me/api-keys/"+id.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{apiKeyIDTag: id.String()})
+		req = req.WithContext(utils.SetUser(req.Context(), &testdata.User1))
+		rr := httptest.NewRecorder()
+		server.revokeAPIKey(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		id := uuid.New()
+		storeMock.EXPECT().RevokeAPIKey(mock.Anything, testdata.User1.ID, id).
+			Return(errlocal.NewErrNotFound("api key not found", "", nil)).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me/api-keys/"+id.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{apiKeyIDTag: id.String()})
+		req = req.WithContext(utils.SetUser(req.Context(), &testdata.User1))
+		rr := httptest.NewRecorder()
+		server.revokeAPIKey(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestAuthMiddleware_APIKey(t *testing.T) {
+	const rawKey = "tsk_test-key"
+
+	t.Run("valid key populates user", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		key := &models.APIKey{ID: uuid.New(), UserID: testdata.User1.ID}
+		storeMock.EXPECT().GetAPIKeyByHash(mock.Anything, utils.HashToken(rawKey)).Return(key, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, testdata.User1.ID, false).Return(&testdata.User1, nil).Once()
+		storeMock.EXPECT().TouchAPIKey(mock.Anything, key.ID).Return(nil).Once()
+
+		nextCalled := false
+		handler := server.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			nextCalled = true
+			assert.Equal(t, testdata.User1.ID, utils.GetUser(r.Context()).ID)
+			assert.Equal(t, key, utils.GetAPIKey(r.Context()))
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(apiKeyHeader, rawKey)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.True(t, nextCalled)
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		expiredAt := time.Now().Add(-time.Minute)
+		key := &models.APIKey{ID: uuid.New(), UserID: testdata.User1.ID, ExpiresAt: &expiredAt}
+		storeMock.EXPECT().GetAPIKeyByHash(mock.Anything, utils.HashToken(rawKey)).Return(key, nil).Once()
+
+		handler := server.authMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			t.Fatal("should not be called")
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(apiKeyHeader, rawKey)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetAPIKeyByHash(mock.Anything, utils.HashToken(rawKey)).
+			Return(nil, errlocal.NewErrNotFound("api key not found", "", nil)).Once()
+
+		handler := server.authMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			t.Fatal("should not be called")
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(apiKeyHeader, rawKey)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestAPIKeyRouting(t *testing.T) {
+	const rawKey = "tsk_test-key"
+
+	expectKey := func(t *testing.T, scopes ...models.APIKeyScope) (*Server, *storemocks.Store) {
+		t.Helper()
+		server, storeMock, _, _, _ := newTestServer(t)
+		server.initRouter()
+
+		key := &models.APIKey{ID: uuid.New(), UserID: testdata.User1.ID, Scopes: scopes}
+		storeMock.EXPECT().GetAPIKeyByHash(mock.Anything, utils.HashToken(rawKey)).Return(key, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, testdata.User1.ID, false).Return(&testdata.User1, nil).Once()
+		storeMock.EXPECT().TouchAPIKey(mock.Anything, key.ID).Return(nil).Once()
+		return server, storeMock
+	}
+
+	t.Run("scope missing", func(t *testing.T) {
+		server, _ := expectKey(t, models.ScopePredictionsWrite)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/predictions", nil)
+		req.Header.Set(apiKeyHeader, rawKey)
+		rr := httptest.NewRecorder()
+		server.router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("key without scopes", func(t *testing.T) {
+		server, _ := expectKey(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/predictions", nil)
+		req.Header.Set(apiKeyHeader, rawKey)
+		rr := httptest.NewRecorder()
+		server.router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	sessionOnly := []struct {
+		method string
+		path   string
+	}{
+		{method: http.MethodPost, path: "/api/v1/users/me/api-keys"},
+		{method: http.MethodDelete, path: "/api/v1/users/me"},
+		{method: http.MethodPatch, path: "/api/v1/users/me"},
+		{method: http.MethodPut, path: "/api/v1/users/me/change-password"},
+		{method: http.MethodPost, path: "/api/v1/users/me/mfa/totp"},
+		{method: http.MethodDelete, path: "/api/v1/users/me/mfa/totp"},
+		{method: http.MethodPost, path: "/api/v1/users/me/mfa/totp/confirm"},
+		{method: http.MethodPost, path: "/api/v1/users/me/logout"},
+	}
+	for _, tt := range sessionOnly {
+		t.Run(tt.method+" "+tt.path+" needs a session", func(t *testing.T) {
+			server, storeMock := expectKey(t, models.ScopeProfileWrite)
+			storeMock.EXPECT().GetUser(mock.Anything, testdata.User1.ID, true).Return(&testdata.User1, nil).Once()
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(`{}`))
+			req.Header.Set(apiKeyHeader, rawKey)
+			rr := httptest.NewRecorder()
+			server.router.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+		})
+	}
+
+	orgID := uuid.New()
+	for _, tt := range ownerOnlyOrganizationRoutes(orgID) {
+		t.Run(tt.method+" "+tt.path+" needs a session", func(t *testing.T) {
+			server, storeMock := expectKey(t, models.ScopeProfileWrite)
+			storeMock.EXPECT().GetOrganizationMember(mock.Anything, orgID, testdata.User1.ID).Return(
+				&models.OrganizationMember{OrganizationID: orgID, UserID: testdata.User1.ID, Role: models.OrgRoleOwner},
+				nil).Once()
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(`{}`))
+			req.Header.Set(apiKeyHeader, rawKey)
+			rr := httptest.NewRecorder()
+			server.router.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+		})
+	}
+
+	t.Run("admin endpoints need a session", func(t *testing.T) {
+		server, _ := expectKey(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users", nil)
+		req.Header.Set(apiKeyHeader, rawKey)
+		rr := httptest.NewRecorder()
+		server.router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
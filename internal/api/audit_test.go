@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+type recordingSink struct {
+	events []models.AuditEvent
+}
+
+func (s *recordingSink) Write(_ context.Context, event models.AuditEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestAudit(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	userID := uuid.New()
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/admin/users/"+userID.String(), nil)
+		req.RemoteAddr = "203.0.113.7:1234"
+		ctx := context.WithValue(utils.SetUser(req.Context(), admin), utils.RequestIDKey, "req-1")
+		return req.WithContext(ctx)
+	}
+
+	t.Run("records changed fields", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		sink := &recordingSink{}
+		server.auditSink = sink
+
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.Anything).Return(nil).Once()
+
+		server.audit(newRequest(), userEvent(models.AuditUserUpdate, userID),
+			map[string]any{"name": "user", "role": models.RoleUser},
+			map[string]any{"name": "user", "role": models.RoleModerator})
+
+		require.Len(t, sink.events, 1)
+		event := sink.events[0]
+		assert.Equal(t, admin.ID, event.ActorID)
+		assert.Equal(t, userID.String(), event.TargetID)
+		assert.Equal(t, "req-1", event.RequestID)
+		require.NotNil(t, event.IPAddress)
+		assert.Equal(t, "203.0.113.7", event.IPAddress.String())
+		assert.JSONEq(t, `{"role":"user"}`, string(event.Before))
+		assert.JSONEq(t, `{"role":"moderator"}`, string(event.After))
+	})
+
+	t.Run("records the impersonating admin", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		support := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+		user := &models.User{ID: uuid.New(), Role: models.RoleUser}
+
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.ActorID == user.ID && e.ImpersonatorID != nil && *e.ImpersonatorID == support.ID
+		})).Return(nil).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/me", nil)
+		req = req.WithContext(utils.SetImpersonator(utils.SetUser(req.Context(), user), support))
+		server.audit(req, userEvent(models.AuditAccountUpdate, user.ID), nil, nil)
+	})
+
+	t.Run("store failure skips the sink", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		sink := &recordingSink{}
+		server.auditSink = sink
+
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+		server.audit(newRequest(), userEvent(models.AuditUserBan, userID), nil, nil)
+
+		assert.Empty(t, sink.events)
+	})
+}
+
+func TestListAuditEvents(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+
+	t.Run("filtered page", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		actorID := uuid.New()
+		filter := models.AuditFilter{ActorID: &actorID, Action: models.AuditUserBan}
+
+		storeMock.EXPECT().ListAuditEvents(mock.Anything, filter, int32(10), int32(0)).
+			Return([]models.AuditEvent{{ID: uuid.New(), ActorID: actorID, Action: models.AuditUserBan}}, nil).Once()
+		storeMock.EXPECT().CountAuditEvents(mock.Anything, filter).Return(int64(1), nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet,
+			"/api/v1/admin/audit?limit=10&action=user.ban&actor_id="+actorID.String(), nil)
+		req = req.WithContext(utils.SetUser(req.Context(), admin))
+		rr := httptest.NewRecorder()
+		server.listAuditEvents(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.AuditEventListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, int64(1), resp.TotalCount)
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, actorID, resp.Events[0].ActorID)
+	})
+
+	t.Run("invalid actor id", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?actor_id=nope", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), admin))
+		rr := httptest.NewRecorder()
+		server.listAuditEvents(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -2,7 +2,10 @@ package api
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -11,6 +14,8 @@ import (
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
+const jwksCacheControl = "public, max-age=3600"
+
 func (s *Server) loginMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		b, err := dto.GetRequestBody[dto.LoginUserRequest](r)
@@ -35,6 +40,10 @@ func (s *Server) loginMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		if err := checkBan(existedUser); err != nil {
+			s.WriteError(w, r, err)
+			return
+		}
 		ctx = utils.SetUser(ctx, existedUser)
 
 		next.ServeHTTP(w, r.WithContext(ctx))
@@ -44,6 +53,7 @@ func (s *Server) loginMiddleware(next http.Handler) http.Handler {
 // Login godoc
 // @Summary User registration
 // @Description Authenticate user and return JWT tokens
+// @Description A guest session sent with the request is claimed: its predictions and stats move to the new account.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -67,7 +77,14 @@ func (s *Server) register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := s.store.CreateUser(ctx, u); err != nil {
+	if guestID := s.guestFromRequest(r); guestID != uuid.Nil {
+		if err := s.claimGuest(ctx, guestID, u); err != nil {
+			s.WriteError(w, r, err)
+			return
+		}
+		s.logger.WithContext(ctx).WithField("guest_id", guestID.String()).
+			WithField("user_id", u.ID.String()).Info("guest account claimed")
+	} else if err := s.store.CreateUser(ctx, u); err != nil {
 		s.WriteError(w, r, err)
 		return
 	}
@@ -79,6 +96,13 @@ func (s *Server) register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if u.Email != nil {
+		if err := s.issueEmailVerification(ctx, u); err != nil {
+			s.logger.WithContext(ctx).WithField("user_id", u.ID.String()).
+				Warnf("failed to send verification email: %v", err)
+		}
+	}
+
 	setAuthCookies(w, tokens)
 	s.WriteResponse(w, r, http.StatusCreated, dto.NewAuthResponse(*u, tokens.Access, tokens.Refresh))
 	s.writeLoginHistory(r, http.StatusCreated, nil)
@@ -92,20 +116,41 @@ func (s *Server) register(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param request body dto.AuthRequest true "Login credentials"
 // @Success 200 {object} dto.AuthResponse "Tokens for existing user"
+// @Success 202 {object} dto.MFAChallengeResponse "Password accepted, second factor required at /login/mfa"
 // @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
 // @Failure 401 {object} errlocal.ErrUnauthorized "Invalid credentials"
+// @Failure 429 {object} errlocal.ErrToManyRequests "Too many failed attempts"
 // @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Header 429 {integer} Retry-After "Seconds until the next attempt is allowed"
 // @Router /login [post]
 func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	u := utils.GetUser(r.Context())
+
+	retryAfter, err := s.loginThrottle.Check(r.Context(), u.ID, s.clientIP(r))
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if retryAfter > 0 {
+		setRetryAfter(w, retryAfter)
+		s.WriteError(w, r, errlocal.NewErrToManyRequests("too many failed login attempts, try again later"))
+		return
+	}
+
 	var loginErr errlocal.LocalError
 	var statusCode int
 	defer func() {
+		// The password step of a two-factor login is recorded by loginMFA.
+		if statusCode == http.StatusAccepted {
+			return
+		}
 		s.writeLoginHistory(r, statusCode, loginErr)
 	}()
 
-	u := utils.GetUser(r.Context())
 	if u.ID == uuid.Nil {
-		s.WriteError(w, r, errlocal.NewErrUnauthorized("user not found, please create an account", "", nil))
+		statusCode = http.StatusUnauthorized
+		loginErr = errlocal.NewErrUnauthorized("user not found, please create an account", "", nil)
+		s.WriteError(w, r, loginErr)
 		return
 	}
 
@@ -117,6 +162,29 @@ func (s *Server) login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	totpEnabled, mfaErr := s.totpEnabled(r.Context(), u.ID)
+	if mfaErr != nil {
+		statusCode = http.StatusInternalServerError
+		loginErr = errlocal.NewErrInternal("failed to check two-factor authentication", mfaErr.Error(),
+			map[string]any{"user_id": u.ID.String()})
+		s.WriteError(w, r, loginErr)
+		return
+	}
+	if totpEnabled {
+		mfaToken, tErr := s.authManager.CreateMFAToken(*u)
+		if tErr != nil {
+			statusCode = http.StatusInternalServerError
+			loginErr = errlocal.NewErrInternal("failed to create mfa token", tErr.Error(),
+				map[string]any{"user_id": u.ID.String()})
+			s.WriteError(w, r, loginErr)
+			return
+		}
+
+		statusCode = http.StatusAccepted
+		s.WriteResponse(w, r, statusCode, dto.MFAChallengeResponse{MFARequired: true, MFAToken: mfaToken})
+		return
+	}
+
 	statusCode = http.StatusOK
 
 	tokens, tErr := s.authManager.CreateNewPair(r.Context(), *u)
@@ -169,3 +237,19 @@ func (s *Server) refresh(w http.ResponseWriter, r *http.Request) {
 	setAuthCookies(w, tokens)
 	s.WriteResponse(w, r, http.StatusAccepted, nil)
 }
+
+// JWKS godoc
+// @Summary Public signing keys
+// @Description Get the JSON Web Key Set used to verify access tokens issued by this service
+// @Tags auth
+// @Produce json
+// @Success 200 {object} auth.JWKSet "Active and verification-only public keys"
+// @Router /.well-known/jwks.json [get]
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", jwksCacheControl)
+	s.WriteResponse(w, r, http.StatusOK, s.authManager.JWKS())
+}
+
+func setRetryAfter(w http.ResponseWriter, d time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+}
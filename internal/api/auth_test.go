@@ -7,7 +7,9 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -16,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/trashscanner/trashscanner_api/internal/api/dto"
 	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/config"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
 	testdata "github.com/trashscanner/trashscanner_api/internal/testdata"
@@ -44,6 +47,27 @@ func TestLoginMiddleware(t *testing.T) {
 		assert.Equal(t, "invalid request body", resp.Message())
 	})
 
+	t.Run("banned user", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		body := loadJSONFixture(t, "login_valid.json")
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login", bytes.NewReader(body))
+
+		var authReq dto.LoginUserRequest
+		require.NoError(t, json.Unmarshal(body, &authReq))
+
+		storeMock.EXPECT().
+			GetUserByLogin(mock.Anything, authReq.Login).
+			Return(&models.User{ID: uuid.New(), Login: authReq.Login, Ban: &models.Ban{Reason: "spam"}}, nil)
+
+		rr := httptest.NewRecorder()
+		server.loginMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("should not be called")
+		})).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
 	t.Run("user not found", func(t *testing.T) {
 		server, storeMock, _, _, _ := newTestServer(t)
 
@@ -141,7 +165,6 @@ func TestLoginHandler(t *testing.T) {
 		authReq.Name = "testname" // Добавляем Name для register
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/register", bytes.NewReader(body))
-		req.Header.Set("X-Real-IP", testdata.TestIPAddress.String())
 		req.Header.Set("X-Location", testdata.TestLocation)
 		req.Header.Set("User-Agent", testdata.TestUserAgent)
 		req.RemoteAddr = "198.51.100.10:12345"
@@ -172,7 +195,7 @@ func TestLoginHandler(t *testing.T) {
 
 		storeMock.EXPECT().
 			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-				assert.Equal(t, createdID, history.UserID)
+				assert.Equal(t, createdID, uuid.UUID(history.UserID.Bytes))
 				assert.True(t, history.Success)
 				return true
 			})).
@@ -220,17 +243,18 @@ func TestLoginHandler(t *testing.T) {
 		ctx = utils.SetRequestBody(ctx, &authReq)
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/login", bytes.NewReader(body)).WithContext(ctx)
-		req.Header.Set("X-Forwarded-For", testdata.TestIPAddress.String()+",192.0.2.1")
 		req.Header.Set("User-Agent", testdata.TestUserAgent)
-		req.RemoteAddr = "203.0.113.55:4567"
+		req.RemoteAddr = testdata.TestIPAddress.String() + ":4567"
 
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, existing.ID).
+			Return((*models.UserTOTP)(nil), errlocal.NewErrNotFound("totp not enrolled", "", nil))
 		authMock.EXPECT().
 			CreateNewPair(mock.Anything, existing).
 			Return(&auth.TokenPair{Access: "access", Refresh: "refresh"}, nil)
 
 		storeMock.EXPECT().
 			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-				assert.Equal(t, existing.ID, history.UserID)
+				assert.Equal(t, existing.ID, uuid.UUID(history.UserID.Bytes))
 				assert.True(t, history.Success)
 				require.NotNil(t, history.IpAddress)
 				assert.Equal(t, testdata.TestIPAddress, *history.IpAddress)
@@ -270,7 +294,7 @@ func TestLoginHandler(t *testing.T) {
 
 		storeMock.EXPECT().
 			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-				assert.Equal(t, existing.ID, history.UserID)
+				assert.Equal(t, existing.ID, uuid.UUID(history.UserID.Bytes))
 				assert.False(t, history.Success)
 				if assert.NotNil(t, history.FailureReason) {
 					assert.Contains(t, *history.FailureReason, "invalid credentials")
@@ -286,6 +310,33 @@ func TestLoginHandler(t *testing.T) {
 		authMock.AssertNotCalled(t, "CreateNewPair", mock.Anything, mock.Anything)
 	})
 
+	t.Run("unknown login is recorded without user", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		body := loadJSONFixture(t, "login_valid.json")
+		var authReq dto.LoginUserRequest
+		require.NoError(t, json.Unmarshal(body, &authReq))
+
+		unknown := authReq.ToModel()
+		ctx := utils.SetUser(context.Background(), &unknown)
+		ctx = utils.SetRequestBody(ctx, &authReq)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login", bytes.NewReader(body)).WithContext(ctx)
+		req.RemoteAddr = testdata.TestIPAddress.String() + ":4567"
+
+		storeMock.EXPECT().
+			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
+				return !history.UserID.Valid && !history.Success &&
+					history.IpAddress != nil && *history.IpAddress == testdata.TestIPAddress
+			})).
+			Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.login(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
 	t.Run("create user error", func(t *testing.T) {
 		server, storeMock, authMock, _, _ := newTestServer(t)
 
@@ -332,6 +383,8 @@ func TestLoginHandler(t *testing.T) {
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/login", bytes.NewReader(body)).WithContext(ctx)
 
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, existing.ID).
+			Return((*models.UserTOTP)(nil), errlocal.NewErrNotFound("totp not enrolled", "", nil))
 		authMock.EXPECT().
 			CreateNewPair(mock.Anything, existing).
 			Return((*auth.TokenPair)(nil), errors.New("sign failed"))
@@ -351,6 +404,37 @@ func TestLoginHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
+
+	t.Run("too many failed attempts", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		server.loginThrottle = auth.NewLoginThrottle(config.LoginProtectionConfig{
+			Window:              15 * time.Minute,
+			MaxFailuresPerLogin: 5,
+			LockDuration:        15 * time.Minute,
+		}, storeMock)
+
+		body := loadJSONFixture(t, "login_valid.json")
+		var authReq dto.LoginUserRequest
+		require.NoError(t, json.Unmarshal(body, &authReq))
+
+		existing := models.User{ID: testdata.User1ID, Login: authReq.Login}
+		ctx := utils.SetUser(context.Background(), &existing)
+		ctx = utils.SetRequestBody(ctx, &authReq)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login", bytes.NewReader(body)).WithContext(ctx)
+
+		storeMock.EXPECT().
+			GetLoginFailures(mock.Anything, existing.ID, mock.Anything).
+			Return(&models.LoginFailures{Count: 5, LastFailureAt: time.Now().Add(-5 * time.Minute)}, nil)
+
+		rr := httptest.NewRecorder()
+		server.login(rr, req)
+
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+		retryAfter, err := strconv.Atoi(rr.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.InDelta(t, 600, retryAfter, 2)
+		storeMock.AssertNotCalled(t, "InsertLoginHistory", mock.Anything, mock.Anything)
+	})
 }
 
 func TestRegisterHandler(t *testing.T) {
@@ -363,7 +447,7 @@ func TestRegisterHandler(t *testing.T) {
 		authReq.Name = "testname"
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/register", bytes.NewReader(body))
-		req.Header.Set("X-Real-IP", testdata.TestIPAddress.String())
+		req.RemoteAddr = testdata.TestIPAddress.String() + ":4567"
 		req.Header.Set("X-Location", testdata.TestLocation)
 		req.Header.Set("User-Agent", testdata.TestUserAgent)
 
@@ -394,7 +478,7 @@ func TestRegisterHandler(t *testing.T) {
 
 		storeMock.EXPECT().
 			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-				assert.Equal(t, createdID, history.UserID)
+				assert.Equal(t, createdID, uuid.UUID(history.UserID.Bytes))
 				assert.True(t, history.Success)
 				assert.Nil(t, history.FailureReason)
 				return true
@@ -539,7 +623,7 @@ func TestRegisterHandler(t *testing.T) {
 
 		storeMock.EXPECT().
 			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-				assert.Equal(t, createdID, history.UserID)
+				assert.Equal(t, createdID, uuid.UUID(history.UserID.Bytes))
 				assert.False(t, history.Success)
 				assert.NotNil(t, history.FailureReason)
 				assert.Contains(t, *history.FailureReason, "jwt signing failed")
@@ -705,3 +789,28 @@ func TestRefreshHandler(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
 }
+
+func TestJWKSHandler(t *testing.T) {
+	server, _, authMock, _, _ := newTestServer(t)
+
+	set := auth.JWKSet{Keys: []auth.JWK{{
+		KeyType:   "OKP",
+		Curve:     "Ed25519",
+		X:         "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",
+		KeyID:     "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k",
+		Use:       "sig",
+		Algorithm: "EdDSA",
+	}}}
+	authMock.EXPECT().JWKS().Return(set)
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rr := httptest.NewRecorder()
+	server.jwks(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, jwksCacheControl, rr.Header().Get("Cache-Control"))
+
+	var resp auth.JWKSet
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	assert.Equal(t, set, resp)
+}
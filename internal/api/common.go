@@ -12,7 +12,7 @@ import (
 const (
 	requestIDHeader    = "X-Request-ID"
 	corsAllowMethods   = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
-	corsAllowHeaders   = "Content-Type, Authorization, X-Request-ID"
+	corsAllowHeaders   = "Content-Type, Authorization, X-Request-ID, X-API-Key"
 	corsAllowMaxAge    = "3600"
 	corsAllowAnyOrigin = "*"
 )
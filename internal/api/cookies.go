@@ -2,6 +2,7 @@ package api
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/trashscanner/trashscanner_api/internal/auth"
 )
@@ -31,6 +32,19 @@ func setAuthCookies(w http.ResponseWriter, tokens *auth.TokenPair) {
 	http.SetCookie(w, refreshCookie)
 }
 
+// setGuestCookie stores a guest access token. Guests get no refresh token, so
+// the cookie expires together with the session.
+func setGuestCookie(w http.ResponseWriter, token string, expiresAt time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     accessCookieName,
+		Value:    token,
+		HttpOnly: true,
+		Secure:   false, // Set to false to support non-HTTPS test endpoints
+		Path:     "/",
+		Expires:  expiresAt,
+	})
+}
+
 func getAccessCookie(r *http.Request) (string, error) {
 	accessCookie, err := r.Cookie(accessCookieName)
 	if err != nil {
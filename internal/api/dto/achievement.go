@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/trashscanner/trashscanner_api/internal/models"
+
+type AchievementListResponse struct {
+	Unlocked     int                          `json:"unlocked"`
+	Total        int                          `json:"total"`
+	Achievements []models.AchievementProgress `json:"achievements"`
+}
+
+func NewAchievementListResponse(achievements []models.AchievementProgress) AchievementListResponse {
+	resp := AchievementListResponse{Total: len(achievements), Achievements: achievements}
+	for _, achievement := range achievements {
+		if achievement.Unlocked {
+			resp.Unlocked++
+		}
+	}
+	return resp
+}
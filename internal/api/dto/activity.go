@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+const (
+	defaultActivityDays = 365
+	maxActivityDays     = 366
+)
+
+// ActivityRange is a span of calendar days, both ends inclusive, as UTC
+// midnights.
+type ActivityRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// NewActivityRange reads the activity range from query. Without bounds it
+// covers the year up to today, the calendar day of today in its own location.
+func NewActivityRange(query url.Values, today time.Time) (ActivityRange, error) {
+	from, err := parseDateQuery(query, "from")
+	if err != nil {
+		return ActivityRange{}, err
+	}
+	to, err := parseDateQuery(query, "to")
+	if err != nil {
+		return ActivityRange{}, err
+	}
+
+	rng := ActivityRange{To: models.Day(today)}
+	if to != nil {
+		rng.To = *to
+	}
+	if from != nil {
+		rng.From = *from
+	} else {
+		rng.From = rng.To.AddDate(0, 0, 1-defaultActivityDays)
+	}
+
+	if rng.To.Before(rng.From) {
+		return rng, errors.New("from must not be after to")
+	}
+	if rng.days() > maxActivityDays {
+		return rng, fmt.Errorf("range must not exceed %d days", maxActivityDays)
+	}
+
+	return rng, nil
+}
+
+func (r ActivityRange) days() int {
+	return int(r.To.Sub(r.From)/(24*time.Hour)) + 1
+}
+
+type ActivityDayResponse struct {
+	Date  string `json:"date" example:"2026-03-10"`
+	Count int    `json:"count"`
+}
+
+type ActivityResponse struct {
+	Timezone string `json:"timezone" example:"Europe/Berlin"`
+	From     string `json:"from" example:"2025-03-11"`
+	To       string `json:"to" example:"2026-03-10"`
+	// Total is the number of scans within the range.
+	Total int `json:"total"`
+	// CurrentStreak is zero once a day has passed without scans.
+	CurrentStreak int `json:"current_streak"`
+	LongestStreak int `json:"longest_streak"`
+	// Days holds every day of the range in order, including days without
+	// scans.
+	Days []ActivityDayResponse `json:"days"`
+}
+
+func NewActivityResponse(
+	user *models.User, rng ActivityRange, activity []models.ActivityDay, now time.Time,
+) ActivityResponse {
+	scans := make(map[time.Time]int, len(activity))
+	for _, day := range activity {
+		scans[day.Day] = day.Scans
+	}
+
+	resp := ActivityResponse{
+		Timezone: user.Location().String(),
+		From:     rng.From.Format(time.DateOnly),
+		To:       rng.To.Format(time.DateOnly),
+		Days:     make([]ActivityDayResponse, 0, rng.days()),
+	}
+	for day := rng.From; !day.After(rng.To); day = day.AddDate(0, 0, 1) {
+		resp.Days = append(resp.Days, ActivityDayResponse{Date: day.Format(time.DateOnly), Count: scans[day]})
+		resp.Total += scans[day]
+	}
+	if user.Stat != nil {
+		resp.CurrentStreak = user.Stat.StreakAt(now, user.Location())
+		resp.LongestStreak = user.Stat.LongestStreak
+	}
+
+	return resp
+}
+
+func parseDateQuery(query url.Values, key string) (*time.Time, error) {
+	v := query.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a date (YYYY-MM-DD)", key)
+	}
+	return &t, nil
+}
@@ -1,6 +1,11 @@
 package dto
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,10 +23,17 @@ type AdminUserResponse struct {
 	CreatedAt time.Time   `json:"created_at"`
 	UpdatedAt time.Time   `json:"updated_at"`
 
+	Ban         *models.Ban   `json:"ban,omitempty"`
 	LastLoginAt *time.Time    `json:"last_login_at,omitempty"`
 	Stat        *StatResponse `json:"stat,omitempty"`
 }
 
+// StatsRecomputeResponse acknowledges a recompute of the stats of all users
+// started in the background.
+type StatsRecomputeResponse struct {
+	StartedAt time.Time `json:"started_at"`
+}
+
 type AdminUserListResponse struct {
 	TotalCount int64               `json:"total_count"`
 	Limit      int                 `json:"limit"`
@@ -33,7 +45,18 @@ type CreateAdminRequest struct {
 	Name     string      `json:"name" validate:"required,min=2,max=100"`
 	Login    string      `json:"login" validate:"required,alphanum,min=3,max=50"`
 	Password string      `json:"password" validate:"required,min=8"`
-	Role     models.Role `json:"role" validate:"required"`
+	Role     models.Role `json:"role" validate:"required,oneof=admin moderator user"`
+}
+
+type UpdateAdminUserRequest struct {
+	Name *string      `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Role *models.Role `json:"role,omitempty" validate:"omitempty,oneof=admin moderator user"`
+}
+
+type BanUserRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+	// Until is the end of the ban; without it the ban does not expire.
+	Until *time.Time `json:"until,omitempty"`
 }
 
 type AdminUserParams struct {
@@ -41,6 +64,84 @@ type AdminUserParams struct {
 	Offset int `query:"offset" validate:"min=0"`
 }
 
+// NewUserFilter reads the admin user list filters from query. Unknown values
+// are rejected rather than ignored so that a typo does not list everyone.
+func NewUserFilter(query url.Values) (models.UserFilter, error) {
+	filter := models.UserFilter{
+		Search: strings.TrimSpace(query.Get("search")),
+		SortBy: models.UserSort(query.Get("sort")),
+	}
+
+	if v := query.Get("role"); v != "" {
+		role := models.Role(v)
+		if !role.IsValid() {
+			return filter, fmt.Errorf("unknown role %q", v)
+		}
+		filter.Role = &role
+	}
+	if v := query.Get("status"); v != "" {
+		status := models.UserStatus(v)
+		if !status.Valid() {
+			return filter, fmt.Errorf("unknown status %q", v)
+		}
+		filter.Status = &status
+	}
+
+	var err error
+	if filter.Deleted, err = parseBoolQuery(query, "deleted"); err != nil {
+		return filter, err
+	}
+	if filter.Banned, err = parseBoolQuery(query, "banned"); err != nil {
+		return filter, err
+	}
+	if filter.LastLoginFrom, err = parseTimeQuery(query, "last_login_from"); err != nil {
+		return filter, err
+	}
+	if filter.LastLoginTo, err = parseTimeQuery(query, "last_login_to"); err != nil {
+		return filter, err
+	}
+	if filter.LastLoginFrom != nil && filter.LastLoginTo != nil && !filter.LastLoginFrom.Before(*filter.LastLoginTo) {
+		return filter, errors.New("last_login_from must be before last_login_to")
+	}
+
+	if filter.SortBy != "" && !filter.SortBy.Valid() {
+		return filter, fmt.Errorf("unknown sort %q", filter.SortBy)
+	}
+	switch order := query.Get("order"); order {
+	case "", "desc":
+	case "asc":
+		filter.SortAsc = true
+	default:
+		return filter, fmt.Errorf("unknown order %q", order)
+	}
+
+	return filter, nil
+}
+
+func parseBoolQuery(query url.Values, key string) (*bool, error) {
+	v := query.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a boolean", key)
+	}
+	return &b, nil
+}
+
+func parseTimeQuery(query url.Values, key string) (*time.Time, error) {
+	v := query.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC 3339 time", key)
+	}
+	return &t, nil
+}
+
 func NewAdminUserListResponse(users []models.User, totalCount int64, limit, offset int) AdminUserListResponse {
 	res := AdminUserListResponse{
 		TotalCount: totalCount,
@@ -70,6 +171,7 @@ func NewAdminUserResponse(user models.User) AdminUserResponse {
 		Role:        user.Role,
 		Avatar:      user.Avatar,
 		Deleted:     user.Deleted,
+		Ban:         user.Ban,
 		CreatedAt:   user.CreatedAt,
 		UpdatedAt:   user.UpdatedAt,
 		LastLoginAt: user.LastLoginAt,
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+const (
+	defaultAnalyticsRange = 30 * 24 * time.Hour
+	maxAnalyticsRange     = 5 * 366 * 24 * time.Hour
+)
+
+// NewAnalyticsFilter reads the analytics range from query. Without bounds it
+// covers the 30 days up to the end of the current UTC day.
+func NewAnalyticsFilter(query url.Values, now time.Time) (models.AnalyticsFilter, error) {
+	filter := models.AnalyticsFilter{Bucket: models.AnalyticsBucketDay}
+	if v := query.Get("bucket"); v != "" {
+		filter.Bucket = models.AnalyticsBucket(v)
+	}
+	if !filter.Bucket.IsValid() {
+		return filter, errors.New("bucket must be one of day, week, month")
+	}
+
+	from, err := parseTimeQuery(query, "from")
+	if err != nil {
+		return filter, err
+	}
+	to, err := parseTimeQuery(query, "to")
+	if err != nil {
+		return filter, err
+	}
+
+	if to != nil {
+		filter.To = to.UTC()
+	} else {
+		now = now.UTC()
+		filter.To = time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	}
+	if from != nil {
+		filter.From = from.UTC()
+	} else {
+		filter.From = filter.To.Add(-defaultAnalyticsRange)
+	}
+
+	if !filter.From.Before(filter.To) {
+		return filter, errors.New("from must be before to")
+	}
+	if filter.To.Sub(filter.From) > maxAnalyticsRange {
+		return filter, errors.New("range must not exceed 5 years")
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=64"`
+	// Scopes limit the key; at least one is required.
+	Scopes    []models.APIKeyScope `json:"scopes" validate:"required,min=1,unique,dive,required"`
+	ExpiresAt *time.Time           `json:"expires_at,omitempty"`
+}
+
+type APIKeyResponse models.APIKey
+
+// CreateAPIKeyResponse carries the plain key. It is returned only once.
+type CreateAPIKeyResponse struct {
+	Key string `json:"key" example:"tsk_3q2-7wEVUq0aNrlLwKbwAUaBpkMqVb2mzeFzV1nUXZo"`
+	APIKeyResponse
+}
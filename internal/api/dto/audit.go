@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+type AuditEventListResponse struct {
+	TotalCount int64               `json:"total_count"`
+	Limit      int                 `json:"limit"`
+	Offset     int                 `json:"offset"`
+	Events     []models.AuditEvent `json:"events"`
+}
+
+// NewAuditFilter reads the audit log filters from query.
+func NewAuditFilter(query url.Values) (models.AuditFilter, error) {
+	filter := models.AuditFilter{
+		Action:     models.AuditAction(query.Get("action")),
+		TargetType: models.AuditTarget(query.Get("target_type")),
+		TargetID:   query.Get("target_id"),
+	}
+
+	if v := query.Get("actor_id"); v != "" {
+		actorID, err := uuid.Parse(v)
+		if err != nil {
+			return filter, errors.New("actor_id must be a UUID")
+		}
+		filter.ActorID = &actorID
+	}
+	if v := query.Get("impersonator_id"); v != "" {
+		impersonatorID, err := uuid.Parse(v)
+		if err != nil {
+			return filter, errors.New("impersonator_id must be a UUID")
+		}
+		filter.ImpersonatorID = &impersonatorID
+	}
+
+	var err error
+	if filter.From, err = parseTimeQuery(query, "from"); err != nil {
+		return filter, err
+	}
+	if filter.To, err = parseTimeQuery(query, "to"); err != nil {
+		return filter, err
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, errors.New("from must be before to")
+	}
+
+	return filter, nil
+}
+
+func NewAuditEventListResponse(events []models.AuditEvent, totalCount int64, limit, offset int) AuditEventListResponse {
+	if events == nil {
+		events = []models.AuditEvent{}
+	}
+
+	return AuditEventListResponse{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		Events:     events,
+	}
+}
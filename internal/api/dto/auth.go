@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/trashscanner/trashscanner_api/internal/models"
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
@@ -14,16 +16,30 @@ type LoginUserRequest struct {
 	Login    string `json:"login" validate:"required,min=3,max=32,alphanum"`
 	Password string `json:"password" validate:"required,min=8,max=64"`
 	Name     string `json:"name,omitempty" validate:"omitempty,min=3,max=64,alphanum"`
+	Email    string `json:"email,omitempty" validate:"omitempty,email,max=254"`
 }
 
 func (r *LoginUserRequest) ToModel() models.User {
 	hp, _ := utils.HashPass(r.Password)
-	return models.User{
+	user := models.User{
 		Name:           r.Name,
 		Login:          r.Login,
 		HashedPassword: hp,
 		Role:           models.RoleUser,
 	}
+	if r.Email != "" {
+		user.Email = &r.Email
+	}
+	return user
+}
+
+type ForgotPasswordRequest struct {
+	Login string `json:"login" validate:"required,min=3,max=32,alphanum"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=8,max=64"`
 }
 
 type AuthResponse struct {
@@ -44,3 +60,18 @@ func NewAuthResponse(user models.User, access, refresh string) AuthResponse {
 		},
 	}
 }
+
+type GuestResponse struct {
+	AuthResponse
+	ExpiresAt      time.Time `json:"expires_at"`
+	MaxPredictions int       `json:"max_predictions"`
+}
+
+// ImpersonationResponse carries the access token an admin uses to act as the
+// user. It is returned in the body so the admin's own session cookies stay
+// untouched.
+type ImpersonationResponse struct {
+	AuthResponse
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
@@ -0,0 +1,127 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// ExportFormat is the encoding of an admin data export.
+type ExportFormat string
+
+const (
+	ExportFormatCSV   ExportFormat = "csv"
+	ExportFormatJSONL ExportFormat = "jsonl"
+)
+
+// NewExportFormat reads the format query parameter; CSV is the default.
+func NewExportFormat(query url.Values) (ExportFormat, error) {
+	switch format := ExportFormat(query.Get("format")); format {
+	case "", ExportFormatCSV:
+		return ExportFormatCSV, nil
+	case ExportFormatJSONL:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatJSONL {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// UserExportHeader names the columns of UserExportRecord.
+var UserExportHeader = []string{
+	"id", "login", "name", "role", "deleted", "banned_at", "banned_until", "ban_reason",
+	"status", "rating", "files_scanned", "total_weight", "last_scanned_at", "last_login_at",
+	"created_at", "updated_at",
+}
+
+// UserExportRecord flattens user into a CSV row. Missing values are empty.
+func UserExportRecord(user models.User) []string {
+	record := []string{
+		user.ID.String(), csvText(user.Login), csvText(user.Name), string(user.Role), strconv.FormatBool(user.Deleted),
+		"", "", "", "", "", "", "", "",
+		formatExportTime(user.LastLoginAt),
+		user.CreatedAt.UTC().Format(time.RFC3339), user.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if user.Ban != nil {
+		record[5] = formatExportTime(&user.Ban.BannedAt)
+		record[6] = formatExportTime(user.Ban.Until)
+		record[7] = csvText(user.Ban.Reason)
+	}
+	if user.Stat != nil {
+		record[8] = string(user.Stat.Status)
+		record[9] = strconv.Itoa(user.Stat.Rating)
+		record[10] = strconv.Itoa(user.Stat.FilesScanned)
+		record[11] = strconv.FormatFloat(user.Stat.TotalWeight, 'f', -1, 64)
+		if !user.Stat.LastScannedAt.IsZero() {
+			record[12] = formatExportTime(&user.Stat.LastScannedAt)
+		}
+	}
+	return record
+}
+
+// PredictionExportHeader names the columns of PredictionExportRecord.
+var PredictionExportHeader = []string{
+	"id", "user_id", "user_login", "scan_key", "status", "result", "error", "weight",
+	"verified_label", "verified_by", "verified_at", "feedback_label", "feedback_comment", "feedback_at",
+	"created_at", "updated_at",
+}
+
+// PredictionExportRecord flattens prediction into a CSV row; the result is
+// kept as a JSON object. Missing values are empty.
+func PredictionExportRecord(prediction models.AdminPrediction) []string {
+	var result string
+	if prediction.Result != nil {
+		raw, _ := json.Marshal(prediction.Result)
+		result = string(raw)
+	}
+
+	record := []string{
+		prediction.ID.String(), prediction.UserID.String(), csvText(prediction.UserLogin), prediction.TrashScan,
+		prediction.Status.String(), result, csvText(prediction.Error), "",
+		"", "", "", "", "", "",
+		prediction.CreatedAt.UTC().Format(time.RFC3339), prediction.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if prediction.Weight != nil {
+		record[7] = strconv.FormatFloat(*prediction.Weight, 'f', -1, 64)
+	}
+	if v := prediction.Verification; v != nil {
+		record[8] = v.Label
+		if v.VerifiedBy != nil {
+			record[9] = v.VerifiedBy.String()
+		}
+		record[10] = formatExportTime(&v.VerifiedAt)
+	}
+	if f := prediction.Feedback; f != nil {
+		record[11] = csvText(f.Label)
+		record[12] = csvText(f.Comment)
+		record[13] = formatExportTime(&f.CreatedAt)
+	}
+	return record
+}
+
+// csvText keeps spreadsheets from running text users entered as a formula by
+// prefixing the cells that would start one with a quote.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
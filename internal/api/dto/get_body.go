@@ -3,7 +3,10 @@ package dto
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/trashscanner/trashscanner_api/internal/models"
@@ -29,7 +32,9 @@ func GetRequestBody[T any](r *http.Request) (*T, error) {
 const (
 	avatarFieldName = "avatar"
 	scanFieldName   = "scan"
+	weightFieldName = "weight"
 	maxFileSize     = 10 << 20 // 10 MB
+	maxItemWeight   = 100      // kg
 )
 
 var supportedFileTypes = map[string]struct{}{
@@ -46,6 +51,20 @@ func GetScanFromMultipartForm(r *http.Request) (*models.File, error) {
 	return getFileFromMultipartForm(r, scanFieldName)
 }
 
+// GetWeightFromMultipartForm returns the optional weight in kg of the scanned
+// item. Call it after GetScanFromMultipartForm parsed the form.
+func GetWeightFromMultipartForm(r *http.Request) (*float64, error) {
+	v := r.FormValue(weightFieldName)
+	if v == "" {
+		return nil, nil
+	}
+	weight, err := strconv.ParseFloat(v, 64)
+	if err != nil || math.IsNaN(weight) || math.IsInf(weight, 0) || weight <= 0 || weight > maxItemWeight {
+		return nil, fmt.Errorf("%s must be a number of kg above 0 and at most %d", weightFieldName, maxItemWeight)
+	}
+	return &weight, nil
+}
+
 func getFileFromMultipartForm(r *http.Request, fieldName string) (*models.File, error) {
 	if err := r.ParseMultipartForm(maxFileSize); err != nil {
 		return nil, errors.New("failed to parse multipart form")
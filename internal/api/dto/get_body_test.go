@@ -6,6 +6,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -232,3 +233,35 @@ func TestGetAvatarFromMultipartForm(t *testing.T) {
 		assert.Nil(t, file)
 	})
 }
+
+func TestGetWeightFromMultipartForm(t *testing.T) {
+	newRequest := func(weight string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("weight="+url.QueryEscape(weight)))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	t.Run("valid weight", func(t *testing.T) {
+		weight, err := GetWeightFromMultipartForm(newRequest("0.25"))
+
+		require.NoError(t, err)
+		require.NotNil(t, weight)
+		assert.Equal(t, 0.25, *weight)
+	})
+
+	t.Run("no weight", func(t *testing.T) {
+		weight, err := GetWeightFromMultipartForm(newRequest(""))
+
+		require.NoError(t, err)
+		assert.Nil(t, weight)
+	})
+
+	for _, value := range []string{"heavy", "0", "-1", "500", "NaN", "Inf", "+Inf", "-Inf"} {
+		t.Run("rejects "+value, func(t *testing.T) {
+			weight, err := GetWeightFromMultipartForm(newRequest(value))
+
+			assert.Error(t, err)
+			assert.Nil(t, weight)
+		})
+	}
+}
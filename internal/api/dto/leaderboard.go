@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+type LeaderboardResponse struct {
+	Period models.LeaderboardPeriod `json:"period"`
+	// Start is when the weekly or monthly period began.
+	Start   *time.Time                `json:"start,omitempty"`
+	Entries []models.LeaderboardEntry `json:"entries"`
+	// Me is the place of the caller, also outside of the top; it is omitted
+	// when the caller is not ranked or hid themselves.
+	Me *models.LeaderboardEntry `json:"me,omitempty"`
+}
+
+func NewLeaderboardResponse(
+	period models.LeaderboardPeriod, now time.Time, entries []models.LeaderboardEntry, me *models.LeaderboardEntry,
+) LeaderboardResponse {
+	resp := LeaderboardResponse{Period: period, Entries: entries, Me: me}
+	if period != models.LeaderboardAllTime {
+		start := period.Start(now)
+		resp.Start = &start
+	}
+	return resp
+}
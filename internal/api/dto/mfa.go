@@ -0,0 +1,27 @@
+package dto
+
+type TOTPEnrollmentResponse struct {
+	Secret        string   `json:"secret"`
+	URI           string   `json:"otpauth_uri" example:"otpauth://totp/TrashScanner:admin?secret=..."`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TOTPCodeRequest struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+// MFACodeRequest carries either a TOTP code or one of the recovery codes.
+type MFACodeRequest struct {
+	Code         string `json:"code,omitempty" validate:"required_without=RecoveryCode,omitempty,numeric,len=6"`
+	RecoveryCode string `json:"recovery_code,omitempty" validate:"required_without=Code,omitempty,max=32"`
+}
+
+type LoginMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	MFACodeRequest
+}
+
+type MFAChallengeResponse struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+}
+
+type JoinOrganizationRequest struct {
+	InviteCode string `json:"invite_code" validate:"required"`
+}
+
+type UpdateOrganizationMemberRequest struct {
+	Role models.OrgRole `json:"role" validate:"required,oneof=owner member" enums:"owner,member"`
+}
+
+type OrganizationResponse struct {
+	models.Organization
+	// Role is the role of the caller in the organization.
+	Role models.OrgRole `json:"role" enums:"owner,member"`
+	// InviteCode lets users join the organization; it is shown to owners only.
+	InviteCode string                    `json:"invite_code,omitempty" example:"Xb3kQ9vPz2A"`
+	Stats      *models.OrganizationStats `json:"stats,omitempty"`
+}
+
+func NewOrganizationResponse(
+	org models.Organization, role models.OrgRole, stats *models.OrganizationStats,
+) OrganizationResponse {
+	resp := OrganizationResponse{Organization: org, Role: role, Stats: stats}
+	if role == models.OrgRoleOwner {
+		resp.InviteCode = org.InviteCode
+	}
+	return resp
+}
+
+type InviteCodeResponse struct {
+	InviteCode string `json:"invite_code" example:"Xb3kQ9vPz2A"`
+}
+
+type OrganizationMembersResponse struct {
+	// Since is the first day the recent scans of the members are counted from.
+	Since   string                  `json:"since" example:"2026-02-09"`
+	Members []models.MemberActivity `json:"members"`
+}
+
+type OrganizationLeaderboardResponse struct {
+	Period models.LeaderboardPeriod `json:"period"`
+	// Start is when the weekly or monthly period began.
+	Start   *time.Time                            `json:"start,omitempty"`
+	Entries []models.OrganizationLeaderboardEntry `json:"entries"`
+}
+
+func NewOrganizationLeaderboardResponse(
+	period models.LeaderboardPeriod, now time.Time, entries []models.OrganizationLeaderboardEntry,
+) OrganizationLeaderboardResponse {
+	resp := OrganizationLeaderboardResponse{Period: period, Entries: entries}
+	if period != models.LeaderboardAllTime {
+		start := period.Start(now)
+		resp.Start = &start
+	}
+	return resp
+}
@@ -1,5 +1,100 @@
 package dto
 
-import "github.com/trashscanner/trashscanner_api/internal/models"
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
 
 type PredictionResponse models.Prediction
+
+type AdminPredictionResponse struct {
+	models.AdminPrediction
+	Confidence float64 `json:"confidence"`
+}
+
+type AdminPredictionListResponse struct {
+	TotalCount  int64                     `json:"total_count"`
+	Limit       int                       `json:"limit"`
+	Offset      int                       `json:"offset"`
+	Predictions []AdminPredictionResponse `json:"predictions"`
+}
+
+type VerifyPredictionRequest struct {
+	// Label is the confirmed trash type; null clears the verification.
+	Label *string `json:"label" validate:"omitempty,oneof=cardboard glass metal paper plastic trash"`
+}
+
+type PredictionFeedbackRequest struct {
+	// Label is the trash type the owner thinks is on the scan.
+	Label   string `json:"label,omitempty" validate:"omitempty,oneof=cardboard glass metal paper plastic trash"`
+	Comment string `json:"comment,omitempty" validate:"required_without=Label,max=1000"`
+}
+
+// NewPredictionFilter reads the admin prediction filters from query.
+func NewPredictionFilter(query url.Values) (models.PredictionFilter, error) {
+	var filter models.PredictionFilter
+
+	if v := query.Get("user_id"); v != "" {
+		userID, err := uuid.Parse(v)
+		if err != nil {
+			return filter, errors.New("user_id must be a UUID")
+		}
+		filter.UserID = &userID
+	}
+	if v := query.Get("status"); v != "" {
+		status := models.PredictionStatus(v)
+		if !status.IsValid() {
+			return filter, fmt.Errorf("unknown status %q", v)
+		}
+		filter.Status = &status
+	}
+
+	if v := query.Get("max_confidence"); v != "" {
+		confidence, err := strconv.ParseFloat(v, 64)
+		if err != nil || confidence <= 0 || confidence > 1 {
+			return filter, errors.New("max_confidence must be a number in (0, 1]")
+		}
+		filter.MaxConfidence = &confidence
+	}
+
+	var err error
+	if filter.HasFeedback, err = parseBoolQuery(query, "has_feedback"); err != nil {
+		return filter, err
+	}
+	if filter.Verified, err = parseBoolQuery(query, "verified"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedFrom, err = parseTimeQuery(query, "from"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedTo, err = parseTimeQuery(query, "to"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return filter, errors.New("from must be before to")
+	}
+
+	return filter, nil
+}
+
+func NewAdminPredictionListResponse(
+	predictions []models.AdminPrediction, totalCount int64, limit, offset int,
+) AdminPredictionListResponse {
+	res := AdminPredictionListResponse{
+		TotalCount:  totalCount,
+		Limit:       limit,
+		Offset:      offset,
+		Predictions: make([]AdminPredictionResponse, 0, len(predictions)),
+	}
+
+	for _, p := range predictions {
+		res.Predictions = append(res.Predictions, AdminPredictionResponse{AdminPrediction: p, Confidence: p.Confidence()})
+	}
+
+	return res
+}
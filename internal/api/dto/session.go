@@ -0,0 +1,94 @@
+package dto
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+type LoginHistoryResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Success       bool      `json:"success"`
+	FailureReason *string   `json:"failure_reason,omitempty"`
+	IPAddress     *string   `json:"ip_address,omitempty" example:"203.0.113.7"`
+	UserAgent     *string   `json:"user_agent,omitempty"`
+	Location      *string   `json:"location,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type LoginHistoryListResponse struct {
+	TotalCount int64                  `json:"total_count"`
+	Limit      int                    `json:"limit"`
+	Offset     int                    `json:"offset"`
+	Logins     []LoginHistoryResponse `json:"logins"`
+}
+
+// SessionResponse describes a refresh token without exposing its hash.
+type SessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewLoginHistoryFilter reads the login history filters from query.
+func NewLoginHistoryFilter(query url.Values) (models.LoginHistoryFilter, error) {
+	var (
+		filter models.LoginHistoryFilter
+		err    error
+	)
+	if filter.Success, err = parseBoolQuery(query, "success"); err != nil {
+		return filter, err
+	}
+	if filter.From, err = parseTimeQuery(query, "from"); err != nil {
+		return filter, err
+	}
+	if filter.To, err = parseTimeQuery(query, "to"); err != nil {
+		return filter, err
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, errors.New("from must be before to")
+	}
+
+	return filter, nil
+}
+
+func NewLoginHistoryListResponse(
+	history []models.LoginHistory, totalCount int64, limit, offset int,
+) LoginHistoryListResponse {
+	res := LoginHistoryListResponse{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		Logins:     make([]LoginHistoryResponse, 0, len(history)),
+	}
+
+	for _, h := range history {
+		login := LoginHistoryResponse{
+			ID:            h.ID,
+			Success:       h.Success,
+			FailureReason: h.FailureReason,
+			UserAgent:     h.UserAgent,
+			Location:      h.Location,
+			CreatedAt:     h.CreatedAt,
+		}
+		if h.IpAddress != nil {
+			ip := h.IpAddress.String()
+			login.IPAddress = &ip
+		}
+		res.Logins = append(res.Logins, login)
+	}
+
+	return res
+}
+
+func NewSessionResponses(tokens []models.RefreshToken) []SessionResponse {
+	res := make([]SessionResponse, len(tokens))
+	for i, t := range tokens {
+		res[i] = SessionResponse{ID: t.ID, CreatedAt: t.CreatedAt, ExpiresAt: t.ExpiresAt}
+	}
+
+	return res
+}
@@ -0,0 +1,153 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+const maxTrendPeriods = 366
+
+// defaultTrendPeriods is the number of periods, up to today, trends cover
+// without a from bound.
+var defaultTrendPeriods = map[models.TrendPeriod]int{
+	models.TrendDaily:   30,
+	models.TrendWeekly:  12,
+	models.TrendMonthly: 12,
+}
+
+// TrendsQuery is a span of periods. From is the first day of the first
+// period and To the last day, inclusive, both as UTC midnights.
+type TrendsQuery struct {
+	Period models.TrendPeriod
+	From   time.Time
+	To     time.Time
+}
+
+// NewTrendsQuery reads the trends query from query. Periods default to days;
+// without bounds the query covers the last few periods up to today, the
+// calendar day of today in its own location.
+func NewTrendsQuery(query url.Values, today time.Time) (TrendsQuery, error) {
+	q := TrendsQuery{Period: models.TrendDaily, To: models.Day(today)}
+	if period := query.Get("period"); period != "" {
+		q.Period = models.TrendPeriod(period)
+		if !q.Period.IsValid() {
+			return q, fmt.Errorf("period must be one of %s, %s, %s",
+				models.TrendDaily, models.TrendWeekly, models.TrendMonthly)
+		}
+	}
+
+	from, err := parseDateQuery(query, "from")
+	if err != nil {
+		return q, err
+	}
+	to, err := parseDateQuery(query, "to")
+	if err != nil {
+		return q, err
+	}
+	if to != nil {
+		q.To = *to
+	}
+	if from != nil && from.After(q.To) {
+		return q, errors.New("from must not be after to")
+	}
+
+	if from != nil {
+		q.From = q.Period.Start(*from)
+	} else {
+		q.From = q.Period.Start(q.To)
+		for range defaultTrendPeriods[q.Period] - 1 {
+			q.From = q.Period.Start(q.From.AddDate(0, 0, -1))
+		}
+	}
+	if q.periods() > maxTrendPeriods {
+		return q, fmt.Errorf("range must not exceed %d periods", maxTrendPeriods)
+	}
+
+	return q, nil
+}
+
+// periods counts the periods of q, stopping once there are too many.
+func (q TrendsQuery) periods() int {
+	var n int
+	for start := q.From; !start.After(q.To) && n <= maxTrendPeriods; start = q.Period.Next(start) {
+		n++
+	}
+	return n
+}
+
+type TrendPeriodResponse struct {
+	// Start is the first day of the period.
+	Start string `json:"start" example:"2026-03-09"`
+	Scans int    `json:"scans"`
+	// TrashByTypes is the number of items of each type sorted within the
+	// period.
+	TrashByTypes map[string]int `json:"trash_by_types"`
+	// Rating is the rating at the end of the period.
+	Rating       int `json:"rating"`
+	RatingChange int `json:"rating_change"`
+}
+
+type TrendsResponse struct {
+	Timezone string             `json:"timezone" example:"Europe/Berlin"`
+	Period   models.TrendPeriod `json:"period" example:"week"`
+	From     string             `json:"from" example:"2025-12-22"`
+	To       string             `json:"to" example:"2026-03-10"`
+	// Periods holds every period of the range in order, including periods
+	// without scans.
+	Periods []TrendPeriodResponse `json:"periods"`
+}
+
+// NewTrendsResponse breaks history, as returned by the store for q, into the
+// periods of q. Each period is the change from the last snapshot before it to
+// the last one within it. History starts at the first snapshot, so periods up
+// to it show no change; revisions that lowered the totals count as nothing
+// sorted rather than as negative counts.
+func NewTrendsResponse(user *models.User, q TrendsQuery, history []models.StatSnapshot) TrendsResponse {
+	resp := TrendsResponse{
+		Timezone: user.Location().String(),
+		Period:   q.Period,
+		From:     q.From.Format(time.DateOnly),
+		To:       q.To.Format(time.DateOnly),
+		Periods:  make([]TrendPeriodResponse, 0, q.periods()),
+	}
+
+	var prev models.StatSnapshot
+	if len(history) > 0 {
+		prev = history[0]
+	}
+	next := 0
+	for start := q.From; !start.After(q.To); start = q.Period.Next(start) {
+		end := q.Period.Next(start)
+		last := prev
+		for ; next < len(history) && history[next].Day.Before(end); next++ {
+			last = history[next]
+		}
+
+		resp.Periods = append(resp.Periods, TrendPeriodResponse{
+			Start:        start.Format(time.DateOnly),
+			Scans:        max(last.FilesScanned-prev.FilesScanned, 0),
+			TrashByTypes: sortedSince(prev.TrashByTypes, last.TrashByTypes),
+			Rating:       last.Rating,
+			RatingChange: last.Rating - prev.Rating,
+		})
+		prev = last
+	}
+
+	return resp
+}
+
+// sortedSince returns the number of items of each type sorted between the
+// totals before and after.
+func sortedSince(before, after map[string]int) map[string]int {
+	sorted := make(map[string]int)
+	for trashType, count := range after {
+		if n := count - before[trashType]; n > 0 {
+			sorted[trashType] = n
+		}
+	}
+	return sorted
+}
@@ -12,7 +12,17 @@ type ChangePasswordRequest struct {
 }
 
 type UpdateUserRequest struct {
-	Name string `json:"name" validate:"required,min=3,max=64,alphanum"`
+	Name  string  `json:"name" validate:"required_without_all=Email Hidden Timezone,omitempty,min=3,max=64,alphanum"`
+	Email *string `json:"email,omitempty" validate:"omitempty,email,max=254"`
+	// Hidden keeps the user off leaderboards when true.
+	Hidden *bool `json:"leaderboard_hidden,omitempty"`
+	// Timezone is the IANA time zone days are counted in for streaks and
+	// activity.
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty,timezone,ne=Local" example:"Europe/Berlin"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
 }
 
 type UploadAvatarRequest struct {
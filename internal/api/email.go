@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	verificationTokenSize = 32
+	verificationMailSubj  = "TrashScanner email verification"
+)
+
+// requireVerifiedEmail rejects requests from users whose email is not verified
+// when verification is required by the configuration.
+func (s *Server) requireVerifiedEmail(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.emailVerification.Required {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctxUser := utils.GetUser(r.Context())
+		// Guests have no email; their scans are bounded by the guest quota instead.
+		if ctxUser.Role == models.RoleAnonymous {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, err := s.store.GetUser(r.Context(), ctxUser.ID, false)
+		if err != nil {
+			s.WriteError(w, r, err)
+			return
+		}
+		if !user.EmailVerified {
+			s.WriteError(w, r, errlocal.NewErrForbidden("email is not verified",
+				"email verification required", map[string]any{"user_id": user.ID.String()}))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SendEmailVerification godoc
+// @Summary Send email verification
+// @Description Send a single-use verification token to the email of the current user
+// @Tags users
+// @Accept json
+// @Produce json
+// @Success 202 "Verification email sent"
+// @Failure 400 {object} errlocal.ErrBadRequest "No email on the account"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 409 {object} errlocal.ErrConflict "Email already verified"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/email/verification [post]
+func (s *Server) sendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	user := utils.GetUser(r.Context())
+	if user.Email == nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("account has no email", "", nil))
+		return
+	}
+	if user.EmailVerified {
+		s.WriteError(w, r, errlocal.NewErrConflict("email already verified", "", nil))
+		return
+	}
+
+	if err := s.issueEmailVerification(r.Context(), user); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusAccepted, nil)
+}
+
+// VerifyEmail godoc
+// @Summary Verify email
+// @Description Mark the email of an account as verified with a verification token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.VerifyEmailRequest true "Verification token"
+// @Success 204 "Email verified"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid body or invalid/expired token"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Router /email/verify [post]
+func (s *Server) verifyEmail(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.VerifyEmailRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+
+	if err := s.store.ExecTx(r.Context(), func(tx store.Store) error {
+		userID, email, err := tx.ConsumeEmailVerificationToken(r.Context(), utils.HashToken(b.Token))
+		if err != nil {
+			return err
+		}
+		if err := tx.VerifyUserEmail(r.Context(), userID, email); err != nil {
+			return err
+		}
+		return tx.InvalidateEmailVerificationTokens(r.Context(), userID)
+	}); err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			s.WriteError(w, r, errlocal.NewErrBadRequest("invalid or expired verification token", "", nil))
+			return
+		}
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// issueEmailVerification replaces any pending verification tokens of the user
+// with a new one and mails it to the user's current email.
+func (s *Server) issueEmailVerification(ctx context.Context, user *models.User) error {
+	token, err := utils.GenerateToken(verificationTokenSize)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to create verification token", err.Error(), nil)
+	}
+
+	verificationToken := &models.EmailVerificationToken{
+		UserID:    user.ID,
+		Email:     *user.Email,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: time.Now().Add(s.emailVerification.TokenTTL),
+	}
+	if err := s.store.ExecTx(ctx, func(tx store.Store) error {
+		if err := tx.InvalidateEmailVerificationTokens(ctx, user.ID); err != nil {
+			return err
+		}
+		return tx.InsertEmailVerificationToken(ctx, verificationToken)
+	}); err != nil {
+		return err
+	}
+
+	if err := s.mailer.Send(ctx, s.verificationMessage(*user.Email, token)); err != nil {
+		return errlocal.NewErrInternal("failed to send verification email", err.Error(),
+			map[string]any{"user_id": user.ID.String()})
+	}
+
+	return nil
+}
+
+func (s *Server) verificationMessage(to, token string) mailer.Message {
+	body := fmt.Sprintf("Your email verification token: %s\n", token)
+	if link, err := url.Parse(s.emailVerification.URL); err == nil && s.emailVerification.URL != "" {
+		query := link.Query()
+		query.Set(resetTokenQueryKey, token)
+		link.RawQuery = query.Encode()
+		body = fmt.Sprintf("Follow the link to verify your email: %s\n", link.String())
+	}
+	body += fmt.Sprintf("It expires in %s.\n", s.emailVerification.TokenTTL)
+
+	return mailer.Message{
+		To:      to,
+		Subject: verificationMailSubj,
+		Body:    body,
+	}
+}
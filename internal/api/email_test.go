@@ -0,0 +1,186 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
+	mailermocks "github.com/trashscanner/trashscanner_api/internal/mailer/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestSendEmailVerification(t *testing.T) {
+	email := "user@example.com"
+
+	t.Run("sends verification link", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		mailerMock := mailermocks.NewMailer(t)
+		server.mailer = mailerMock
+		server.emailVerification = config.EmailVerificationConfig{
+			TokenTTL: time.Hour,
+			URL:      "https://app.example.com/verify",
+		}
+		user := &models.User{ID: uuid.New(), Login: "testuser", Email: &email}
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().InvalidateEmailVerificationTokens(mock.Anything, user.ID).Return(nil)
+
+		var tokenHash string
+		storeMock.EXPECT().
+			InsertEmailVerificationToken(mock.Anything, mock.MatchedBy(func(token *models.EmailVerificationToken) bool {
+				tokenHash = token.TokenHash
+				return token.UserID == user.ID && token.Email == email && token.ExpiresAt.After(time.Now())
+			})).
+			Return(nil)
+		mailerMock.EXPECT().
+			Send(mock.Anything, mock.MatchedBy(func(msg mailer.Message) bool {
+				_, token, found := strings.Cut(msg.Body, "https://app.example.com/verify?token=")
+				if !found {
+					return false
+				}
+				token, _, _ = strings.Cut(token, "\n")
+				return msg.To == email && utils.CompareTokenHash(tokenHash, token)
+			})).
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/email/verification", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), user))
+		rr := httptest.NewRecorder()
+		server.sendEmailVerification(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("account without email", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/email/verification", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), &models.User{ID: uuid.New()}))
+		rr := httptest.NewRecorder()
+		server.sendEmailVerification(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("already verified", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Email: &email, EmailVerified: true}
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/email/verification", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), user))
+		rr := httptest.NewRecorder()
+		server.sendEmailVerification(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Run("marks email verified", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		userID := uuid.New()
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().ConsumeEmailVerificationToken(mock.Anything, utils.HashToken("token")).
+			Return(userID, "user@example.com", nil)
+		storeMock.EXPECT().VerifyUserEmail(mock.Anything, userID, "user@example.com").Return(nil)
+		storeMock.EXPECT().InvalidateEmailVerificationTokens(mock.Anything, userID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/email/verify",
+			bytes.NewBufferString(`{"token":"token"}`))
+		rr := httptest.NewRecorder()
+		server.verifyEmail(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().ConsumeEmailVerificationToken(mock.Anything, utils.HashToken("token")).
+			Return(uuid.Nil, "", errlocal.NewErrNotFound("email verification token not found", "", nil))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/email/verify",
+			bytes.NewBufferString(`{"token":"token"}`))
+		rr := httptest.NewRecorder()
+		server.verifyEmail(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/email/verify", bytes.NewBufferString(`{}`))
+		rr := httptest.NewRecorder()
+		server.verifyEmail(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestRequireVerifiedEmail(t *testing.T) {
+	email := "user@example.com"
+	tests := []struct {
+		name         string
+		required     bool
+		user         *models.User
+		expectedCode int
+	}{
+		{
+			name:         "not required",
+			required:     false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "verified",
+			required:     true,
+			user:         &models.User{ID: uuid.New(), Email: &email, EmailVerified: true},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "not verified",
+			required:     true,
+			user:         &models.User{ID: uuid.New(), Email: &email},
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, storeMock, _, _, _ := newTestServer(t)
+			server.emailVerification.Required = tt.required
+
+			ctxUser := &models.User{ID: uuid.New()}
+			if tt.user != nil {
+				ctxUser.ID = tt.user.ID
+				storeMock.EXPECT().GetUser(mock.Anything, tt.user.ID, false).Return(tt.user, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/predictions", nil)
+			req = req.WithContext(utils.SetUser(req.Context(), ctxUser))
+			rr := httptest.NewRecorder()
+			server.requireVerifiedEmail(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedCode, rr.Code)
+		})
+	}
+}
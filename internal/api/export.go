@@ -0,0 +1,189 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// exportWriter encodes rows of an export as they arrive. The response is
+// committed with the first row, so errors before it are still reported as
+// JSON errors.
+type exportWriter struct {
+	w        http.ResponseWriter
+	format   dto.ExportFormat
+	name     string
+	header   []string
+	csv      *csv.Writer
+	jsonl    *json.Encoder
+	rows     int
+	started  bool
+	modified time.Time
+}
+
+func newExportWriter(w http.ResponseWriter, format dto.ExportFormat, name string, header []string) *exportWriter {
+	return &exportWriter{w: w, format: format, name: name, header: header, modified: time.Now().UTC()}
+}
+
+func (e *exportWriter) start() error {
+	e.started = true
+	filename := fmt.Sprintf("%s-%s.%s", e.name, e.modified.Format("20060102T150405Z"), e.format)
+	e.w.Header().Set("Content-Type", e.format.ContentType())
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.format == dto.ExportFormatJSONL {
+		e.jsonl = json.NewEncoder(e.w)
+		return nil
+	}
+	e.csv = csv.NewWriter(e.w)
+	return e.csv.Write(e.header)
+}
+
+// write adds a row: record in CSV, value in JSON lines.
+func (e *exportWriter) write(record []string, value any) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	e.rows++
+
+	if e.jsonl != nil {
+		return e.jsonl.Encode(value)
+	}
+	return e.csv.Write(record)
+}
+
+func (e *exportWriter) finish() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	if e.csv != nil {
+		e.csv.Flush()
+		return e.csv.Error()
+	}
+	return nil
+}
+
+// stream runs export, which feeds rows to the writer, and records the export
+// in the audit trail. Exports may outlast the server write timeout, so it is
+// lifted for this response. A failure after the first row aborts the
+// connection so that clients don't mistake a truncated file for a full one.
+func (s *Server) stream(w http.ResponseWriter, r *http.Request, e *exportWriter, export func() error) {
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	err := export()
+	if err == nil {
+		err = e.finish()
+	}
+
+	s.audit(r, models.AuditEvent{
+		Action:     models.AuditDataExport,
+		TargetType: models.AuditTargetExport,
+		TargetID:   e.name,
+	}, nil, map[string]any{
+		"format":   e.format,
+		"query":    r.URL.RawQuery,
+		"rows":     e.rows,
+		"complete": err == nil,
+	})
+
+	switch {
+	case err == nil:
+		s.logger.WithContext(r.Context()).WithField("rows", e.rows).Info("export streamed")
+	case !e.started:
+		s.WriteError(w, r, err)
+	default:
+		s.logger.WithContext(r.Context()).WithError(err).Error("export aborted")
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// exportUsers godoc
+// @Summary      Export users
+// @Description  Stream all users matching the admin user list filters as CSV or JSON lines, oldest first
+// @Tags         admin
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Param        format           query  string  false  "Format"  Enums(csv,jsonl)  default(csv)
+// @Param        search           query  string  false  "Substring of the login or the name"
+// @Param        role             query  string  false  "Role"  Enums(admin,moderator,user,anonymous)
+// @Param        deleted          query  bool    false  "Only deleted (true) or not deleted (false) users"
+// @Param        banned           query  bool    false  "Only banned (true) or not banned (false) users"
+// @Param        status           query  string  false  "Stats status"
+// @Param        last_login_from  query  string  false  "Last login at or after (RFC 3339)"
+// @Param        last_login_to    query  string  false  "Last login before (RFC 3339)"
+// @Success      200              {file}    file
+// @Failure      400              {object}  errlocal.ErrBadRequest
+// @Failure      401              {object}  errlocal.ErrUnauthorized
+// @Failure      403              {object}  errlocal.ErrForbidden
+// @Failure      500              {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/export/users [get]
+func (s *Server) exportUsers(w http.ResponseWriter, r *http.Request) {
+	format, err := dto.NewExportFormat(r.URL.Query())
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid format", err.Error(), nil))
+		return
+	}
+	filter, err := dto.NewUserFilter(r.URL.Query())
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid filter", err.Error(), nil))
+		return
+	}
+
+	e := newExportWriter(w, format, "users", dto.UserExportHeader)
+	s.stream(w, r, e, func() error {
+		return s.store.ExportUsers(r.Context(), filter, func(user models.User) error {
+			return e.write(dto.UserExportRecord(user), dto.NewAdminUserResponse(user))
+		})
+	})
+}
+
+// exportPredictions godoc
+// @Summary      Export predictions
+// @Description  Stream the predictions of all users as CSV or JSON lines, oldest first
+// @Tags         admin
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Param        format          query  string  false  "Format"  Enums(csv,jsonl)  default(csv)
+// @Param        user_id         query  string  false  "Owner ID (UUID)"
+// @Param        status          query  string  false  "Status"  Enums(processing,completed,failed)
+// @Param        max_confidence  query  number  false  "Only predictions whose top probability is below it"
+// @Param        has_feedback    query  bool    false  "Only predictions with (true) or without owner feedback"
+// @Param        verified        query  bool    false  "Only predictions with (true) or without a verified label"
+// @Param        from            query  string  false  "Created at or after (RFC 3339)"
+// @Param        to              query  string  false  "Created before (RFC 3339)"
+// @Success      200             {file}    file
+// @Failure      400             {object}  errlocal.ErrBadRequest
+// @Failure      401             {object}  errlocal.ErrUnauthorized
+// @Failure      403             {object}  errlocal.ErrForbidden
+// @Failure      500             {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/export/predictions [get]
+func (s *Server) exportPredictions(w http.ResponseWriter, r *http.Request) {
+	format, err := dto.NewExportFormat(r.URL.Query())
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid format", err.Error(), nil))
+		return
+	}
+	filter, err := dto.NewPredictionFilter(r.URL.Query())
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid filter", err.Error(), nil))
+		return
+	}
+
+	e := newExportWriter(w, format, "predictions", dto.PredictionExportHeader)
+	s.stream(w, r, e, func() error {
+		return s.store.ExportPredictions(r.Context(), filter, func(prediction models.AdminPrediction) error {
+			return e.write(dto.PredictionExportRecord(prediction), prediction)
+		})
+	})
+}
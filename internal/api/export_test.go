@@ -0,0 +1,236 @@
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestExportUsers(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	user := models.User{
+		ID:        uuid.New(),
+		Login:     "user",
+		Name:      "Some, User",
+		Role:      models.RoleUser,
+		CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Stat:      &models.Stat{Rating: 12, FilesScanned: 3},
+	}
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/export/users"+query, nil)
+		return req.WithContext(utils.SetUser(req.Context(), admin))
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		role := models.RoleUser
+
+		storeMock.EXPECT().ExportUsers(mock.Anything, models.UserFilter{Role: &role}, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ models.UserFilter, fn func(models.User) error) error {
+				return fn(user)
+			}).Once()
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditDataExport && e.TargetID == "users" &&
+				strings.Contains(string(e.After), `"rows":1`)
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.exportUsers(rr, newRequest("?role=user"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Header().Get("Content-Disposition"), `attachment; filename="users-`)
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, dto.UserExportHeader, records[0])
+		assert.Equal(t, "Some, User", records[1][2])
+		assert.Equal(t, "12", records[1][9])
+	})
+
+	t.Run("formulas are not run by spreadsheets", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		malicious := user
+		malicious.Name = `=HYPERLINK("http://evil.example","click")`
+		malicious.Login = "@login"
+		malicious.Ban = &models.Ban{BannedAt: time.Now(), Reason: "-1+2"}
+
+		storeMock.EXPECT().ExportUsers(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ models.UserFilter, fn func(models.User) error) error {
+				return fn(malicious)
+			}).Once()
+		expectAudit(storeMock, models.AuditDataExport)
+
+		rr := httptest.NewRecorder()
+		server.exportUsers(rr, newRequest(""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "'@login", records[1][1])
+		assert.Equal(t, `'=HYPERLINK("http://evil.example","click")`, records[1][2])
+		assert.Equal(t, "'-1+2", records[1][7])
+	})
+
+	t.Run("empty export still has a header", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().ExportUsers(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		expectAudit(storeMock, models.AuditDataExport)
+
+		rr := httptest.NewRecorder()
+		server.exportUsers(rr, newRequest(""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, strings.Join(dto.UserExportHeader, ",")+"\n", rr.Body.String())
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.exportUsers(rr, newRequest("?format=xlsx"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("failure before the first row", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().ExportUsers(mock.Anything, mock.Anything, mock.Anything).
+			Return(errlocal.NewErrInternal("failed to export users", "db down", nil)).Once()
+		expectAudit(storeMock, models.AuditDataExport)
+
+		rr := httptest.NewRecorder()
+		server.exportUsers(rr, newRequest(""))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("failure mid-stream aborts the response", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().ExportUsers(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ models.UserFilter, fn func(models.User) error) error {
+				if err := fn(user); err != nil {
+					return err
+				}
+				return errors.New("db down")
+			}).Once()
+		expectAudit(storeMock, models.AuditDataExport)
+
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			server.exportUsers(httptest.NewRecorder(), newRequest(""))
+		})
+	})
+}
+
+func TestExportPredictions(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	prediction := models.AdminPrediction{
+		Prediction: models.Prediction{
+			ID:     uuid.New(),
+			UserID: uuid.New(),
+			Status: models.PredictionCompletedStatus,
+			Result: models.PredictionResult{"glass": 0.9},
+		},
+		UserLogin: "user",
+	}
+
+	t.Run("jsonl", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		status := models.PredictionCompletedStatus
+
+		storeMock.EXPECT().ExportPredictions(mock.Anything, models.PredictionFilter{Status: &status}, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ models.PredictionFilter, fn func(models.AdminPrediction) error) error {
+				if err := fn(prediction); err != nil {
+					return err
+				}
+				return fn(prediction)
+			}).Once()
+		expectAudit(storeMock, models.AuditDataExport)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/export/predictions?format=jsonl&status=completed", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), admin))
+		rr := httptest.NewRecorder()
+		server.exportPredictions(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+		require.Len(t, lines, 2)
+		var got models.AdminPrediction
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+		assert.Equal(t, prediction.ID, got.ID)
+		assert.Equal(t, "user", got.UserLogin)
+		assert.Equal(t, 0.9, got.Result["glass"])
+	})
+
+	t.Run("csv keeps the review of verified predictions", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		staffID := uuid.New()
+		verified := prediction
+		verified.Weight = utils.Ptr(0.25)
+		verified.Verification = &models.PredictionVerification{
+			Label: "plastic", VerifiedBy: &staffID, VerifiedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		}
+		verified.Feedback = &models.PredictionFeedback{
+			Label: "plastic", Comment: "=cmd", CreatedAt: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		}
+
+		storeMock.EXPECT().ExportPredictions(mock.Anything, models.PredictionFilter{Verified: utils.Ptr(true)}, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ models.PredictionFilter, fn func(models.AdminPrediction) error) error {
+				return fn(verified)
+			}).Once()
+		expectAudit(storeMock, models.AuditDataExport)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/export/predictions?verified=true", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), admin))
+		rr := httptest.NewRecorder()
+		server.exportPredictions(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, dto.PredictionExportHeader, records[0])
+		row := make(map[string]string, len(records[0]))
+		for i, column := range records[0] {
+			row[column] = records[1][i]
+		}
+		assert.Equal(t, "0.25", row["weight"])
+		assert.Equal(t, "plastic", row["verified_label"])
+		assert.Equal(t, staffID.String(), row["verified_by"])
+		assert.Equal(t, "2026-02-01T00:00:00Z", row["verified_at"])
+		assert.Equal(t, "'=cmd", row["feedback_comment"])
+		assert.Equal(t, "2026-01-31T00:00:00Z", row["feedback_at"])
+	})
+
+	t.Run("invalid user id", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/export/predictions?user_id=nope", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), admin))
+		rr := httptest.NewRecorder()
+		server.exportPredictions(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	guestLoginPrefix     = "guest"
+	guestLoginSuffixSize = 6
+	guestPasswordSize    = 24
+	guestName            = "Guest"
+)
+
+// CreateGuest godoc
+// @Summary Start a guest session
+// @Description Create an anonymous account with a short-lived session that can start a limited number of scans.
+// @Description Registering through /register with the guest session moves its predictions and stats to the new account.
+// @Tags auth
+// @Produce json
+// @Success 201 {object} dto.GuestResponse "Guest session set in HttpOnly cookie"
+// @Failure 404 {object} errlocal.ErrNotFound "Guest sessions are disabled"
+// @Failure 429 {object} errlocal.ErrToManyRequests "Too many guest sessions"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Header 429 {integer} Retry-After "Seconds until the next attempt is allowed"
+// @Router /guest [post]
+func (s *Server) createGuest(w http.ResponseWriter, r *http.Request) {
+	if !s.guest.Enabled {
+		s.WriteError(w, r, errlocal.NewErrNotFound("guest sessions are disabled", "", nil))
+		return
+	}
+
+	var clientKey string
+	if ip := s.clientIP(r); ip != nil {
+		clientKey = ip.String()
+	}
+	if retryAfter := s.guestLimiter.Allow(clientKey); retryAfter > 0 {
+		setRetryAfter(w, retryAfter)
+		s.WriteError(w, r, errlocal.NewErrToManyRequests("too many guest sessions, try again later"))
+		return
+	}
+
+	suffix, err := randomHex(guestLoginSuffixSize)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create login", err.Error(), nil))
+		return
+	}
+	password, err := utils.GenerateToken(guestPasswordSize)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create password", err.Error(), nil))
+		return
+	}
+	hashedPassword, err := utils.HashPass(password)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to hash password", err.Error(), nil))
+		return
+	}
+
+	user := &models.User{
+		Login:          guestLoginPrefix + suffix,
+		Name:           guestName,
+		HashedPassword: hashedPassword,
+		Role:           models.RoleAnonymous,
+	}
+	if err := s.store.CreateUser(r.Context(), user); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	token, err := s.authManager.CreateGuestToken(*user)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create guest token", err.Error(),
+			map[string]any{"user_id": user.ID.String()}))
+		return
+	}
+
+	expiresAt := time.Now().Add(s.guest.SessionTTL)
+	setGuestCookie(w, token, expiresAt)
+	s.WriteResponse(w, r, http.StatusCreated, dto.GuestResponse{
+		AuthResponse:   dto.NewAuthResponse(*user, token, ""),
+		ExpiresAt:      expiresAt,
+		MaxPredictions: s.guest.MaxPredictions,
+	})
+}
+
+// requireGuestQuota stops guests from starting more scans than allowed.
+func (s *Server) requireGuestQuota(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := utils.GetUser(r.Context())
+		if user.Role != models.RoleAnonymous {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		count, err := s.store.CountPredictions(r.Context(), user.ID)
+		if err != nil {
+			s.WriteError(w, r, err)
+			return
+		}
+		if count >= int64(s.guest.MaxPredictions) {
+			s.WriteError(w, r, errlocal.NewErrForbidden("guest scan limit reached, register to continue",
+				"guest quota exceeded", map[string]any{"user_id": user.ID.String()}))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requireRegistered denies guests the account management endpoints.
+func (s *Server) requireRegistered(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if utils.GetUser(r.Context()).Role == models.RoleAnonymous {
+			s.WriteError(w, r, errlocal.NewErrForbidden("register to use this endpoint", "guest session", nil))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// guestFromRequest returns the ID of the guest whose session came with the
+// request, or uuid.Nil when there is no valid guest session.
+func (s *Server) guestFromRequest(r *http.Request) uuid.UUID {
+	access, err := getAccessCookie(r)
+	if err != nil || access == "" {
+		return uuid.Nil
+	}
+	claims, err := s.authManager.Parse(access)
+	if err != nil || models.Role(claims.Role) != models.RoleAnonymous {
+		return uuid.Nil
+	}
+	id, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	return id
+}
+
+// claimGuest creates user and moves the guest's predictions and stats onto it
+// in one transaction.
+func (s *Server) claimGuest(ctx context.Context, guestID uuid.UUID, user *models.User) error {
+	return s.store.ExecTx(ctx, func(tx store.Store) error {
+		if err := tx.CreateUser(ctx, user); err != nil {
+			return err
+		}
+		return tx.ClaimGuest(ctx, guestID, user.ID)
+	})
+}
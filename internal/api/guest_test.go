@@ -0,0 +1,233 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestCreateGuest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		guestID := uuid.New()
+
+		storeMock.EXPECT().CreateUser(mock.Anything, mock.MatchedBy(func(u *models.User) bool {
+			return u.Role == models.RoleAnonymous && u.Name == guestName && u.HashedPassword != ""
+		})).Run(func(_ context.Context, u *models.User) {
+			u.ID = guestID
+		}).Return(nil).Once()
+		authMock.EXPECT().CreateGuestToken(mock.MatchedBy(func(u models.User) bool {
+			return u.ID == guestID
+		})).Return("guest.token", nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.createGuest(rr, httptest.NewRequest(http.MethodPost, "/api/v1/guest", nil))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var resp dto.GuestResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, guestID.String(), resp.User.ID)
+		assert.Equal(t, 3, resp.MaxPredictions)
+
+		cookies := rr.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, accessCookieName, cookies[0].Name)
+		assert.Equal(t, "guest.token", cookies[0].Value)
+		assert.False(t, cookies[0].Expires.IsZero())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		server.guest.Enabled = false
+
+		rr := httptest.NewRecorder()
+		server.createGuest(rr, httptest.NewRequest(http.MethodPost, "/api/v1/guest", nil))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		server.guestLimiter = auth.NewRateLimiter(config.RateLimitConfig{Requests: 1, Window: time.Minute})
+
+		storeMock.EXPECT().CreateUser(mock.Anything, mock.Anything).Return(nil).Once()
+		authMock.EXPECT().CreateGuestToken(mock.Anything).Return("guest.token", nil).Once()
+
+		send := func(remoteAddr string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/guest", nil)
+			req.RemoteAddr = remoteAddr
+			rr := httptest.NewRecorder()
+			server.createGuest(rr, req)
+			return rr
+		}
+
+		assert.Equal(t, http.StatusCreated, send("203.0.113.7:1234").Code)
+		rr := send("203.0.113.7:5678")
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+		assert.Equal(t, "60", rr.Header().Get("Retry-After"))
+	})
+}
+
+func TestRequireGuestQuota(t *testing.T) {
+	guest := &models.User{ID: uuid.New(), Role: models.RoleAnonymous}
+
+	run := func(server *Server, user *models.User) (*httptest.ResponseRecorder, bool) {
+		called := false
+		handler := server.requireGuestQuota(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/predictions", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), user))
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr, called
+	}
+
+	t.Run("guest under quota", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		storeMock.EXPECT().CountPredictions(mock.Anything, guest.ID).Return(int64(2), nil).Once()
+
+		_, called := run(server, guest)
+		assert.True(t, called)
+	})
+
+	t.Run("guest quota reached", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		storeMock.EXPECT().CountPredictions(mock.Anything, guest.ID).Return(int64(3), nil).Once()
+
+		rr, called := run(server, guest)
+		assert.False(t, called)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("registered user is not limited", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		_, called := run(server, &models.User{ID: uuid.New(), Role: models.RoleUser})
+		assert.True(t, called)
+	})
+}
+
+func TestRegister_ClaimsGuest(t *testing.T) {
+	guestID := uuid.New()
+	newRequest := func() *http.Request {
+		b := &dto.LoginUserRequest{Login: "newuser", Password: "password123", Name: "newuser"}
+		user := b.ToModel()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/register", nil)
+		req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "guest.token"})
+		ctx := utils.SetRequestBody(utils.SetUser(req.Context(), &user), b)
+		return req.WithContext(ctx)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		createdID := uuid.New()
+
+		authMock.EXPECT().Parse("guest.token").Return(&auth.Claims{
+			UserID: guestID.String(), Role: string(models.RoleAnonymous),
+		}, nil).Once()
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().CreateUser(mock.Anything, mock.Anything).Run(func(_ context.Context, u *models.User) {
+			u.ID = createdID
+		}).Return(nil).Once()
+		storeMock.EXPECT().ClaimGuest(mock.Anything, guestID, createdID).Return(nil).Once()
+		authMock.EXPECT().CreateNewPair(mock.Anything, mock.Anything).
+			Return(&auth.TokenPair{Access: "access", Refresh: "refresh"}, nil).Once()
+		storeMock.EXPECT().InsertLoginHistory(mock.Anything, mock.Anything).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.register(rr, newRequest())
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("claim failure aborts registration", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+
+		authMock.EXPECT().Parse("guest.token").Return(&auth.Claims{
+			UserID: guestID.String(), Role: string(models.RoleAnonymous),
+		}, nil).Once()
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().CreateUser(mock.Anything, mock.Anything).Return(nil).Once()
+		storeMock.EXPECT().ClaimGuest(mock.Anything, guestID, mock.Anything).
+			Return(errlocal.NewErrNotFound("guest not found", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.register(rr, newRequest())
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("session of a registered user is ignored", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+
+		authMock.EXPECT().Parse("guest.token").Return(&auth.Claims{
+			UserID: uuid.NewString(), Role: string(models.RoleUser),
+		}, nil).Once()
+		storeMock.EXPECT().CreateUser(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+		rr := httptest.NewRecorder()
+		server.register(rr, newRequest())
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestGuestRouting(t *testing.T) {
+	guest := models.User{ID: uuid.New(), Login: "guest0a1b2c3d4e5f", Role: models.RoleAnonymous}
+
+	newServer := func(t *testing.T, user models.User) *Server {
+		t.Helper()
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		server.initRouter()
+
+		authMock.EXPECT().Parse("access.token").Return(&auth.Claims{
+			UserID: user.ID.String(), Login: user.Login, Role: string(user.Role),
+		}, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(&user, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		return server
+	}
+	serve := func(server *Server, method, path string) int {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(`{`))
+		req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "access.token"})
+		rr := httptest.NewRecorder()
+		server.router.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	t.Run("guest reads own profile", func(t *testing.T) {
+		server := newServer(t, guest)
+		assert.Equal(t, http.StatusOK, serve(server, http.MethodGet, "/api/v1/users/me"))
+	})
+
+	t.Run("guest cannot manage the account", func(t *testing.T) {
+		server := newServer(t, guest)
+		assert.Equal(t, http.StatusForbidden, serve(server, http.MethodPut, "/api/v1/users/me/change-password"))
+	})
+
+	t.Run("registered user reaches account endpoints", func(t *testing.T) {
+		user := models.User{ID: uuid.New(), Login: "user", Role: models.RoleUser}
+		server := newServer(t, user)
+		assert.Equal(t, http.StatusBadRequest, serve(server, http.MethodPatch, "/api/v1/users/me"))
+	})
+}
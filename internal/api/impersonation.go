@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+// impersonateUser godoc
+// @Summary      Impersonate user
+// @Description  Issue a short-lived access token to act as the user, e.g. to reproduce a reported bug.
+// @Description  The token carries the admin's ID in its act claim. Requests made with it are tagged in logs
+// @Description  and in the audit log, and cannot change the password or delete the account.
+// @Description  Send the token in the access_token cookie. There is no refresh token.
+// @Tags         admin
+// @Produce      json
+// @Param        user_id path      string true  "User ID (UUID)"
+// @Success      201     {object}  dto.ImpersonationResponse
+// @Failure      400     {object}  errlocal.ErrBadRequest
+// @Failure      401     {object}  errlocal.ErrUnauthorized
+// @Failure      403     {object}  errlocal.ErrForbidden
+// @Failure      404     {object}  errlocal.ErrNotFound
+// @Failure      500     {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/users/{user_id}/impersonate [post]
+func (s *Server) impersonateUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user ID", err.Error(), nil))
+		return
+	}
+	admin := utils.GetUser(r.Context())
+	if userID == admin.ID {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("cannot impersonate yourself", "", nil))
+		return
+	}
+
+	user, err := s.store.GetUser(r.Context(), userID, false)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if user.Role == models.RoleAdmin {
+		s.WriteError(w, r, errlocal.NewErrForbidden("cannot impersonate an admin", "",
+			map[string]any{"user_id": userID.String()}))
+		return
+	}
+
+	token, err := s.authManager.CreateImpersonationToken(*user, admin.ID)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create impersonation token", err.Error(),
+			map[string]any{"user_id": userID.String()}))
+		return
+	}
+	expiresAt := time.Now().Add(s.impersonationTTL)
+	s.audit(r, userEvent(models.AuditUserImpersonate, userID), nil, map[string]any{"expires_at": expiresAt})
+
+	s.WriteResponse(w, r, http.StatusCreated, dto.ImpersonationResponse{
+		AuthResponse: dto.NewAuthResponse(*user, token, ""),
+		AccessToken:  token,
+		ExpiresAt:    expiresAt,
+	})
+}
+
+// impersonator loads the admin named in the act claim of an access token. The
+// token is refused once the admin is gone, banned or lost the permission.
+func (s *Server) impersonator(ctx context.Context, adminID uuid.UUID) (*models.User, error) {
+	admin, err := s.store.GetUser(ctx, adminID, false)
+	if err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil, errlocal.NewErrUnauthorized("impersonation is no longer allowed", "admin not found", nil)
+		}
+		return nil, err
+	}
+	if checkBan(admin) != nil || !s.policy.Allows(admin.Role, models.PermUsersImpersonate) {
+		return nil, errlocal.NewErrUnauthorized("impersonation is no longer allowed", "",
+			map[string]any{"admin_id": adminID.String()})
+	}
+
+	return admin, nil
+}
+
+// forbidImpersonation keeps admins acting as a user away from endpoints only
+// the user may use.
+func (s *Server) forbidImpersonation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if utils.GetImpersonator(r.Context()) != nil {
+			s.WriteError(w, r, errlocal.NewErrForbidden("not allowed while impersonating", "", nil))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
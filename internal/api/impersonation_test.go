@@ -0,0 +1,189 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestImpersonateUser(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Login: "admin", Role: models.RoleAdmin}
+	user := &models.User{ID: uuid.New(), Login: "user", Role: models.RoleUser}
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(user, nil).Once()
+		authMock.EXPECT().CreateImpersonationToken(*user, admin.ID).Return("impersonation.token", nil).Once()
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditUserImpersonate && e.ActorID == admin.ID && e.TargetID == user.ID.String()
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.impersonateUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+user.ID.String(), "", admin,
+			map[string]string{userIDTag: user.ID.String()}))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var resp dto.ImpersonationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "impersonation.token", resp.AccessToken)
+		assert.Equal(t, user.ID.String(), resp.User.ID)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), resp.ExpiresAt, time.Minute)
+		assert.Empty(t, rr.Result().Cookies())
+	})
+
+	t.Run("self", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.impersonateUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+admin.ID.String(), "", admin,
+			map[string]string{userIDTag: admin.ID.String()}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("admin target", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		other := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+
+		storeMock.EXPECT().GetUser(mock.Anything, other.ID, false).Return(other, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.impersonateUser(rr, newRequest(http.MethodPost, "/api/v1/admin/users/"+other.ID.String(), "", admin,
+			map[string]string{userIDTag: other.ID.String()}))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestAuthMiddleware_Impersonation(t *testing.T) {
+	user := models.User{ID: uuid.New(), Login: "user", Role: models.RoleUser}
+
+	serve := func(t *testing.T, admin models.User) (*httptest.ResponseRecorder, *models.User) {
+		t.Helper()
+		server, storeMock, authMock, _, _ := newTestServer(t)
+
+		authMock.EXPECT().Parse("access.token").Return(&auth.Claims{
+			UserID: user.ID.String(), Login: user.Login, Role: string(user.Role),
+			Actor: &auth.Actor{Subject: admin.ID.String()},
+		}, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(&user, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, admin.ID, false).Return(&admin, nil).Once()
+
+		var impersonator *models.User
+		handler := server.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			impersonator = utils.GetImpersonator(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+		req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "access.token"})
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr, impersonator
+	}
+
+	t.Run("tags the request with the admin", func(t *testing.T) {
+		admin := models.User{ID: uuid.New(), Login: "admin", Role: models.RoleAdmin}
+
+		rr, impersonator := serve(t, admin)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.NotNil(t, impersonator)
+		assert.Equal(t, admin.ID, impersonator.ID)
+	})
+
+	t.Run("admin lost the permission", func(t *testing.T) {
+		demoted := models.User{ID: uuid.New(), Login: "demoted", Role: models.RoleModerator}
+
+		rr, impersonator := serve(t, demoted)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Nil(t, impersonator)
+	})
+}
+
+func TestImpersonationRouting(t *testing.T) {
+	admin := models.User{ID: uuid.New(), Login: "admin", Role: models.RoleAdmin}
+	user := models.User{ID: uuid.New(), Login: "user", Role: models.RoleUser}
+
+	serve := func(t *testing.T, method, path string) int {
+		t.Helper()
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		server.initRouter()
+
+		authMock.EXPECT().Parse("access.token").Return(&auth.Claims{
+			UserID: user.ID.String(), Login: user.Login, Role: string(user.Role),
+			Actor: &auth.Actor{Subject: admin.ID.String()},
+		}, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(&user, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, admin.ID, false).Return(&admin, nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+
+		req := httptest.NewRequest(method, path, nil)
+		req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "access.token"})
+		rr := httptest.NewRecorder()
+		server.router.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	t.Run("profile is readable", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(t, http.MethodGet, "/api/v1/users/me"))
+	})
+
+	refused := []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{name: "password change", method: http.MethodPut, path: "/api/v1/users/me/change-password"},
+		{name: "account deletion", method: http.MethodDelete, path: "/api/v1/users/me"},
+		{name: "profile update", method: http.MethodPatch, path: "/api/v1/users/me"},
+		{name: "logout", method: http.MethodPost, path: "/api/v1/users/me/logout"},
+		{name: "totp enrollment", method: http.MethodPost, path: "/api/v1/users/me/mfa/totp"},
+		{name: "totp confirmation", method: http.MethodPost, path: "/api/v1/users/me/mfa/totp/confirm"},
+		{name: "totp removal", method: http.MethodDelete, path: "/api/v1/users/me/mfa/totp"},
+		{name: "api key creation", method: http.MethodPost, path: "/api/v1/users/me/api-keys"},
+		{name: "api key listing", method: http.MethodGet, path: "/api/v1/users/me/api-keys"},
+		{name: "api key revocation", method: http.MethodDelete, path: "/api/v1/users/me/api-keys/" + uuid.NewString()},
+	}
+	for _, tt := range refused {
+		t.Run(tt.name+" is refused", func(t *testing.T) {
+			assert.Equal(t, http.StatusForbidden, serve(t, tt.method, tt.path))
+		})
+	}
+
+	orgID := uuid.New()
+	for _, tt := range ownerOnlyOrganizationRoutes(orgID) {
+		t.Run(tt.method+" "+tt.path+" is refused", func(t *testing.T) {
+			server, storeMock, authMock, _, _ := newTestServer(t)
+			server.initRouter()
+
+			authMock.EXPECT().Parse("access.token").Return(&auth.Claims{
+				UserID: user.ID.String(), Login: user.Login, Role: string(user.Role),
+				Actor: &auth.Actor{Subject: admin.ID.String()},
+			}, nil).Once()
+			storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(&user, nil).Once()
+			storeMock.EXPECT().GetUser(mock.Anything, admin.ID, false).Return(&admin, nil).Once()
+			storeMock.EXPECT().GetOrganizationMember(mock.Anything, orgID, user.ID).Return(
+				&models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleOwner},
+				nil).Once()
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "access.token"})
+			rr := httptest.NewRecorder()
+			server.router.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+		})
+	}
+}
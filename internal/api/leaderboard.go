@@ -0,0 +1,114 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	periodQueryKey          = "period"
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+// GetLeaderboard godoc
+// @Summary Get leaderboard
+// @Description Get the users with the highest rating of all time, or the most rating gained this month or week.
+// @Description The place of the caller is included even when it is outside of the top.
+// @Description Users who hid themselves from leaderboards are not ranked.
+// @Tags leaderboard
+// @Produce json
+// @Param period query string false "Period" Enums(all,month,week) default(all)
+// @Param limit query int false "Number of top users" default(10)
+// @Success 200 {object} dto.LeaderboardResponse "Leaderboard"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid period or limit"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /leaderboard [get]
+func (s *Server) getLeaderboard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := utils.GetUser(ctx)
+
+	period, limit, err := leaderboardQuery(r)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	now := time.Now()
+	entries, err := s.store.GetLeaderboard(ctx, period, now, int32(limit))
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	var me *models.LeaderboardEntry
+	if !user.LeaderboardHidden {
+		me, err = s.store.GetLeaderboardEntry(ctx, period, now, user.ID)
+		var notFoundErr *errlocal.ErrNotFound
+		if err != nil && !errors.As(err, &notFoundErr) {
+			s.WriteError(w, r, err)
+			return
+		}
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewLeaderboardResponse(period, now, entries, me))
+}
+
+// GetOrganizationLeaderboard godoc
+// @Summary Get organization leaderboard
+// @Description Get the organizations whose members have the highest rating of all time, or gained the most rating
+// @Description this month or week. Deleted and banned members don't count.
+// @Tags leaderboard
+// @Produce json
+// @Param period query string false "Period" Enums(all,month,week) default(all)
+// @Param limit query int false "Number of top organizations" default(10)
+// @Success 200 {object} dto.OrganizationLeaderboardResponse "Organization leaderboard"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid period or limit"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /leaderboard/organizations [get]
+func (s *Server) getOrganizationLeaderboard(w http.ResponseWriter, r *http.Request) {
+	period, limit, err := leaderboardQuery(r)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	now := time.Now()
+	entries, err := s.store.GetOrganizationLeaderboard(r.Context(), period, now, int32(limit))
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewOrganizationLeaderboardResponse(period, now, entries))
+}
+
+// leaderboardQuery reads the period and the number of top entries of a
+// leaderboard from the query of r.
+func leaderboardQuery(r *http.Request) (models.LeaderboardPeriod, int, error) {
+	period := models.LeaderboardAllTime
+	if raw := r.URL.Query().Get(periodQueryKey); raw != "" {
+		period = models.LeaderboardPeriod(raw)
+	}
+	if !period.IsValid() {
+		return "", 0, errlocal.NewErrBadRequest("invalid period", "",
+			map[string]any{"period": period})
+	}
+	limit := utils.GetQueryParam(r, limitQueryKey, defaultLeaderboardLimit)
+	if limit <= 0 || limit > maxLeaderboardLimit {
+		return "", 0, errlocal.NewErrBadRequest("invalid limit", "",
+			map[string]any{"limit": limit, "max": maxLeaderboardLimit})
+	}
+
+	return period, limit, nil
+}
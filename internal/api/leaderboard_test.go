@@ -0,0 +1,153 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestGetLeaderboard(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Role: models.RoleUser}
+	top := []models.LeaderboardEntry{
+		{Rank: 1, UserID: uuid.New(), Name: "first", Status: models.UserStatusEcoScout, Score: 120},
+		{Rank: 2, UserID: uuid.New(), Name: "second", Status: models.UserStatusNewbie, Score: 90},
+	}
+
+	newRequest := func(query string, user *models.User) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leaderboard"+query, nil)
+		return req.WithContext(utils.SetUser(req.Context(), user))
+	}
+
+	t.Run("all time with own rank outside of the top", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		me := &models.LeaderboardEntry{Rank: 57, UserID: user.ID, Name: "me", Score: 20}
+
+		storeMock.EXPECT().GetLeaderboard(mock.Anything, models.LeaderboardAllTime, mock.Anything, int32(2)).
+			Return(top, nil).Once()
+		storeMock.EXPECT().GetLeaderboardEntry(mock.Anything, models.LeaderboardAllTime, mock.Anything, user.ID).
+			Return(me, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getLeaderboard(rr, newRequest("?limit=2", user))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.LeaderboardResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, models.LeaderboardAllTime, resp.Period)
+		assert.Nil(t, resp.Start)
+		assert.Equal(t, top, resp.Entries)
+		require.NotNil(t, resp.Me)
+		assert.Equal(t, 57, resp.Me.Rank)
+	})
+
+	t.Run("weekly without own rank", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetLeaderboard(mock.Anything, models.LeaderboardWeekly, mock.Anything,
+			int32(defaultLeaderboardLimit)).Return([]models.LeaderboardEntry{}, nil).Once()
+		storeMock.EXPECT().GetLeaderboardEntry(mock.Anything, models.LeaderboardWeekly, mock.Anything, user.ID).
+			Return(nil, errlocal.NewErrNotFound("user is not ranked", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.getLeaderboard(rr, newRequest("?period=week", user))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.LeaderboardResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		require.NotNil(t, resp.Start)
+		assert.Equal(t, 0, (int(resp.Start.Weekday())+6)%7, "weeks start on Monday")
+		assert.Empty(t, resp.Entries)
+		assert.Nil(t, resp.Me)
+	})
+
+	t.Run("hidden user is not looked up", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		hidden := &models.User{ID: uuid.New(), LeaderboardHidden: true}
+
+		storeMock.EXPECT().GetLeaderboard(mock.Anything, models.LeaderboardMonthly, mock.Anything, mock.Anything).
+			Return(top, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getLeaderboard(rr, newRequest("?period=month", hidden))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getLeaderboard(rr, newRequest("?period=year", user))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("limit too high", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getLeaderboard(rr, newRequest("?limit=1000", user))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetLeaderboard(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errlocal.NewErrInternal("failed to get leaderboard", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.getLeaderboard(rr, newRequest("", user))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestGetOrganizationLeaderboard(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Role: models.RoleUser}
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leaderboard/organizations"+query, nil)
+		return req.WithContext(utils.SetUser(req.Context(), user))
+	}
+
+	t.Run("monthly", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		top := []models.OrganizationLeaderboardEntry{
+			{Rank: 1, OrganizationID: uuid.New(), Name: "Green School", Members: 30, Score: 900},
+		}
+
+		storeMock.EXPECT().GetOrganizationLeaderboard(mock.Anything, models.LeaderboardMonthly, mock.Anything,
+			int32(5)).Return(top, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getOrganizationLeaderboard(rr, newRequest("?period=month&limit=5"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.OrganizationLeaderboardResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, models.LeaderboardMonthly, resp.Period)
+		require.NotNil(t, resp.Start)
+		assert.Equal(t, 1, resp.Start.Day())
+		assert.Equal(t, top, resp.Entries)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getOrganizationLeaderboard(rr, newRequest("?period=year"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -6,34 +6,17 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
+// writeLoginHistory records a login attempt. Attempts with an unknown login are
+// recorded without a user so that they still count towards the per-IP limit.
 func (s *Server) writeLoginHistory(r *http.Request, statusCode int, err error) {
 	u := utils.GetUser(r.Context())
-	if u.ID == uuid.Nil {
-		return
-	}
-
-	ipStr := r.Header.Get("X-Real-IP")
-	if ipStr == "" {
-		ipStr = r.Header.Get("X-Forwarded-For")
-		if ipStr != "" {
-			ipStr = strings.Split(ipStr, ",")[0]
-		}
-	}
-	if ipStr == "" {
-		ipStr = r.RemoteAddr
-		if idx := strings.LastIndex(ipStr, ":"); idx != -1 {
-			ipStr = ipStr[:idx]
-		}
-	}
-
-	var ipAddr *netip.Addr
-	if parsedIP, err := netip.ParseAddr(ipStr); err == nil {
-		ipAddr = &parsedIP
-	}
 
 	location := r.Header.Get("X-Location")
 	var locationPtr *string
@@ -48,9 +31,9 @@ func (s *Server) writeLoginHistory(r *http.Request, statusCode int, err error) {
 	}
 
 	loginHistory := &models.LoginHistory{
-		UserID:    u.ID,
+		UserID:    pgtype.UUID{Bytes: u.ID, Valid: u.ID != uuid.Nil},
 		Success:   statusCode >= 200 && statusCode < 300,
-		IpAddress: ipAddr,
+		IpAddress: s.clientIP(r),
 		UserAgent: userAgentPtr,
 		Location:  locationPtr,
 	}
@@ -61,3 +44,113 @@ func (s *Server) writeLoginHistory(r *http.Request, statusCode int, err error) {
 
 	_ = s.store.InsertLoginHistory(r.Context(), loginHistory)
 }
+
+// clientIP returns the address of the client that sent r. Forwarding headers
+// are only believed when the connection comes from a trusted proxy; the
+// client is then the nearest untrusted hop of X-Forwarded-For.
+func (s *Server) clientIP(r *http.Request) *netip.Addr {
+	remote, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return nil
+	}
+	peer := remote.Addr().Unmap()
+	if !s.trustedProxy(peer) {
+		return &peer
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				break
+			}
+			hop = hop.Unmap()
+			if i == 0 || !s.trustedProxy(hop) {
+				return &hop
+			}
+		}
+	}
+	if realIP, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+		realIP = realIP.Unmap()
+		return &realIP
+	}
+
+	return &peer
+}
+
+func (s *Server) trustedProxy(addr netip.Addr) bool {
+	for _, prefix := range s.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTrustedProxies turns the configured proxy addresses and CIDR ranges into
+// prefixes, skipping invalid entries.
+func parseTrustedProxies(entries []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+
+	return prefixes
+}
+
+// ListLogins godoc
+// @Summary Get own login history
+// @Description Get the login attempts made with the current account, newest first, to spot suspicious access
+// @Tags users
+// @Produce json
+// @Param offset query int false "Offset" default(0)
+// @Param limit query int false "Limit" default(100)
+// @Param success query bool false "Only successful (true) or failed (false) attempts"
+// @Param from query string false "Attempts at or after (RFC 3339)"
+// @Param to query string false "Attempts before (RFC 3339)"
+// @Success 200 {object} dto.LoginHistoryListResponse "Login history"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid filter"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/logins [get]
+func (s *Server) listLogins(w http.ResponseWriter, r *http.Request) {
+	s.writeLoginHistoryPage(w, r, utils.GetUser(r.Context()).ID)
+}
+
+// writeLoginHistoryPage responds with the page of the login history of userID
+// selected by the query of r.
+func (s *Server) writeLoginHistoryPage(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	limit := utils.GetQueryParam[int](r, limitQueryKey, defaultLimit)
+	offset := utils.GetQueryParam[int](r, offsetQueryKey, defaultOffset)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	filter, err := dto.NewLoginHistoryFilter(r.URL.Query())
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid filter", err.Error(), nil))
+		return
+	}
+
+	history, err := s.store.ListLoginHistory(r.Context(), userID, filter, int32(limit), int32(offset))
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	totalCount, err := s.store.CountLoginHistory(r.Context(), userID, filter)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewLoginHistoryListResponse(history, totalCount, limit, offset))
+}
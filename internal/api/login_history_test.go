@@ -7,7 +7,9 @@ import (
 	"net/http/httptest"
 	"net/netip"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -22,9 +24,13 @@ func TestWriteLoginHistory_NoUser(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
 	req = req.WithContext(context.WithValue(req.Context(), utils.UserCtxKey, &models.User{}))
 
-	server.writeLoginHistory(req, http.StatusOK, nil)
+	storeMock.EXPECT().
+		InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
+			return !history.UserID.Valid && !history.Success
+		})).
+		Return(nil).Once()
 
-	storeMock.AssertNotCalled(t, "InsertLoginHistory", mock.Anything, mock.Anything)
+	server.writeLoginHistory(req, http.StatusUnauthorized, errors.New("user not found"))
 }
 
 func TestWriteLoginHistory_Success(t *testing.T) {
@@ -33,13 +39,13 @@ func TestWriteLoginHistory_Success(t *testing.T) {
 	user := testdata.User1
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
 	req = req.WithContext(utils.SetUser(req.Context(), &user))
-	req.Header.Set("X-Real-IP", testdata.TestIPAddress.String())
+	req.RemoteAddr = testdata.TestIPAddress.String() + ":4321"
 	req.Header.Set("X-Location", testdata.TestLocation)
 	req.Header.Set("User-Agent", testdata.TestUserAgent)
 
 	storeMock.EXPECT().
 		InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-			assert.Equal(t, user.ID, history.UserID)
+			assert.Equal(t, user.ID, uuid.UUID(history.UserID.Bytes))
 			assert.True(t, history.Success)
 			require.NotNil(t, history.IpAddress)
 			assert.Equal(t, testdata.TestIPAddress, *history.IpAddress)
@@ -61,13 +67,12 @@ func TestWriteLoginHistory_WithFailure(t *testing.T) {
 	user := testdata.User1
 	ctx := utils.SetUser(context.Background(), &user)
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil).WithContext(ctx)
-	req.Header.Set("X-Forwarded-For", "198.51.100.10, 203.0.113.5")
-	req.RemoteAddr = "198.51.100.30:9999"
+	req.RemoteAddr = "198.51.100.10:9999"
 	failureErr := errors.New("invalid credentials")
 
 	storeMock.EXPECT().
 		InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-			assert.Equal(t, user.ID, history.UserID)
+			assert.Equal(t, user.ID, uuid.UUID(history.UserID.Bytes))
 			assert.False(t, history.Success)
 			require.NotNil(t, history.IpAddress)
 			expectedIP := netip.MustParseAddr("198.51.100.10")
@@ -81,3 +86,98 @@ func TestWriteLoginHistory_WithFailure(t *testing.T) {
 
 	server.writeLoginHistory(req, http.StatusUnauthorized, failureErr)
 }
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		trusted    []string
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{
+			name:       "peer without trusted proxies",
+			remoteAddr: "198.51.100.30:9999",
+			headers:    map[string]string{"X-Forwarded-For": "203.0.113.5", "X-Real-IP": "203.0.113.6"},
+			want:       "198.51.100.30",
+		},
+		{
+			name:       "headers of untrusted peer are ignored",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "198.51.100.30:9999",
+			headers:    map[string]string{"X-Forwarded-For": "203.0.113.5"},
+			want:       "198.51.100.30",
+		},
+		{
+			name:       "nearest untrusted hop behind trusted proxies",
+			trusted:    []string{"10.0.0.0/8", "192.0.2.1"},
+			remoteAddr: "10.0.0.2:9999",
+			headers:    map[string]string{"X-Forwarded-For": "203.0.113.9, 203.0.113.5, 192.0.2.1"},
+			want:       "203.0.113.5",
+		},
+		{
+			name:       "real ip from trusted proxy",
+			trusted:    []string{"10.0.0.2"},
+			remoteAddr: "10.0.0.2:9999",
+			headers:    map[string]string{"X-Real-IP": "203.0.113.6"},
+			want:       "203.0.113.6",
+		},
+		{
+			name:       "ipv6 peer",
+			remoteAddr: "[2001:db8::1]:9999",
+			want:       "2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, _, _, _ := newTestServer(t)
+			server.trustedProxies = parseTrustedProxies(tt.trusted)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+
+			ip := server.clientIP(req)
+
+			require.NotNil(t, ip)
+			assert.Equal(t, netip.MustParseAddr(tt.want), *ip)
+		})
+	}
+}
+
+func TestListLogins(t *testing.T) {
+	user := testdata.User1
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/logins?"+query, nil)
+		return req.WithContext(utils.SetUser(req.Context(), &user))
+	}
+
+	t.Run("own history", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		filter := models.LoginHistoryFilter{From: &from}
+
+		storeMock.EXPECT().ListLoginHistory(mock.Anything, user.ID, filter, int32(defaultLimit), int32(0)).
+			Return([]models.LoginHistory{}, nil).Once()
+		storeMock.EXPECT().CountLoginHistory(mock.Anything, user.ID, filter).Return(int64(0), nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.listLogins(rr, newRequest("from=2026-01-01T00:00:00Z"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"total_count":0,"limit":100,"offset":0,"logins":[]}`, rr.Body.String())
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.listLogins(rr, newRequest("from=2026-02-01T00:00:00Z&to=2026-01-01T00:00:00Z"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
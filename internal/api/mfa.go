@@ -0,0 +1,336 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+// requireAdminMFA denies admin endpoints to admins without confirmed TOTP
+// when two-factor authentication is mandatory for them.
+func (s *Server) requireAdminMFA(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := utils.GetUser(r.Context())
+		if !s.mfa.RequiredForAdmin || user.Role != models.RoleAdmin {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		enabled, err := s.totpEnabled(r.Context(), user.ID)
+		if err != nil {
+			s.WriteError(w, r, err)
+			return
+		}
+		if !enabled {
+			s.WriteError(w, r, errlocal.NewErrForbidden("two-factor authentication required",
+				"admin without confirmed totp", map[string]any{"user_id": user.ID.String()}))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// EnrollTOTP godoc
+// @Summary Enroll TOTP
+// @Description Start TOTP enrollment. Returns the secret as an otpauth URI and single-use recovery codes.
+// @Description Logins require a code only after the enrollment is confirmed.
+// @Tags users
+// @Produce json
+// @Success 201 {object} dto.TOTPEnrollmentResponse "Secret and recovery codes"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not allowed with an API key or while impersonating"
+// @Failure 409 {object} errlocal.ErrConflict "TOTP already enabled"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/mfa/totp [post]
+func (s *Server) enrollTOTP(w http.ResponseWriter, r *http.Request) {
+	user := utils.GetUser(r.Context())
+
+	enabled, err := s.totpEnabled(r.Context(), user.ID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if enabled {
+		s.WriteError(w, r, errlocal.NewErrConflict("two-factor authentication already enabled", "", nil))
+		return
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create totp secret", err.Error(), nil))
+		return
+	}
+	codes, err := auth.GenerateRecoveryCodes(s.mfa.RecoveryCodes)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create recovery codes", err.Error(), nil))
+		return
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = utils.HashToken(code)
+	}
+
+	if err := s.store.ExecTx(r.Context(), func(tx store.Store) error {
+		if err := tx.SaveUserTOTP(r.Context(), user.ID, secret); err != nil {
+			return err
+		}
+		return tx.ReplaceRecoveryCodes(r.Context(), user.ID, hashes)
+	}); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusCreated, dto.TOTPEnrollmentResponse{
+		Secret:        secret,
+		URI:           auth.TOTPURI(s.mfa.Issuer, user.Login, secret),
+		RecoveryCodes: codes,
+	})
+}
+
+// ConfirmTOTP godoc
+// @Summary Confirm TOTP
+// @Description Confirm TOTP enrollment with a code from the authenticator app
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body dto.TOTPCodeRequest true "Current TOTP code"
+// @Success 204 "TOTP enabled"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Invalid code"
+// @Failure 403 {object} errlocal.ErrForbidden "Not allowed with an API key or while impersonating"
+// @Failure 404 {object} errlocal.ErrNotFound "TOTP not enrolled"
+// @Failure 409 {object} errlocal.ErrConflict "TOTP already enabled"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/mfa/totp/confirm [post]
+func (s *Server) confirmTOTP(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.TOTPCodeRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+	user := utils.GetUser(r.Context())
+
+	totp, err := s.store.GetUserTOTP(r.Context(), user.ID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if totp.Enabled() {
+		s.WriteError(w, r, errlocal.NewErrConflict("two-factor authentication already enabled", "", nil))
+		return
+	}
+
+	if err := s.checkTOTP(r.Context(), totp, b.Code); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if err := s.store.ConfirmUserTOTP(r.Context(), user.ID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditMFAEnable, user.ID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// DisableTOTP godoc
+// @Summary Disable TOTP
+// @Description Disable two-factor authentication with a current code or a recovery code
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body dto.MFACodeRequest true "TOTP or recovery code"
+// @Success 204 "TOTP disabled"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Invalid code"
+// @Failure 403 {object} errlocal.ErrForbidden "Two-factor authentication is mandatory, API key used or impersonating"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/mfa/totp [delete]
+func (s *Server) disableTOTP(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.MFACodeRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+	user := utils.GetUser(r.Context())
+
+	if s.mfa.RequiredForAdmin && user.Role == models.RoleAdmin {
+		s.WriteError(w, r, errlocal.NewErrForbidden("two-factor authentication is mandatory for admins", "", nil))
+		return
+	}
+
+	if err := s.verifySecondFactor(r.Context(), user.ID, b); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if err := s.store.DeleteUserTOTP(r.Context(), user.ID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, userEvent(models.AuditMFADisable, user.ID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// LoginMFA godoc
+// @Summary Complete login with second factor
+// @Description Exchange the mfa pending token returned by login and a TOTP or recovery code for tokens
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.LoginMFARequest true "Pending token and code"
+// @Success 200 {object} dto.AuthResponse "Tokens set in HttpOnly cookies"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Invalid token or code"
+// @Failure 403 {object} errlocal.ErrForbidden "Account is banned"
+// @Failure 429 {object} errlocal.ErrToManyRequests "Too many failed attempts"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Router /login/mfa [post]
+func (s *Server) loginMFA(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.LoginMFARequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+
+	claims, err := s.authManager.ParseMFAToken(b.MFAToken)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrUnauthorized("invalid or expired mfa token", err.Error(), nil))
+		return
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrUnauthorized("invalid or expired mfa token", err.Error(), nil))
+		return
+	}
+
+	user, err := s.store.GetUser(r.Context(), userID, false)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if err := checkBan(user); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	r = r.WithContext(utils.SetUser(r.Context(), user))
+
+	retryAfter, err := s.loginThrottle.Check(r.Context(), user.ID, s.clientIP(r))
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if retryAfter > 0 {
+		setRetryAfter(w, retryAfter)
+		s.WriteError(w, r, errlocal.NewErrToManyRequests("too many failed login attempts, try again later"))
+		return
+	}
+
+	var loginErr error
+	var statusCode int
+	defer func() {
+		s.writeLoginHistory(r, statusCode, loginErr)
+	}()
+
+	if err := s.verifySecondFactor(r.Context(), user.ID, &b.MFACodeRequest); err != nil {
+		statusCode = http.StatusUnauthorized
+		var localErr errlocal.LocalError
+		if errors.As(err, &localErr) {
+			statusCode = localErr.Code()
+		}
+		loginErr = err
+		s.WriteError(w, r, err)
+		return
+	}
+
+	tokens, err := s.authManager.CreateNewPair(r.Context(), *user)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		loginErr = errlocal.NewErrInternal("failed to create tokens", err.Error(),
+			map[string]any{"user_id": user.ID.String(), "login": user.Login})
+		s.WriteError(w, r, loginErr)
+		return
+	}
+
+	statusCode = http.StatusOK
+	setAuthCookies(w, tokens)
+	s.WriteResponse(w, r, statusCode, dto.NewAuthResponse(*user, tokens.Access, tokens.Refresh))
+}
+
+// totpEnabled reports whether the user has confirmed TOTP enrollment.
+func (s *Server) totpEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	totp, err := s.store.GetUserTOTP(ctx, userID)
+	if err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return totp.Enabled(), nil
+}
+
+// verifySecondFactor accepts either a code of the confirmed TOTP or an unused
+// recovery code, which is consumed.
+func (s *Server) verifySecondFactor(ctx context.Context, userID uuid.UUID, b *dto.MFACodeRequest) error {
+	invalid := errlocal.NewErrUnauthorized("invalid two-factor code", "", nil)
+
+	if b.RecoveryCode != "" {
+		hash := utils.HashToken(auth.NormalizeRecoveryCode(b.RecoveryCode))
+		if err := s.store.ConsumeRecoveryCode(ctx, userID, hash); err != nil {
+			var notFoundErr *errlocal.ErrNotFound
+			if errors.As(err, &notFoundErr) {
+				return invalid
+			}
+			return err
+		}
+		return nil
+	}
+
+	totp, err := s.store.GetUserTOTP(ctx, userID)
+	if err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			return invalid
+		}
+		return err
+	}
+	if !totp.Enabled() {
+		return invalid
+	}
+
+	return s.checkTOTP(ctx, totp, b.Code)
+}
+
+// checkTOTP validates code and burns its time step so it cannot be replayed.
+func (s *Server) checkTOTP(ctx context.Context, totp *models.UserTOTP, code string) error {
+	step, ok := auth.ValidateTOTP(totp.Secret, code, time.Now(), s.mfa.AllowedSkew)
+	if !ok {
+		return errlocal.NewErrUnauthorized("invalid two-factor code", "", nil)
+	}
+
+	if err := s.store.UseTOTPStep(ctx, totp.UserID, step); err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			return errlocal.NewErrUnauthorized("two-factor code already used", "", nil)
+		}
+		return err
+	}
+
+	return nil
+}
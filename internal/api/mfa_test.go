@@ -0,0 +1,334 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func confirmedTOTP(t *testing.T, userID uuid.UUID) *models.UserTOTP {
+	t.Helper()
+
+	secret, err := auth.GenerateTOTPSecret()
+	require.NoError(t, err)
+	confirmedAt := time.Now()
+	return &models.UserTOTP{UserID: userID, Secret: secret, ConfirmedAt: &confirmedAt}
+}
+
+func currentTOTPCode(t *testing.T, totp *models.UserTOTP) string {
+	t.Helper()
+
+	code, err := auth.TOTPCode(totp.Secret, time.Now())
+	require.NoError(t, err)
+	return code
+}
+
+func TestLoginRequiresMFA(t *testing.T) {
+	server, storeMock, authMock, _, _ := newTestServer(t)
+
+	authReq := dto.LoginUserRequest{Login: "admin", Password: "password123"}
+	hashed, err := utils.HashPass(authReq.Password)
+	require.NoError(t, err)
+	existing := models.User{ID: uuid.New(), Login: authReq.Login, HashedPassword: hashed, Role: models.RoleAdmin}
+
+	ctx := utils.SetUser(context.Background(), &existing)
+	ctx = utils.SetRequestBody(ctx, &authReq)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil).WithContext(ctx)
+
+	storeMock.EXPECT().GetUserTOTP(mock.Anything, existing.ID).Return(confirmedTOTP(t, existing.ID), nil)
+	authMock.EXPECT().CreateMFAToken(existing).Return("mfa-token", nil)
+
+	rr := httptest.NewRecorder()
+	server.login(rr, req)
+
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+	assert.Empty(t, rr.Result().Cookies())
+
+	var resp dto.MFAChallengeResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	assert.True(t, resp.MFARequired)
+	assert.Equal(t, "mfa-token", resp.MFAToken)
+	storeMock.AssertNotCalled(t, "InsertLoginHistory", mock.Anything, mock.Anything)
+}
+
+func TestLoginMFA(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Login: "admin", Role: models.RoleAdmin}
+	claims := &auth.Claims{UserID: user.ID.String(), Login: user.Login, TokenType: "mfa_pending"}
+
+	t.Run("valid totp code", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		totp := confirmedTOTP(t, user.ID)
+
+		authMock.EXPECT().ParseMFAToken("mfa-token").Return(claims, nil)
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(user, nil)
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, user.ID).Return(totp, nil)
+		storeMock.EXPECT().UseTOTPStep(mock.Anything, user.ID, mock.AnythingOfType("int64")).Return(nil)
+		authMock.EXPECT().CreateNewPair(mock.Anything, *user).
+			Return(&auth.TokenPair{Access: "access", Refresh: "refresh"}, nil)
+		storeMock.EXPECT().
+			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
+				return history.UserID.Bytes == user.ID && history.Success
+			})).
+			Return(nil)
+
+		body := `{"mfa_token":"mfa-token","code":"` + currentTOTPCode(t, totp) + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/mfa", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+		server.loginMFA(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Len(t, rr.Result().Cookies(), 2)
+	})
+
+	t.Run("recovery code", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+
+		authMock.EXPECT().ParseMFAToken("mfa-token").Return(claims, nil)
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(user, nil)
+		storeMock.EXPECT().ConsumeRecoveryCode(mock.Anything, user.ID, utils.HashToken("abcd-efgh")).Return(nil)
+		authMock.EXPECT().CreateNewPair(mock.Anything, *user).
+			Return(&auth.TokenPair{Access: "access", Refresh: "refresh"}, nil)
+		storeMock.EXPECT().InsertLoginHistory(mock.Anything, mock.Anything).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/mfa",
+			strings.NewReader(`{"mfa_token":"mfa-token","recovery_code":"ABCDEFGH"}`))
+		rr := httptest.NewRecorder()
+		server.loginMFA(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		totp := confirmedTOTP(t, user.ID)
+
+		authMock.EXPECT().ParseMFAToken("mfa-token").Return(claims, nil)
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(user, nil)
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, user.ID).Return(totp, nil)
+		storeMock.EXPECT().
+			InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
+				return history.UserID.Bytes == user.ID && !history.Success
+			})).
+			Return(nil)
+
+		code := "000000"
+		if currentTOTPCode(t, totp) == code {
+			code = "111111"
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/mfa",
+			strings.NewReader(`{"mfa_token":"mfa-token","code":"`+code+`"}`))
+		rr := httptest.NewRecorder()
+		server.loginMFA(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		authMock.AssertNotCalled(t, "CreateNewPair", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid mfa token", func(t *testing.T) {
+		server, _, authMock, _, _ := newTestServer(t)
+
+		authMock.EXPECT().ParseMFAToken("access-token").Return((*auth.Claims)(nil), assert.AnError)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/mfa",
+			strings.NewReader(`{"mfa_token":"access-token","code":"123456"}`))
+		rr := httptest.NewRecorder()
+		server.loginMFA(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("banned user", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		banned := *user
+		banned.Ban = &models.Ban{Reason: "spam"}
+
+		authMock.EXPECT().ParseMFAToken("mfa-token").Return(claims, nil)
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(&banned, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/mfa",
+			strings.NewReader(`{"mfa_token":"mfa-token","code":"123456"}`))
+		rr := httptest.NewRecorder()
+		server.loginMFA(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		authMock.AssertNotCalled(t, "CreateNewPair", mock.Anything, mock.Anything)
+	})
+
+	t.Run("malformed subject", func(t *testing.T) {
+		server, _, authMock, _, _ := newTestServer(t)
+
+		authMock.EXPECT().ParseMFAToken("mfa-token").
+			Return(&auth.Claims{UserID: "not-a-uuid", TokenType: "mfa_pending"}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login/mfa",
+			strings.NewReader(`{"mfa_token":"mfa-token","code":"123456"}`))
+		rr := httptest.NewRecorder()
+		server.loginMFA(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestEnrollTOTP(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Login: "admin"}
+
+	t.Run("returns uri and recovery codes", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		server.mfa = config.MFAConfig{Issuer: "TrashScanner", RecoveryCodes: 3}
+
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, user.ID).
+			Return((*models.UserTOTP)(nil), errlocal.NewErrNotFound("totp not enrolled", "", nil))
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		var secret string
+		storeMock.EXPECT().SaveUserTOTP(mock.Anything, user.ID, mock.Anything).
+			Run(func(_ context.Context, _ uuid.UUID, s string) { secret = s }).
+			Return(nil)
+		var hashes []string
+		storeMock.EXPECT().ReplaceRecoveryCodes(mock.Anything, user.ID, mock.Anything).
+			Run(func(_ context.Context, _ uuid.UUID, h []string) { hashes = h }).
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/mfa/totp", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), user))
+		rr := httptest.NewRecorder()
+		server.enrollTOTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+
+		var resp dto.TOTPEnrollmentResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, secret, resp.Secret)
+		assert.Contains(t, resp.URI, "otpauth://totp/TrashScanner:admin?")
+		require.Len(t, resp.RecoveryCodes, 3)
+		for i, code := range resp.RecoveryCodes {
+			assert.Equal(t, utils.HashToken(code), hashes[i])
+		}
+	})
+
+	t.Run("already enabled", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, user.ID).Return(confirmedTOTP(t, user.ID), nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/mfa/totp", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), user))
+		rr := httptest.NewRecorder()
+		server.enrollTOTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestConfirmTOTP(t *testing.T) {
+	server, storeMock, _, _, _ := newTestServer(t)
+	user := &models.User{ID: uuid.New(), Login: "admin"}
+	totp := confirmedTOTP(t, user.ID)
+	totp.ConfirmedAt = nil
+
+	storeMock.EXPECT().GetUserTOTP(mock.Anything, user.ID).Return(totp, nil)
+	storeMock.EXPECT().UseTOTPStep(mock.Anything, user.ID, mock.AnythingOfType("int64")).Return(nil)
+	storeMock.EXPECT().ConfirmUserTOTP(mock.Anything, user.ID).Return(nil)
+	expectAudit(storeMock, models.AuditMFAEnable)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/me/mfa/totp/confirm",
+		bytes.NewBufferString(`{"code":"`+currentTOTPCode(t, totp)+`"}`))
+	req = req.WithContext(utils.SetUser(req.Context(), user))
+	rr := httptest.NewRecorder()
+	server.confirmTOTP(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestDisableTOTP(t *testing.T) {
+	t.Run("with recovery code", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Role: models.RoleUser}
+
+		storeMock.EXPECT().ConsumeRecoveryCode(mock.Anything, user.ID, utils.HashToken("abcd-efgh")).Return(nil)
+		storeMock.EXPECT().DeleteUserTOTP(mock.Anything, user.ID).Return(nil)
+		expectAudit(storeMock, models.AuditMFADisable)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me/mfa/totp",
+			bytes.NewBufferString(`{"recovery_code":"abcd-efgh"}`))
+		req = req.WithContext(utils.SetUser(req.Context(), user))
+		rr := httptest.NewRecorder()
+		server.disableTOTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("mandatory for admin", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		server.mfa.RequiredForAdmin = true
+		user := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me/mfa/totp",
+			bytes.NewBufferString(`{"recovery_code":"abcd-efgh"}`))
+		req = req.WithContext(utils.SetUser(req.Context(), user))
+		rr := httptest.NewRecorder()
+		server.disableTOTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestRequireAdminMFA(t *testing.T) {
+	adminID := uuid.New()
+	tests := []struct {
+		name         string
+		required     bool
+		role         models.Role
+		totp         *models.UserTOTP
+		expectedCode int
+	}{
+		{name: "not required", required: false, role: models.RoleAdmin, expectedCode: http.StatusOK},
+		{name: "not an admin", required: true, role: models.RoleUser, expectedCode: http.StatusOK},
+		{name: "admin with totp", required: true, role: models.RoleAdmin,
+			totp: confirmedTOTP(t, adminID), expectedCode: http.StatusOK},
+		{name: "admin without totp", required: true, role: models.RoleAdmin,
+			expectedCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, storeMock, _, _, _ := newTestServer(t)
+			server.mfa.RequiredForAdmin = tt.required
+
+			if tt.required && tt.role == models.RoleAdmin {
+				if tt.totp != nil {
+					storeMock.EXPECT().GetUserTOTP(mock.Anything, adminID).Return(tt.totp, nil)
+				} else {
+					storeMock.EXPECT().GetUserTOTP(mock.Anything, adminID).
+						Return((*models.UserTOTP)(nil), errlocal.NewErrNotFound("totp not enrolled", "", nil))
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users", nil)
+			req = req.WithContext(utils.SetUser(req.Context(), &models.User{ID: adminID, Role: tt.role}))
+			rr := httptest.NewRecorder()
+			server.requireAdminMFA(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func predictionEvent(action models.AuditAction, predictionID uuid.UUID) models.AuditEvent {
+	return models.AuditEvent{Action: action, TargetType: models.AuditTargetPrediction, TargetID: predictionID.String()}
+}
+
+// listAdminPredictions godoc
+// @Summary      Get predictions for moderation
+// @Description  Get predictions of all users, newest first, e.g. failed or low-confidence ones
+// @Tags         admin
+// @Produce      json
+// @Param        offset          query  int     false  "Offset"  default(0)
+// @Param        limit           query  int     false  "Limit"   default(100)
+// @Param        status          query  string  false  "Status"  Enums(processing,completed,failed)
+// @Param        user_id         query  string  false  "Owner ID (UUID)"
+// @Param        max_confidence  query  number  false  "Only predictions whose top probability is below it"
+// @Param        has_feedback    query  bool    false  "Only predictions with (true) or without owner feedback"
+// @Param        verified        query  bool    false  "Only predictions with (true) or without a verified label"
+// @Param        from            query  string  false  "Created at or after (RFC 3339)"
+// @Param        to              query  string  false  "Created before (RFC 3339)"
+// @Success      200             {object}  dto.AdminPredictionListResponse
+// @Failure      400             {object}  errlocal.ErrBadRequest
+// @Failure      401             {object}  errlocal.ErrUnauthorized
+// @Failure      403             {object}  errlocal.ErrForbidden
+// @Failure      500             {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/predictions [get]
+func (s *Server) listAdminPredictions(w http.ResponseWriter, r *http.Request) {
+	limit := utils.GetQueryParam[int](r, limitQueryKey, defaultLimit)
+	offset := utils.GetQueryParam[int](r, offsetQueryKey, defaultOffset)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	filter, err := dto.NewPredictionFilter(r.URL.Query())
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid filter", err.Error(), nil))
+		return
+	}
+
+	predictions, err := s.store.ListAdminPredictions(r.Context(), filter, int32(limit), int32(offset))
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	totalCount, err := s.store.CountAdminPredictions(r.Context(), filter)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewAdminPredictionListResponse(predictions, totalCount, limit, offset))
+}
+
+// verifyPrediction godoc
+// @Summary      Verify prediction
+// @Description  Set the trash type staff confirmed for a scan, or clear it with null.
+// @Description  The stats of the owner count the label instead of the predicted types.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        prediction_id  path      string                       true  "Prediction ID (UUID)"
+// @Param        request        body      dto.VerifyPredictionRequest  true  "Label"
+// @Success      200            {object}  dto.PredictionResponse
+// @Failure      400            {object}  errlocal.ErrBadRequest
+// @Failure      401            {object}  errlocal.ErrUnauthorized
+// @Failure      403            {object}  errlocal.ErrForbidden
+// @Failure      404            {object}  errlocal.ErrNotFound
+// @Failure      409            {object}  errlocal.ErrConflict
+// @Failure      500            {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/predictions/{prediction_id}/label [put]
+func (s *Server) verifyPrediction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	predictionID, err := uuid.Parse(mux.Vars(r)[predictionIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid prediction ID", err.Error(), nil))
+		return
+	}
+	req, err := dto.GetRequestBody[dto.VerifyPredictionRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body or validation failed", err.Error(), nil))
+		return
+	}
+
+	var before, after *models.Prediction
+	if err := s.store.ExecTx(ctx, func(tx store.Store) error {
+		if before, err = tx.GetPredictionForUpdate(ctx, predictionID); err != nil {
+			return err
+		}
+		// The stats of a processing prediction are only counted once it
+		// completes, without looking at the label.
+		if before.Status == models.PredictionProcessingStatus {
+			return errlocal.NewErrConflict("prediction is still processing", "",
+				map[string]any{"prediction_id": predictionID.String()})
+		}
+		if after, err = tx.SetPredictionVerification(ctx, predictionID, req.Label, utils.GetUser(ctx).ID); err != nil {
+			return err
+		}
+		return stats.ReviseStats(ctx, tx, s.rules, before, after)
+	}); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, predictionEvent(models.AuditPredictionLabel, predictionID),
+		map[string]any{"label": verifiedLabel(before)}, map[string]any{"label": verifiedLabel(after)})
+
+	s.WriteResponse(w, r, http.StatusOK, after)
+}
+
+func verifiedLabel(prediction *models.Prediction) *string {
+	if prediction.Verification == nil {
+		return nil
+	}
+	return &prediction.Verification.Label
+}
+
+// rerunPrediction godoc
+// @Summary      Re-run prediction
+// @Description  Send the scan of a prediction to the predictor again. The new result replaces the old one
+// @Description  and the stats of the owner are revised when it arrives.
+// @Tags         admin
+// @Produce      json
+// @Param        prediction_id  path      string  true  "Prediction ID (UUID)"
+// @Success      202            {object}  dto.PredictionResponse
+// @Failure      400            {object}  errlocal.ErrBadRequest
+// @Failure      401            {object}  errlocal.ErrUnauthorized
+// @Failure      403            {object}  errlocal.ErrForbidden
+// @Failure      404            {object}  errlocal.ErrNotFound
+// @Failure      409            {object}  errlocal.ErrConflict
+// @Failure      429            {object}  errlocal.ErrToManyRequests
+// @Failure      500            {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/predictions/{prediction_id}/rerun [post]
+func (s *Server) rerunPrediction(w http.ResponseWriter, r *http.Request) {
+	predictionID, err := uuid.Parse(mux.Vars(r)[predictionIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid prediction ID", err.Error(), nil))
+		return
+	}
+
+	prediction, err := s.store.GetPrediction(r.Context(), predictionID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if err := s.predictor.Rerun(r.Context(), prediction); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, predictionEvent(models.AuditPredictionRerun, predictionID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusAccepted, prediction)
+}
@@ -0,0 +1,253 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestListAdminPredictions(t *testing.T) {
+	moderator := &models.User{ID: uuid.New(), Role: models.RoleModerator}
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		status := models.PredictionFailedStatus
+		hasFeedback := true
+		maxConfidence := 0.6
+		filter := models.PredictionFilter{Status: &status, MaxConfidence: &maxConfidence, HasFeedback: &hasFeedback}
+		prediction := models.AdminPrediction{
+			Prediction: models.Prediction{ID: uuid.New(), Result: models.PredictionResult{"glass": 0.4, "metal": 0.3}},
+			UserLogin:  "user",
+		}
+
+		storeMock.EXPECT().ListAdminPredictions(mock.Anything, filter, int32(20), int32(40)).
+			Return([]models.AdminPrediction{prediction}, nil).Once()
+		storeMock.EXPECT().CountAdminPredictions(mock.Anything, filter).Return(int64(41), nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet,
+			"/api/v1/admin/predictions?status=failed&max_confidence=0.6&has_feedback=true&limit=20&offset=40", nil)
+		req = req.WithContext(utils.SetUser(req.Context(), moderator))
+		rr := httptest.NewRecorder()
+		server.listAdminPredictions(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.AdminPredictionListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, int64(41), resp.TotalCount)
+		require.Len(t, resp.Predictions, 1)
+		assert.Equal(t, "user", resp.Predictions[0].UserLogin)
+		assert.Equal(t, 0.4, resp.Predictions[0].Confidence)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/predictions?max_confidence=2", nil)
+		rr := httptest.NewRecorder()
+		server.listAdminPredictions(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().ListAdminPredictions(mock.Anything, models.PredictionFilter{}, int32(defaultLimit), int32(0)).
+			Return(nil, errlocal.NewErrInternal("query failed", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.listAdminPredictions(rr, httptest.NewRequest(http.MethodGet, "/api/v1/admin/predictions", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestVerifyPrediction(t *testing.T) {
+	moderator := &models.User{ID: uuid.New(), Role: models.RoleModerator}
+	path := "/api/v1/admin/predictions/label"
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		owner := &models.User{ID: uuid.New(), Stat: &models.Stat{
+			Rating: 20, FilesScanned: 2, TrashByTypes: map[string]int{"metal": 2},
+		}}
+		before := &models.Prediction{
+			ID: uuid.New(), UserID: owner.ID, Status: models.PredictionCompletedStatus,
+			Result: models.PredictionResult{"metal": 0.5},
+		}
+		label := "glass"
+		after := *before
+		after.Verification = &models.PredictionVerification{
+			Label: label, VerifiedBy: &moderator.ID, VerifiedAt: time.Now(),
+		}
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+				return fn(storeMock)
+			}).Once()
+		storeMock.EXPECT().GetPredictionForUpdate(mock.Anything, before.ID).Return(before, nil).Once()
+		storeMock.EXPECT().SetPredictionVerification(mock.Anything, before.ID, &label, moderator.ID).
+			Return(&after, nil).Once()
+		storeMock.EXPECT().LockStats(mock.Anything, owner.ID).Return(nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, owner.ID, true).Return(owner, nil).Once()
+		storeMock.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			return stat.Rating == 20 && stat.TrashByTypes["metal"] == 1 && stat.TrashByTypes["glass"] == 1
+		})).Return(nil).Once()
+		storeMock.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		expectAudit(storeMock, models.AuditPredictionLabel)
+
+		rr := httptest.NewRecorder()
+		server.verifyPrediction(rr, newRequest(http.MethodPut, path, `{"label":"glass"}`, moderator,
+			map[string]string{predictionIDTag: before.ID.String()}))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.PredictionResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		require.NotNil(t, resp.Verification)
+		assert.Equal(t, "glass", resp.Verification.Label)
+	})
+
+	t.Run("still processing", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		prediction := &models.Prediction{ID: uuid.New(), Status: models.PredictionProcessingStatus}
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+				return fn(storeMock)
+			}).Once()
+		storeMock.EXPECT().GetPredictionForUpdate(mock.Anything, prediction.ID).Return(prediction, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.verifyPrediction(rr, newRequest(http.MethodPut, path, `{"label":"glass"}`, moderator,
+			map[string]string{predictionIDTag: prediction.ID.String()}))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.verifyPrediction(rr, newRequest(http.MethodPut, path, `{"label":"wood"}`, moderator,
+			map[string]string{predictionIDTag: uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("invalid prediction ID", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.verifyPrediction(rr, newRequest(http.MethodPut, path, `{}`, moderator,
+			map[string]string{predictionIDTag: "invalid"}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestRerunPrediction(t *testing.T) {
+	moderator := &models.User{ID: uuid.New(), Role: models.RoleModerator}
+	path := "/api/v1/admin/predictions/rerun"
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, _, predictorMock := newTestServer(t)
+		prediction := &models.Prediction{ID: uuid.New(), Status: models.PredictionFailedStatus, Error: "timeout"}
+
+		storeMock.EXPECT().GetPrediction(mock.Anything, prediction.ID).Return(prediction, nil).Once()
+		predictorMock.EXPECT().Rerun(mock.Anything, prediction).Return(nil).Once()
+		expectAudit(storeMock, models.AuditPredictionRerun)
+
+		rr := httptest.NewRecorder()
+		server.rerunPrediction(rr, newRequest(http.MethodPost, path, "", moderator,
+			map[string]string{predictionIDTag: prediction.ID.String()}))
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("already processing", func(t *testing.T) {
+		server, storeMock, _, _, predictorMock := newTestServer(t)
+		prediction := &models.Prediction{ID: uuid.New(), Status: models.PredictionCompletedStatus}
+
+		storeMock.EXPECT().GetPrediction(mock.Anything, prediction.ID).Return(prediction, nil).Once()
+		predictorMock.EXPECT().Rerun(mock.Anything, prediction).
+			Return(errlocal.NewErrConflict("scan is already processing", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.rerunPrediction(rr, newRequest(http.MethodPost, path, "", moderator,
+			map[string]string{predictionIDTag: prediction.ID.String()}))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		predictionID := uuid.New()
+
+		storeMock.EXPECT().GetPrediction(mock.Anything, predictionID).
+			Return(nil, errlocal.NewErrNotFound("prediction not found", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.rerunPrediction(rr, newRequest(http.MethodPost, path, "", moderator,
+			map[string]string{predictionIDTag: predictionID.String()}))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestSetPredictionFeedback(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Role: models.RoleUser}
+	path := "/api/v1/predictions/feedback"
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		predictionID := uuid.New()
+		feedback := models.PredictionFeedback{Label: "paper", Comment: "it is a newspaper"}
+
+		storeMock.EXPECT().SetPredictionFeedback(mock.Anything, predictionID, user.ID, feedback).
+			Return(&models.Prediction{ID: predictionID, Feedback: &feedback}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.setPredictionFeedback(rr, newRequest(http.MethodPut, path,
+			`{"label":"paper","comment":"it is a newspaper"}`, user,
+			map[string]string{predictionIDTag: predictionID.String()}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("empty feedback", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.setPredictionFeedback(rr, newRequest(http.MethodPut, path, `{}`, user,
+			map[string]string{predictionIDTag: uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("prediction of another user", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		predictionID := uuid.New()
+
+		storeMock.EXPECT().SetPredictionFeedback(mock.Anything, predictionID, user.ID, mock.Anything).
+			Return(nil, errlocal.NewErrNotFound("prediction not found", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.setPredictionFeedback(rr, newRequest(http.MethodPut, path, `{"comment":"wrong"}`, user,
+			map[string]string{predictionIDTag: predictionID.String()}))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,368 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/gorilla/mux"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/oidc"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	oidcProviderTag     = "provider"
+	oidcStateCookieName = "oidc_state"
+	oidcStateSize       = 24
+	oidcLoginMaxBase    = 24
+	oidcLoginSuffixSize = 4
+	oidcLoginMinLength  = 3
+	oidcPasswordSize    = 24
+)
+
+// oidcState is kept in a short-lived cookie between the redirect to the
+// provider and the callback.
+type oidcState struct {
+	Provider     string `json:"provider"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// OIDCLogin godoc
+// @Summary Start OpenID Connect login
+// @Description Redirect to the provider's authorization endpoint (authorization code flow with PKCE)
+// @Tags auth
+// @Param provider path string true "Provider name from configuration"
+// @Success 302 "Redirect to the provider"
+// @Failure 404 {object} errlocal.ErrNotFound "Unknown provider"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Router /oidc/{provider}/login [get]
+func (s *Server) oidcLogin(w http.ResponseWriter, r *http.Request) {
+	provider, err := s.oidcProvider(r)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	state := oidcState{Provider: provider.Name()}
+	for _, value := range []*string{&state.State, &state.Nonce} {
+		if *value, err = utils.GenerateToken(oidcStateSize); err != nil {
+			s.WriteError(w, r, errlocal.NewErrInternal("failed to create oidc state", err.Error(), nil))
+			return
+		}
+	}
+	if state.CodeVerifier, err = oidc.NewCodeVerifier(); err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create code verifier", err.Error(), nil))
+		return
+	}
+
+	authURL, err := provider.AuthCodeURL(r.Context(), state.State, state.Nonce, oidc.CodeChallenge(state.CodeVerifier))
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("oidc provider unavailable", err.Error(),
+			map[string]any{"provider": provider.Name()}))
+		return
+	}
+
+	if err := s.setOIDCStateCookie(w, state); err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to store oidc state", err.Error(), nil))
+		return
+	}
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// OIDCCallback godoc
+// @Summary Complete OpenID Connect login
+// @Description Exchange the authorization code, verify the ID token and sign the linked user in.
+// @Description A user is provisioned on the first login with a provider account.
+// @Description Redirects to the configured success URL when set, otherwise returns the tokens as JSON.
+// @Tags auth
+// @Produce json
+// @Param provider path string true "Provider name from configuration"
+// @Param code query string true "Authorization code"
+// @Param state query string true "State from the login redirect"
+// @Success 200 {object} dto.AuthResponse "Tokens set in HttpOnly cookies"
+// @Success 202 {object} dto.MFAChallengeResponse "Second factor required at /login/mfa"
+// @Success 302 "Redirect to the success URL with tokens set in HttpOnly cookies"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid state or code"
+// @Failure 401 {object} errlocal.ErrUnauthorized "ID token rejected"
+// @Failure 403 {object} errlocal.ErrForbidden "Account is banned"
+// @Failure 404 {object} errlocal.ErrNotFound "Unknown provider"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Router /oidc/{provider}/callback [get]
+func (s *Server) oidcCallback(w http.ResponseWriter, r *http.Request) {
+	provider, err := s.oidcProvider(r)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	state, err := getOIDCStateCookie(r)
+	clearOIDCStateCookie(w)
+	if err != nil || state.Provider != provider.Name() || state.State != r.URL.Query().Get("state") {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid or expired login state", "", nil))
+		return
+	}
+	if providerErr := r.URL.Query().Get("error"); providerErr != "" {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("login rejected by provider", providerErr,
+			map[string]any{"provider": provider.Name()}))
+		return
+	}
+	code := r.URL.Query().Get("code")
+	if code == "" {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("missing authorization code", "", nil))
+		return
+	}
+
+	identity, err := provider.Authenticate(r.Context(), code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidIDToken) {
+			s.WriteError(w, r, errlocal.NewErrUnauthorized("id token rejected", err.Error(),
+				map[string]any{"provider": provider.Name()}))
+			return
+		}
+		s.WriteError(w, r, errlocal.NewErrBadRequest("failed to authenticate with provider", err.Error(),
+			map[string]any{"provider": provider.Name()}))
+		return
+	}
+
+	user, err := s.oidcUser(r.Context(), identity)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if err := checkBan(user); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	r = r.WithContext(utils.SetUser(r.Context(), user))
+
+	totpEnabled, err := s.totpEnabled(r.Context(), user.ID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	if totpEnabled {
+		mfaToken, err := s.authManager.CreateMFAToken(*user)
+		if err != nil {
+			s.WriteError(w, r, errlocal.NewErrInternal("failed to create mfa token", err.Error(),
+				map[string]any{"user_id": user.ID.String()}))
+			return
+		}
+		s.WriteResponse(w, r, http.StatusAccepted, dto.MFAChallengeResponse{MFARequired: true, MFAToken: mfaToken})
+		return
+	}
+
+	tokens, err := s.authManager.CreateNewPair(r.Context(), *user)
+	if err != nil {
+		loginErr := errlocal.NewErrInternal("failed to create tokens", err.Error(),
+			map[string]any{"user_id": user.ID.String(), "login": user.Login})
+		s.WriteError(w, r, loginErr)
+		s.writeLoginHistory(r, http.StatusInternalServerError, loginErr)
+		return
+	}
+
+	setAuthCookies(w, tokens)
+	if s.oidc.SuccessURL != "" {
+		http.Redirect(w, r, s.oidc.SuccessURL, http.StatusFound)
+		s.writeLoginHistory(r, http.StatusFound, nil)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewAuthResponse(*user, tokens.Access, tokens.Refresh))
+	s.writeLoginHistory(r, http.StatusOK, nil)
+}
+
+func (s *Server) oidcProvider(r *http.Request) (*oidc.Provider, error) {
+	name := mux.Vars(r)[oidcProviderTag]
+	provider, ok := s.oidcProviders[name]
+	if !ok {
+		return nil, errlocal.NewErrNotFound("unknown login provider", "", map[string]any{"provider": name})
+	}
+	return provider, nil
+}
+
+// oidcUser returns the user linked to the provider identity, provisioning a
+// new account on the first login.
+func (s *Server) oidcUser(ctx context.Context, identity *oidc.Identity) (*models.User, error) {
+	link, err := s.store.GetUserIdentity(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		link.Email = optionalString(identity.Email)
+		if err := s.store.TouchUserIdentity(ctx, link); err != nil {
+			s.logger.WithContext(ctx).WithField("user_id", link.UserID.String()).
+				Warnf("failed to update identity: %v", err)
+		}
+		return s.store.GetUser(ctx, link.UserID, false)
+	}
+	var notFoundErr *errlocal.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		return nil, err
+	}
+
+	user, err := s.newOIDCUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.ExecTx(ctx, func(tx store.Store) error {
+		if err := tx.CreateUser(ctx, user); err != nil {
+			return err
+		}
+		if user.Email != nil {
+			if err := tx.VerifyUserEmail(ctx, user.ID, *user.Email); err != nil {
+				return err
+			}
+		}
+		return tx.CreateUserIdentity(ctx, &models.UserIdentity{
+			UserID:   user.ID,
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			Email:    optionalString(identity.Email),
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	user.EmailVerified = user.Email != nil
+	return user, nil
+}
+
+// newOIDCUser prepares an account for a provider identity. The login is made
+// unique with a random suffix and the password is random, so the account can
+// only sign in through the provider until a password is set. The email is
+// adopted only when the provider verified it and no other account uses it;
+// existing accounts are never linked by email.
+func (s *Server) newOIDCUser(ctx context.Context, identity *oidc.Identity) (*models.User, error) {
+	base := identity.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+	base = alphanumeric(base, oidcLoginMaxBase)
+	if len(base) < oidcLoginMinLength {
+		base = "user"
+	}
+
+	suffix, err := randomHex(oidcLoginSuffixSize)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to create login", err.Error(), nil)
+	}
+	password, err := utils.GenerateToken(oidcPasswordSize)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to create password", err.Error(), nil)
+	}
+	hashedPassword, err := utils.HashPass(password)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to hash password", err.Error(), nil)
+	}
+
+	user := &models.User{
+		Login:          base + suffix,
+		Name:           alphanumeric(identity.Name, 64),
+		HashedPassword: hashedPassword,
+		Role:           models.RoleUser,
+	}
+	if len(user.Name) < oidcLoginMinLength {
+		user.Name = user.Login
+	}
+
+	if identity.Email != "" && identity.EmailVerified {
+		_, err := s.store.GetUserByEmail(ctx, identity.Email)
+		var notFoundErr *errlocal.ErrNotFound
+		switch {
+		case errors.As(err, &notFoundErr):
+			user.Email = &identity.Email
+		case err != nil:
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func (s *Server) setOIDCStateCookie(w http.ResponseWriter, state oidcState) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    base64.RawURLEncoding.EncodeToString(raw),
+		HttpOnly: true,
+		Secure:   false, // Set to false to support non-HTTPS test endpoints
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   int(s.oidc.StateTTL.Seconds()),
+		Path:     apiPrefix + "/oidc",
+	})
+	return nil
+}
+
+func getOIDCStateCookie(r *http.Request) (*oidcState, error) {
+	cookie, err := r.Cookie(oidcStateCookieName)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var state oidcState
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+	if state.State == "" || state.CodeVerifier == "" {
+		return nil, errors.New("incomplete oidc state")
+	}
+	return &state, nil
+}
+
+func clearOIDCStateCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    "",
+		HttpOnly: true,
+		Secure:   false,
+		MaxAge:   -1,
+		Path:     apiPrefix + "/oidc",
+	})
+}
+
+func alphanumeric(s string, maxLen int) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			if b.Len() == maxLen {
+				break
+			}
+		}
+	}
+	return b.String()
+}
+
+func randomHex(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,285 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/oidc"
+	"github.com/trashscanner/trashscanner_api/internal/oidc/oidctest"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+)
+
+const testOIDCProvider = "test"
+
+func withOIDCProvider(t *testing.T, server *Server) *oidctest.Server {
+	t.Helper()
+
+	provider := oidctest.NewServer(t)
+	server.oidc = config.OIDCConfig{StateTTL: 10 * time.Minute}
+	server.oidcProviders = map[string]*oidc.Provider{
+		testOIDCProvider: oidc.NewProvider(testOIDCProvider, provider.Config(), nil),
+	}
+	return provider
+}
+
+// startOIDCLogin runs the login redirect and the provider leg and returns the
+// callback request the browser would send.
+func startOIDCLogin(t *testing.T, server *Server, provider *oidctest.Server) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/oidc/test/login", nil)
+	req = mux.SetURLVars(req, map[string]string{oidcProviderTag: testOIDCProvider})
+	rr := httptest.NewRecorder()
+	server.oidcLogin(rr, req)
+	require.Equal(t, http.StatusFound, rr.Code)
+
+	code, state := provider.Authorize(t, rr.Header().Get("Location"))
+
+	query := url.Values{"code": {code}, "state": {state}}
+	callback := httptest.NewRequest(http.MethodGet, "/api/v1/oidc/test/callback?"+query.Encode(), nil)
+	for _, cookie := range rr.Result().Cookies() {
+		callback.AddCookie(cookie)
+	}
+	return mux.SetURLVars(callback, map[string]string{oidcProviderTag: testOIDCProvider})
+}
+
+func TestOIDCLogin(t *testing.T) {
+	t.Run("redirects with pkce", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/oidc/test/login", nil)
+		req = mux.SetURLVars(req, map[string]string{oidcProviderTag: testOIDCProvider})
+		rr := httptest.NewRecorder()
+		server.oidcLogin(rr, req)
+
+		require.Equal(t, http.StatusFound, rr.Code)
+		location, err := url.Parse(rr.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, provider.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+		assert.Equal(t, "S256", location.Query().Get("code_challenge_method"))
+		assert.NotEmpty(t, location.Query().Get("state"))
+
+		cookies := rr.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, oidcStateCookieName, cookies[0].Name)
+		assert.True(t, cookies[0].HttpOnly)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		withOIDCProvider(t, server)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/oidc/other/login", nil)
+		req = mux.SetURLVars(req, map[string]string{oidcProviderTag: "other"})
+		rr := httptest.NewRecorder()
+		server.oidcLogin(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestOIDCCallback(t *testing.T) {
+	t.Run("existing link signs in", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+
+		user := &models.User{ID: uuid.New(), Login: "linked", Role: models.RoleUser}
+		link := &models.UserIdentity{ID: uuid.New(), UserID: user.ID, Provider: testOIDCProvider, Subject: "subject-1"}
+		tokens := &auth.TokenPair{Access: "access", Refresh: "refresh"}
+
+		storeMock.EXPECT().GetUserIdentity(mock.Anything, testOIDCProvider, "subject-1").Return(link, nil).Once()
+		storeMock.EXPECT().TouchUserIdentity(mock.Anything, link).Return(nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(user, nil).Once()
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, user.ID).
+			Return(nil, errlocal.NewErrNotFound("totp not enrolled", "", nil)).Once()
+		authMock.EXPECT().CreateNewPair(mock.Anything, *user).Return(tokens, nil).Once()
+		storeMock.EXPECT().InsertLoginHistory(mock.Anything, mock.MatchedBy(func(h *models.LoginHistory) bool {
+			return h.UserID.Bytes == user.ID && h.Success
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, startOIDCLogin(t, server, provider))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.AuthResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, user.ID.String(), resp.User.ID)
+		assert.Equal(t, "oidc.user@example.com", *link.Email)
+	})
+
+	t.Run("first login provisions user", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+		provider.SetUser(oidctest.User{
+			Subject:           "subject-2",
+			Email:             "jane@example.com",
+			EmailVerified:     true,
+			Name:              "Jane Doe",
+			PreferredUsername: "jane.doe",
+		})
+		server.oidc.SuccessURL = "http://localhost:3000/welcome"
+
+		newID := uuid.New()
+		storeMock.EXPECT().GetUserIdentity(mock.Anything, testOIDCProvider, "subject-2").
+			Return(nil, errlocal.NewErrNotFound("identity not found", "", nil)).Once()
+		storeMock.EXPECT().GetUserByEmail(mock.Anything, "jane@example.com").
+			Return(nil, errlocal.NewErrNotFound("user not found", "", nil)).Once()
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().CreateUser(mock.Anything, mock.MatchedBy(func(u *models.User) bool {
+			return strings.HasPrefix(u.Login, "janedoe") && len(u.Login) == len("janedoe")+8 &&
+				u.Name == "JaneDoe" && u.Role == models.RoleUser &&
+				u.Email != nil && *u.Email == "jane@example.com" && u.HashedPassword != ""
+		})).RunAndReturn(func(_ context.Context, u *models.User) error {
+			u.ID = newID
+			return nil
+		}).Once()
+		storeMock.EXPECT().VerifyUserEmail(mock.Anything, newID, "jane@example.com").Return(nil).Once()
+		storeMock.EXPECT().CreateUserIdentity(mock.Anything, mock.MatchedBy(func(i *models.UserIdentity) bool {
+			return i.UserID == newID && i.Provider == testOIDCProvider && i.Subject == "subject-2"
+		})).Return(nil).Once()
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, newID).
+			Return(nil, errlocal.NewErrNotFound("totp not enrolled", "", nil)).Once()
+		authMock.EXPECT().CreateNewPair(mock.Anything, mock.MatchedBy(func(u models.User) bool {
+			return u.ID == newID
+		})).Return(&auth.TokenPair{Access: "access", Refresh: "refresh"}, nil).Once()
+		storeMock.EXPECT().InsertLoginHistory(mock.Anything, mock.Anything).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, startOIDCLogin(t, server, provider))
+
+		require.Equal(t, http.StatusFound, rr.Code)
+		assert.Equal(t, "http://localhost:3000/welcome", rr.Header().Get("Location"))
+		names := map[string]bool{}
+		for _, cookie := range rr.Result().Cookies() {
+			names[cookie.Name] = cookie.Value != ""
+		}
+		assert.True(t, names[accessCookieName])
+		assert.True(t, names[refreshCookieName])
+	})
+
+	t.Run("taken email is not adopted", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+
+		newID := uuid.New()
+		storeMock.EXPECT().GetUserIdentity(mock.Anything, testOIDCProvider, "subject-1").
+			Return(nil, errlocal.NewErrNotFound("identity not found", "", nil)).Once()
+		storeMock.EXPECT().GetUserByEmail(mock.Anything, "oidc.user@example.com").
+			Return(&models.User{ID: uuid.New()}, nil).Once()
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().CreateUser(mock.Anything, mock.MatchedBy(func(u *models.User) bool {
+			return u.Email == nil && strings.HasPrefix(u.Login, "oidcuser") && len(u.Login) == len("oidcuser")+8
+		})).RunAndReturn(func(_ context.Context, u *models.User) error {
+			u.ID = newID
+			return nil
+		}).Once()
+		storeMock.EXPECT().CreateUserIdentity(mock.Anything, mock.Anything).Return(nil).Once()
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, newID).
+			Return(nil, errlocal.NewErrNotFound("totp not enrolled", "", nil)).Once()
+		authMock.EXPECT().CreateNewPair(mock.Anything, mock.Anything).
+			Return(&auth.TokenPair{Access: "access", Refresh: "refresh"}, nil).Once()
+		storeMock.EXPECT().InsertLoginHistory(mock.Anything, mock.Anything).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, startOIDCLogin(t, server, provider))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("second factor required", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+
+		user := &models.User{ID: uuid.New(), Login: "linked", Role: models.RoleUser}
+		link := &models.UserIdentity{ID: uuid.New(), UserID: user.ID, Provider: testOIDCProvider, Subject: "subject-1"}
+
+		storeMock.EXPECT().GetUserIdentity(mock.Anything, testOIDCProvider, "subject-1").Return(link, nil).Once()
+		storeMock.EXPECT().TouchUserIdentity(mock.Anything, link).Return(nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(user, nil).Once()
+		storeMock.EXPECT().GetUserTOTP(mock.Anything, user.ID).Return(confirmedTOTP(t, user.ID), nil).Once()
+		authMock.EXPECT().CreateMFAToken(*user).Return("mfa-token", nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, startOIDCLogin(t, server, provider))
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var resp dto.MFAChallengeResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "mfa-token", resp.MFAToken)
+	})
+
+	t.Run("banned user gets no tokens", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+
+		user := &models.User{ID: uuid.New(), Login: "linked", Role: models.RoleUser, Ban: &models.Ban{Reason: "spam"}}
+		link := &models.UserIdentity{ID: uuid.New(), UserID: user.ID, Provider: testOIDCProvider, Subject: "subject-1"}
+
+		storeMock.EXPECT().GetUserIdentity(mock.Anything, testOIDCProvider, "subject-1").Return(link, nil).Once()
+		storeMock.EXPECT().TouchUserIdentity(mock.Anything, link).Return(nil).Once()
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(user, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, startOIDCLogin(t, server, provider))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("state mismatch", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+
+		req := startOIDCLogin(t, server, provider)
+		query := req.URL.Query()
+		query.Set("state", "forged")
+		req.URL.RawQuery = query.Encode()
+
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("missing state cookie", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		withOIDCProvider(t, server)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/oidc/test/callback?code=abc&state=xyz", nil)
+		req = mux.SetURLVars(req, map[string]string{oidcProviderTag: testOIDCProvider})
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("invalid id token", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		provider := withOIDCProvider(t, server)
+		provider.ModifyClaims = func(claims jwt.MapClaims) { claims["aud"] = "other-client" }
+
+		rr := httptest.NewRecorder()
+		server.oidcCallback(rr, startOIDCLogin(t, server, provider))
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
@@ -0,0 +1,462 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	organizationIDTag     = "organization_id"
+	daysQueryKey          = "days"
+	inviteCodeSize        = 8
+	defaultActivityWindow = 30
+	maxActivityWindow     = 365
+)
+
+// organizationMiddleware loads the membership of the user in the organization
+// of the path, for the role checks and handlers after it. Organizations the
+// user isn't a member of are not found, so that their existence isn't
+// revealed.
+func (s *Server) organizationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		orgID, err := uuid.Parse(mux.Vars(r)[organizationIDTag])
+		if err != nil {
+			s.WriteError(w, r, errlocal.NewErrBadRequest("invalid organization id", err.Error(), nil))
+			return
+		}
+
+		member, err := s.store.GetOrganizationMember(r.Context(), orgID, utils.GetUser(r.Context()).ID)
+		if err != nil {
+			var notFoundErr *errlocal.ErrNotFound
+			if errors.As(err, &notFoundErr) {
+				err = errlocal.NewErrNotFound("organization not found", "user is not a member",
+					map[string]any{"organization_id": orgID.String()})
+			}
+			s.WriteError(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(utils.SetOrgMember(r.Context(), member)))
+	})
+}
+
+// changeMembers runs fn, which changes the members of the organization, in a
+// transaction that holds the organization locked. The change is rolled back
+// when it would leave the organization without an owner.
+func (s *Server) changeMembers(ctx context.Context, orgID uuid.UUID, fn func(tx store.Store) error) error {
+	return s.store.ExecTx(ctx, func(tx store.Store) error {
+		if _, err := tx.GetOrganizationForUpdate(ctx, orgID); err != nil {
+			return err
+		}
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		owners, err := tx.CountOrganizationOwners(ctx, orgID)
+		if err != nil {
+			return err
+		}
+		if owners == 0 {
+			return errlocal.NewErrConflict("organization must keep an owner",
+				"make another member owner first or delete the organization",
+				map[string]any{"organization_id": orgID.String()})
+		}
+		return nil
+	})
+}
+
+// checkSoleOwner refuses the removal of the account of userID while an
+// organization has no other owner.
+func checkSoleOwner(ctx context.Context, st store.Store, userID uuid.UUID) error {
+	count, err := st.CountSoleOwnedOrganizations(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errlocal.NewErrConflict("user is the only owner of an organization",
+			"make another member owner first or delete the organization",
+			map[string]any{"user_id": userID.String(), "organizations": count})
+	}
+	return nil
+}
+
+// memberRoles records the role of a member for the audit log of the
+// organization.
+func memberRoles(userID uuid.UUID, role models.OrgRole) map[string]any {
+	return map[string]any{"members": map[string]models.OrgRole{userID.String(): role}}
+}
+
+func newInviteCode() (string, error) {
+	code, err := utils.GenerateToken(inviteCodeSize)
+	if err != nil {
+		return "", errlocal.NewErrInternal("failed to create invite code", err.Error(), nil)
+	}
+	return code, nil
+}
+
+// CreateOrganization godoc
+// @Summary Create organization
+// @Description Create an organization, such as a school or an office, to compete as a group.
+// @Description The caller becomes its owner; others join it with the invite code in the response.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOrganizationRequest true "Organization name"
+// @Success 201 {object} dto.OrganizationResponse "Created organization"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Guest session"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations [post]
+func (s *Server) createOrganization(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.CreateOrganizationRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+	code, err := newInviteCode()
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	org := &models.Organization{Name: b.Name, InviteCode: code}
+	if err := s.store.CreateOrganization(r.Context(), org, utils.GetUser(r.Context()).ID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, organizationEvent(models.AuditOrganizationCreate, org.ID), nil, map[string]any{"name": org.Name})
+
+	s.WriteResponse(w, r, http.StatusCreated, dto.NewOrganizationResponse(*org, models.OrgRoleOwner, nil))
+}
+
+// ListOrganizations godoc
+// @Summary List organizations
+// @Description List the organizations the current user is a member of, with their role in each.
+// @Tags organizations
+// @Produce json
+// @Success 200 {array} dto.OrganizationResponse "Organizations"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Guest session"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations [get]
+func (s *Server) listOrganizations(w http.ResponseWriter, r *http.Request) {
+	orgs, err := s.store.ListUserOrganizations(r.Context(), utils.GetUser(r.Context()).ID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	resp := make([]dto.OrganizationResponse, len(orgs))
+	for i, org := range orgs {
+		resp[i] = dto.NewOrganizationResponse(org.Organization, org.Role, nil)
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, resp)
+}
+
+// JoinOrganization godoc
+// @Summary Join organization
+// @Description Join the organization with the invite code as a member.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param request body dto.JoinOrganizationRequest true "Invite code"
+// @Success 201 {object} dto.OrganizationResponse "Joined organization"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Guest session"
+// @Failure 404 {object} errlocal.ErrNotFound "Invalid invite code"
+// @Failure 409 {object} errlocal.ErrConflict "Already a member"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/join [post]
+func (s *Server) joinOrganization(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.JoinOrganizationRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+
+	org, err := s.store.GetOrganizationByInviteCode(r.Context(), b.InviteCode)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	member := &models.OrganizationMember{
+		OrganizationID: org.ID,
+		UserID:         utils.GetUser(r.Context()).ID,
+		Role:           models.OrgRoleMember,
+	}
+	if err := s.store.AddOrganizationMember(r.Context(), member); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, organizationEvent(models.AuditOrganizationJoin, org.ID),
+		nil, memberRoles(member.UserID, member.Role))
+
+	s.WriteResponse(w, r, http.StatusCreated, dto.NewOrganizationResponse(*org, member.Role, nil))
+}
+
+// GetOrganization godoc
+// @Summary Get organization
+// @Description Get an organization of the current user with the stats of its members added up,
+// @Description including the estimated CO2 emissions avoided.
+// @Tags organizations
+// @Produce json
+// @Param organization_id path string true "Organization ID"
+// @Success 200 {object} dto.OrganizationResponse "Organization"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid organization ID"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Guest session"
+// @Failure 404 {object} errlocal.ErrNotFound "Organization not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/{organization_id} [get]
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	member := utils.GetOrgMember(r.Context())
+
+	org, err := s.store.GetOrganization(r.Context(), member.OrganizationID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	stats, err := s.store.GetOrganizationStats(r.Context(), member.OrganizationID)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	stats.Impact = s.rules.Weights.Impact(stats.Stat())
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewOrganizationResponse(*org, member.Role, stats))
+}
+
+// DeleteOrganization godoc
+// @Summary Delete organization
+// @Description Delete an organization with all its memberships. The stats of the members are kept.
+// @Description Only owners may delete the organization.
+// @Tags organizations
+// @Param organization_id path string true "Organization ID"
+// @Success 204 "Organization deleted"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid organization ID"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not an owner, or with an API key or while impersonating"
+// @Failure 404 {object} errlocal.ErrNotFound "Organization not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/{organization_id} [delete]
+func (s *Server) deleteOrganization(w http.ResponseWriter, r *http.Request) {
+	orgID := utils.GetOrgMember(r.Context()).OrganizationID
+
+	if err := s.store.DeleteOrganization(r.Context(), orgID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, organizationEvent(models.AuditOrganizationDelete, orgID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// ResetOrganizationInviteCode godoc
+// @Summary Reset invite code
+// @Description Replace the invite code of an organization; the old code no longer lets anyone join.
+// @Description Only owners may reset the code.
+// @Tags organizations
+// @Produce json
+// @Param organization_id path string true "Organization ID"
+// @Success 200 {object} dto.InviteCodeResponse "New invite code"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid organization ID"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not an owner, or with an API key or while impersonating"
+// @Failure 404 {object} errlocal.ErrNotFound "Organization not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/{organization_id}/invite-code [post]
+func (s *Server) resetOrganizationInviteCode(w http.ResponseWriter, r *http.Request) {
+	orgID := utils.GetOrgMember(r.Context()).OrganizationID
+	code, err := newInviteCode()
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	if err := s.store.SetOrganizationInviteCode(r.Context(), orgID, code); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, organizationEvent(models.AuditOrganizationInviteReset, orgID), nil, nil)
+
+	s.WriteResponse(w, r, http.StatusOK, dto.InviteCodeResponse{InviteCode: code})
+}
+
+// ListOrganizationMembers godoc
+// @Summary List organization members
+// @Description List the members of an organization with their stats and the number of scans they made
+// @Description in the last days, highest rating first. Only owners may list the members.
+// @Tags organizations
+// @Produce json
+// @Param organization_id path string true "Organization ID"
+// @Param days query int false "Number of days, up to today, recent scans are counted in" default(30)
+// @Success 200 {object} dto.OrganizationMembersResponse "Member activity"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid organization ID or days"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not an owner"
+// @Failure 404 {object} errlocal.ErrNotFound "Organization not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/{organization_id}/members [get]
+func (s *Server) listOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+	user := utils.GetUser(r.Context())
+	days := utils.GetQueryParam(r, daysQueryKey, defaultActivityWindow)
+	if days <= 0 || days > maxActivityWindow {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid days", "",
+			map[string]any{"days": days, "max": maxActivityWindow}))
+		return
+	}
+	since := models.Day(time.Now().In(user.Location())).AddDate(0, 0, 1-days)
+
+	members, err := s.store.ListOrganizationMembers(r.Context(), utils.GetOrgMember(r.Context()).OrganizationID, since)
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.OrganizationMembersResponse{
+		Since:   since.Format(time.DateOnly),
+		Members: members,
+	})
+}
+
+// UpdateOrganizationMember godoc
+// @Summary Update organization member
+// @Description Change the role of a member of an organization. Only owners may change roles,
+// @Description and the organization must keep at least one owner.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param organization_id path string true "Organization ID"
+// @Param user_id path string true "User ID of the member"
+// @Param request body dto.UpdateOrganizationMemberRequest true "New role"
+// @Success 200 {object} models.OrganizationMember "Updated member"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not an owner, or with an API key or while impersonating"
+// @Failure 404 {object} errlocal.ErrNotFound "Organization or member not found"
+// @Failure 409 {object} errlocal.ErrConflict "Last owner"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/{organization_id}/members/{user_id} [patch]
+func (s *Server) updateOrganizationMember(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user id", err.Error(), nil))
+		return
+	}
+	b, err := dto.GetRequestBody[dto.UpdateOrganizationMemberRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+	orgID := utils.GetOrgMember(r.Context()).OrganizationID
+
+	var member *models.OrganizationMember
+	var before models.OrgRole
+	if err := s.changeMembers(r.Context(), orgID, func(tx store.Store) error {
+		var err error
+		if member, err = tx.GetOrganizationMember(r.Context(), orgID, userID); err != nil {
+			return err
+		}
+		before, member.Role = member.Role, b.Role
+		return tx.SetOrganizationMemberRole(r.Context(), orgID, userID, b.Role)
+	}); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, organizationEvent(models.AuditOrganizationMemberUpdate, orgID),
+		memberRoles(userID, before), memberRoles(userID, member.Role))
+
+	s.WriteResponse(w, r, http.StatusOK, member)
+}
+
+// RemoveOrganizationMember godoc
+// @Summary Remove organization member
+// @Description Remove a member from an organization. Only owners may remove members,
+// @Description and the organization must keep at least one owner.
+// @Tags organizations
+// @Param organization_id path string true "Organization ID"
+// @Param user_id path string true "User ID of the member"
+// @Success 204 "Member removed"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid organization or user ID"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Not an owner, or with an API key or while impersonating"
+// @Failure 404 {object} errlocal.ErrNotFound "Organization or member not found"
+// @Failure 409 {object} errlocal.ErrConflict "Last owner"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/{organization_id}/members/{user_id} [delete]
+func (s *Server) removeOrganizationMember(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)[userIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid user id", err.Error(), nil))
+		return
+	}
+	orgID := utils.GetOrgMember(r.Context()).OrganizationID
+
+	var member *models.OrganizationMember
+	if err := s.changeMembers(r.Context(), orgID, func(tx store.Store) error {
+		var err error
+		if member, err = tx.GetOrganizationMember(r.Context(), orgID, userID); err != nil {
+			return err
+		}
+		return tx.RemoveOrganizationMember(r.Context(), orgID, userID)
+	}); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, organizationEvent(models.AuditOrganizationMemberRemove, orgID),
+		memberRoles(userID, member.Role), nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+// LeaveOrganization godoc
+// @Summary Leave organization
+// @Description Leave an organization of the current user. The last owner cannot leave;
+// @Description they make another member owner first or delete the organization.
+// @Tags organizations
+// @Param organization_id path string true "Organization ID"
+// @Success 204 "Left organization"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid organization ID"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 403 {object} errlocal.ErrForbidden "Guest session"
+// @Failure 404 {object} errlocal.ErrNotFound "Organization not found"
+// @Failure 409 {object} errlocal.ErrConflict "Last owner"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /organizations/{organization_id}/members/me [delete]
+func (s *Server) leaveOrganization(w http.ResponseWriter, r *http.Request) {
+	member := utils.GetOrgMember(r.Context())
+
+	if err := s.changeMembers(r.Context(), member.OrganizationID, func(tx store.Store) error {
+		return tx.RemoveOrganizationMember(r.Context(), member.OrganizationID, member.UserID)
+	}); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+	s.audit(r, organizationEvent(models.AuditOrganizationLeave, member.OrganizationID),
+		memberRoles(member.UserID, member.Role), nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
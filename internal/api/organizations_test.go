@@ -0,0 +1,395 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	storemocks "github.com/trashscanner/trashscanner_api/internal/store/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+// newOrgRequest returns a request of user on an organization route, with the
+// membership put in the context as organizationMiddleware does.
+func newOrgRequest(method, target, body string, user *models.User, member *models.OrganizationMember) *http.Request {
+	req := newRequest(method, target, body, user, nil)
+	if member == nil {
+		return req
+	}
+	return req.WithContext(utils.SetOrgMember(req.Context(), member))
+}
+
+// ownerOnlyOrganizationRoutes lists the routes of orgID that change it and
+// need the session of an owner.
+func ownerOnlyOrganizationRoutes(orgID uuid.UUID) []struct{ method, path string } {
+	base := "/api/v1/organizations/" + orgID.String()
+	member := base + "/members/" + uuid.NewString()
+	return []struct{ method, path string }{
+		{method: http.MethodDelete, path: base},
+		{method: http.MethodPost, path: base + "/invite-code"},
+		{method: http.MethodPatch, path: member},
+		{method: http.MethodDelete, path: member},
+	}
+}
+
+// expectMemberChange makes the store run a change of the members of orgID in
+// a transaction, leaving owners owners behind.
+func expectMemberChange(storeMock *storemocks.Store, orgID uuid.UUID, owners int64) {
+	storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+			return fn(storeMock)
+		}).Once()
+	storeMock.EXPECT().GetOrganizationForUpdate(mock.Anything, orgID).
+		Return(&models.Organization{ID: orgID}, nil).Once()
+	storeMock.EXPECT().CountOrganizationOwners(mock.Anything, orgID).Return(owners, nil).Once()
+}
+
+func TestCreateOrganization(t *testing.T) {
+	t.Run("creator becomes owner", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := testdata.User1
+
+		storeMock.EXPECT().CreateOrganization(mock.Anything, mock.MatchedBy(func(org *models.Organization) bool {
+			return org.Name == "Green School" && org.InviteCode != ""
+		}), user.ID).RunAndReturn(func(_ context.Context, org *models.Organization, _ uuid.UUID) error {
+			org.ID = uuid.New()
+			return nil
+		}).Once()
+		expectAudit(storeMock, models.AuditOrganizationCreate)
+
+		rr := httptest.NewRecorder()
+		server.createOrganization(rr, newOrgRequest(http.MethodPost, "/api/v1/organizations",
+			`{"name":"Green School"}`, &user, nil))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var resp dto.OrganizationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "Green School", resp.Name)
+		assert.Equal(t, models.OrgRoleOwner, resp.Role)
+		assert.NotEmpty(t, resp.InviteCode)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.createOrganization(rr, newOrgRequest(http.MethodPost, "/api/v1/organizations",
+			`{"name":""}`, &testdata.User1, nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestJoinOrganization(t *testing.T) {
+	org := &models.Organization{ID: uuid.New(), Name: "Office", InviteCode: "code123"}
+
+	t.Run("joins as member", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := testdata.User1
+
+		storeMock.EXPECT().GetOrganizationByInviteCode(mock.Anything, "code123").Return(org, nil).Once()
+		storeMock.EXPECT().AddOrganizationMember(mock.Anything, &models.OrganizationMember{
+			OrganizationID: org.ID,
+			UserID:         user.ID,
+			Role:           models.OrgRoleMember,
+		}).Return(nil).Once()
+		expectAudit(storeMock, models.AuditOrganizationJoin)
+
+		rr := httptest.NewRecorder()
+		server.joinOrganization(rr, newOrgRequest(http.MethodPost, "/api/v1/organizations/join",
+			`{"invite_code":"code123"}`, &user, nil))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var resp dto.OrganizationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, org.ID, resp.ID)
+		assert.Equal(t, models.OrgRoleMember, resp.Role)
+		assert.Empty(t, resp.InviteCode)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetOrganizationByInviteCode(mock.Anything, "nope").
+			Return(nil, errlocal.NewErrNotFound("organization not found", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.joinOrganization(rr, newOrgRequest(http.MethodPost, "/api/v1/organizations/join",
+			`{"invite_code":"nope"}`, &testdata.User1, nil))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("already a member", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetOrganizationByInviteCode(mock.Anything, "code123").Return(org, nil).Once()
+		storeMock.EXPECT().AddOrganizationMember(mock.Anything, mock.Anything).
+			Return(errlocal.NewErrConflict("already a member of the organization", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.joinOrganization(rr, newOrgRequest(http.MethodPost, "/api/v1/organizations/join",
+			`{"invite_code":"code123"}`, &testdata.User1, nil))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestGetOrganization(t *testing.T) {
+	server, storeMock, _, _, _ := newTestServer(t)
+	user := testdata.User1
+	org := &models.Organization{ID: uuid.New(), Name: "Office", InviteCode: "code123"}
+	member := &models.OrganizationMember{OrganizationID: org.ID, UserID: user.ID, Role: models.OrgRoleMember}
+
+	storeMock.EXPECT().GetOrganization(mock.Anything, org.ID).Return(org, nil).Once()
+	storeMock.EXPECT().GetOrganizationStats(mock.Anything, org.ID).Return(&models.OrganizationStats{
+		Members:       3,
+		Rating:        250,
+		FilesScanned:  25,
+		TotalWeight:   1,
+		TrashByTypes:  map[string]int{models.TrashTypeGlass: 5},
+		WeightByTypes: map[string]float64{models.TrashTypeGlass: 1},
+	}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	server.getOrganization(rr, newOrgRequest(http.MethodGet, "/api/v1/organizations/"+org.ID.String(), "",
+		&user, member))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp dto.OrganizationResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	assert.Empty(t, resp.InviteCode)
+	require.NotNil(t, resp.Stats)
+	assert.Equal(t, 3, resp.Stats.Members)
+	assert.Equal(t, 250, resp.Stats.Rating)
+	require.NotNil(t, resp.Stats.Impact)
+	assert.Equal(t, 0.3, resp.Stats.Impact.CO2Saved)
+}
+
+func TestOrganizationRoutes(t *testing.T) {
+	user := testdata.User1
+	orgID := uuid.New()
+	token := "access.token"
+
+	serve := func(t *testing.T, path string, member *models.OrganizationMember, err error) int {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+		server.initRouter()
+
+		authMock.EXPECT().Parse(token).
+			Return(&auth.Claims{UserID: user.ID.String(), Login: user.Login, Role: string(user.Role)}, nil)
+		storeMock.EXPECT().GetUser(mock.Anything, user.ID, false).Return(&user, nil)
+		storeMock.EXPECT().GetOrganizationMember(mock.Anything, orgID, user.ID).Return(member, err).Once()
+		if member != nil && member.Role == models.OrgRoleOwner {
+			storeMock.EXPECT().ListOrganizationMembers(mock.Anything, orgID, mock.Anything).
+				Return([]models.MemberActivity{}, nil).Once()
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/organizations/"+orgID.String()+path, nil)
+		req.AddCookie(&http.Cookie{Name: "access_token", Value: token})
+		rr := httptest.NewRecorder()
+		server.router.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	t.Run("non-members do not find the organization", func(t *testing.T) {
+		code := serve(t, "/members", nil, errlocal.NewErrNotFound("organization member not found", "", nil))
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("members may not see member activity", func(t *testing.T) {
+		member := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleMember}
+		assert.Equal(t, http.StatusForbidden, serve(t, "/members", member, nil))
+	})
+
+	t.Run("owners see member activity", func(t *testing.T) {
+		member := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleOwner}
+		assert.Equal(t, http.StatusOK, serve(t, "/members", member, nil))
+	})
+}
+
+func TestListOrganizationMembers(t *testing.T) {
+	user := testdata.User1
+	user.Timezone = "UTC"
+	orgID := uuid.New()
+	owner := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleOwner}
+
+	t.Run("recent scans of the last days", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		since := models.Day(time.Now().UTC()).AddDate(0, 0, -6)
+
+		storeMock.EXPECT().ListOrganizationMembers(mock.Anything, orgID, since).Return([]models.MemberActivity{
+			{UserID: user.ID, Name: user.Name, Role: models.OrgRoleOwner, Rating: 120, RecentScans: 4},
+		}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.listOrganizationMembers(rr, newOrgRequest(http.MethodGet,
+			"/api/v1/organizations/"+orgID.String()+"/members?days=7", "", &user, owner))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.OrganizationMembersResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, since.Format(time.DateOnly), resp.Since)
+		require.Len(t, resp.Members, 1)
+		assert.Equal(t, 4, resp.Members[0].RecentScans)
+	})
+
+	t.Run("invalid days", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.listOrganizationMembers(rr, newOrgRequest(http.MethodGet,
+			"/api/v1/organizations/"+orgID.String()+"/members?days=1000", "", &user, owner))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestUpdateOrganizationMember(t *testing.T) {
+	user := testdata.User1
+	orgID := uuid.New()
+	owner := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleOwner}
+	newRequest := func(userID uuid.UUID, body string) *http.Request {
+		req := newOrgRequest(http.MethodPatch, "/api/v1/organizations/"+orgID.String()+"/members/"+userID.String(),
+			body, &user, owner)
+		return mux.SetURLVars(req, map[string]string{userIDTag: userID.String()})
+	}
+
+	t.Run("member promoted to owner", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		memberID := uuid.New()
+
+		expectMemberChange(storeMock, orgID, 2)
+		storeMock.EXPECT().GetOrganizationMember(mock.Anything, orgID, memberID).Return(&models.OrganizationMember{
+			OrganizationID: orgID, UserID: memberID, Role: models.OrgRoleMember,
+		}, nil).Once()
+		storeMock.EXPECT().SetOrganizationMemberRole(mock.Anything, orgID, memberID, models.OrgRoleOwner).
+			Return(nil).Once()
+		expectAudit(storeMock, models.AuditOrganizationMemberUpdate)
+
+		rr := httptest.NewRecorder()
+		server.updateOrganizationMember(rr, newRequest(memberID, `{"role":"owner"}`))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp models.OrganizationMember
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, models.OrgRoleOwner, resp.Role)
+	})
+
+	t.Run("last owner cannot step down", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		expectMemberChange(storeMock, orgID, 0)
+		storeMock.EXPECT().GetOrganizationMember(mock.Anything, orgID, user.ID).Return(owner, nil).Once()
+		storeMock.EXPECT().SetOrganizationMemberRole(mock.Anything, orgID, user.ID, models.OrgRoleMember).
+			Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.updateOrganizationMember(rr, newRequest(user.ID, `{"role":"member"}`))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.updateOrganizationMember(rr, newRequest(uuid.New(), `{"role":"admin"}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestRemoveOrganizationMember(t *testing.T) {
+	server, storeMock, _, _, _ := newTestServer(t)
+	user := testdata.User1
+	orgID := uuid.New()
+	memberID := uuid.New()
+	owner := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleOwner}
+
+	expectMemberChange(storeMock, orgID, 1)
+	storeMock.EXPECT().GetOrganizationMember(mock.Anything, orgID, memberID).Return(&models.OrganizationMember{
+		OrganizationID: orgID, UserID: memberID, Role: models.OrgRoleMember,
+	}, nil).Once()
+	storeMock.EXPECT().RemoveOrganizationMember(mock.Anything, orgID, memberID).Return(nil).Once()
+	expectAudit(storeMock, models.AuditOrganizationMemberRemove)
+
+	req := newOrgRequest(http.MethodDelete, "/api/v1/organizations/"+orgID.String()+"/members/"+memberID.String(),
+		"", &user, owner)
+	req = mux.SetURLVars(req, map[string]string{userIDTag: memberID.String()})
+	rr := httptest.NewRecorder()
+	server.removeOrganizationMember(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestLeaveOrganization(t *testing.T) {
+	user := testdata.User1
+	orgID := uuid.New()
+	target := "/api/v1/organizations/" + orgID.String() + "/members/me"
+
+	t.Run("member leaves", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		member := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleMember}
+
+		expectMemberChange(storeMock, orgID, 1)
+		storeMock.EXPECT().RemoveOrganizationMember(mock.Anything, orgID, user.ID).Return(nil).Once()
+		expectAudit(storeMock, models.AuditOrganizationLeave)
+
+		rr := httptest.NewRecorder()
+		server.leaveOrganization(rr, newOrgRequest(http.MethodDelete, target, "", &user, member))
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("last owner cannot leave", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		owner := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleOwner}
+
+		expectMemberChange(storeMock, orgID, 0)
+		storeMock.EXPECT().RemoveOrganizationMember(mock.Anything, orgID, user.ID).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.leaveOrganization(rr, newOrgRequest(http.MethodDelete, target, "", &user, owner))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestResetOrganizationInviteCode(t *testing.T) {
+	server, storeMock, _, _, _ := newTestServer(t)
+	user := testdata.User1
+	orgID := uuid.New()
+	owner := &models.OrganizationMember{OrganizationID: orgID, UserID: user.ID, Role: models.OrgRoleOwner}
+
+	var stored string
+	storeMock.EXPECT().SetOrganizationInviteCode(mock.Anything, orgID, mock.AnythingOfType("string")).
+		RunAndReturn(func(_ context.Context, _ uuid.UUID, code string) error {
+			stored = code
+			return nil
+		}).Once()
+	expectAudit(storeMock, models.AuditOrganizationInviteReset)
+
+	rr := httptest.NewRecorder()
+	server.resetOrganizationInviteCode(rr, newOrgRequest(http.MethodPost,
+		"/api/v1/organizations/"+orgID.String()+"/invite-code", "", &user, owner))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp dto.InviteCodeResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	assert.NotEmpty(t, resp.InviteCode)
+	assert.Equal(t, stored, resp.InviteCode)
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	resetTokenSize     = 32
+	resetTokenQueryKey = "token"
+	resetMailSubject   = "TrashScanner password reset"
+)
+
+// ForgotPassword godoc
+// @Summary Request password reset
+// @Description Send a single-use password reset token to the email of the account.
+// @Description The response is the same whether or not the account exists.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ForgotPasswordRequest true "Account login"
+// @Success 202 "Reset instructions sent if the account has a verified email"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request body"
+// @Failure 429 {object} errlocal.ErrToManyRequests "Too many reset requests"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Header 429 {integer} Retry-After "Seconds until the next request is allowed"
+// @Router /password/forgot [post]
+func (s *Server) forgotPassword(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.ForgotPasswordRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+
+	var clientKey string
+	if ip := s.clientIP(r); ip != nil {
+		clientKey = ip.String()
+	}
+	retryAfter := max(s.resetLimiter.Allow("ip:"+clientKey), s.resetLimiter.Allow("login:"+b.Login))
+	if retryAfter > 0 {
+		setRetryAfter(w, retryAfter)
+		s.WriteError(w, r, errlocal.NewErrToManyRequests("too many password reset requests, try again later"))
+		return
+	}
+
+	user, err := s.store.GetUserByLogin(r.Context(), b.Login)
+	if err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			s.WriteResponse(w, r, http.StatusAccepted, nil)
+			return
+		}
+		s.WriteError(w, r, err)
+		return
+	}
+
+	if user.Email == nil || !user.EmailVerified {
+		s.logger.WithContext(r.Context()).WithField("user_id", user.ID.String()).
+			Warn("password reset requested for account without verified email")
+		s.WriteResponse(w, r, http.StatusAccepted, nil)
+		return
+	}
+
+	token, err := utils.GenerateToken(resetTokenSize)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to create reset token", err.Error(), nil))
+		return
+	}
+
+	resetToken := &models.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: time.Now().Add(s.passwordReset.TokenTTL),
+	}
+	if err := s.store.ExecTx(r.Context(), func(tx store.Store) error {
+		if err := tx.InvalidatePasswordResetTokens(r.Context(), user.ID); err != nil {
+			return err
+		}
+		return tx.InsertPasswordResetToken(r.Context(), resetToken)
+	}); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	// A failed delivery must not answer differently from an unknown login.
+	if err := s.mailer.Send(r.Context(), s.resetMessage(*user.Email, token)); err != nil {
+		s.logger.WithContext(r.Context()).WithField("user_id", user.ID.String()).
+			WithError(err).Error("failed to send reset email")
+	}
+
+	s.WriteResponse(w, r, http.StatusAccepted, nil)
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password with a reset token and revoke all sessions of the account
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ResetPasswordRequest true "Reset token and new password"
+// @Success 204 "Password changed"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid body or invalid/expired token"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Router /password/reset [post]
+func (s *Server) resetPassword(w http.ResponseWriter, r *http.Request) {
+	b, err := dto.GetRequestBody[dto.ResetPasswordRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body", err.Error(), nil))
+		return
+	}
+
+	hashedPass, err := utils.HashPass(b.NewPassword)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrInternal("failed to hash password", err.Error(), nil))
+		return
+	}
+
+	var userID uuid.UUID
+	if err := s.store.ExecTx(r.Context(), func(tx store.Store) error {
+		userID, err = tx.ConsumePasswordResetToken(r.Context(), utils.HashToken(b.Token))
+		if err != nil {
+			return err
+		}
+		if err := tx.UpdateUserPass(r.Context(), userID, hashedPass); err != nil {
+			return err
+		}
+		if err := tx.InvalidatePasswordResetTokens(r.Context(), userID); err != nil {
+			return err
+		}
+		return tx.RevokeAllUserTokens(r.Context(), userID)
+	}); err != nil {
+		var notFoundErr *errlocal.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			s.WriteError(w, r, errlocal.NewErrBadRequest("invalid or expired reset token", "", nil))
+			return
+		}
+		s.WriteError(w, r, err)
+		return
+	}
+	event := userEvent(models.AuditPasswordReset, userID)
+	event.ActorID = userID
+	s.audit(r, event, nil, nil)
+
+	s.WriteResponse(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) resetMessage(to, token string) mailer.Message {
+	body := fmt.Sprintf("Your password reset token: %s\n", token)
+	if link, err := url.Parse(s.passwordReset.URL); err == nil && s.passwordReset.URL != "" {
+		query := link.Query()
+		query.Set(resetTokenQueryKey, token)
+		link.RawQuery = query.Encode()
+		body = fmt.Sprintf("Follow the link to set a new password: %s\n", link.String())
+	}
+	body += fmt.Sprintf("It expires in %s. If you did not request a reset, ignore this message.\n",
+		s.passwordReset.TokenTTL)
+
+	return mailer.Message{
+		To:      to,
+		Subject: resetMailSubject,
+		Body:    body,
+	}
+}
@@ -0,0 +1,225 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
+	mailermocks "github.com/trashscanner/trashscanner_api/internal/mailer/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestForgotPassword(t *testing.T) {
+	email := "user@example.com"
+
+	t.Run("sends reset link", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		mailerMock := mailermocks.NewMailer(t)
+		server.mailer = mailerMock
+		server.passwordReset = config.PasswordResetConfig{
+			TokenTTL: time.Hour,
+			URL:      "https://app.example.com/reset",
+		}
+		user := &models.User{ID: uuid.New(), Login: "testuser", Email: &email, EmailVerified: true}
+
+		storeMock.EXPECT().GetUserByLogin(mock.Anything, "testuser").Return(user, nil)
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().InvalidatePasswordResetTokens(mock.Anything, user.ID).Return(nil)
+
+		var tokenHash string
+		storeMock.EXPECT().
+			InsertPasswordResetToken(mock.Anything, mock.MatchedBy(func(token *models.PasswordResetToken) bool {
+				tokenHash = token.TokenHash
+				return token.UserID == user.ID && token.ExpiresAt.After(time.Now())
+			})).
+			Return(nil)
+		mailerMock.EXPECT().
+			Send(mock.Anything, mock.MatchedBy(func(msg mailer.Message) bool {
+				_, token, found := strings.Cut(msg.Body, "https://app.example.com/reset?token=")
+				if !found {
+					return false
+				}
+				token, _, _ = strings.Cut(token, "\n")
+				return msg.To == email && utils.CompareTokenHash(tokenHash, token)
+			})).
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot",
+			bytes.NewBufferString(`{"login":"testuser"}`))
+		rr := httptest.NewRecorder()
+		server.forgotPassword(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("unknown login is not disclosed", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetUserByLogin(mock.Anything, "nobody").
+			Return((*models.User)(nil), errlocal.NewErrNotFound("user not found", "", nil))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot",
+			bytes.NewBufferString(`{"login":"nobody"}`))
+		rr := httptest.NewRecorder()
+		server.forgotPassword(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("account without email", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().GetUserByLogin(mock.Anything, "testuser").
+			Return(&models.User{ID: uuid.New(), Login: "testuser"}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot",
+			bytes.NewBufferString(`{"login":"testuser"}`))
+		rr := httptest.NewRecorder()
+		server.forgotPassword(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("unverified email gets no token", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		mailerMock := mailermocks.NewMailer(t)
+		server.mailer = mailerMock
+
+		storeMock.EXPECT().GetUserByLogin(mock.Anything, "testuser").
+			Return(&models.User{ID: uuid.New(), Login: "testuser", Email: &email}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot",
+			bytes.NewBufferString(`{"login":"testuser"}`))
+		rr := httptest.NewRecorder()
+		server.forgotPassword(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		storeMock.AssertNotCalled(t, "InsertPasswordResetToken", mock.Anything, mock.Anything)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("mail failure is not disclosed", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		mailerMock := mailermocks.NewMailer(t)
+		server.mailer = mailerMock
+		server.passwordReset = config.PasswordResetConfig{TokenTTL: time.Hour}
+		user := &models.User{ID: uuid.New(), Login: "testuser", Email: &email, EmailVerified: true}
+
+		storeMock.EXPECT().GetUserByLogin(mock.Anything, "testuser").Return(user, nil)
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().InvalidatePasswordResetTokens(mock.Anything, user.ID).Return(nil)
+		storeMock.EXPECT().InsertPasswordResetToken(mock.Anything, mock.Anything).Return(nil)
+		mailerMock.EXPECT().Send(mock.Anything, mock.Anything).Return(errors.New("smtp unavailable"))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot",
+			bytes.NewBufferString(`{"login":"testuser"}`))
+		rr := httptest.NewRecorder()
+		server.forgotPassword(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		server.resetLimiter = auth.NewRateLimiter(config.RateLimitConfig{Requests: 1, Window: time.Minute})
+
+		storeMock.EXPECT().GetUserByLogin(mock.Anything, "nobody").
+			Return((*models.User)(nil), errlocal.NewErrNotFound("user not found", "", nil)).Once()
+
+		send := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot",
+				bytes.NewBufferString(`{"login":"nobody"}`))
+			rr := httptest.NewRecorder()
+			server.forgotPassword(rr, req)
+			return rr
+		}
+
+		assert.Equal(t, http.StatusAccepted, send().Code)
+		rr := send()
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+		assert.Equal(t, "60", rr.Header().Get("Retry-After"))
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot", bytes.NewBufferString(`{}`))
+		rr := httptest.NewRecorder()
+		server.forgotPassword(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	const token = "reset-token"
+
+	t.Run("success", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		userID := uuid.New()
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().ConsumePasswordResetToken(mock.Anything, utils.HashToken(token)).Return(userID, nil)
+		storeMock.EXPECT().
+			UpdateUserPass(mock.Anything, userID, mock.MatchedBy(func(hash string) bool {
+				return utils.CompareHashPass(hash, "newpassword123") == nil
+			})).
+			Return(nil)
+		storeMock.EXPECT().InvalidatePasswordResetTokens(mock.Anything, userID).Return(nil)
+		storeMock.EXPECT().RevokeAllUserTokens(mock.Anything, userID).Return(nil)
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditPasswordReset && e.ActorID == userID
+		})).Return(nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/reset",
+			bytes.NewBufferString(`{"token":"reset-token","new_password":"newpassword123"}`))
+		rr := httptest.NewRecorder()
+		server.resetPassword(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("used or expired token", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().ConsumePasswordResetToken(mock.Anything, utils.HashToken(token)).
+			Return(uuid.Nil, errlocal.NewErrNotFound("password reset token not found", "", nil))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/reset",
+			bytes.NewBufferString(`{"token":"reset-token","new_password":"newpassword123"}`))
+		rr := httptest.NewRecorder()
+		server.resetPassword(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("short password", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/password/reset",
+			bytes.NewBufferString(`{"token":"reset-token","new_password":"short"}`))
+		rr := httptest.NewRecorder()
+		server.resetPassword(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/trashscanner/trashscanner_api/internal/api/dto"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
@@ -17,6 +18,7 @@ import (
 // @Accept multipart/form-data
 // @Produce json
 // @Param scan formData file true "File to upload"
+// @Param weight formData number false "Weight of the item in kg; defaults to the average of its trash type"
 // @Success 202 {object} dto.PredictionResponse "Prediction result"
 // @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
 // @Failure 403 {object} errlocal.ErrForbidden "Forbidden - user ID mismatch"
@@ -33,6 +35,11 @@ func (s *Server) startPrediction(w http.ResponseWriter, r *http.Request) {
 		s.WriteError(w, r, errlocal.NewErrBadRequest("bad format of file", err.Error(), nil))
 		return
 	}
+	weight, err := dto.GetWeightFromMultipartForm(r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid weight", err.Error(), nil))
+		return
+	}
 	file.ID = uuid.New()
 
 	fileURL, err := s.fileStore.UploadScan(ctx, user.ID.String(), file)
@@ -41,7 +48,7 @@ func (s *Server) startPrediction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newPrediction, err := s.predictor.Predict(ctx, fileURL)
+	newPrediction, err := s.predictor.Predict(ctx, fileURL, weight)
 	if err != nil {
 		s.WriteError(w, r, err)
 		return
@@ -111,3 +118,43 @@ func (s *Server) listPredictions(w http.ResponseWriter, r *http.Request) {
 
 	s.WriteResponse(w, r, http.StatusOK, predictions)
 }
+
+// SetPredictionFeedback godoc
+// @Summary Report a prediction
+// @Description Tell moderators the prediction is wrong, with the correct trash type and/or a comment
+// @Tags predictions
+// @Accept json
+// @Produce json
+// @Param PredictionID path string true "Prediction ID UUID format"
+// @Param request body dto.PredictionFeedbackRequest true "Feedback"
+// @Success 200 {object} dto.PredictionResponse "Prediction result"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid request"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 404 {object} errlocal.ErrNotFound "Prediction of the user not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /predictions/{PredictionID}/feedback [put]
+func (s *Server) setPredictionFeedback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := utils.GetUser(ctx)
+
+	predictionID, err := uuid.Parse(mux.Vars(r)[predictionIDTag])
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid prediction ID", err.Error(), nil))
+		return
+	}
+	req, err := dto.GetRequestBody[dto.PredictionFeedbackRequest](r)
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid request body or validation failed", err.Error(), nil))
+		return
+	}
+
+	prediction, err := s.store.SetPredictionFeedback(ctx, predictionID, user.ID,
+		models.PredictionFeedback{Label: req.Label, Comment: req.Comment})
+	if err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, prediction)
+}
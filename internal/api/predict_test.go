@@ -39,7 +39,7 @@ func TestStartPrediction(t *testing.T) {
 			Once()
 
 		predictorMock.EXPECT().
-			Predict(mock.Anything, fileURL).
+			Predict(mock.Anything, fileURL, (*float64)(nil)).
 			Return(prediction, nil).
 			Once()
 
@@ -106,6 +106,52 @@ func TestStartPrediction(t *testing.T) {
 		assert.Contains(t, errResp.Message(), "failed to upload scan")
 	})
 
+	t.Run("with weight", func(t *testing.T) {
+		server, _, _, fileStoreMock, predictorMock := newTestServer(t)
+
+		user := testdata.User1
+		formData := createMultipartFormWithField(t, "scan", "trash.jpg", "image/jpeg",
+			[]byte("fake scan image content"), formField{name: "weight", value: "0.25"})
+		fileURL := "user123/scans/scan-id-123"
+		weight := 0.25
+
+		fileStoreMock.EXPECT().UploadScan(mock.Anything, user.ID.String(), mock.Anything).Return(fileURL, nil).Once()
+		predictorMock.EXPECT().Predict(mock.Anything, fileURL, &weight).
+			Return(&models.Prediction{ID: uuid.New(), Weight: &weight}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/predictions", formData.body)
+		req.Header.Set("Content-Type", formData.contentType)
+		req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+		rr := httptest.NewRecorder()
+		server.startPrediction(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var response models.Prediction
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.NotNil(t, response.Weight)
+		assert.Equal(t, weight, *response.Weight)
+	})
+
+	t.Run("invalid weight", func(t *testing.T) {
+		for _, weight := range []string{"heavy", "0", "-1", "500", "NaN", "Inf", "-Inf"} {
+			server, _, _, _, _ := newTestServer(t)
+
+			user := testdata.User1
+			formData := createMultipartFormWithField(t, "scan", "trash.jpg", "image/jpeg",
+				[]byte("fake scan image content"), formField{name: "weight", value: weight})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/predictions", formData.body)
+			req.Header.Set("Content-Type", formData.contentType)
+			req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+			rr := httptest.NewRecorder()
+			server.startPrediction(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, weight)
+		}
+	})
+
 	t.Run("predictor fails", func(t *testing.T) {
 		server, _, _, fileStoreMock, predictorMock := newTestServer(t)
 
@@ -121,7 +167,7 @@ func TestStartPrediction(t *testing.T) {
 			Once()
 
 		predictorMock.EXPECT().
-			Predict(mock.Anything, fileURL).
+			Predict(mock.Anything, fileURL, (*float64)(nil)).
 			Return(nil, errlocal.NewErrToManyRequests("too many predictions in processing")).
 			Once()
 
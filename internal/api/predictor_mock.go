@@ -38,8 +38,8 @@ func (_m *mockPredictor) EXPECT() *mockPredictor_Expecter {
 }
 
 // Predict provides a mock function for the type mockPredictor
-func (_mock *mockPredictor) Predict(ctx context.Context, scanURL string) (*models.Prediction, error) {
-	ret := _mock.Called(ctx, scanURL)
+func (_mock *mockPredictor) Predict(ctx context.Context, scanURL string, weight *float64) (*models.Prediction, error) {
+	ret := _mock.Called(ctx, scanURL, weight)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Predict")
@@ -47,18 +47,18 @@ func (_mock *mockPredictor) Predict(ctx context.Context, scanURL string) (*model
 
 	var r0 *models.Prediction
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.Prediction, error)); ok {
-		return returnFunc(ctx, scanURL)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *float64) (*models.Prediction, error)); ok {
+		return returnFunc(ctx, scanURL, weight)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.Prediction); ok {
-		r0 = returnFunc(ctx, scanURL)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *float64) *models.Prediction); ok {
+		r0 = returnFunc(ctx, scanURL, weight)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Prediction)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, scanURL)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *float64) error); ok {
+		r1 = returnFunc(ctx, scanURL, weight)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,11 +73,12 @@ type mockPredictor_Predict_Call struct {
 // Predict is a helper method to define mock.On call
 //   - ctx context.Context
 //   - scanURL string
-func (_e *mockPredictor_Expecter) Predict(ctx interface{}, scanURL interface{}) *mockPredictor_Predict_Call {
-	return &mockPredictor_Predict_Call{Call: _e.mock.On("Predict", ctx, scanURL)}
+//   - weight *float64
+func (_e *mockPredictor_Expecter) Predict(ctx interface{}, scanURL interface{}, weight interface{}) *mockPredictor_Predict_Call {
+	return &mockPredictor_Predict_Call{Call: _e.mock.On("Predict", ctx, scanURL, weight)}
 }
 
-func (_c *mockPredictor_Predict_Call) Run(run func(ctx context.Context, scanURL string)) *mockPredictor_Predict_Call {
+func (_c *mockPredictor_Predict_Call) Run(run func(ctx context.Context, scanURL string, weight *float64)) *mockPredictor_Predict_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -87,9 +88,14 @@ func (_c *mockPredictor_Predict_Call) Run(run func(ctx context.Context, scanURL
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 *float64
+		if args[2] != nil {
+			arg2 = args[2].(*float64)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -100,7 +106,64 @@ func (_c *mockPredictor_Predict_Call) Return(prediction *models.Prediction, err
 	return _c
 }
 
-func (_c *mockPredictor_Predict_Call) RunAndReturn(run func(ctx context.Context, scanURL string) (*models.Prediction, error)) *mockPredictor_Predict_Call {
+func (_c *mockPredictor_Predict_Call) RunAndReturn(run func(ctx context.Context, scanURL string, weight *float64) (*models.Prediction, error)) *mockPredictor_Predict_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rerun provides a mock function for the type mockPredictor
+func (_mock *mockPredictor) Rerun(ctx context.Context, prediction *models.Prediction) error {
+	ret := _mock.Called(ctx, prediction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rerun")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Prediction) error); ok {
+		r0 = returnFunc(ctx, prediction)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockPredictor_Rerun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rerun'
+type mockPredictor_Rerun_Call struct {
+	*mock.Call
+}
+
+// Rerun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prediction *models.Prediction
+func (_e *mockPredictor_Expecter) Rerun(ctx interface{}, prediction interface{}) *mockPredictor_Rerun_Call {
+	return &mockPredictor_Rerun_Call{Call: _e.mock.On("Rerun", ctx, prediction)}
+}
+
+func (_c *mockPredictor_Rerun_Call) Run(run func(ctx context.Context, prediction *models.Prediction)) *mockPredictor_Rerun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.Prediction
+		if args[1] != nil {
+			arg1 = args[1].(*models.Prediction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockPredictor_Rerun_Call) Return(err error) *mockPredictor_Rerun_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockPredictor_Rerun_Call) RunAndReturn(run func(ctx context.Context, prediction *models.Prediction) error) *mockPredictor_Rerun_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -14,15 +14,18 @@ import (
 const (
 	predictionIDTag = "prediction_id"
 	userIDTag       = "user_id"
+	sessionIDTag    = "session_id"
 	offsetQueryKey  = "offset"
 	limitQueryKey   = "limit"
 	defaultLimit    = 100
 	defaultOffset   = 0
+	jwksPath        = "/.well-known/jwks.json"
 )
 
 func (s *Server) initRouter() {
 	s.router.Use(s.commonMiddleware, s.corsMiddleware)
 	s.router.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
+	s.router.HandleFunc(jwksPath, s.jwks).Methods(http.MethodGet)
 	s.router.PathPrefix(apiPrefix).MatcherFunc(func(r *http.Request, _ *mux.RouteMatch) bool {
 		return r.Method == http.MethodOptions
 	}).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -38,6 +41,13 @@ func (s *Server) initRouter() {
 	})
 	root.HandleFunc("/health", s.healthCheck).Methods(http.MethodGet)
 	root.HandleFunc("/refresh", s.refresh).Methods(http.MethodPost)
+	root.HandleFunc("/password/forgot", s.forgotPassword).Methods(http.MethodPost)
+	root.HandleFunc("/password/reset", s.resetPassword).Methods(http.MethodPost)
+	root.HandleFunc("/email/verify", s.verifyEmail).Methods(http.MethodPost)
+	root.HandleFunc("/login/mfa", s.loginMFA).Methods(http.MethodPost)
+	root.HandleFunc("/guest", s.createGuest).Methods(http.MethodPost)
+	root.HandleFunc(fmt.Sprintf("/oidc/{%s}/login", oidcProviderTag), s.oidcLogin).Methods(http.MethodGet)
+	root.HandleFunc(fmt.Sprintf("/oidc/{%s}/callback", oidcProviderTag), s.oidcCallback).Methods(http.MethodGet)
 
 	authRouter := root.PathPrefix("").Subrouter()
 	authRouter.Use(s.loginMiddleware)
@@ -45,24 +55,126 @@ func (s *Server) initRouter() {
 	authRouter.HandleFunc("/register", s.register).Methods(http.MethodPost)
 
 	userRouter := root.PathPrefix("/users/me").Subrouter()
-	userRouter.Use(s.authMiddleware, s.userMiddleware)
+	userRouter.Use(s.authMiddleware, s.requireScope(models.ScopeProfileRead, models.ScopeProfileWrite), s.userMiddleware)
 	userRouter.HandleFunc("", s.getUser).Methods(http.MethodGet)
-	userRouter.HandleFunc("", s.updateUser).Methods(http.MethodPatch)
-	userRouter.HandleFunc("", s.deleteUser).Methods(http.MethodDelete)
-	userRouter.HandleFunc("/avatar", s.setAvatar).Methods(http.MethodPut)
-	userRouter.HandleFunc("/avatar", s.deleteAvatar).Methods(http.MethodDelete)
-	userRouter.HandleFunc("/logout", s.logout).Methods(http.MethodPost)
-	userRouter.HandleFunc("/change-password", s.changePassword).Methods(http.MethodPut)
+	userRouter.HandleFunc("/achievements", s.listAchievements).Methods(http.MethodGet)
+	userRouter.HandleFunc("/activity", s.getActivity).Methods(http.MethodGet)
+	userRouter.HandleFunc("/stats", s.getStats).Methods(http.MethodGet)
+	userRouter.HandleFunc("/stats/trends", s.getStatsTrends).Methods(http.MethodGet)
+
+	sessionRouter := userRouter.PathPrefix("").Subrouter()
+	sessionRouter.Use(s.requireSession, s.forbidImpersonation)
+	sessionRouter.HandleFunc("", s.deleteUser).Methods(http.MethodDelete)
+	sessionRouter.HandleFunc("/logout", s.logout).Methods(http.MethodPost)
+
+	accountRouter := userRouter.PathPrefix("").Subrouter()
+	accountRouter.Use(s.requireRegistered)
+	accountRouter.HandleFunc("/avatar", s.setAvatar).Methods(http.MethodPut)
+	accountRouter.HandleFunc("/avatar", s.deleteAvatar).Methods(http.MethodDelete)
+	accountRouter.HandleFunc("/logins", s.listLogins).Methods(http.MethodGet)
+	accountRouter.HandleFunc("/email/verification", s.sendEmailVerification).Methods(http.MethodPost)
+
+	securityRouter := accountRouter.PathPrefix("").Subrouter()
+	securityRouter.Use(s.requireSession, s.forbidImpersonation)
+	securityRouter.HandleFunc("", s.updateUser).Methods(http.MethodPatch)
+	securityRouter.HandleFunc("/change-password", s.changePassword).Methods(http.MethodPut)
+	securityRouter.HandleFunc("/mfa/totp", s.enrollTOTP).Methods(http.MethodPost)
+	securityRouter.HandleFunc("/mfa/totp", s.disableTOTP).Methods(http.MethodDelete)
+	securityRouter.HandleFunc("/mfa/totp/confirm", s.confirmTOTP).Methods(http.MethodPost)
+
+	apiKeyRouter := securityRouter.PathPrefix("/api-keys").Subrouter()
+	apiKeyRouter.HandleFunc("", s.createAPIKey).Methods(http.MethodPost)
+	apiKeyRouter.HandleFunc("", s.listAPIKeys).Methods(http.MethodGet)
+	apiKeyRouter.HandleFunc(fmt.Sprintf("/{%s}", apiKeyIDTag), s.revokeAPIKey).Methods(http.MethodDelete)
 
 	predictionRouter := root.PathPrefix("/predictions").Subrouter()
-	predictionRouter.Use(s.authMiddleware)
-	predictionRouter.HandleFunc("", s.startPrediction).Methods(http.MethodPost)
+	predictionRouter.Use(s.authMiddleware,
+		s.requireScope(models.ScopePredictionsRead, models.ScopePredictionsWrite))
+	predictionRouter.Handle("", s.requireVerifiedEmail(s.requireGuestQuota(http.HandlerFunc(s.startPrediction)))).
+		Methods(http.MethodPost)
 	predictionRouter.HandleFunc("", s.listPredictions).Methods(http.MethodGet)
 	predictionRouter.HandleFunc(fmt.Sprintf("/{%s}", predictionIDTag), s.getPrediction).Methods(http.MethodGet)
+	predictionRouter.HandleFunc(fmt.Sprintf("/{%s}/feedback", predictionIDTag), s.setPredictionFeedback).
+		Methods(http.MethodPut)
+
+	leaderboardRouter := root.PathPrefix("/leaderboard").Subrouter()
+	leaderboardRouter.Use(s.authMiddleware, s.requireScope(models.ScopeProfileRead, models.ScopeProfileWrite))
+	leaderboardRouter.HandleFunc("", s.getLeaderboard).Methods(http.MethodGet)
+	leaderboardRouter.HandleFunc("/organizations", s.getOrganizationLeaderboard).Methods(http.MethodGet)
+
+	organizationRouter := root.PathPrefix("/organizations").Subrouter()
+	organizationRouter.Use(s.authMiddleware, s.requireScope(models.ScopeProfileRead, models.ScopeProfileWrite),
+		s.requireRegistered)
+	organizationRouter.HandleFunc("", s.createOrganization).Methods(http.MethodPost)
+	organizationRouter.HandleFunc("", s.listOrganizations).Methods(http.MethodGet)
+	organizationRouter.HandleFunc("/join", s.joinOrganization).Methods(http.MethodPost)
+
+	memberRouter := organizationRouter.PathPrefix(fmt.Sprintf("/{%s}", organizationIDTag)).Subrouter()
+	memberRouter.Use(s.organizationMiddleware)
+	memberRouter.HandleFunc("", s.getOrganization).Methods(http.MethodGet)
+	memberRouter.Handle("/members",
+		s.permitOrg(s.listOrganizationMembers, models.OrgRoleOwner)).Methods(http.MethodGet)
+	memberRouter.HandleFunc("/members/me", s.leaveOrganization).Methods(http.MethodDelete)
+
+	ownerRouter := memberRouter.PathPrefix("").Subrouter()
+	ownerRouter.Use(s.requireSession, s.forbidImpersonation)
+	ownerRouter.Handle("", s.permitOrg(s.deleteOrganization, models.OrgRoleOwner)).Methods(http.MethodDelete)
+	ownerRouter.Handle("/invite-code",
+		s.permitOrg(s.resetOrganizationInviteCode, models.OrgRoleOwner)).Methods(http.MethodPost)
+	ownerRouter.Handle(fmt.Sprintf("/members/{%s}", userIDTag),
+		s.permitOrg(s.updateOrganizationMember, models.OrgRoleOwner)).Methods(http.MethodPatch)
+	ownerRouter.Handle(fmt.Sprintf("/members/{%s}", userIDTag),
+		s.permitOrg(s.removeOrganizationMember, models.OrgRoleOwner)).Methods(http.MethodDelete)
 
 	adminRouter := root.PathPrefix("/admin").Subrouter()
-	adminRouter.Use(s.authMiddleware, rbac.RequireRole(s.WriteError, models.RoleAdmin))
-	adminRouter.HandleFunc("/users", s.getUsersList).Methods(http.MethodGet)
-	adminRouter.HandleFunc("/users", s.createUser).Methods(http.MethodPost)
-	adminRouter.HandleFunc(fmt.Sprintf("/users/{%s}", userIDTag), s.getAdminUser).Methods(http.MethodGet)
+	adminRouter.Use(s.authMiddleware, s.requireSession, s.requireAdminMFA)
+	adminRouter.Handle("/users", s.permit(s.getUsersList, models.PermUsersRead)).Methods(http.MethodGet)
+	adminRouter.Handle("/users", s.permit(s.createUser, models.PermUsersWrite)).Methods(http.MethodPost)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}", userIDTag),
+		s.permit(s.getAdminUser, models.PermUsersRead)).Methods(http.MethodGet)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}", userIDTag),
+		s.permit(s.updateAdminUser, models.PermUsersWrite)).Methods(http.MethodPatch)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}", userIDTag),
+		s.permit(s.purgeUser, models.PermUsersWrite)).Methods(http.MethodDelete)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/unlock", userIDTag),
+		s.permit(s.unlockUser, models.PermUsersWrite)).Methods(http.MethodPost)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/ban", userIDTag),
+		s.permit(s.banUser, models.PermUsersBan)).Methods(http.MethodPost)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/ban", userIDTag),
+		s.permit(s.unbanUser, models.PermUsersBan)).Methods(http.MethodDelete)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/restore", userIDTag),
+		s.permit(s.restoreUser, models.PermUsersWrite)).Methods(http.MethodPost)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/logins", userIDTag),
+		s.permit(s.getUserLogins, models.PermUsersRead)).Methods(http.MethodGet)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/sessions", userIDTag),
+		s.permit(s.getUserSessions, models.PermUsersRead)).Methods(http.MethodGet)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/sessions/{%s}", userIDTag, sessionIDTag),
+		s.permit(s.revokeUserSession, models.PermUsersBan)).Methods(http.MethodDelete)
+	adminRouter.Handle(fmt.Sprintf("/users/{%s}/impersonate", userIDTag),
+		s.permit(s.impersonateUser, models.PermUsersImpersonate)).Methods(http.MethodPost)
+	adminRouter.Handle("/predictions",
+		s.permit(s.listAdminPredictions, models.PermPredictionsRead)).Methods(http.MethodGet)
+	adminRouter.Handle(fmt.Sprintf("/predictions/{%s}/label", predictionIDTag),
+		s.permit(s.verifyPrediction, models.PermPredictionsModerate)).Methods(http.MethodPut)
+	adminRouter.Handle(fmt.Sprintf("/predictions/{%s}/rerun", predictionIDTag),
+		s.permit(s.rerunPrediction, models.PermPredictionsModerate)).Methods(http.MethodPost)
+	adminRouter.Handle("/audit", s.permit(s.listAuditEvents, models.PermAuditRead)).Methods(http.MethodGet)
+	adminRouter.Handle("/analytics", s.permit(s.getAnalytics, models.PermStatsRead)).Methods(http.MethodGet)
+	adminRouter.Handle("/stats/recompute",
+		s.permit(s.recomputeStats, models.PermStatsRecompute)).Methods(http.MethodPost)
+	adminRouter.Handle("/export/users",
+		s.permit(s.exportUsers, models.PermUsersRead, models.PermDataExport)).Methods(http.MethodGet)
+	adminRouter.Handle("/export/predictions",
+		s.permit(s.exportPredictions, models.PermPredictionsRead, models.PermDataExport)).Methods(http.MethodGet)
+}
+
+// permit wraps handler so that only roles with all of permissions reach it.
+func (s *Server) permit(handler http.HandlerFunc, permissions ...models.Permission) http.Handler {
+	return rbac.RequirePermission(s.WriteError, s.policy, permissions...)(handler)
+}
+
+// permitOrg wraps handler so that only members with one of roles in the
+// organization of the request reach it.
+func (s *Server) permitOrg(handler http.HandlerFunc, roles ...models.OrgRole) http.Handler {
+	return rbac.RequireOrgRole(s.WriteError, roles...)(handler)
 }
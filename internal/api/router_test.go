@@ -79,7 +79,7 @@ func TestInitRouter_LoginFlow(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/register", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Real-IP", testdata.TestIPAddress.String())
+	req.RemoteAddr = testdata.TestIPAddress.String() + ":4567"
 	req.Header.Set("User-Agent", testdata.TestUserAgent)
 
 	storeMock.EXPECT().
@@ -104,7 +104,7 @@ func TestInitRouter_LoginFlow(t *testing.T) {
 
 	storeMock.EXPECT().
 		InsertLoginHistory(mock.Anything, mock.MatchedBy(func(history *models.LoginHistory) bool {
-			assert.Equal(t, createdID, history.UserID)
+			assert.Equal(t, createdID, uuid.UUID(history.UserID.Bytes))
 			assert.True(t, history.Success)
 			return true
 		})).
@@ -148,6 +148,9 @@ func TestInitRouter_GetUserFlow(t *testing.T) {
 		Parse(token).
 		Return(claims, nil)
 
+	storeMock.EXPECT().
+		GetUser(mock.Anything, user.ID, false).
+		Return(&user, nil)
 	storeMock.EXPECT().
 		GetUser(mock.Anything, user.ID, true).
 		Return(&user, nil)
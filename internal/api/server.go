@@ -6,16 +6,23 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/netip"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/mux"
 	_ "github.com/trashscanner/trashscanner_api/docs"
+	"github.com/trashscanner/trashscanner_api/internal/audit"
 	"github.com/trashscanner/trashscanner_api/internal/auth"
 	"github.com/trashscanner/trashscanner_api/internal/config"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/filestore"
 	"github.com/trashscanner/trashscanner_api/internal/logging"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/oidc"
+	"github.com/trashscanner/trashscanner_api/internal/rbac"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
 	"github.com/trashscanner/trashscanner_api/internal/store"
 )
 
@@ -35,10 +42,34 @@ type Server struct {
 	predictor   predictor
 	logger      *logging.Logger
 	healthy     bool
+
+	loginThrottle  *auth.LoginThrottle
+	trustedProxies []netip.Prefix
+	mailer         mailer.Mailer
+	passwordReset  config.PasswordResetConfig
+	resetLimiter   *auth.RateLimiter
+	guestLimiter   *auth.RateLimiter
+
+	emailVerification config.EmailVerificationConfig
+	mfa               config.MFAConfig
+
+	oidc          config.OIDCConfig
+	oidcProviders map[string]*oidc.Provider
+
+	policy    *rbac.Policy
+	guest     config.GuestConfig
+	auditSink audit.Sink
+	rules     stats.Rules
+
+	impersonationTTL time.Duration
+	analytics        *analyticsCache
+	// recomputing is set while stats are recomputed in the background.
+	recomputing atomic.Bool
 }
 
 type predictor interface {
-	Predict(ctx context.Context, scanURL string) (*models.Prediction, error)
+	Predict(ctx context.Context, scanURL string, weight *float64) (*models.Prediction, error)
+	Rerun(ctx context.Context, prediction *models.Prediction) error
 }
 
 // @title TrashScanner API
@@ -59,12 +90,21 @@ type predictor interface {
 // @in header
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description Personal API key created at /users/me/api-keys.
 func NewServer(
 	cfg config.Config,
 	store store.Store,
 	fileStore filestore.FileStore,
 	authManager auth.AuthManager,
 	predictor predictor,
+	mailer mailer.Mailer,
+	auditSink audit.Sink,
+	policy *rbac.Policy,
+	rules stats.Rules,
 	logger *logging.Logger,
 ) *Server {
 	r := mux.NewRouter()
@@ -82,6 +122,27 @@ func NewServer(
 		authManager: authManager,
 		predictor:   predictor,
 		logger:      logger.WithApiTag(),
+
+		loginThrottle:  auth.NewLoginThrottle(cfg.LoginProtection, store),
+		trustedProxies: parseTrustedProxies(cfg.Server.TrustedProxies),
+		mailer:         mailer,
+		passwordReset:  cfg.PasswordReset,
+		resetLimiter:   auth.NewRateLimiter(cfg.PasswordReset.RateLimit),
+		guestLimiter:   auth.NewRateLimiter(cfg.Guest.RateLimit),
+
+		emailVerification: cfg.EmailVerification,
+		mfa:               cfg.MFA,
+
+		oidc:          cfg.OIDC,
+		oidcProviders: oidc.NewProviders(cfg.OIDC),
+
+		policy:    policy,
+		guest:     cfg.Guest,
+		auditSink: auditSink,
+		rules:     rules,
+
+		impersonationTTL: cfg.Auth.ImpersonationTokenTTL,
+		analytics:        newAnalyticsCache(cfg.Analytics.CacheTTL),
 	}
 }
 
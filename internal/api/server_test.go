@@ -9,11 +9,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/audit"
 	"github.com/trashscanner/trashscanner_api/internal/auth/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/config"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	filestoremocks "github.com/trashscanner/trashscanner_api/internal/filestore/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/logging"
+	mailermocks "github.com/trashscanner/trashscanner_api/internal/mailer/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/rbac"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
 	storemocks "github.com/trashscanner/trashscanner_api/internal/store/mocks"
 )
 
@@ -26,9 +30,12 @@ func TestNewServer(t *testing.T) {
 	fileStore := filestoremocks.NewFileStore(t)
 	authManager := mocks.NewAuthManager(t)
 	predictor := newMockPredictor(t)
+	mailer := mailermocks.NewMailer(t)
 	logger := logging.NewLogger(cfg)
 
-	server := NewServer(cfg, store, fileStore, authManager, predictor, logger)
+	policy := rbac.DefaultPolicy()
+	server := NewServer(cfg, store, fileStore, authManager, predictor, mailer, audit.Discard, policy,
+		stats.DefaultRules(), logger)
 
 	require.NotNil(t, server.router)
 	require.NotNil(t, server.s)
@@ -37,6 +44,9 @@ func TestNewServer(t *testing.T) {
 	assert.Equal(t, defaultTimeout, server.s.ReadTimeout)
 	assert.Equal(t, store, server.store)
 	assert.Equal(t, authManager, server.authManager)
+	assert.Equal(t, mailer, server.mailer)
+	assert.Equal(t, audit.Discard, server.auditSink)
+	assert.Equal(t, policy, server.policy)
 }
 
 func TestWriteResponse(t *testing.T) {
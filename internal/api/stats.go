@@ -0,0 +1,117 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+// GetStats godoc
+// @Summary Get user stats
+// @Description Get the stats of the current user with the estimated CO2 emissions avoided by sorting the scanned items.
+// @Description Weights are entered by the user or estimated from the average weight of each trash type.
+// @Tags users
+// @Produce json
+// @Success 200 {object} dto.StatResponse "User stats"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 404 {object} errlocal.ErrNotFound "User not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/stats [get]
+func (s *Server) getStats(w http.ResponseWriter, r *http.Request) {
+	stat := utils.GetUser(r.Context()).Stat
+	if stat == nil {
+		stat = &models.Stat{}
+	}
+	s.addImpact(stat)
+
+	s.WriteResponse(w, r, http.StatusOK, stat)
+}
+
+// GetStatsTrends godoc
+// @Summary Get stats trends
+// @Description Get the scans, trash types sorted and rating of the current user in each day, week or month of the
+// @Description range, for charts. Periods are calendar periods in the user's time zone; weeks start on Monday.
+// @Tags users
+// @Produce json
+// @Param period query string false "Period length: day, week or month; defaults to day"
+// @Param from query string false "A day of the first period (YYYY-MM-DD); defaults to 30 days, 12 weeks or 12 months"
+// @Param to query string false "A day of the last period (YYYY-MM-DD); defaults to today"
+// @Success 200 {object} dto.TrendsResponse "Stats trends"
+// @Failure 400 {object} errlocal.ErrBadRequest "Invalid range"
+// @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
+// @Failure 404 {object} errlocal.ErrNotFound "User not found"
+// @Failure 500 {object} errlocal.ErrInternal "Internal server error"
+// @Security BearerAuth
+// @Router /users/me/stats/trends [get]
+func (s *Server) getStatsTrends(w http.ResponseWriter, r *http.Request) {
+	user := utils.GetUser(r.Context())
+	q, err := dto.NewTrendsQuery(r.URL.Query(), time.Now().In(user.Location()))
+	if err != nil {
+		s.WriteError(w, r, errlocal.NewErrBadRequest("invalid range", err.Error(), nil))
+		return
+	}
+
+	var history []models.StatSnapshot
+	if user.Stat != nil {
+		history, err = s.store.GetStatsHistory(r.Context(), user.Stat.ID, q.From, q.To)
+		if err != nil {
+			s.WriteError(w, r, err)
+			return
+		}
+	}
+
+	s.WriteResponse(w, r, http.StatusOK, dto.NewTrendsResponse(user, q, history))
+}
+
+// addImpact derives the impact figures of stat from its weights.
+func (s *Server) addImpact(stat *models.Stat) {
+	if stat != nil {
+		stat.Impact = s.rules.Weights.Impact(stat)
+	}
+}
+
+// recomputeStats godoc
+// @Summary      Recompute stats
+// @Description  Recompute the stats of every user from their predictions with the current scoring rules, e.g.
+// @Description  after a rule change. The recompute runs in the background; only one runs at a time.
+// @Tags         admin
+// @Produce      json
+// @Success      202  {object}  dto.StatsRecomputeResponse
+// @Failure      401  {object}  errlocal.ErrUnauthorized
+// @Failure      403  {object}  errlocal.ErrForbidden
+// @Failure      409  {object}  errlocal.ErrConflict
+// @Failure      500  {object}  errlocal.ErrInternal
+// @Router       /api/v1/admin/stats/recompute [post]
+func (s *Server) recomputeStats(w http.ResponseWriter, r *http.Request) {
+	if !s.recomputing.CompareAndSwap(false, true) {
+		s.WriteError(w, r, errlocal.NewErrConflict("stats recompute is already running", "", nil))
+		return
+	}
+	startedAt := time.Now()
+	s.audit(r, models.AuditEvent{
+		Action:     models.AuditStatsRecompute,
+		TargetType: models.AuditTargetStats,
+		TargetID:   "all",
+	}, nil, nil)
+
+	ctx := utils.CopyContext(r.Context())
+	go func() {
+		defer s.recomputing.Store(false)
+		log := s.logger.WithContext(ctx)
+
+		count, err := stats.RecomputeAll(ctx, s.store, s.rules, startedAt)
+		if err != nil {
+			log.Errorf("stats recompute stopped after %d users: %v", count, err)
+			return
+		}
+		log.Infof("stats of %d users recomputed in %s", count, time.Since(startedAt))
+	}()
+
+	s.WriteResponse(w, r, http.StatusAccepted, dto.StatsRecomputeResponse{StartedAt: startedAt})
+}
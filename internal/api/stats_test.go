@@ -0,0 +1,195 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/api/dto"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestGetStats(t *testing.T) {
+	newRequest := func(user *models.User) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/stats", nil)
+		return req.WithContext(utils.SetUser(req.Context(), user))
+	}
+
+	t.Run("impact is derived from the weights", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Stat: &models.Stat{
+			TotalWeight:   1.3,
+			WeightByTypes: map[string]float64{models.TrashTypeGlass: 1, models.TrashTypeMetal: 0.3},
+		}}
+
+		rr := httptest.NewRecorder()
+		server.getStats(rr, newRequest(user))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp models.Stat
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, 1.3, resp.TotalWeight)
+		require.NotNil(t, resp.Impact)
+		assert.Equal(t, 1.8, resp.Impact.CO2Saved)
+		assert.Equal(t, 1.5, resp.Impact.CO2SavedByType[models.TrashTypeMetal])
+	})
+
+	t.Run("user without stats", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getStats(rr, newRequest(&models.User{ID: uuid.New()}))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp models.Stat
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		require.NotNil(t, resp.Impact)
+		assert.Zero(t, resp.Impact.CO2Saved)
+	})
+}
+
+func TestGetStatsTrends(t *testing.T) {
+	newRequest := func(user *models.User, query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/stats/trends?"+query, nil)
+		return req.WithContext(utils.SetUser(req.Context(), user))
+	}
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("periods are the changes between snapshots", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Timezone: "Europe/Berlin", Stat: &models.Stat{ID: uuid.New()}}
+
+		// Monday March 2 to Sunday March 15, with the last snapshot before
+		// the range first.
+		storeMock.EXPECT().GetStatsHistory(mock.Anything, user.Stat.ID, day(2), day(15)).
+			Return([]models.StatSnapshot{
+				{Day: day(1), Rating: 50, FilesScanned: 5, TrashByTypes: map[string]int{models.TrashTypeGlass: 5}},
+				{Day: day(3), Rating: 70, FilesScanned: 7, TrashByTypes: map[string]int{
+					models.TrashTypeGlass: 6, models.TrashTypeMetal: 1,
+				}},
+				{Day: day(8), Rating: 80, FilesScanned: 8, TrashByTypes: map[string]int{
+					models.TrashTypeGlass: 6, models.TrashTypeMetal: 2,
+				}},
+			}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getStatsTrends(rr, newRequest(user, "period=week&from=2026-03-04&to=2026-03-15"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.TrendsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "Europe/Berlin", resp.Timezone)
+		assert.Equal(t, models.TrendWeekly, resp.Period)
+		assert.Equal(t, "2026-03-02", resp.From)
+		assert.Equal(t, []dto.TrendPeriodResponse{
+			{
+				Start:        "2026-03-02",
+				Scans:        3,
+				TrashByTypes: map[string]int{models.TrashTypeGlass: 1, models.TrashTypeMetal: 2},
+				Rating:       80,
+				RatingChange: 30,
+			},
+			{Start: "2026-03-09", TrashByTypes: map[string]int{}, Rating: 80},
+		}, resp.Periods)
+	})
+
+	t.Run("defaults to the last 30 days", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Stat: &models.Stat{ID: uuid.New()}}
+		today := models.Day(time.Now().UTC())
+
+		storeMock.EXPECT().GetStatsHistory(mock.Anything, user.Stat.ID, today.AddDate(0, 0, -29), today).
+			Return([]models.StatSnapshot{}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.getStatsTrends(rr, newRequest(user, ""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.TrendsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, models.TrendDaily, resp.Period)
+		assert.Len(t, resp.Periods, 30)
+	})
+
+	t.Run("user without stats", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		rr := httptest.NewRecorder()
+		server.getStatsTrends(rr, newRequest(&models.User{ID: uuid.New()}, "period=month"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp dto.TrendsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Len(t, resp.Periods, 12)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		for _, query := range []string{
+			"period=year",
+			"from=yesterday",
+			"from=2026-03-07&to=2026-03-01",
+			"from=2024-01-01&to=2026-01-01",
+		} {
+			server, _, _, _, _ := newTestServer(t)
+			rr := httptest.NewRecorder()
+			server.getStatsTrends(rr, newRequest(&models.User{ID: uuid.New()}, query))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		user := &models.User{ID: uuid.New(), Stat: &models.Stat{ID: uuid.New()}}
+
+		storeMock.EXPECT().GetStatsHistory(mock.Anything, user.Stat.ID, mock.Anything, mock.Anything).
+			Return(nil, errlocal.NewErrInternal("db error", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.getStatsTrends(rr, newRequest(user, ""))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestRecomputeStats(t *testing.T) {
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/stats/recompute", nil)
+		return req.WithContext(utils.SetUser(req.Context(), admin))
+	}
+
+	t.Run("runs in the background", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		done := make(chan struct{})
+		expectAudit(storeMock, models.AuditStatsRecompute)
+		storeMock.EXPECT().WalkStatsUsers(mock.Anything, mock.Anything).
+			RunAndReturn(func(context.Context, func(uuid.UUID) error) error {
+				<-done
+				return nil
+			}).Once()
+
+		rr := httptest.NewRecorder()
+		server.recomputeStats(rr, newRequest())
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var resp dto.StatsRecomputeResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.False(t, resp.StartedAt.IsZero())
+
+		rr = httptest.NewRecorder()
+		server.recomputeStats(rr, newRequest())
+		assert.Equal(t, http.StatusConflict, rr.Code, "only one recompute runs at a time")
+
+		close(done)
+		assert.Eventually(t, func() bool { return !server.recomputing.Load() }, time.Second, time.Millisecond)
+	})
+}
@@ -5,18 +5,28 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/audit"
+	"github.com/trashscanner/trashscanner_api/internal/auth"
 	authmocks "github.com/trashscanner/trashscanner_api/internal/auth/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/config"
 	filestoremocks "github.com/trashscanner/trashscanner_api/internal/filestore/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/logging"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/rbac"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
 	storemocks "github.com/trashscanner/trashscanner_api/internal/store/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
 func newTestServer(t *testing.T) (*Server, *storemocks.Store, *authmocks.AuthManager, *filestoremocks.FileStore, *mockPredictor) {
@@ -37,11 +47,39 @@ func newTestServer(t *testing.T) (*Server, *storemocks.Store, *authmocks.AuthMan
 		fileStore:   fileStore,
 		predictor:   predictor,
 		logger:      logger,
+
+		loginThrottle: auth.NewLoginThrottle(config.LoginProtectionConfig{}, store),
+		resetLimiter:  auth.NewRateLimiter(config.RateLimitConfig{}),
+		guestLimiter:  auth.NewRateLimiter(config.RateLimitConfig{}),
+		policy:        rbac.DefaultPolicy(),
+		guest:         config.GuestConfig{Enabled: true, SessionTTL: time.Hour, MaxPredictions: 3},
+		auditSink:     audit.Discard,
+		rules:         stats.DefaultRules(),
+
+		impersonationTTL: 15 * time.Minute,
+		analytics:        newAnalyticsCache(time.Minute),
 	}
 
 	return srv, store, authManager, fileStore, predictor
 }
 
+// expectAudit expects one audit event with action to be recorded.
+func expectAudit(store *storemocks.Store, action models.AuditAction) {
+	store.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+		return e.Action == action
+	})).Return(nil).Once()
+}
+
+// newRequest builds a request the way the router hands it to a handler: actor
+// is the authenticated user and vars, when given, are the route variables.
+func newRequest(method, target, body string, actor *models.User, vars map[string]string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if vars != nil {
+		req = mux.SetURLVars(req, vars)
+	}
+	return req.WithContext(utils.SetUser(req.Context(), actor))
+}
+
 // multipartFormData holds the created multipart form data
 type multipartFormData struct {
 	body        io.Reader
@@ -54,12 +92,23 @@ func createMultipartFormWithAvatar(t *testing.T, filename, contentType string, d
 	return createMultipartFormWithField(t, "avatar", filename, contentType, data)
 }
 
+// formField is a plain value sent along with the file of a multipart form.
+type formField struct {
+	name  string
+	value string
+}
+
 // createMultipartFormWithField creates a multipart form with a custom field name
-func createMultipartFormWithField(t *testing.T, fieldName, filename, contentType string, data []byte) multipartFormData {
+func createMultipartFormWithField(
+	t *testing.T, fieldName, filename, contentType string, data []byte, fields ...formField,
+) multipartFormData {
 	t.Helper()
 
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
+	for _, field := range fields {
+		require.NoError(t, writer.WriteField(field.name, field.value))
+	}
 
 	part, err := writer.CreatePart(map[string][]string{
 		"Content-Disposition": {`form-data; name="` + fieldName + `"; filename="` + filename + `"`},
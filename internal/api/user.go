@@ -3,16 +3,30 @@ package api
 import (
 	"errors"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/trashscanner/trashscanner_api/internal/api/dto"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
 func (s *Server) authMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rawKey := r.Header.Get(apiKeyHeader); rawKey != "" {
+			user, key, err := s.authenticateAPIKey(r.Context(), rawKey)
+			if err != nil {
+				s.WriteError(w, r, err)
+				return
+			}
+			ctx := utils.SetAPIKey(utils.SetUser(r.Context(), user), key)
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
 		access, err := getAccessCookie(r)
 		if err != nil || access == "" {
 			s.WriteError(w, r, errlocal.NewErrUnauthorized("missing or invalid authorization", err.Error(), nil))
@@ -24,17 +38,47 @@ func (s *Server) authMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		user := &models.User{
-			ID:    uuid.MustParse(claims.UserID),
-			Login: claims.Login,
-			Role:  models.Role(claims.Role),
+		user, err := s.store.GetUser(r.Context(), uuid.MustParse(claims.UserID), false)
+		if err != nil {
+			var notFoundErr *errlocal.ErrNotFound
+			if errors.As(err, &notFoundErr) {
+				s.WriteError(w, r, errlocal.NewErrUnauthorized("user not found", "", nil))
+				return
+			}
+			s.WriteError(w, r, err)
+			return
+		}
+		if err := checkBan(user); err != nil {
+			s.WriteError(w, r, err)
+			return
 		}
 		ctx := utils.SetUser(r.Context(), user)
+		if adminID, ok := claims.ActorID(); ok {
+			admin, err := s.impersonator(r.Context(), adminID)
+			if err != nil {
+				s.WriteError(w, r, err)
+				return
+			}
+			ctx = utils.SetImpersonator(ctx, admin)
+		}
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// checkBan returns the error for users with an active ban.
+func checkBan(user *models.User) error {
+	if !user.Ban.Active(time.Now()) {
+		return nil
+	}
+
+	details := map[string]any{"reason": user.Ban.Reason}
+	if user.Ban.Until != nil {
+		details["until"] = user.Ban.Until.Format(time.RFC3339)
+	}
+	return errlocal.NewErrForbidden("account is banned", "", details)
+}
+
 func (s *Server) userMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctxUser := utils.GetUser(r.Context())
@@ -63,7 +107,7 @@ func (s *Server) userMiddleware(next http.Handler) http.Handler {
 
 // GetUser godoc
 // @Summary Get user information
-// @Description Get user details by ID (requires authentication)
+// @Description Get user details by ID (requires authentication), with stats and their estimated impact
 // @Tags users
 // @Accept json
 // @Produce json
@@ -75,12 +119,16 @@ func (s *Server) userMiddleware(next http.Handler) http.Handler {
 // @Security BearerAuth
 // @Router /users/me [get]
 func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
-	s.WriteResponse(w, r, http.StatusOK, utils.GetUser(r.Context()))
+	user := utils.GetUser(r.Context())
+	s.addImpact(user.Stat)
+
+	s.WriteResponse(w, r, http.StatusOK, user)
 }
 
 // UpdateUser godoc
 // @Summary Update user
-// @Description Update user
+// @Description Update the name, email, leaderboard visibility and/or time zone of the current user.
+// @Description Changing the email resets its verification and sends a new verification token.
 // @Tags users
 // @Accept json
 // @Produce json
@@ -89,6 +137,7 @@ func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
 // @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
 // @Failure 403 {object} errlocal.ErrForbidden "Forbidden - user ID mismatch"
 // @Failure 404 {object} errlocal.ErrNotFound "User not found"
+// @Failure 409 {object} errlocal.ErrConflict "Email already taken"
 // @Failure 500 {object} errlocal.ErrInternal "Internal server error"
 // @Security BearerAuth
 // @Router /users/me [patch]
@@ -100,18 +149,70 @@ func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	u := utils.GetUser(r.Context())
-	u.Name = body.Name
-	if err := s.store.UpdateUser(r.Context(), u); err != nil {
+	before := map[string]any{
+		"name": u.Name, "email": u.Email, "leaderboard_hidden": u.LeaderboardHidden, "timezone": u.Timezone,
+	}
+	if body.Name != "" {
+		u.Name = body.Name
+	}
+	emailChanged := body.Email != nil && (u.Email == nil || !strings.EqualFold(*u.Email, *body.Email))
+	hiddenChanged := body.Hidden != nil && *body.Hidden != u.LeaderboardHidden
+	timezoneChanged := body.Timezone != nil && *body.Timezone != "" && *body.Timezone != u.Timezone
+
+	if err := s.store.ExecTx(r.Context(), func(tx store.Store) error {
+		if body.Name != "" {
+			if err := tx.UpdateUser(r.Context(), u); err != nil {
+				return err
+			}
+		}
+		if hiddenChanged {
+			if err := tx.SetLeaderboardHidden(r.Context(), u.ID, *body.Hidden); err != nil {
+				return err
+			}
+		}
+		if timezoneChanged {
+			if err := tx.SetUserTimezone(r.Context(), u.ID, *body.Timezone); err != nil {
+				return err
+			}
+		}
+		if emailChanged {
+			if err := tx.UpdateUserEmail(r.Context(), u.ID, body.Email); err != nil {
+				return err
+			}
+			return tx.InvalidateEmailVerificationTokens(r.Context(), u.ID)
+		}
+		return nil
+	}); err != nil {
 		s.WriteError(w, r, err)
 		return
 	}
 
+	if hiddenChanged {
+		u.LeaderboardHidden = *body.Hidden
+	}
+	if timezoneChanged {
+		u.Timezone = *body.Timezone
+	}
+	if emailChanged {
+		u.Email = body.Email
+		u.EmailVerified = false
+		if err := s.issueEmailVerification(r.Context(), u); err != nil {
+			s.logger.WithContext(r.Context()).WithField("user_id", u.ID.String()).
+				Warnf("failed to send verification email: %v", err)
+		}
+	}
+	s.audit(r, userEvent(models.AuditAccountUpdate, u.ID), before,
+		map[string]any{
+			"name": u.Name, "email": u.Email, "leaderboard_hidden": u.LeaderboardHidden, "timezone": u.Timezone,
+		})
+
 	s.WriteResponse(w, r, http.StatusOK, u)
 }
 
 // DeleteUser godoc
 // @Summary Delete user account
-// @Description Delete the authenticated user's account
+// @Description Delete the authenticated user's account. The only owner of an organization
+// @Description makes another member owner or deletes the organization first.
 // @Tags users
 // @Security BearerAuth
 // @Accept json
@@ -120,15 +221,21 @@ func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 // @Failure 401 {object} errlocal.ErrUnauthorized "Unauthorized"
 // @Failure 403 {object} errlocal.ErrForbidden "Forbidden - user ID mismatch"
 // @Failure 404 {object} errlocal.ErrNotFound "User not found"
+// @Failure 409 {object} errlocal.ErrConflict "Only owner of an organization"
 // @Failure 500 {object} errlocal.ErrInternal "Internal server error"
 // @Router /users/me [delete]
 func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 	user := utils.GetUser(r.Context())
 
+	if err := checkSoleOwner(r.Context(), s.store, user.ID); err != nil {
+		s.WriteError(w, r, err)
+		return
+	}
 	if err := s.store.DeleteUser(r.Context(), user.ID); err != nil {
 		s.WriteError(w, r, err)
 		return
 	}
+	s.audit(r, userEvent(models.AuditAccountDelete, user.ID), nil, nil)
 
 	s.WriteResponse(w, r, http.StatusNoContent, nil)
 }
@@ -166,6 +273,7 @@ func (s *Server) changePassword(w http.ResponseWriter, r *http.Request) {
 		s.WriteError(w, r, err)
 		return
 	}
+	s.audit(r, userEvent(models.AuditPasswordChange, user.ID), nil, nil)
 
 	s.WriteResponse(w, r, http.StatusAccepted, nil)
 }
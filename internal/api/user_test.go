@@ -8,20 +8,24 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/trashscanner/trashscanner_api/internal/auth"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
+	mailermocks "github.com/trashscanner/trashscanner_api/internal/mailer/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
 	testdata "github.com/trashscanner/trashscanner_api/internal/testdata"
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
 func TestAuthMiddleware(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
-		server, _, authMock, _, _ := newTestServer(t)
+		server, storeMock, authMock, _, _ := newTestServer(t)
 
 		token := "access.token"
 		claims := &auth.Claims{UserID: testdata.User1.ID.String(), Login: testdata.User1.Login}
@@ -29,6 +33,9 @@ func TestAuthMiddleware(t *testing.T) {
 		authMock.EXPECT().
 			Parse(token).
 			Return(claims, nil)
+		storeMock.EXPECT().
+			GetUser(mock.Anything, testdata.User1.ID, false).
+			Return(&testdata.User1, nil)
 
 		nextCalled := false
 		handler := server.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -90,6 +97,58 @@ func TestAuthMiddleware(t *testing.T) {
 
 		assert.Equal(t, http.StatusUnauthorized, rr.Code)
 	})
+
+	t.Run("banned user", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+
+		banned := testdata.User1
+		banned.Ban = &models.Ban{BannedAt: time.Now(), Reason: "spam"}
+		authMock.EXPECT().
+			Parse("access.token").
+			Return(&auth.Claims{UserID: banned.ID.String()}, nil)
+		storeMock.EXPECT().
+			GetUser(mock.Anything, banned.ID, false).
+			Return(&banned, nil)
+
+		handler := server.authMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			t.Fatal("should not be called")
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "access.token"})
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("expired ban", func(t *testing.T) {
+		server, storeMock, authMock, _, _ := newTestServer(t)
+
+		user := testdata.User1
+		until := time.Now().Add(-time.Minute)
+		user.Ban = &models.Ban{BannedAt: time.Now().Add(-time.Hour), Until: &until, Reason: "spam"}
+		authMock.EXPECT().
+			Parse("access.token").
+			Return(&auth.Claims{UserID: user.ID.String()}, nil)
+		storeMock.EXPECT().
+			GetUser(mock.Anything, user.ID, false).
+			Return(&user, nil)
+
+		nextCalled := false
+		handler := server.authMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			nextCalled = true
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "access.token"})
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.True(t, nextCalled)
+	})
 }
 
 func TestUserMiddleware(t *testing.T) {
@@ -186,11 +245,14 @@ func TestUpdateUser(t *testing.T) {
 			loadJSONFixtureReader(t, "update_user_valid.json"))
 		req = req.WithContext(utils.SetUser(req.Context(), &user))
 
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
 		storeMock.EXPECT().
 			UpdateUser(mock.Anything, mock.MatchedBy(func(u *models.User) bool {
 				return u.ID == user.ID && u.Name != ""
 			})).
 			Return(nil)
+		expectAudit(storeMock, models.AuditAccountUpdate)
 
 		rr := httptest.NewRecorder()
 		server.updateUser(rr, req)
@@ -216,6 +278,143 @@ func TestUpdateUser(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
 	})
 
+	t.Run("hide from leaderboards", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		user := testdata.User1
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/me",
+			strings.NewReader(`{"leaderboard_hidden":true}`))
+		req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().SetLeaderboardHidden(mock.Anything, user.ID, true).Return(nil).Once()
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditAccountUpdate && string(e.After) == `{"leaderboard_hidden":true}`
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.updateUser(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp models.User
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.True(t, resp.LeaderboardHidden)
+	})
+
+	t.Run("set timezone", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		user := testdata.User1
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/me",
+			strings.NewReader(`{"timezone":"Europe/Berlin"}`))
+		req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().SetUserTimezone(mock.Anything, user.ID, "Europe/Berlin").Return(nil).Once()
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditAccountUpdate && string(e.After) == `{"timezone":"Europe/Berlin"}`
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.updateUser(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp models.User
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "Europe/Berlin", resp.Timezone)
+	})
+
+	t.Run("failed write aborts the whole update", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		user := testdata.User1
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/me",
+			strings.NewReader(`{"name":"Renamed","leaderboard_hidden":true,"timezone":"Europe/Berlin"}`))
+		req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
+		storeMock.EXPECT().UpdateUser(mock.Anything, mock.Anything).Return(nil).Once()
+		storeMock.EXPECT().SetLeaderboardHidden(mock.Anything, user.ID, true).Return(nil).Once()
+		storeMock.EXPECT().SetUserTimezone(mock.Anything, user.ID, "Europe/Berlin").
+			Return(errlocal.NewErrInternal("failed to set timezone", "", nil)).Once()
+
+		rr := httptest.NewRecorder()
+		server.updateUser(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("unknown timezone", func(t *testing.T) {
+		server, _, _, _, _ := newTestServer(t)
+
+		user := testdata.User1
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/me",
+			strings.NewReader(`{"timezone":"Mars/Olympus_Mons"}`))
+		req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+		rr := httptest.NewRecorder()
+		server.updateUser(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("change email", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+		mailerMock := mailermocks.NewMailer(t)
+		server.mailer = mailerMock
+
+		user := testdata.User1
+		email := "new@example.com"
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/me",
+			strings.NewReader(`{"email":"new@example.com"}`))
+		req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().UpdateUserEmail(mock.Anything, user.ID, &email).Return(nil).Once()
+		storeMock.EXPECT().InvalidateEmailVerificationTokens(mock.Anything, user.ID).Return(nil)
+		storeMock.EXPECT().InsertEmailVerificationToken(mock.Anything, mock.Anything).Return(nil).Once()
+		mailerMock.EXPECT().Send(mock.Anything, mock.MatchedBy(func(msg mailer.Message) bool {
+			return msg.To == email
+		})).Return(nil).Once()
+		storeMock.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(e *models.AuditEvent) bool {
+			return e.Action == models.AuditAccountUpdate && string(e.After) == `{"email":"new@example.com"}`
+		})).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.updateUser(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp models.User
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		require.NotNil(t, resp.Email)
+		assert.Equal(t, email, *resp.Email)
+		assert.False(t, resp.EmailVerified)
+	})
+
+	t.Run("email taken", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		user := testdata.User1
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/users/me",
+			strings.NewReader(`{"email":"taken@example.com"}`))
+		req = req.WithContext(utils.SetUser(req.Context(), &user))
+
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) })
+		storeMock.EXPECT().UpdateUserEmail(mock.Anything, user.ID, mock.Anything).
+			Return(errlocal.NewErrConflict("user with this email already exists", "", nil))
+
+		rr := httptest.NewRecorder()
+		server.updateUser(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
 	t.Run("update error", func(t *testing.T) {
 		server, storeMock, _, _, _ := newTestServer(t)
 
@@ -224,6 +423,8 @@ func TestUpdateUser(t *testing.T) {
 			loadJSONFixtureReader(t, "update_user_valid.json"))
 		req = req.WithContext(utils.SetUser(req.Context(), &user))
 
+		storeMock.EXPECT().ExecTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error { return fn(storeMock) }).Once()
 		storeMock.EXPECT().
 			UpdateUser(mock.Anything, mock.Anything).
 			Return(errlocal.NewErrInternal("db error", "", nil))
@@ -243,9 +444,11 @@ func TestDeleteUser(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me", nil)
 		req = req.WithContext(utils.SetUser(req.Context(), &user))
 
+		storeMock.EXPECT().CountSoleOwnedOrganizations(mock.Anything, user.ID).Return(0, nil).Once()
 		storeMock.EXPECT().
 			DeleteUser(mock.Anything, user.ID).
 			Return(nil)
+		expectAudit(storeMock, models.AuditAccountDelete)
 
 		rr := httptest.NewRecorder()
 		server.deleteUser(rr, req)
@@ -260,6 +463,7 @@ func TestDeleteUser(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me", nil)
 		req = req.WithContext(utils.SetUser(req.Context(), &user))
 
+		storeMock.EXPECT().CountSoleOwnedOrganizations(mock.Anything, user.ID).Return(0, nil).Once()
 		storeMock.EXPECT().
 			DeleteUser(mock.Anything, user.ID).
 			Return(errlocal.NewErrInternal("db error", "", nil))
@@ -269,6 +473,18 @@ func TestDeleteUser(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
+
+	t.Run("only owner of an organization", func(t *testing.T) {
+		server, storeMock, _, _, _ := newTestServer(t)
+
+		user := testdata.User1
+		storeMock.EXPECT().CountSoleOwnedOrganizations(mock.Anything, user.ID).Return(1, nil).Once()
+
+		rr := httptest.NewRecorder()
+		server.deleteUser(rr, newRequest(http.MethodDelete, "/api/v1/users/me", "", &user, nil))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
 }
 
 func TestChangePassword(t *testing.T) {
@@ -288,6 +504,7 @@ func TestChangePassword(t *testing.T) {
 		storeMock.EXPECT().
 			UpdateUserPass(mock.Anything, user.ID, mock.AnythingOfType("string")).
 			Return(nil)
+		expectAudit(storeMock, models.AuditPasswordChange)
 
 		rr := httptest.NewRecorder()
 		server.changePassword(rr, req)
@@ -0,0 +1,57 @@
+// Package audit keeps copies of audit events outside the database and
+// computes the changes they record.
+package audit
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// Sink receives every audit event once it is stored.
+type Sink interface {
+	Write(ctx context.Context, event models.AuditEvent) error
+}
+
+// Discard is the sink used when no copy of the audit log is configured.
+var Discard Sink = discard{}
+
+type discard struct{}
+
+func (discard) Write(context.Context, models.AuditEvent) error { return nil }
+
+func New(cfg config.AuditConfig) Sink {
+	if cfg.File == "" {
+		return Discard
+	}
+
+	return NewFileSink(cfg.File)
+}
+
+// Diff keeps the fields whose values differ between before and after. A field
+// missing on one side is kept on the other, so a nil before records a creation
+// and a nil after a deletion. Empty results are returned as nil.
+func Diff(before, after map[string]any) (map[string]any, map[string]any) {
+	changedBefore := make(map[string]any)
+	changedAfter := make(map[string]any)
+	for key, value := range before {
+		if other, ok := after[key]; !ok || !reflect.DeepEqual(value, other) {
+			changedBefore[key] = value
+		}
+	}
+	for key, value := range after {
+		if other, ok := before[key]; !ok || !reflect.DeepEqual(value, other) {
+			changedAfter[key] = value
+		}
+	}
+
+	if len(changedBefore) == 0 {
+		changedBefore = nil
+	}
+	if len(changedAfter) == 0 {
+		changedAfter = nil
+	}
+	return changedBefore, changedAfter
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, Discard, New(config.AuditConfig{}))
+	assert.IsType(t, &FileSink{}, New(config.AuditConfig{File: "audit.jsonl"}))
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("update keeps changed fields", func(t *testing.T) {
+		before, after := Diff(
+			map[string]any{"name": "Old", "role": "user"},
+			map[string]any{"name": "New", "role": "user"},
+		)
+		assert.Equal(t, map[string]any{"name": "Old"}, before)
+		assert.Equal(t, map[string]any{"name": "New"}, after)
+	})
+
+	t.Run("creation", func(t *testing.T) {
+		before, after := Diff(nil, map[string]any{"login": "admin"})
+		assert.Nil(t, before)
+		assert.Equal(t, map[string]any{"login": "admin"}, after)
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		before, after := Diff(map[string]any{"role": "user"}, map[string]any{"role": "user"})
+		assert.Nil(t, before)
+		assert.Nil(t, after)
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink := NewFileSink(path)
+
+	for _, action := range []models.AuditAction{models.AuditUserBan, models.AuditUserUnban} {
+		require.NoError(t, sink.Write(context.Background(), models.AuditEvent{
+			ID:         uuid.New(),
+			ActorID:    uuid.New(),
+			Action:     action,
+			TargetType: models.AuditTargetUser,
+			TargetID:   "target",
+			After:      json.RawMessage(`{"reason":"spam"}`),
+		}))
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var event models.AuditEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+	assert.Equal(t, models.AuditUserUnban, event.Action)
+	assert.JSONEq(t, `{"reason":"spam"}`, string(event.After))
+}
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+const (
+	jwkKeyTypeOKP   = "OKP"
+	jwkKeyTypeRSA   = "RSA"
+	jwkKeyTypeEC    = "EC"
+	jwkCurveEd25519 = "Ed25519"
+	jwkUseSignature = "sig"
+)
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+// JWKSet is the document served at /.well-known/jwks.json.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+func newJWK(publicKey crypto.PublicKey, algorithm string) (JWK, error) {
+	jwk := JWK{
+		Use:       jwkUseSignature,
+		Algorithm: algorithm,
+	}
+
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType = jwkKeyTypeOKP
+		jwk.Curve = jwkCurveEd25519
+		jwk.X = encodeSegment(key)
+	case *rsa.PublicKey:
+		jwk.KeyType = jwkKeyTypeRSA
+		jwk.N = encodeSegment(key.N.Bytes())
+		jwk.E = encodeSegment(big.NewInt(int64(key.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		jwk.KeyType = jwkKeyTypeEC
+		jwk.Curve = key.Curve.Params().Name
+		jwk.X = encodeSegment(key.X.FillBytes(make([]byte, size)))
+		jwk.Y = encodeSegment(key.Y.FillBytes(make([]byte, size)))
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	jwk.KeyID = jwk.thumbprint()
+
+	return jwk, nil
+}
+
+// thumbprint computes the RFC 7638 thumbprint, which is used as the key ID.
+func (k JWK) thumbprint() string {
+	var members string
+	switch k.KeyType {
+	case jwkKeyTypeRSA:
+		members = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, k.E, k.KeyType, k.N)
+	case jwkKeyTypeEC:
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, k.Curve, k.KeyType, k.X, k.Y)
+	default:
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, k.Curve, k.KeyType, k.X)
+	}
+	sum := sha256.Sum256([]byte(members))
+
+	return encodeSegment(sum[:])
+}
+
+// checkKeyAlgorithm makes sure the configured algorithm can be used with the key.
+func checkKeyAlgorithm(algorithm string, publicKey crypto.PublicKey) error {
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		if algorithm == "EdDSA" {
+			return nil
+		}
+	case *rsa.PublicKey:
+		if algorithm == "RS256" {
+			if key.N.BitLen() < minRSAKeyBits {
+				return fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+			}
+			return nil
+		}
+	case *ecdsa.PublicKey:
+		if algorithm == "ES256" {
+			if key.Curve != elliptic.P256() {
+				return fmt.Errorf("ES256 requires a P-256 key")
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%T key cannot be used with %s", publicKey, algorithm)
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
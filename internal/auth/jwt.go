@@ -10,23 +10,34 @@ import (
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
+const (
+	accessTokenType = "access"
+	mfaTokenType    = "mfa_pending"
+)
+
 type jwtGenerator struct {
 	signingMethod         jwt.SigningMethod
-	privateKey, publicKey interface{}
+	keys                  *keyRing
 	ttlAccess, ttlRefresh time.Duration
+	ttlMFA                time.Duration
+	ttlGuest              time.Duration
+	ttlImpersonation      time.Duration
 }
 
 func newJWTGenerator(cfg config.Config) (*jwtGenerator, error) {
-	privateKey, publicKey, err := utils.GetEdDSAKeysFromEnv()
+	keys, err := loadKeyRing(cfg.Auth)
 	if err != nil {
 		return nil, err
 	}
 	return &jwtGenerator{
 		signingMethod: jwt.GetSigningMethod(cfg.Auth.Algorithm),
-		privateKey:    privateKey,
-		publicKey:     publicKey,
+		keys:          keys,
 		ttlAccess:     cfg.Auth.AccessTokenTTL,
 		ttlRefresh:    cfg.Auth.RefreshTokenTTL,
+		ttlMFA:        cfg.MFA.PendingTokenTTL,
+		ttlGuest:      cfg.Guest.SessionTTL,
+
+		ttlImpersonation: cfg.Auth.ImpersonationTokenTTL,
 	}, nil
 }
 
@@ -36,6 +47,25 @@ type Claims struct {
 	Login     string `json:"login"`
 	Role      string `json:"role"`
 	TokenType string `json:"token_type"`
+	// Actor is set on tokens an admin uses to act as the user (RFC 8693).
+	Actor *Actor `json:"act,omitempty"`
+}
+
+// Actor identifies who acts on behalf of the token's user.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// ActorID returns the ID of the admin impersonating the user, if any.
+func (c *Claims) ActorID() (uuid.UUID, bool) {
+	if c.Actor == nil {
+		return uuid.Nil, false
+	}
+	id, err := uuid.Parse(c.Actor.Subject)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
 }
 
 func (m *jwtGenerator) newPair(user models.User) (*TokenPair, error) {
@@ -45,7 +75,7 @@ func (m *jwtGenerator) newPair(user models.User) (*TokenPair, error) {
 		UserID:    user.ID.String(),
 		Login:     user.Login,
 		Role:      string(user.Role),
-		TokenType: "access",
+		TokenType: accessTokenType,
 
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttlAccess)),
@@ -54,7 +84,7 @@ func (m *jwtGenerator) newPair(user models.User) (*TokenPair, error) {
 			ID:        uuid.New().String(),
 		},
 	})
-	accessString, err := accessToken.SignedString(m.privateKey)
+	accessString, err := m.sign(accessToken)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +97,7 @@ func (m *jwtGenerator) newPair(user models.User) (*TokenPair, error) {
 		ID:        uuid.New().String(),
 	})
 
-	refreshString, err := refreshToken.SignedString(m.privateKey)
+	refreshString, err := m.sign(refreshToken)
 	if err != nil {
 		return nil, err
 	}
@@ -78,14 +108,98 @@ func (m *jwtGenerator) newPair(user models.User) (*TokenPair, error) {
 	}, nil
 }
 
-func (m *jwtGenerator) parseAccess(tokenStr string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if token.Method.Alg() != m.signingMethod.Alg() {
-			return nil, jwt.ErrTokenUnverifiable
-		}
+// newMFAToken issues a short-lived token proving that the password step of a
+// login succeeded. It is not accepted as an access token.
+func (m *jwtGenerator) newMFAToken(user models.User) (string, error) {
+	now := time.Now()
+
+	token := jwt.NewWithClaims(m.signingMethod, Claims{
+		UserID:    user.ID.String(),
+		Login:     user.Login,
+		Role:      string(user.Role),
+		TokenType: mfaTokenType,
+
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttlMFA)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ID:        uuid.New().String(),
+		},
+	})
+
+	return m.sign(token)
+}
+
+// newGuestToken issues an access token for an anonymous session. There is no
+// refresh token, so the session ends when the token expires.
+func (m *jwtGenerator) newGuestToken(user models.User) (string, error) {
+	now := time.Now()
+
+	token := jwt.NewWithClaims(m.signingMethod, Claims{
+		UserID:    user.ID.String(),
+		Login:     user.Login,
+		Role:      string(user.Role),
+		TokenType: accessTokenType,
+
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttlGuest)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ID:        uuid.New().String(),
+		},
+	})
+
+	return m.sign(token)
+}
+
+// newImpersonationToken issues a short-lived access token for user on behalf
+// of actorID. There is no refresh token.
+func (m *jwtGenerator) newImpersonationToken(user models.User, actorID uuid.UUID) (string, error) {
+	now := time.Now()
+
+	token := jwt.NewWithClaims(m.signingMethod, Claims{
+		UserID:    user.ID.String(),
+		Login:     user.Login,
+		Role:      string(user.Role),
+		TokenType: accessTokenType,
+		Actor:     &Actor{Subject: actorID.String()},
 
-		return m.publicKey, nil
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttlImpersonation)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ID:        uuid.New().String(),
+		},
 	})
+
+	return m.sign(token)
+}
+
+func (m *jwtGenerator) sign(token *jwt.Token) (string, error) {
+	if m.keys.active.id != "" {
+		token.Header["kid"] = m.keys.active.id
+	}
+	return token.SignedString(m.keys.active.privateKey)
+}
+
+func (m *jwtGenerator) keyFunc(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() != m.signingMethod.Alg() {
+		return nil, jwt.ErrTokenUnverifiable
+	}
+
+	return m.keys.verificationKey(token)
+}
+
+func (m *jwtGenerator) parseAccess(tokenStr string) (*Claims, error) {
+	return m.parseClaims(tokenStr, accessTokenType)
+}
+
+func (m *jwtGenerator) parseMFA(tokenStr string) (*Claims, error) {
+	return m.parseClaims(tokenStr, mfaTokenType)
+}
+
+func (m *jwtGenerator) parseClaims(tokenStr, tokenType string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, m.keyFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +209,7 @@ func (m *jwtGenerator) parseAccess(tokenStr string) (*Claims, error) {
 		return nil, jwt.ErrTokenInvalidClaims
 	}
 
-	if claims.TokenType != "access" {
+	if claims.TokenType != tokenType {
 		return nil, jwt.ErrTokenUnverifiable
 	}
 
@@ -103,14 +217,7 @@ func (m *jwtGenerator) parseAccess(tokenStr string) (*Claims, error) {
 }
 
 func (m *jwtGenerator) parseRefresh(tokenStr string) (*models.RefreshToken, error) {
-	token, err := jwt.ParseWithClaims(tokenStr, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if token.Method.Alg() != m.signingMethod.Alg() {
-			return nil, jwt.ErrTokenUnverifiable
-		}
-
-		return m.publicKey, nil
-	})
-
+	token, err := jwt.ParseWithClaims(tokenStr, &jwt.RegisteredClaims{}, m.keyFunc)
 	if err != nil {
 		return nil, err
 	}
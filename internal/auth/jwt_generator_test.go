@@ -28,8 +28,10 @@ func TestNewJWTGenerator(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.NotNil(t, generator)
-		assert.NotNil(t, generator.privateKey)
-		assert.NotNil(t, generator.publicKey)
+		require.NotNil(t, generator.keys)
+		assert.NotNil(t, generator.keys.active.privateKey)
+		assert.NotNil(t, generator.keys.active.publicKey)
+		assert.NotEmpty(t, generator.keys.active.id)
 		assert.Equal(t, 15*time.Minute, generator.ttlAccess)
 		assert.Equal(t, 7*24*time.Hour, generator.ttlRefresh)
 	})
@@ -278,3 +280,110 @@ func TestJWTGenerator_ParseRefresh(t *testing.T) {
 		assert.Equal(t, token1.TokenHash, token2.TokenHash)
 	})
 }
+
+func TestJWTGenerator_MFAToken(t *testing.T) {
+	utils.GenerateAndSetKeys()
+	cfg := config.Config{
+		Auth: config.AuthManagerConfig{
+			Algorithm:       "EdDSA",
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 7 * 24 * time.Hour,
+		},
+		MFA: config.MFAConfig{PendingTokenTTL: 5 * time.Minute},
+	}
+	generator, err := newJWTGenerator(cfg)
+	require.NoError(t, err)
+
+	user := models.User{ID: uuid.New(), Login: "admin", Role: models.RoleAdmin}
+
+	t.Run("valid_token", func(t *testing.T) {
+		token, err := generator.newMFAToken(user)
+		require.NoError(t, err)
+
+		claims, err := generator.parseMFA(token)
+
+		require.NoError(t, err)
+		assert.Equal(t, user.ID.String(), claims.UserID)
+		assert.Equal(t, mfaTokenType, claims.TokenType)
+	})
+
+	t.Run("not_accepted_as_access", func(t *testing.T) {
+		token, err := generator.newMFAToken(user)
+		require.NoError(t, err)
+
+		_, err = generator.parseAccess(token)
+
+		require.Error(t, err)
+	})
+
+	t.Run("access_not_accepted_as_mfa", func(t *testing.T) {
+		tokens, err := generator.newPair(user)
+		require.NoError(t, err)
+
+		_, err = generator.parseMFA(tokens.Access)
+
+		require.Error(t, err)
+	})
+}
+
+func TestJWTGenerator_GuestToken(t *testing.T) {
+	utils.GenerateAndSetKeys()
+	cfg := config.Config{
+		Auth: config.AuthManagerConfig{
+			Algorithm:       "EdDSA",
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 7 * 24 * time.Hour,
+		},
+		Guest: config.GuestConfig{SessionTTL: time.Hour},
+	}
+	generator, err := newJWTGenerator(cfg)
+	require.NoError(t, err)
+
+	user := models.User{ID: uuid.New(), Login: "guest-1a2b3c4d", Role: models.RoleAnonymous}
+
+	token, err := generator.newGuestToken(user)
+	require.NoError(t, err)
+
+	claims, err := generator.parseAccess(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, user.ID.String(), claims.UserID)
+	assert.Equal(t, string(models.RoleAnonymous), claims.Role)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), claims.ExpiresAt.Time, time.Minute)
+}
+
+func TestJWTGenerator_ImpersonationToken(t *testing.T) {
+	utils.GenerateAndSetKeys()
+	cfg := config.Config{
+		Auth: config.AuthManagerConfig{
+			Algorithm:             "EdDSA",
+			AccessTokenTTL:        15 * time.Minute,
+			RefreshTokenTTL:       7 * 24 * time.Hour,
+			ImpersonationTokenTTL: 10 * time.Minute,
+		},
+	}
+	generator, err := newJWTGenerator(cfg)
+	require.NoError(t, err)
+
+	user := models.User{ID: uuid.New(), Login: "user", Role: models.RoleUser}
+	adminID := uuid.New()
+
+	token, err := generator.newImpersonationToken(user, adminID)
+	require.NoError(t, err)
+
+	claims, err := generator.parseAccess(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, user.ID.String(), claims.UserID)
+	actorID, ok := claims.ActorID()
+	require.True(t, ok)
+	assert.Equal(t, adminID, actorID)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), claims.ExpiresAt.Time, time.Minute)
+
+	tokens, err := generator.newPair(user)
+	require.NoError(t, err)
+	claims, err = generator.parseAccess(tokens.Access)
+	require.NoError(t, err)
+	_, ok = claims.ActorID()
+	assert.False(t, ok)
+}
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	verificationKeyExt = ".pub"
+	hmacAlgorithm      = "HS256"
+	hmacSecretSize     = 32
+	minRSAKeyBits      = 2048
+)
+
+type signingKey struct {
+	id         string
+	privateKey crypto.PrivateKey
+	publicKey  crypto.PublicKey
+}
+
+// keyRing holds the active signing key and every public key that is still
+// accepted for verification, indexed by kid.
+type keyRing struct {
+	algorithm string
+	active    signingKey
+	keys      map[string]JWK
+	public    map[string]crypto.PublicKey
+}
+
+func newKeyRing(algorithm string, privateKey crypto.PrivateKey, publicKey crypto.PublicKey) (*keyRing, error) {
+	ring := &keyRing{
+		algorithm: algorithm,
+		keys:      make(map[string]JWK),
+		public:    make(map[string]crypto.PublicKey),
+	}
+
+	id, err := ring.add(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	ring.active = signingKey{
+		id:         id,
+		privateKey: privateKey,
+		publicKey:  publicKey,
+	}
+
+	return ring, nil
+}
+
+// newHMACKeyRing builds a ring around a shared secret. Symmetric keys are
+// never published, so the ring has no key ID and an empty JWKS.
+func newHMACKeyRing(secret []byte) (*keyRing, error) {
+	if len(secret) == 0 {
+		secret = make([]byte, hmacSecretSize)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate HMAC secret: %w", err)
+		}
+	}
+
+	return &keyRing{
+		algorithm: hmacAlgorithm,
+		active: signingKey{
+			privateKey: secret,
+			publicKey:  secret,
+		},
+		keys:   make(map[string]JWK),
+		public: make(map[string]crypto.PublicKey),
+	}, nil
+}
+
+func loadKeyRing(cfg config.AuthManagerConfig) (*keyRing, error) {
+	if cfg.Algorithm == hmacAlgorithm {
+		if !cfg.TestMode {
+			return nil, fmt.Errorf("%s signing is only allowed in test mode", hmacAlgorithm)
+		}
+		return newHMACKeyRing(utils.GetHMACSecretFromEnv())
+	}
+
+	privateKey, publicKey, err := utils.GetSigningKeysFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	ring, err := newKeyRing(cfg.Algorithm, privateKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	envKeys, err := utils.GetVerificationKeysFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range envKeys {
+		if _, err := ring.add(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.VerificationKeysDir != "" {
+		if err := ring.loadDir(cfg.VerificationKeysDir); err != nil {
+			return nil, err
+		}
+	}
+
+	return ring, nil
+}
+
+func (r *keyRing) loadDir(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+verificationKeyExt))
+	if err != nil {
+		return fmt.Errorf("failed to list verification keys: %w", err)
+	}
+
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read verification key %s: %w", path, err)
+		}
+
+		key, err := utils.ParsePublicKey(raw)
+		if err != nil {
+			return fmt.Errorf("invalid verification key %s: %w", path, err)
+		}
+
+		if _, err := r.add(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *keyRing) add(publicKey crypto.PublicKey) (string, error) {
+	if err := checkKeyAlgorithm(r.algorithm, publicKey); err != nil {
+		return "", err
+	}
+	jwk, err := newJWK(publicKey, r.algorithm)
+	if err != nil {
+		return "", err
+	}
+	r.keys[jwk.KeyID] = jwk
+	r.public[jwk.KeyID] = publicKey
+
+	return jwk.KeyID, nil
+}
+
+// verificationKey resolves the public key for a token. Tokens issued before
+// key IDs were introduced carry no kid and are checked against the active key.
+func (r *keyRing) verificationKey(token *jwt.Token) (crypto.PublicKey, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return r.active.publicKey, nil
+	}
+
+	key, ok := r.public[kid]
+	if !ok {
+		return nil, jwt.ErrTokenUnverifiable
+	}
+
+	return key, nil
+}
+
+func (r *keyRing) jwks() JWKSet {
+	ids := make([]string, 0, len(r.keys))
+	for id := range r.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	set := JWKSet{Keys: make([]JWK, 0, len(ids))}
+	if active, ok := r.keys[r.active.id]; ok {
+		set.Keys = append(set.Keys, active)
+	}
+	for _, id := range ids {
+		if id != r.active.id {
+			set.Keys = append(set.Keys, r.keys[id])
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,273 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+	"github.com/trashscanner/trashscanner_api/internal/utils/keytest"
+)
+
+func testAuthConfig() config.Config {
+	return config.Config{
+		Auth: config.AuthManagerConfig{
+			Algorithm:       "EdDSA",
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 7 * 24 * time.Hour,
+		},
+	}
+}
+
+func writeCurrentPublicKey(t *testing.T, dir, name string) {
+	t.Helper()
+
+	raw, err := base64.StdEncoding.DecodeString(os.Getenv("AUTH_MANAGER_PUBLIC_KEY"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), raw, 0o600))
+}
+
+func TestJWK_Thumbprint(t *testing.T) {
+	// RFC 8037, appendix A.3
+	jwk := JWK{
+		KeyType: jwkKeyTypeOKP,
+		Curve:   jwkCurveEd25519,
+		X:       "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",
+	}
+
+	assert.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", jwk.thumbprint())
+}
+
+func TestKeyRing_TokensCarryKeyID(t *testing.T) {
+	utils.GenerateAndSetKeys()
+	generator, err := newJWTGenerator(testAuthConfig())
+	require.NoError(t, err)
+
+	tokens, err := generator.newPair(models.User{ID: uuid.New(), Login: "testuser"})
+	require.NoError(t, err)
+
+	for _, tokenStr := range []string{tokens.Access, tokens.Refresh} {
+		token, _, err := jwt.NewParser().ParseUnverified(tokenStr, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, generator.keys.active.id, token.Header["kid"])
+	}
+}
+
+func TestKeyRing_Rotation(t *testing.T) {
+	user := models.User{ID: uuid.New(), Login: "testuser"}
+
+	utils.GenerateAndSetKeys()
+	oldGenerator, err := newJWTGenerator(testAuthConfig())
+	require.NoError(t, err)
+	oldTokens, err := oldGenerator.newPair(user)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeCurrentPublicKey(t, dir, "retired.pub")
+
+	utils.GenerateAndSetKeys()
+
+	t.Run("retired_key_from_dir_still_verifies", func(t *testing.T) {
+		cfg := testAuthConfig()
+		cfg.Auth.VerificationKeysDir = dir
+		generator, err := newJWTGenerator(cfg)
+		require.NoError(t, err)
+
+		claims, err := generator.parseAccess(oldTokens.Access)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID.String(), claims.UserID)
+
+		_, err = generator.parseRefresh(oldTokens.Refresh)
+		require.NoError(t, err)
+
+		newTokens, err := generator.newPair(user)
+		require.NoError(t, err)
+		_, err = generator.parseAccess(newTokens.Access)
+		require.NoError(t, err)
+
+		set := generator.keys.jwks()
+		require.Len(t, set.Keys, 2)
+		assert.Equal(t, generator.keys.active.id, set.Keys[0].KeyID)
+		assert.Equal(t, oldGenerator.keys.active.id, set.Keys[1].KeyID)
+	})
+
+	t.Run("retired_key_from_env_still_verifies", func(t *testing.T) {
+		rawDir, err := os.ReadFile(filepath.Join(dir, "retired.pub"))
+		require.NoError(t, err)
+		t.Setenv("AUTH_MANAGER_VERIFICATION_KEYS", base64.StdEncoding.EncodeToString(rawDir))
+
+		generator, err := newJWTGenerator(testAuthConfig())
+		require.NoError(t, err)
+
+		_, err = generator.parseAccess(oldTokens.Access)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown_key_id_rejected", func(t *testing.T) {
+		generator, err := newJWTGenerator(testAuthConfig())
+		require.NoError(t, err)
+
+		_, err = generator.parseAccess(oldTokens.Access)
+		require.ErrorIs(t, err, jwt.ErrTokenUnverifiable)
+	})
+
+	t.Run("invalid_key_in_dir", func(t *testing.T) {
+		badDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(badDir, "broken.pub"), []byte("not a key"), 0o600))
+
+		cfg := testAuthConfig()
+		cfg.Auth.VerificationKeysDir = badDir
+		_, err := newJWTGenerator(cfg)
+		require.Error(t, err)
+	})
+}
+
+func TestKeyRing_LegacyTokenWithoutKeyID(t *testing.T) {
+	utils.GenerateAndSetKeys()
+	generator, err := newJWTGenerator(testAuthConfig())
+	require.NoError(t, err)
+
+	now := time.Now()
+	token := jwt.NewWithClaims(generator.signingMethod, Claims{
+		UserID:    uuid.New().String(),
+		TokenType: "access",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	})
+	tokenStr, err := token.SignedString(generator.keys.active.privateKey)
+	require.NoError(t, err)
+
+	_, err = generator.parseAccess(tokenStr)
+	require.NoError(t, err)
+}
+
+func TestKeyRing_Algorithms(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		algorithm string
+		key       crypto.Signer
+		keyType   string
+	}{
+		{name: "RS256", algorithm: "RS256", key: rsaKey, keyType: jwkKeyTypeRSA},
+		{name: "ES256", algorithm: "ES256", key: ecKey, keyType: jwkKeyTypeEC},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keytest.SetPEMSigningKeys(t, tt.key)
+			cfg := testAuthConfig()
+			cfg.Auth.Algorithm = tt.algorithm
+
+			generator, err := newJWTGenerator(cfg)
+			require.NoError(t, err)
+
+			user := models.User{ID: uuid.New(), Login: "testuser"}
+			tokens, err := generator.newPair(user)
+			require.NoError(t, err)
+
+			claims, err := generator.parseAccess(tokens.Access)
+			require.NoError(t, err)
+			assert.Equal(t, user.ID.String(), claims.UserID)
+
+			set := generator.keys.jwks()
+			require.Len(t, set.Keys, 1)
+			assert.Equal(t, tt.keyType, set.Keys[0].KeyType)
+			assert.Equal(t, tt.algorithm, set.Keys[0].Algorithm)
+			assert.Equal(t, generator.keys.active.id, set.Keys[0].KeyID)
+		})
+	}
+
+	t.Run("key_does_not_match_algorithm", func(t *testing.T) {
+		keytest.SetPEMSigningKeys(t, ecKey)
+		cfg := testAuthConfig()
+		cfg.Auth.Algorithm = "RS256"
+
+		_, err := newJWTGenerator(cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("ES256_requires_P256", func(t *testing.T) {
+		p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+		keytest.SetPEMSigningKeys(t, p384Key)
+		cfg := testAuthConfig()
+		cfg.Auth.Algorithm = "ES256"
+
+		_, err = newJWTGenerator(cfg)
+		require.Error(t, err)
+	})
+}
+
+func TestKeyRing_HMAC(t *testing.T) {
+	t.Setenv("AUTH_MANAGER_SECRET_PRIVATE_KEY", "")
+	t.Setenv("AUTH_MANAGER_PUBLIC_KEY", "")
+
+	t.Run("requires_test_mode", func(t *testing.T) {
+		cfg := testAuthConfig()
+		cfg.Auth.Algorithm = "HS256"
+
+		_, err := newJWTGenerator(cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("random_secret", func(t *testing.T) {
+		cfg := testAuthConfig()
+		cfg.Auth.Algorithm = "HS256"
+		cfg.Auth.TestMode = true
+
+		generator, err := newJWTGenerator(cfg)
+		require.NoError(t, err)
+
+		tokens, err := generator.newPair(models.User{ID: uuid.New(), Login: "testuser"})
+		require.NoError(t, err)
+		_, err = generator.parseAccess(tokens.Access)
+		require.NoError(t, err)
+
+		token, _, err := jwt.NewParser().ParseUnverified(tokens.Access, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+		assert.NotContains(t, token.Header, "kid")
+		assert.Empty(t, generator.keys.jwks().Keys)
+
+		other, err := newJWTGenerator(cfg)
+		require.NoError(t, err)
+		_, err = other.parseAccess(tokens.Access)
+		require.Error(t, err)
+	})
+
+	t.Run("shared_secret_from_env", func(t *testing.T) {
+		t.Setenv("AUTH_MANAGER_HMAC_SECRET", "shared-test-secret")
+		cfg := testAuthConfig()
+		cfg.Auth.Algorithm = "HS256"
+		cfg.Auth.TestMode = true
+
+		issuer, err := newJWTGenerator(cfg)
+		require.NoError(t, err)
+		verifier, err := newJWTGenerator(cfg)
+		require.NoError(t, err)
+
+		tokens, err := issuer.newPair(models.User{ID: uuid.New(), Login: "testuser"})
+		require.NoError(t, err)
+		_, err = verifier.parseRefresh(tokens.Refresh)
+		require.NoError(t, err)
+	})
+}
@@ -21,7 +21,12 @@ type AuthManager interface {
 	CreateNewPair(ctx context.Context, user models.User) (*TokenPair, error)
 	Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
 	Parse(tokenStr string) (*Claims, error)
+	CreateMFAToken(user models.User) (string, error)
+	ParseMFAToken(tokenStr string) (*Claims, error)
+	CreateGuestToken(user models.User) (string, error)
+	CreateImpersonationToken(user models.User, actorID uuid.UUID) (string, error)
 	RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error
+	JWKS() JWKSet
 }
 
 type jwtManager struct {
@@ -92,6 +97,26 @@ func (m *jwtManager) Parse(tokenStr string) (*Claims, error) {
 	return m.generator.parseAccess(tokenStr)
 }
 
+func (m *jwtManager) CreateMFAToken(user models.User) (string, error) {
+	return m.generator.newMFAToken(user)
+}
+
+func (m *jwtManager) ParseMFAToken(tokenStr string) (*Claims, error) {
+	return m.generator.parseMFA(tokenStr)
+}
+
+func (m *jwtManager) CreateGuestToken(user models.User) (string, error) {
+	return m.generator.newGuestToken(user)
+}
+
+func (m *jwtManager) CreateImpersonationToken(user models.User, actorID uuid.UUID) (string, error) {
+	return m.generator.newImpersonationToken(user, actorID)
+}
+
 func (m *jwtManager) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
 	return m.store.RevokeAllUserTokens(ctx, userID)
 }
+
+func (m *jwtManager) JWKS() JWKSet {
+	return m.generator.keys.jwks()
+}
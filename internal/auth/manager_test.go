@@ -18,13 +18,12 @@ import (
 )
 
 func setupTestManager(t *testing.T) (AuthManager, *mocks.Store, models.User) {
-	utils.GenerateAndSetKeys()
-
 	cfg := config.Config{
 		Auth: config.AuthManagerConfig{
-			Algorithm:       "EdDSA",
+			Algorithm:       "HS256",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
+			TestMode:        true,
 		},
 	}
 
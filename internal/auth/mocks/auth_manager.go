@@ -39,6 +39,192 @@ func (_m *AuthManager) EXPECT() *AuthManager_Expecter {
 	return &AuthManager_Expecter{mock: &_m.Mock}
 }
 
+// CreateGuestToken provides a mock function for the type AuthManager
+func (_mock *AuthManager) CreateGuestToken(user models.User) (string, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGuestToken")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(models.User) (string, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(models.User) string); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(models.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthManager_CreateGuestToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGuestToken'
+type AuthManager_CreateGuestToken_Call struct {
+	*mock.Call
+}
+
+// CreateGuestToken is a helper method to define mock.On call
+//   - user models.User
+func (_e *AuthManager_Expecter) CreateGuestToken(user interface{}) *AuthManager_CreateGuestToken_Call {
+	return &AuthManager_CreateGuestToken_Call{Call: _e.mock.On("CreateGuestToken", user)}
+}
+
+func (_c *AuthManager_CreateGuestToken_Call) Run(run func(user models.User)) *AuthManager_CreateGuestToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 models.User
+		if args[0] != nil {
+			arg0 = args[0].(models.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthManager_CreateGuestToken_Call) Return(s string, err error) *AuthManager_CreateGuestToken_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *AuthManager_CreateGuestToken_Call) RunAndReturn(run func(user models.User) (string, error)) *AuthManager_CreateGuestToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateImpersonationToken provides a mock function for the type AuthManager
+func (_mock *AuthManager) CreateImpersonationToken(user models.User, actorID uuid.UUID) (string, error) {
+	ret := _mock.Called(user, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateImpersonationToken")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(models.User, uuid.UUID) (string, error)); ok {
+		return returnFunc(user, actorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(models.User, uuid.UUID) string); ok {
+		r0 = returnFunc(user, actorID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(models.User, uuid.UUID) error); ok {
+		r1 = returnFunc(user, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthManager_CreateImpersonationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImpersonationToken'
+type AuthManager_CreateImpersonationToken_Call struct {
+	*mock.Call
+}
+
+// CreateImpersonationToken is a helper method to define mock.On call
+//   - user models.User
+//   - actorID uuid.UUID
+func (_e *AuthManager_Expecter) CreateImpersonationToken(user interface{}, actorID interface{}) *AuthManager_CreateImpersonationToken_Call {
+	return &AuthManager_CreateImpersonationToken_Call{Call: _e.mock.On("CreateImpersonationToken", user, actorID)}
+}
+
+func (_c *AuthManager_CreateImpersonationToken_Call) Run(run func(user models.User, actorID uuid.UUID)) *AuthManager_CreateImpersonationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 models.User
+		if args[0] != nil {
+			arg0 = args[0].(models.User)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthManager_CreateImpersonationToken_Call) Return(s string, err error) *AuthManager_CreateImpersonationToken_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *AuthManager_CreateImpersonationToken_Call) RunAndReturn(run func(user models.User, actorID uuid.UUID) (string, error)) *AuthManager_CreateImpersonationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMFAToken provides a mock function for the type AuthManager
+func (_mock *AuthManager) CreateMFAToken(user models.User) (string, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMFAToken")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(models.User) (string, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(models.User) string); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(models.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthManager_CreateMFAToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMFAToken'
+type AuthManager_CreateMFAToken_Call struct {
+	*mock.Call
+}
+
+// CreateMFAToken is a helper method to define mock.On call
+//   - user models.User
+func (_e *AuthManager_Expecter) CreateMFAToken(user interface{}) *AuthManager_CreateMFAToken_Call {
+	return &AuthManager_CreateMFAToken_Call{Call: _e.mock.On("CreateMFAToken", user)}
+}
+
+func (_c *AuthManager_CreateMFAToken_Call) Run(run func(user models.User)) *AuthManager_CreateMFAToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 models.User
+		if args[0] != nil {
+			arg0 = args[0].(models.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthManager_CreateMFAToken_Call) Return(s string, err error) *AuthManager_CreateMFAToken_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *AuthManager_CreateMFAToken_Call) RunAndReturn(run func(user models.User) (string, error)) *AuthManager_CreateMFAToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateNewPair provides a mock function for the type AuthManager
 func (_mock *AuthManager) CreateNewPair(ctx context.Context, user models.User) (*auth.TokenPair, error) {
 	ret := _mock.Called(ctx, user)
@@ -107,6 +293,50 @@ func (_c *AuthManager_CreateNewPair_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// JWKS provides a mock function for the type AuthManager
+func (_mock *AuthManager) JWKS() auth.JWKSet {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JWKS")
+	}
+
+	var r0 auth.JWKSet
+	if returnFunc, ok := ret.Get(0).(func() auth.JWKSet); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(auth.JWKSet)
+	}
+	return r0
+}
+
+// AuthManager_JWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JWKS'
+type AuthManager_JWKS_Call struct {
+	*mock.Call
+}
+
+// JWKS is a helper method to define mock.On call
+func (_e *AuthManager_Expecter) JWKS() *AuthManager_JWKS_Call {
+	return &AuthManager_JWKS_Call{Call: _e.mock.On("JWKS")}
+}
+
+func (_c *AuthManager_JWKS_Call) Run(run func()) *AuthManager_JWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AuthManager_JWKS_Call) Return(jWKSet auth.JWKSet) *AuthManager_JWKS_Call {
+	_c.Call.Return(jWKSet)
+	return _c
+}
+
+func (_c *AuthManager_JWKS_Call) RunAndReturn(run func() auth.JWKSet) *AuthManager_JWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Parse provides a mock function for the type AuthManager
 func (_mock *AuthManager) Parse(tokenStr string) (*auth.Claims, error) {
 	ret := _mock.Called(tokenStr)
@@ -169,6 +399,68 @@ func (_c *AuthManager_Parse_Call) RunAndReturn(run func(tokenStr string) (*auth.
 	return _c
 }
 
+// ParseMFAToken provides a mock function for the type AuthManager
+func (_mock *AuthManager) ParseMFAToken(tokenStr string) (*auth.Claims, error) {
+	ret := _mock.Called(tokenStr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseMFAToken")
+	}
+
+	var r0 *auth.Claims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*auth.Claims, error)); ok {
+		return returnFunc(tokenStr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *auth.Claims); ok {
+		r0 = returnFunc(tokenStr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Claims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(tokenStr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthManager_ParseMFAToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseMFAToken'
+type AuthManager_ParseMFAToken_Call struct {
+	*mock.Call
+}
+
+// ParseMFAToken is a helper method to define mock.On call
+//   - tokenStr string
+func (_e *AuthManager_Expecter) ParseMFAToken(tokenStr interface{}) *AuthManager_ParseMFAToken_Call {
+	return &AuthManager_ParseMFAToken_Call{Call: _e.mock.On("ParseMFAToken", tokenStr)}
+}
+
+func (_c *AuthManager_ParseMFAToken_Call) Run(run func(tokenStr string)) *AuthManager_ParseMFAToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthManager_ParseMFAToken_Call) Return(claims *auth.Claims, err error) *AuthManager_ParseMFAToken_Call {
+	_c.Call.Return(claims, err)
+	return _c
+}
+
+func (_c *AuthManager_ParseMFAToken_Call) RunAndReturn(run func(tokenStr string) (*auth.Claims, error)) *AuthManager_ParseMFAToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Refresh provides a mock function for the type AuthManager
 func (_mock *AuthManager) Refresh(ctx context.Context, refreshToken string) (*auth.TokenPair, error) {
 	ret := _mock.Called(ctx, refreshToken)
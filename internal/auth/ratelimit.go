@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+)
+
+// RateLimiter allows a number of requests per key within a fixed window. The
+// counters live in memory, so every instance of the API limits on its own.
+type RateLimiter struct {
+	cfg config.RateLimitConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	windows   map[string]rateWindow
+	nextPrune time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func NewRateLimiter(cfg config.RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		cfg:     cfg,
+		now:     time.Now,
+		windows: make(map[string]rateWindow),
+	}
+}
+
+// Allow counts a request for key and returns how long the caller has to wait
+// when the limit of the current window is used up. A zero duration means the
+// request may proceed.
+func (l *RateLimiter) Allow(key string) time.Duration {
+	if l.cfg.Requests <= 0 || l.cfg.Window <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.cfg.Window {
+		l.windows[key] = rateWindow{start: now, count: 1}
+		return 0
+	}
+	if w.count >= l.cfg.Requests {
+		return w.start.Add(l.cfg.Window).Sub(now)
+	}
+	w.count++
+	l.windows[key] = w
+
+	return 0
+}
+
+// prune drops expired windows at most once per window, so the map only holds
+// the keys seen recently.
+func (l *RateLimiter) prune(now time.Time) {
+	if now.Before(l.nextPrune) {
+		return
+	}
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.cfg.Window {
+			delete(l.windows, key)
+		}
+	}
+	l.nextPrune = now.Add(l.cfg.Window)
+}
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(config.RateLimitConfig{Requests: 2, Window: time.Minute})
+	limiter.now = func() time.Time { return now }
+
+	assert.Zero(t, limiter.Allow("a"))
+	assert.Zero(t, limiter.Allow("a"))
+	assert.Equal(t, time.Minute, limiter.Allow("a"))
+	assert.Zero(t, limiter.Allow("b"), "keys are limited separately")
+
+	now = now.Add(40 * time.Second)
+	assert.Equal(t, 20*time.Second, limiter.Allow("a"))
+
+	now = now.Add(20 * time.Second)
+	assert.Zero(t, limiter.Allow("a"), "a new window starts")
+	assert.Len(t, limiter.windows, 1, "expired windows are pruned")
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	limiter := NewRateLimiter(config.RateLimitConfig{})
+
+	for range 10 {
+		assert.Zero(t, limiter.Allow("a"))
+	}
+	assert.Empty(t, limiter.windows)
+}
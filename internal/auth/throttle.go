@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+)
+
+// maxDelayShift bounds the exponential backoff so the shift never overflows.
+const maxDelayShift = 16
+
+// LoginThrottle decides whether a login attempt may proceed based on the
+// failures recorded in login history.
+type LoginThrottle struct {
+	cfg   config.LoginProtectionConfig
+	store store.Store
+	now   func() time.Time
+}
+
+func NewLoginThrottle(cfg config.LoginProtectionConfig, store store.Store) *LoginThrottle {
+	return &LoginThrottle{
+		cfg:   cfg,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Check returns how long the caller has to wait before the next attempt.
+// A zero duration means the attempt may proceed. userID may be uuid.Nil and
+// ip may be nil when they are unknown.
+func (t *LoginThrottle) Check(ctx context.Context, userID uuid.UUID, ip *netip.Addr) (time.Duration, error) {
+	if t.cfg.Window <= 0 {
+		return 0, nil
+	}
+
+	now := t.now()
+	since := now.Add(-t.cfg.Window)
+	var retryAfter time.Duration
+
+	if userID != uuid.Nil && t.cfg.MaxFailuresPerLogin > 0 {
+		failures, err := t.store.GetLoginFailures(ctx, userID, since)
+		if err != nil {
+			return 0, err
+		}
+		retryAfter = max(retryAfter, t.wait(failures, t.cfg.MaxFailuresPerLogin, t.cfg.DelayAfterFailures, now))
+	}
+
+	if ip != nil && t.cfg.MaxFailuresPerIP > 0 {
+		failures, err := t.store.GetLoginFailuresByIP(ctx, *ip, since)
+		if err != nil {
+			return 0, err
+		}
+		retryAfter = max(retryAfter, t.wait(failures, t.cfg.MaxFailuresPerIP, 0, now))
+	}
+
+	return retryAfter, nil
+}
+
+// wait applies a lock once the threshold is reached and, before that, a delay
+// that doubles with every failure past delayAfter.
+func (t *LoginThrottle) wait(failures *models.LoginFailures, threshold, delayAfter int, now time.Time) time.Duration {
+	var delay time.Duration
+	switch {
+	case failures.Count >= threshold:
+		delay = t.cfg.LockDuration
+	case delayAfter > 0 && failures.Count >= delayAfter:
+		delay = t.cfg.LockDuration
+		if shift := failures.Count - delayAfter; shift < maxDelayShift {
+			delay = min(t.cfg.BaseDelay<<shift, t.cfg.LockDuration)
+		}
+	default:
+		return 0
+	}
+
+	return max(failures.LastFailureAt.Add(delay).Sub(now), 0)
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store/mocks"
+)
+
+func testLoginProtection() config.LoginProtectionConfig {
+	return config.LoginProtectionConfig{
+		Window:              15 * time.Minute,
+		MaxFailuresPerLogin: 5,
+		MaxFailuresPerIP:    20,
+		DelayAfterFailures:  3,
+		BaseDelay:           time.Second,
+		LockDuration:        15 * time.Minute,
+	}
+}
+
+func newTestThrottle(t *testing.T, cfg config.LoginProtectionConfig) (*LoginThrottle, *mocks.Store, time.Time) {
+	t.Helper()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	storeMock := mocks.NewStore(t)
+	throttle := NewLoginThrottle(cfg, storeMock)
+	throttle.now = func() time.Time { return now }
+
+	return throttle, storeMock, now
+}
+
+func TestLoginThrottle_Check(t *testing.T) {
+	userID := uuid.New()
+	ip := netip.MustParseAddr("203.0.113.7")
+
+	tests := []struct {
+		name       string
+		failures   int
+		lastAgo    time.Duration
+		ipFailures int
+		want       time.Duration
+	}{
+		{name: "below_delay_threshold", failures: 2, lastAgo: time.Second, want: 0},
+		{name: "first_delay", failures: 3, lastAgo: 0, want: time.Second},
+		{name: "delay_doubles", failures: 4, lastAgo: 500 * time.Millisecond, want: 1500 * time.Millisecond},
+		{name: "delay_elapsed", failures: 4, lastAgo: 3 * time.Second, want: 0},
+		{name: "locked", failures: 5, lastAgo: time.Minute, want: 14 * time.Minute},
+		{name: "lock_expired", failures: 5, lastAgo: 16 * time.Minute, want: 0},
+		{name: "ip_locked", failures: 0, ipFailures: 20, lastAgo: 5 * time.Minute, want: 10 * time.Minute},
+		{name: "ip_below_threshold_has_no_delay", failures: 0, ipFailures: 19, lastAgo: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttle, storeMock, now := newTestThrottle(t, testLoginProtection())
+			since := now.Add(-15 * time.Minute)
+
+			storeMock.EXPECT().GetLoginFailures(context.Background(), userID, since).
+				Return(&models.LoginFailures{Count: tt.failures, LastFailureAt: now.Add(-tt.lastAgo)}, nil)
+			storeMock.EXPECT().GetLoginFailuresByIP(context.Background(), ip, since).
+				Return(&models.LoginFailures{Count: tt.ipFailures, LastFailureAt: now.Add(-tt.lastAgo)}, nil)
+
+			retryAfter, err := throttle.Check(context.Background(), userID, &ip)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, retryAfter)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		throttle, _, _ := newTestThrottle(t, config.LoginProtectionConfig{})
+
+		retryAfter, err := throttle.Check(context.Background(), userID, &ip)
+
+		require.NoError(t, err)
+		assert.Zero(t, retryAfter)
+	})
+
+	t.Run("unknown_user_and_ip", func(t *testing.T) {
+		throttle, _, _ := newTestThrottle(t, testLoginProtection())
+
+		retryAfter, err := throttle.Check(context.Background(), uuid.Nil, nil)
+
+		require.NoError(t, err)
+		assert.Zero(t, retryAfter)
+	})
+
+	t.Run("store_error", func(t *testing.T) {
+		throttle, storeMock, now := newTestThrottle(t, testLoginProtection())
+		storeErr := errlocal.NewErrInternal("failed to get login failures", "db down", nil)
+
+		storeMock.EXPECT().GetLoginFailures(context.Background(), userID, now.Add(-15*time.Minute)).
+			Return((*models.LoginFailures)(nil), storeErr)
+
+		_, err := throttle.Check(context.Background(), userID, &ip)
+
+		require.ErrorIs(t, err, storeErr)
+	})
+}
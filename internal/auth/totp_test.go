@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTOTP(t *testing.T) {
+	// RFC 6238, appendix B (SHA1 seed), truncated to 6 digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+	}
+
+	for _, tt := range tests {
+		step, ok := ValidateTOTP(secret, tt.code, time.Unix(tt.unix, 0), 0)
+		assert.True(t, ok, tt.code)
+		assert.Equal(t, tt.unix/30, step)
+	}
+
+	t.Run("skew", func(t *testing.T) {
+		_, ok := ValidateTOTP(secret, "287082", time.Unix(89, 0), 0)
+		assert.False(t, ok)
+
+		step, ok := ValidateTOTP(secret, "287082", time.Unix(89, 0), 1)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), step)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, ok := ValidateTOTP(secret, "28708", time.Unix(59, 0), 1)
+		assert.False(t, ok)
+		_, ok = ValidateTOTP("not base32!", "287082", time.Unix(59, 0), 1)
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := TOTPCode(secret, now)
+	require.NoError(t, err)
+	_, ok := ValidateTOTP(secret, code, now, 0)
+	assert.True(t, ok)
+}
+
+func TestTOTPURI(t *testing.T) {
+	uri, err := url.Parse(TOTPURI("TrashScanner", "admin", "SECRET"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/TrashScanner:admin", uri.Path)
+	assert.Equal(t, "SECRET", uri.Query().Get("secret"))
+	assert.Equal(t, "TrashScanner", uri.Query().Get("issuer"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	for _, code := range codes {
+		assert.Len(t, code, 9)
+		assert.Equal(t, code, NormalizeRecoveryCode(" "+code[:4]+code[5:]+" "))
+	}
+}
@@ -21,11 +21,29 @@ type Config struct {
 	Auth      AuthManagerConfig `mapstructure:"auth_manager"`
 	Predictor PredictorConfig   `mapstructure:"predictor"`
 	Log       LogConfig         `mapstructure:"log"`
+
+	LoginProtection   LoginProtectionConfig   `mapstructure:"login_protection"`
+	Mail              MailConfig              `mapstructure:"mail"`
+	PasswordReset     PasswordResetConfig     `mapstructure:"password_reset"`
+	EmailVerification EmailVerificationConfig `mapstructure:"email_verification"`
+	MFA               MFAConfig               `mapstructure:"mfa"`
+	OIDC              OIDCConfig              `mapstructure:"oidc"`
+	RBAC              RBACConfig              `mapstructure:"rbac"`
+	Guest             GuestConfig             `mapstructure:"guest"`
+	Audit             AuditConfig             `mapstructure:"audit"`
+	Analytics         AnalyticsConfig         `mapstructure:"analytics"`
+	Achievements      AchievementsConfig      `mapstructure:"achievements"`
+	Impact            ImpactConfig            `mapstructure:"impact"`
+	Scoring           ScoringConfig           `mapstructure:"scoring"`
 }
 
 type ServerConfig struct {
 	Host string `mapstructure:"host"`
 	Port string `mapstructure:"port"`
+	// TrustedProxies lists the addresses or CIDR ranges of reverse proxies whose
+	// X-Forwarded-For and X-Real-IP headers are believed. Without it the client
+	// address is always the peer of the connection.
+	TrustedProxies []string `mapstructure:"trusted_proxies" validate:"dive,cidr|ip"`
 }
 
 type DBConfig struct {
@@ -48,9 +66,170 @@ type FileStoreConfig struct {
 }
 
 type AuthManagerConfig struct {
-	AccessTokenTTL  time.Duration `mapstructure:"access_token_ttl" validate:"required,gt=0"`
-	RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl" validate:"required,gt=0"`
-	Algorithm       string        `mapstructure:"signing_algorithm" validate:"required,oneof=EdDSA"`
+	AccessTokenTTL      time.Duration `mapstructure:"access_token_ttl" validate:"required,gt=0"`
+	RefreshTokenTTL     time.Duration `mapstructure:"refresh_token_ttl" validate:"required,gt=0"`
+	Algorithm           string        `mapstructure:"signing_algorithm" validate:"required,oneof=EdDSA RS256 ES256 HS256"`
+	VerificationKeysDir string        `mapstructure:"verification_keys_dir"`
+	// ImpersonationTokenTTL is the lifetime of access tokens admins get to act as another user.
+	ImpersonationTokenTTL time.Duration `mapstructure:"impersonation_token_ttl" validate:"required,gt=0"`
+	// TestMode allows the symmetric HS256 algorithm, which must never be used in production.
+	TestMode bool `mapstructure:"test_mode" validate:"required_if=Algorithm HS256"`
+}
+
+// LoginProtectionConfig limits password guessing. A zero threshold disables the
+// corresponding check.
+type LoginProtectionConfig struct {
+	Window              time.Duration `mapstructure:"window" validate:"gte=0"`
+	MaxFailuresPerLogin int           `mapstructure:"max_failures_per_login" validate:"gte=0"`
+	MaxFailuresPerIP    int           `mapstructure:"max_failures_per_ip" validate:"gte=0"`
+	DelayAfterFailures  int           `mapstructure:"delay_after_failures" validate:"gte=0"`
+	BaseDelay           time.Duration `mapstructure:"base_delay" validate:"gte=0"`
+	LockDuration        time.Duration `mapstructure:"lock_duration" validate:"gte=0"`
+}
+
+type MailConfig struct {
+	Driver string     `mapstructure:"driver" validate:"required,oneof=log smtp"`
+	From   string     `mapstructure:"from" validate:"required"`
+	File   string     `mapstructure:"file"`
+	SMTP   SMTPConfig `mapstructure:"smtp"`
+}
+
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     string `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+type PasswordResetConfig struct {
+	TokenTTL time.Duration `mapstructure:"token_ttl" validate:"required,gt=0"`
+	// URL of the frontend reset page; the token is appended as a query parameter.
+	URL string `mapstructure:"url"`
+	// RateLimit bounds reset requests per client address and per login.
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+}
+
+// RateLimitConfig allows Requests per Window; zero values disable the limit.
+type RateLimitConfig struct {
+	Requests int           `mapstructure:"requests" validate:"gte=0"`
+	Window   time.Duration `mapstructure:"window" validate:"gte=0"`
+}
+
+type EmailVerificationConfig struct {
+	// Required blocks starting predictions until the user's email is verified.
+	Required bool          `mapstructure:"required"`
+	TokenTTL time.Duration `mapstructure:"token_ttl" validate:"required,gt=0"`
+	// URL of the frontend verification page; the token is appended as a query parameter.
+	URL string `mapstructure:"url"`
+}
+
+type MFAConfig struct {
+	// RequiredForAdmin denies admin endpoints to admins without confirmed TOTP.
+	RequiredForAdmin bool          `mapstructure:"required_for_admin"`
+	Issuer           string        `mapstructure:"issuer" validate:"required"`
+	PendingTokenTTL  time.Duration `mapstructure:"pending_token_ttl" validate:"required,gt=0"`
+	RecoveryCodes    int           `mapstructure:"recovery_codes" validate:"gte=0"`
+	// AllowedSkew is the number of 30 second steps accepted around the current one.
+	AllowedSkew int `mapstructure:"allowed_skew" validate:"gte=0"`
+}
+
+type OIDCConfig struct {
+	// StateTTL bounds the time between the redirect to the provider and the callback.
+	StateTTL time.Duration `mapstructure:"state_ttl" validate:"required,gt=0"`
+	// SuccessURL is where the browser is redirected after a login; without it the
+	// callback answers with the token pair as JSON.
+	SuccessURL string                        `mapstructure:"success_url"`
+	Providers  map[string]OIDCProviderConfig `mapstructure:"providers" validate:"dive"`
+}
+
+type OIDCProviderConfig struct {
+	Issuer       string   `mapstructure:"issuer" validate:"required,url"`
+	ClientID     string   `mapstructure:"client_id" validate:"required"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url" validate:"required,url"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// RBACConfig overrides the built-in permissions of roles. Each listed role gets
+// exactly the given permissions; roles that are not listed keep the defaults.
+type RBACConfig struct {
+	Roles map[string][]string `mapstructure:"roles"`
+}
+
+// GuestConfig controls anonymous sessions that let new users try a scan
+// before registering.
+type GuestConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// SessionTTL is the lifetime of a guest session; guests get no refresh token.
+	// Guests older than this are removed by cmd/cleanup-guests.
+	SessionTTL time.Duration `mapstructure:"session_ttl" validate:"required,gt=0"`
+	// MaxPredictions is the number of scans a guest may start.
+	MaxPredictions int `mapstructure:"max_predictions" validate:"gte=0"`
+	// RateLimit bounds guest sessions started per client address.
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+}
+
+// AuditConfig controls copies of the audit log kept outside the database.
+type AuditConfig struct {
+	// File, when set, receives every audit event as a JSON line.
+	File string `mapstructure:"file"`
+}
+
+// AnalyticsConfig controls the admin analytics endpoint.
+type AnalyticsConfig struct {
+	// CacheTTL is how long computed analytics are reused; zero disables caching.
+	CacheTTL time.Duration `mapstructure:"cache_ttl" validate:"gte=0"`
+}
+
+// AchievementsConfig replaces the built-in achievement catalog when Rules is
+// not empty.
+type AchievementsConfig struct {
+	Rules []AchievementRuleConfig `mapstructure:"rules" validate:"dive"`
+}
+
+// AchievementRuleConfig unlocks the achievement ID once the metric reaches
+// Threshold. TrashType selects the type counted by the trash_type metric.
+type AchievementRuleConfig struct {
+	ID          string `mapstructure:"id" validate:"required"`
+	Name        string `mapstructure:"name" validate:"required"`
+	Description string `mapstructure:"description"`
+	Metric      string `mapstructure:"metric" validate:"required"`
+	TrashType   string `mapstructure:"trash_type"`
+	Threshold   int    `mapstructure:"threshold" validate:"gt=0"`
+}
+
+// ImpactConfig overrides the built-in estimates per trash type; types left
+// out keep their defaults.
+type ImpactConfig struct {
+	// Weights is the average weight in kg of an item, used when the owner
+	// didn't weigh it.
+	Weights map[string]float64 `mapstructure:"weights" validate:"dive,gt=0"`
+	// CO2Factors is the kg of CO2 emissions avoided per kg sorted.
+	CO2Factors map[string]float64 `mapstructure:"co2_factors" validate:"dive,gte=0"`
+}
+
+// ScoringConfig overrides the built-in rules scans earn rating by; unset
+// fields keep their defaults.
+type ScoringConfig struct {
+	// DefaultPoints is earned for a trash type that Points doesn't list.
+	DefaultPoints *int           `mapstructure:"default_points" validate:"omitempty,gte=0"`
+	Points        map[string]int `mapstructure:"points" validate:"dive,gte=0"`
+	// ConfidenceWeight is how much points depend on the confidence of the
+	// prediction: 0 earns full points at any confidence, 1 earns points in
+	// proportion to it.
+	ConfidenceWeight *float64 `mapstructure:"confidence_weight" validate:"omitempty,gte=0,lte=1"`
+	// FailurePenalty is taken off the rating for a failed prediction.
+	FailurePenalty    *int `mapstructure:"failure_penalty" validate:"omitempty,gte=0"`
+	StreakBonusPerDay *int `mapstructure:"streak_bonus_per_day" validate:"omitempty,gte=0"`
+	MaxStreakBonus    *int `mapstructure:"max_streak_bonus" validate:"omitempty,gte=0"`
+	// Tiers replaces the built-in status thresholds when not empty.
+	Tiers []TierConfig `mapstructure:"tiers" validate:"dive"`
+}
+
+// TierConfig gives users Status from MinRating on.
+type TierConfig struct {
+	Status    string `mapstructure:"status" validate:"required"`
+	MinRating int    `mapstructure:"min_rating" validate:"gte=0"`
 }
 
 type PredictorConfig struct {
@@ -99,4 +278,42 @@ func NewConfig() (Config, error) {
 func setDefaults(v *viper.Viper) {
 	v.SetDefault("server.host", "0.0.0.0")
 	v.SetDefault("server.port", "8080")
+	v.SetDefault("server.trusted_proxies", []string{})
+	v.SetDefault("auth_manager.verification_keys_dir", "")
+	v.SetDefault("auth_manager.test_mode", false)
+	v.SetDefault("auth_manager.impersonation_token_ttl", "15m")
+	v.SetDefault("login_protection.window", "15m")
+	v.SetDefault("login_protection.max_failures_per_login", 5)
+	v.SetDefault("login_protection.max_failures_per_ip", 20)
+	v.SetDefault("login_protection.delay_after_failures", 3)
+	v.SetDefault("login_protection.base_delay", "1s")
+	v.SetDefault("login_protection.lock_duration", "15m")
+	v.SetDefault("mail.driver", "log")
+	v.SetDefault("mail.from", "no-reply@trashscanner.local")
+	v.SetDefault("mail.file", "")
+	v.SetDefault("mail.smtp.host", "")
+	v.SetDefault("mail.smtp.port", "587")
+	v.SetDefault("mail.smtp.username", "")
+	v.SetDefault("mail.smtp.password", "")
+	v.SetDefault("password_reset.token_ttl", "1h")
+	v.SetDefault("password_reset.url", "")
+	v.SetDefault("password_reset.rate_limit.requests", 5)
+	v.SetDefault("password_reset.rate_limit.window", "15m")
+	v.SetDefault("email_verification.required", false)
+	v.SetDefault("email_verification.token_ttl", "24h")
+	v.SetDefault("email_verification.url", "")
+	v.SetDefault("mfa.required_for_admin", false)
+	v.SetDefault("mfa.issuer", "TrashScanner")
+	v.SetDefault("mfa.pending_token_ttl", "5m")
+	v.SetDefault("mfa.recovery_codes", 10)
+	v.SetDefault("mfa.allowed_skew", 1)
+	v.SetDefault("oidc.state_ttl", "10m")
+	v.SetDefault("oidc.success_url", "")
+	v.SetDefault("guest.enabled", true)
+	v.SetDefault("guest.session_ttl", "2h")
+	v.SetDefault("guest.max_predictions", 3)
+	v.SetDefault("guest.rate_limit.requests", 10)
+	v.SetDefault("guest.rate_limit.window", "1h")
+	v.SetDefault("audit.file", "")
+	v.SetDefault("analytics.cache_ttl", "1m")
 }
@@ -30,13 +30,15 @@ func TestConfig(t *testing.T) {
 				SSLMode:        "disable",
 			},
 			Auth: AuthManagerConfig{
-				AccessTokenTTL:  time.Minute * 15,
-				RefreshTokenTTL: time.Hour * 168,
-				Algorithm:       "EdDSA",
+				AccessTokenTTL:        time.Minute * 15,
+				RefreshTokenTTL:       time.Hour * 168,
+				Algorithm:             "EdDSA",
+				ImpersonationTokenTTL: time.Minute * 15,
 			},
 			Server: ServerConfig{
-				Host: "0.0.0.0",
-				Port: "8080",
+				Host:           "0.0.0.0",
+				Port:           "8080",
+				TrustedProxies: []string{},
 			},
 			Store: FileStoreConfig{
 				Endpoint:  "localhost:9000",
@@ -50,6 +52,44 @@ func TestConfig(t *testing.T) {
 				Token:                      "token",
 				MaxPredictionsInProcessing: 10,
 			},
+			LoginProtection: LoginProtectionConfig{
+				Window:              15 * time.Minute,
+				MaxFailuresPerLogin: 5,
+				MaxFailuresPerIP:    20,
+				DelayAfterFailures:  3,
+				BaseDelay:           time.Second,
+				LockDuration:        15 * time.Minute,
+			},
+			Mail: MailConfig{
+				Driver: "log",
+				From:   "no-reply@trashscanner.local",
+				SMTP:   SMTPConfig{Port: "587"},
+			},
+			PasswordReset: PasswordResetConfig{
+				TokenTTL:  time.Hour,
+				RateLimit: RateLimitConfig{Requests: 5, Window: 15 * time.Minute},
+			},
+			EmailVerification: EmailVerificationConfig{
+				TokenTTL: 24 * time.Hour,
+			},
+			MFA: MFAConfig{
+				Issuer:          "TrashScanner",
+				PendingTokenTTL: 5 * time.Minute,
+				RecoveryCodes:   10,
+				AllowedSkew:     1,
+			},
+			OIDC: OIDCConfig{
+				StateTTL: 10 * time.Minute,
+			},
+			Guest: GuestConfig{
+				Enabled:        true,
+				SessionTTL:     2 * time.Hour,
+				MaxPredictions: 3,
+				RateLimit:      RateLimitConfig{Requests: 10, Window: time.Hour},
+			},
+			Analytics: AnalyticsConfig{
+				CacheTTL: time.Minute,
+			},
 		}
 		assert.NoError(t, err)
 		assert.Equal(t, expectedConfig, config)
@@ -304,7 +304,7 @@ func (s *databaseTestSuite) TestCreateLoginHistory() {
 	userID := s.createTestUser("testCreateLoginHistory")
 
 	historyID, err := s.store.CreateLoginHistory(s.ctx, db.CreateLoginHistoryParams{
-		UserID:  userID,
+		UserID:  pgtype.UUID{Bytes: userID, Valid: true},
 		Success: true,
 	})
 	s.NoError(err)
@@ -314,7 +314,7 @@ func (s *databaseTestSuite) TestCreateLoginHistory() {
 	location := "Moscow, Russia"
 
 	historyID2, err := s.store.CreateLoginHistory(s.ctx, db.CreateLoginHistoryParams{
-		UserID:  userID,
+		UserID:  pgtype.UUID{Bytes: userID, Valid: true},
 		Success: false,
 		FailureReason: func() *string {
 			s := "Invalid password"
@@ -332,7 +332,7 @@ func (s *databaseTestSuite) TestGetLoginHistoryByUser() {
 
 	for i := 0; i < 5; i++ {
 		_, err := s.store.CreateLoginHistory(s.ctx, db.CreateLoginHistoryParams{
-			UserID:  userID,
+			UserID:  pgtype.UUID{Bytes: userID, Valid: true},
 			Success: i%2 == 0,
 		})
 		s.NoError(err)
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_login_history_user_failures;
+ALTER TABLE users DROP COLUMN IF EXISTS login_unlocked_at;
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS login_unlocked_at TIMESTAMPTZ NULL;
+CREATE INDEX IF NOT EXISTS idx_login_history_user_failures ON login_history(user_id, created_at DESC) WHERE success = FALSE;
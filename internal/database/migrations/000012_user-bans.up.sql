@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned_at TIMESTAMPTZ NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned_until TIMESTAMPTZ NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS ban_reason TEXT NULL;
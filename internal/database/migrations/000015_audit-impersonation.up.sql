@@ -0,0 +1,4 @@
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS impersonator_id UUID NULL;
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_impersonator_id ON audit_events(impersonator_id)
+    WHERE impersonator_id IS NOT NULL;
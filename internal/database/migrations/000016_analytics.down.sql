@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_predictions_created_at;
+DROP INDEX IF EXISTS idx_users_created_at;
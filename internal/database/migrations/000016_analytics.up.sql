@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users(created_at);
+CREATE INDEX IF NOT EXISTS idx_predictions_created_at ON predictions(created_at);
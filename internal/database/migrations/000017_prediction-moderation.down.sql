@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_predictions_feedback_at;
+
+ALTER TABLE predictions
+    DROP COLUMN IF EXISTS feedback_at,
+    DROP COLUMN IF EXISTS feedback_comment,
+    DROP COLUMN IF EXISTS feedback_label,
+    DROP COLUMN IF EXISTS verified_at,
+    DROP COLUMN IF EXISTS verified_by,
+    DROP COLUMN IF EXISTS verified_label;
@@ -0,0 +1,10 @@
+ALTER TABLE predictions
+    ADD COLUMN IF NOT EXISTS verified_label TEXT NULL,
+    ADD COLUMN IF NOT EXISTS verified_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS verified_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS feedback_label TEXT NULL,
+    ADD COLUMN IF NOT EXISTS feedback_comment TEXT NULL,
+    ADD COLUMN IF NOT EXISTS feedback_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_predictions_feedback_at ON predictions(feedback_at)
+    WHERE feedback_at IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE stats
+    DROP COLUMN IF EXISTS longest_streak,
+    DROP COLUMN IF EXISTS current_streak;
@@ -0,0 +1,3 @@
+ALTER TABLE stats
+    ADD COLUMN IF NOT EXISTS current_streak INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS longest_streak INT NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS rating_periods;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS leaderboard_hidden;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS daily_activity;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,5 @@
+ALTER TABLE stats
+    DROP COLUMN IF EXISTS weight_by_types;
+
+ALTER TABLE predictions
+    DROP COLUMN IF EXISTS weight;
@@ -0,0 +1,5 @@
+ALTER TABLE predictions
+    ADD COLUMN IF NOT EXISTS weight FLOAT;
+
+ALTER TABLE stats
+    ADD COLUMN IF NOT EXISTS weight_by_types JSONB;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS stats_history (
+    stat_id UUID NOT NULL REFERENCES stats(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    rating INT NOT NULL DEFAULT 0,
+    files_scanned INT NOT NULL DEFAULT 0,
+    total_weight FLOAT NOT NULL DEFAULT 0.0,
+    trash_by_types JSONB,
+    PRIMARY KEY (stat_id, day)
+);
+
+-- The first snapshot of every user is the baseline trends start from, so that
+-- everything scanned before history was kept doesn't show up as a single day.
+INSERT INTO stats_history (stat_id, day, rating, files_scanned, total_weight, trash_by_types)
+SELECT id, CURRENT_DATE - 1, rating, files_scanned, total_weight, trash_by_types
+FROM stats
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DELETE FROM login_history WHERE user_id IS NULL;
+ALTER TABLE login_history ALTER COLUMN user_id SET NOT NULL;
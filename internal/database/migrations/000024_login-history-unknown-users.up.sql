@@ -0,0 +1 @@
+ALTER TABLE login_history ALTER COLUMN user_id DROP NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE predictions
+    DROP COLUMN IF EXISTS completed_at;
@@ -0,0 +1,7 @@
+ALTER TABLE predictions
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ NULL;
+
+-- Predictions nobody touched since they finished still have it as updated_at.
+UPDATE predictions
+SET completed_at = updated_at
+WHERE status <> 'processing' AND verified_at IS NULL;
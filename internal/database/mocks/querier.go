@@ -38,44 +38,5924 @@ func (_m *Querier) EXPECT() *Querier_Expecter {
 	return &Querier_Expecter{mock: &_m.Mock}
 }
 
+// AddOrganizationMember provides a mock function for the type Querier
+func (_mock *Querier) AddOrganizationMember(ctx context.Context, arg db.AddOrganizationMemberParams) (db.OrganizationMember, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddOrganizationMember")
+	}
+
+	var r0 db.OrganizationMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.AddOrganizationMemberParams) (db.OrganizationMember, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.AddOrganizationMemberParams) db.OrganizationMember); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.OrganizationMember)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.AddOrganizationMemberParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_AddOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddOrganizationMember'
+type Querier_AddOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// AddOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.AddOrganizationMemberParams
+func (_e *Querier_Expecter) AddOrganizationMember(ctx interface{}, arg interface{}) *Querier_AddOrganizationMember_Call {
+	return &Querier_AddOrganizationMember_Call{Call: _e.mock.On("AddOrganizationMember", ctx, arg)}
+}
+
+func (_c *Querier_AddOrganizationMember_Call) Run(run func(ctx context.Context, arg db.AddOrganizationMemberParams)) *Querier_AddOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.AddOrganizationMemberParams
+		if args[1] != nil {
+			arg1 = args[1].(db.AddOrganizationMemberParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_AddOrganizationMember_Call) Return(organizationMember db.OrganizationMember, err error) *Querier_AddOrganizationMember_Call {
+	_c.Call.Return(organizationMember, err)
+	return _c
+}
+
+func (_c *Querier_AddOrganizationMember_Call) RunAndReturn(run func(ctx context.Context, arg db.AddOrganizationMemberParams) (db.OrganizationMember, error)) *Querier_AddOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRatingDelta provides a mock function for the type Querier
+func (_mock *Querier) AddRatingDelta(ctx context.Context, arg db.AddRatingDeltaParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRatingDelta")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.AddRatingDeltaParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_AddRatingDelta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRatingDelta'
+type Querier_AddRatingDelta_Call struct {
+	*mock.Call
+}
+
+// AddRatingDelta is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.AddRatingDeltaParams
+func (_e *Querier_Expecter) AddRatingDelta(ctx interface{}, arg interface{}) *Querier_AddRatingDelta_Call {
+	return &Querier_AddRatingDelta_Call{Call: _e.mock.On("AddRatingDelta", ctx, arg)}
+}
+
+func (_c *Querier_AddRatingDelta_Call) Run(run func(ctx context.Context, arg db.AddRatingDeltaParams)) *Querier_AddRatingDelta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.AddRatingDeltaParams
+		if args[1] != nil {
+			arg1 = args[1].(db.AddRatingDeltaParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_AddRatingDelta_Call) Return(err error) *Querier_AddRatingDelta_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_AddRatingDelta_Call) RunAndReturn(run func(ctx context.Context, arg db.AddRatingDeltaParams) error) *Querier_AddRatingDelta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BanUser provides a mock function for the type Querier
+func (_mock *Querier) BanUser(ctx context.Context, arg db.BanUserParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BanUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.BanUserParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.BanUserParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.BanUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_BanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BanUser'
+type Querier_BanUser_Call struct {
+	*mock.Call
+}
+
+// BanUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.BanUserParams
+func (_e *Querier_Expecter) BanUser(ctx interface{}, arg interface{}) *Querier_BanUser_Call {
+	return &Querier_BanUser_Call{Call: _e.mock.On("BanUser", ctx, arg)}
+}
+
+func (_c *Querier_BanUser_Call) Run(run func(ctx context.Context, arg db.BanUserParams)) *Querier_BanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.BanUserParams
+		if args[1] != nil {
+			arg1 = args[1].(db.BanUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_BanUser_Call) Return(n int64, err error) *Querier_BanUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_BanUser_Call) RunAndReturn(run func(ctx context.Context, arg db.BanUserParams) (int64, error)) *Querier_BanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CompletePrediction provides a mock function for the type Querier
 func (_mock *Querier) CompletePrediction(ctx context.Context, arg db.CompletePredictionParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CompletePrediction")
+		panic("no return value specified for CompletePrediction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CompletePredictionParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CompletePrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompletePrediction'
+type Querier_CompletePrediction_Call struct {
+	*mock.Call
+}
+
+// CompletePrediction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CompletePredictionParams
+func (_e *Querier_Expecter) CompletePrediction(ctx interface{}, arg interface{}) *Querier_CompletePrediction_Call {
+	return &Querier_CompletePrediction_Call{Call: _e.mock.On("CompletePrediction", ctx, arg)}
+}
+
+func (_c *Querier_CompletePrediction_Call) Run(run func(ctx context.Context, arg db.CompletePredictionParams)) *Querier_CompletePrediction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CompletePredictionParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CompletePredictionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CompletePrediction_Call) Return(err error) *Querier_CompletePrediction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CompletePrediction_Call) RunAndReturn(run func(ctx context.Context, arg db.CompletePredictionParams) error) *Querier_CompletePrediction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmUserTOTP provides a mock function for the type Querier
+func (_mock *Querier) ConfirmUserTOTP(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmUserTOTP")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ConfirmUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmUserTOTP'
+type Querier_ConfirmUserTOTP_Call struct {
+	*mock.Call
+}
+
+// ConfirmUserTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) ConfirmUserTOTP(ctx interface{}, userID interface{}) *Querier_ConfirmUserTOTP_Call {
+	return &Querier_ConfirmUserTOTP_Call{Call: _e.mock.On("ConfirmUserTOTP", ctx, userID)}
+}
+
+func (_c *Querier_ConfirmUserTOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_ConfirmUserTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ConfirmUserTOTP_Call) Return(n int64, err error) *Querier_ConfirmUserTOTP_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_ConfirmUserTOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (int64, error)) *Querier_ConfirmUserTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeEmailVerificationToken provides a mock function for the type Querier
+func (_mock *Querier) ConsumeEmailVerificationToken(ctx context.Context, tokenHash string) (db.ConsumeEmailVerificationTokenRow, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeEmailVerificationToken")
+	}
+
+	var r0 db.ConsumeEmailVerificationTokenRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.ConsumeEmailVerificationTokenRow, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.ConsumeEmailVerificationTokenRow); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(db.ConsumeEmailVerificationTokenRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ConsumeEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeEmailVerificationToken'
+type Querier_ConsumeEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// ConsumeEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Querier_Expecter) ConsumeEmailVerificationToken(ctx interface{}, tokenHash interface{}) *Querier_ConsumeEmailVerificationToken_Call {
+	return &Querier_ConsumeEmailVerificationToken_Call{Call: _e.mock.On("ConsumeEmailVerificationToken", ctx, tokenHash)}
+}
+
+func (_c *Querier_ConsumeEmailVerificationToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_ConsumeEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ConsumeEmailVerificationToken_Call) Return(consumeEmailVerificationTokenRow db.ConsumeEmailVerificationTokenRow, err error) *Querier_ConsumeEmailVerificationToken_Call {
+	_c.Call.Return(consumeEmailVerificationTokenRow, err)
+	return _c
+}
+
+func (_c *Querier_ConsumeEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (db.ConsumeEmailVerificationTokenRow, error)) *Querier_ConsumeEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumePasswordResetToken provides a mock function for the type Querier
+func (_mock *Querier) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumePasswordResetToken")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ConsumePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumePasswordResetToken'
+type Querier_ConsumePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// ConsumePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Querier_Expecter) ConsumePasswordResetToken(ctx interface{}, tokenHash interface{}) *Querier_ConsumePasswordResetToken_Call {
+	return &Querier_ConsumePasswordResetToken_Call{Call: _e.mock.On("ConsumePasswordResetToken", ctx, tokenHash)}
+}
+
+func (_c *Querier_ConsumePasswordResetToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_ConsumePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ConsumePasswordResetToken_Call) Return(uUID uuid.UUID, err error) *Querier_ConsumePasswordResetToken_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_ConsumePasswordResetToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (uuid.UUID, error)) *Querier_ConsumePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeRecoveryCode provides a mock function for the type Querier
+func (_mock *Querier) ConsumeRecoveryCode(ctx context.Context, arg db.ConsumeRecoveryCodeParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeRecoveryCode")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ConsumeRecoveryCodeParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ConsumeRecoveryCodeParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ConsumeRecoveryCodeParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ConsumeRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeRecoveryCode'
+type Querier_ConsumeRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// ConsumeRecoveryCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ConsumeRecoveryCodeParams
+func (_e *Querier_Expecter) ConsumeRecoveryCode(ctx interface{}, arg interface{}) *Querier_ConsumeRecoveryCode_Call {
+	return &Querier_ConsumeRecoveryCode_Call{Call: _e.mock.On("ConsumeRecoveryCode", ctx, arg)}
+}
+
+func (_c *Querier_ConsumeRecoveryCode_Call) Run(run func(ctx context.Context, arg db.ConsumeRecoveryCodeParams)) *Querier_ConsumeRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ConsumeRecoveryCodeParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ConsumeRecoveryCodeParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ConsumeRecoveryCode_Call) Return(n int64, err error) *Querier_ConsumeRecoveryCode_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_ConsumeRecoveryCode_Call) RunAndReturn(run func(ctx context.Context, arg db.ConsumeRecoveryCodeParams) (int64, error)) *Querier_ConsumeRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountAdminPredictions provides a mock function for the type Querier
+func (_mock *Querier) CountAdminPredictions(ctx context.Context, arg db.CountAdminPredictionsParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAdminPredictions")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountAdminPredictionsParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountAdminPredictionsParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CountAdminPredictionsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountAdminPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAdminPredictions'
+type Querier_CountAdminPredictions_Call struct {
+	*mock.Call
+}
+
+// CountAdminPredictions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountAdminPredictionsParams
+func (_e *Querier_Expecter) CountAdminPredictions(ctx interface{}, arg interface{}) *Querier_CountAdminPredictions_Call {
+	return &Querier_CountAdminPredictions_Call{Call: _e.mock.On("CountAdminPredictions", ctx, arg)}
+}
+
+func (_c *Querier_CountAdminPredictions_Call) Run(run func(ctx context.Context, arg db.CountAdminPredictionsParams)) *Querier_CountAdminPredictions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CountAdminPredictionsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CountAdminPredictionsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountAdminPredictions_Call) Return(n int64, err error) *Querier_CountAdminPredictions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountAdminPredictions_Call) RunAndReturn(run func(ctx context.Context, arg db.CountAdminPredictionsParams) (int64, error)) *Querier_CountAdminPredictions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountAdminUsers provides a mock function for the type Querier
+func (_mock *Querier) CountAdminUsers(ctx context.Context, arg db.CountAdminUsersParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAdminUsers")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountAdminUsersParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountAdminUsersParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CountAdminUsersParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountAdminUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAdminUsers'
+type Querier_CountAdminUsers_Call struct {
+	*mock.Call
+}
+
+// CountAdminUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountAdminUsersParams
+func (_e *Querier_Expecter) CountAdminUsers(ctx interface{}, arg interface{}) *Querier_CountAdminUsers_Call {
+	return &Querier_CountAdminUsers_Call{Call: _e.mock.On("CountAdminUsers", ctx, arg)}
+}
+
+func (_c *Querier_CountAdminUsers_Call) Run(run func(ctx context.Context, arg db.CountAdminUsersParams)) *Querier_CountAdminUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CountAdminUsersParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CountAdminUsersParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountAdminUsers_Call) Return(n int64, err error) *Querier_CountAdminUsers_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountAdminUsers_Call) RunAndReturn(run func(ctx context.Context, arg db.CountAdminUsersParams) (int64, error)) *Querier_CountAdminUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountAuditEvents provides a mock function for the type Querier
+func (_mock *Querier) CountAuditEvents(ctx context.Context, arg db.CountAuditEventsParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAuditEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountAuditEventsParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountAuditEventsParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CountAuditEventsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAuditEvents'
+type Querier_CountAuditEvents_Call struct {
+	*mock.Call
+}
+
+// CountAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountAuditEventsParams
+func (_e *Querier_Expecter) CountAuditEvents(ctx interface{}, arg interface{}) *Querier_CountAuditEvents_Call {
+	return &Querier_CountAuditEvents_Call{Call: _e.mock.On("CountAuditEvents", ctx, arg)}
+}
+
+func (_c *Querier_CountAuditEvents_Call) Run(run func(ctx context.Context, arg db.CountAuditEventsParams)) *Querier_CountAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CountAuditEventsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CountAuditEventsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountAuditEvents_Call) Return(n int64, err error) *Querier_CountAuditEvents_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountAuditEvents_Call) RunAndReturn(run func(ctx context.Context, arg db.CountAuditEventsParams) (int64, error)) *Querier_CountAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountLoginHistory provides a mock function for the type Querier
+func (_mock *Querier) CountLoginHistory(ctx context.Context, arg db.CountLoginHistoryParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLoginHistory")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountLoginHistoryParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountLoginHistoryParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CountLoginHistoryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLoginHistory'
+type Querier_CountLoginHistory_Call struct {
+	*mock.Call
+}
+
+// CountLoginHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountLoginHistoryParams
+func (_e *Querier_Expecter) CountLoginHistory(ctx interface{}, arg interface{}) *Querier_CountLoginHistory_Call {
+	return &Querier_CountLoginHistory_Call{Call: _e.mock.On("CountLoginHistory", ctx, arg)}
+}
+
+func (_c *Querier_CountLoginHistory_Call) Run(run func(ctx context.Context, arg db.CountLoginHistoryParams)) *Querier_CountLoginHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CountLoginHistoryParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CountLoginHistoryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountLoginHistory_Call) Return(n int64, err error) *Querier_CountLoginHistory_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountLoginHistory_Call) RunAndReturn(run func(ctx context.Context, arg db.CountLoginHistoryParams) (int64, error)) *Querier_CountLoginHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOrganizationOwners provides a mock function for the type Querier
+func (_mock *Querier) CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, organizationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOrganizationOwners")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, organizationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, organizationID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountOrganizationOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOrganizationOwners'
+type Querier_CountOrganizationOwners_Call struct {
+	*mock.Call
+}
+
+// CountOrganizationOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+func (_e *Querier_Expecter) CountOrganizationOwners(ctx interface{}, organizationID interface{}) *Querier_CountOrganizationOwners_Call {
+	return &Querier_CountOrganizationOwners_Call{Call: _e.mock.On("CountOrganizationOwners", ctx, organizationID)}
+}
+
+func (_c *Querier_CountOrganizationOwners_Call) Run(run func(ctx context.Context, organizationID uuid.UUID)) *Querier_CountOrganizationOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountOrganizationOwners_Call) Return(n int64, err error) *Querier_CountOrganizationOwners_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountOrganizationOwners_Call) RunAndReturn(run func(ctx context.Context, organizationID uuid.UUID) (int64, error)) *Querier_CountOrganizationOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPredictionsByUserID provides a mock function for the type Querier
+func (_mock *Querier) CountPredictionsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPredictionsByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountPredictionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPredictionsByUserID'
+type Querier_CountPredictionsByUserID_Call struct {
+	*mock.Call
+}
+
+// CountPredictionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) CountPredictionsByUserID(ctx interface{}, userID interface{}) *Querier_CountPredictionsByUserID_Call {
+	return &Querier_CountPredictionsByUserID_Call{Call: _e.mock.On("CountPredictionsByUserID", ctx, userID)}
+}
+
+func (_c *Querier_CountPredictionsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_CountPredictionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountPredictionsByUserID_Call) Return(n int64, err error) *Querier_CountPredictionsByUserID_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountPredictionsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (int64, error)) *Querier_CountPredictionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountSoleOwnedOrganizations provides a mock function for the type Querier
+func (_mock *Querier) CountSoleOwnedOrganizations(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSoleOwnedOrganizations")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountSoleOwnedOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSoleOwnedOrganizations'
+type Querier_CountSoleOwnedOrganizations_Call struct {
+	*mock.Call
+}
+
+// CountSoleOwnedOrganizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) CountSoleOwnedOrganizations(ctx interface{}, userID interface{}) *Querier_CountSoleOwnedOrganizations_Call {
+	return &Querier_CountSoleOwnedOrganizations_Call{Call: _e.mock.On("CountSoleOwnedOrganizations", ctx, userID)}
+}
+
+func (_c *Querier_CountSoleOwnedOrganizations_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_CountSoleOwnedOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountSoleOwnedOrganizations_Call) Return(n int64, err error) *Querier_CountSoleOwnedOrganizations_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountSoleOwnedOrganizations_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (int64, error)) *Querier_CountSoleOwnedOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function for the type Querier
+func (_mock *Querier) CreateAPIKey(ctx context.Context, arg db.CreateAPIKeyParams) (db.ApiKey, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 db.ApiKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateAPIKeyParams) (db.ApiKey, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateAPIKeyParams) db.ApiKey); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ApiKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateAPIKeyParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type Querier_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateAPIKeyParams
+func (_e *Querier_Expecter) CreateAPIKey(ctx interface{}, arg interface{}) *Querier_CreateAPIKey_Call {
+	return &Querier_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, arg)}
+}
+
+func (_c *Querier_CreateAPIKey_Call) Run(run func(ctx context.Context, arg db.CreateAPIKeyParams)) *Querier_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateAPIKeyParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateAPIKeyParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateAPIKey_Call) Return(apiKey db.ApiKey, err error) *Querier_CreateAPIKey_Call {
+	_c.Call.Return(apiKey, err)
+	return _c
+}
+
+func (_c *Querier_CreateAPIKey_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateAPIKeyParams) (db.ApiKey, error)) *Querier_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAuditEvent provides a mock function for the type Querier
+func (_mock *Querier) CreateAuditEvent(ctx context.Context, arg db.CreateAuditEventParams) (db.CreateAuditEventRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 db.CreateAuditEventRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateAuditEventParams) (db.CreateAuditEventRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateAuditEventParams) db.CreateAuditEventRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateAuditEventRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateAuditEventParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvent'
+type Querier_CreateAuditEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateAuditEventParams
+func (_e *Querier_Expecter) CreateAuditEvent(ctx interface{}, arg interface{}) *Querier_CreateAuditEvent_Call {
+	return &Querier_CreateAuditEvent_Call{Call: _e.mock.On("CreateAuditEvent", ctx, arg)}
+}
+
+func (_c *Querier_CreateAuditEvent_Call) Run(run func(ctx context.Context, arg db.CreateAuditEventParams)) *Querier_CreateAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateAuditEventParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateAuditEventParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateAuditEvent_Call) Return(createAuditEventRow db.CreateAuditEventRow, err error) *Querier_CreateAuditEvent_Call {
+	_c.Call.Return(createAuditEventRow, err)
+	return _c
+}
+
+func (_c *Querier_CreateAuditEvent_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateAuditEventParams) (db.CreateAuditEventRow, error)) *Querier_CreateAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEmailVerificationToken provides a mock function for the type Querier
+func (_mock *Querier) CreateEmailVerificationToken(ctx context.Context, arg db.CreateEmailVerificationTokenParams) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmailVerificationToken")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateEmailVerificationTokenParams) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateEmailVerificationTokenParams) uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateEmailVerificationTokenParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmailVerificationToken'
+type Querier_CreateEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// CreateEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateEmailVerificationTokenParams
+func (_e *Querier_Expecter) CreateEmailVerificationToken(ctx interface{}, arg interface{}) *Querier_CreateEmailVerificationToken_Call {
+	return &Querier_CreateEmailVerificationToken_Call{Call: _e.mock.On("CreateEmailVerificationToken", ctx, arg)}
+}
+
+func (_c *Querier_CreateEmailVerificationToken_Call) Run(run func(ctx context.Context, arg db.CreateEmailVerificationTokenParams)) *Querier_CreateEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateEmailVerificationTokenParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateEmailVerificationTokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateEmailVerificationToken_Call) Return(uUID uuid.UUID, err error) *Querier_CreateEmailVerificationToken_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_CreateEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateEmailVerificationTokenParams) (uuid.UUID, error)) *Querier_CreateEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoginHistory provides a mock function for the type Querier
+func (_mock *Querier) CreateLoginHistory(ctx context.Context, arg db.CreateLoginHistoryParams) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoginHistory")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateLoginHistoryParams) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateLoginHistoryParams) uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateLoginHistoryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoginHistory'
+type Querier_CreateLoginHistory_Call struct {
+	*mock.Call
+}
+
+// CreateLoginHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateLoginHistoryParams
+func (_e *Querier_Expecter) CreateLoginHistory(ctx interface{}, arg interface{}) *Querier_CreateLoginHistory_Call {
+	return &Querier_CreateLoginHistory_Call{Call: _e.mock.On("CreateLoginHistory", ctx, arg)}
+}
+
+func (_c *Querier_CreateLoginHistory_Call) Run(run func(ctx context.Context, arg db.CreateLoginHistoryParams)) *Querier_CreateLoginHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateLoginHistoryParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateLoginHistoryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateLoginHistory_Call) Return(uUID uuid.UUID, err error) *Querier_CreateLoginHistory_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_CreateLoginHistory_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateLoginHistoryParams) (uuid.UUID, error)) *Querier_CreateLoginHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNewPrediction provides a mock function for the type Querier
+func (_mock *Querier) CreateNewPrediction(ctx context.Context, arg db.CreateNewPredictionParams) (db.Prediction, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNewPrediction")
+	}
+
+	var r0 db.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateNewPredictionParams) (db.Prediction, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateNewPredictionParams) db.Prediction); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Prediction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateNewPredictionParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateNewPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewPrediction'
+type Querier_CreateNewPrediction_Call struct {
+	*mock.Call
+}
+
+// CreateNewPrediction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateNewPredictionParams
+func (_e *Querier_Expecter) CreateNewPrediction(ctx interface{}, arg interface{}) *Querier_CreateNewPrediction_Call {
+	return &Querier_CreateNewPrediction_Call{Call: _e.mock.On("CreateNewPrediction", ctx, arg)}
+}
+
+func (_c *Querier_CreateNewPrediction_Call) Run(run func(ctx context.Context, arg db.CreateNewPredictionParams)) *Querier_CreateNewPrediction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateNewPredictionParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateNewPredictionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateNewPrediction_Call) Return(prediction db.Prediction, err error) *Querier_CreateNewPrediction_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Querier_CreateNewPrediction_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateNewPredictionParams) (db.Prediction, error)) *Querier_CreateNewPrediction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function for the type Querier
+func (_mock *Querier) CreateOrganization(ctx context.Context, arg db.CreateOrganizationParams) (db.CreateOrganizationRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 db.CreateOrganizationRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateOrganizationParams) (db.CreateOrganizationRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateOrganizationParams) db.CreateOrganizationRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateOrganizationRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateOrganizationParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type Querier_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateOrganizationParams
+func (_e *Querier_Expecter) CreateOrganization(ctx interface{}, arg interface{}) *Querier_CreateOrganization_Call {
+	return &Querier_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, arg)}
+}
+
+func (_c *Querier_CreateOrganization_Call) Run(run func(ctx context.Context, arg db.CreateOrganizationParams)) *Querier_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateOrganizationParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateOrganizationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateOrganization_Call) Return(createOrganizationRow db.CreateOrganizationRow, err error) *Querier_CreateOrganization_Call {
+	_c.Call.Return(createOrganizationRow, err)
+	return _c
+}
+
+func (_c *Querier_CreateOrganization_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateOrganizationParams) (db.CreateOrganizationRow, error)) *Querier_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePasswordResetToken provides a mock function for the type Querier
+func (_mock *Querier) CreatePasswordResetToken(ctx context.Context, arg db.CreatePasswordResetTokenParams) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePasswordResetToken")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreatePasswordResetTokenParams) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreatePasswordResetTokenParams) uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreatePasswordResetTokenParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreatePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePasswordResetToken'
+type Querier_CreatePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// CreatePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreatePasswordResetTokenParams
+func (_e *Querier_Expecter) CreatePasswordResetToken(ctx interface{}, arg interface{}) *Querier_CreatePasswordResetToken_Call {
+	return &Querier_CreatePasswordResetToken_Call{Call: _e.mock.On("CreatePasswordResetToken", ctx, arg)}
+}
+
+func (_c *Querier_CreatePasswordResetToken_Call) Run(run func(ctx context.Context, arg db.CreatePasswordResetTokenParams)) *Querier_CreatePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreatePasswordResetTokenParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreatePasswordResetTokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreatePasswordResetToken_Call) Return(uUID uuid.UUID, err error) *Querier_CreatePasswordResetToken_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_CreatePasswordResetToken_Call) RunAndReturn(run func(ctx context.Context, arg db.CreatePasswordResetTokenParams) (uuid.UUID, error)) *Querier_CreatePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRecoveryCode provides a mock function for the type Querier
+func (_mock *Querier) CreateRecoveryCode(ctx context.Context, arg db.CreateRecoveryCodeParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRecoveryCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateRecoveryCodeParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CreateRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRecoveryCode'
+type Querier_CreateRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// CreateRecoveryCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateRecoveryCodeParams
+func (_e *Querier_Expecter) CreateRecoveryCode(ctx interface{}, arg interface{}) *Querier_CreateRecoveryCode_Call {
+	return &Querier_CreateRecoveryCode_Call{Call: _e.mock.On("CreateRecoveryCode", ctx, arg)}
+}
+
+func (_c *Querier_CreateRecoveryCode_Call) Run(run func(ctx context.Context, arg db.CreateRecoveryCodeParams)) *Querier_CreateRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateRecoveryCodeParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateRecoveryCodeParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateRecoveryCode_Call) Return(err error) *Querier_CreateRecoveryCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CreateRecoveryCode_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateRecoveryCodeParams) error) *Querier_CreateRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRefreshToken provides a mock function for the type Querier
+func (_mock *Querier) CreateRefreshToken(ctx context.Context, arg db.CreateRefreshTokenParams) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefreshToken")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateRefreshTokenParams) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateRefreshTokenParams) uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateRefreshTokenParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
+type Querier_CreateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// CreateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateRefreshTokenParams
+func (_e *Querier_Expecter) CreateRefreshToken(ctx interface{}, arg interface{}) *Querier_CreateRefreshToken_Call {
+	return &Querier_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, arg)}
+}
+
+func (_c *Querier_CreateRefreshToken_Call) Run(run func(ctx context.Context, arg db.CreateRefreshTokenParams)) *Querier_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateRefreshTokenParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateRefreshTokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateRefreshToken_Call) Return(uUID uuid.UUID, err error) *Querier_CreateRefreshToken_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_CreateRefreshToken_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateRefreshTokenParams) (uuid.UUID, error)) *Querier_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function for the type Querier
+func (_mock *Querier) CreateUser(ctx context.Context, arg db.CreateUserParams) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type Querier_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateUserParams
+func (_e *Querier_Expecter) CreateUser(ctx interface{}, arg interface{}) *Querier_CreateUser_Call {
+	return &Querier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *Querier_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateUserParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateUser_Call) Return(uUID uuid.UUID, err error) *Querier_CreateUser_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_CreateUser_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateUserParams) (uuid.UUID, error)) *Querier_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUserIdentity provides a mock function for the type Querier
+func (_mock *Querier) CreateUserIdentity(ctx context.Context, arg db.CreateUserIdentityParams) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserIdentity")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateUserIdentityParams) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateUserIdentityParams) uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateUserIdentityParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserIdentity'
+type Querier_CreateUserIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateUserIdentityParams
+func (_e *Querier_Expecter) CreateUserIdentity(ctx interface{}, arg interface{}) *Querier_CreateUserIdentity_Call {
+	return &Querier_CreateUserIdentity_Call{Call: _e.mock.On("CreateUserIdentity", ctx, arg)}
+}
+
+func (_c *Querier_CreateUserIdentity_Call) Run(run func(ctx context.Context, arg db.CreateUserIdentityParams)) *Querier_CreateUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CreateUserIdentityParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CreateUserIdentityParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateUserIdentity_Call) Return(uUID uuid.UUID, err error) *Querier_CreateUserIdentity_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_CreateUserIdentity_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateUserIdentityParams) (uuid.UUID, error)) *Querier_CreateUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGuestUser provides a mock function for the type Querier
+func (_mock *Querier) DeleteGuestUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGuestUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteGuestUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGuestUser'
+type Querier_DeleteGuestUser_Call struct {
+	*mock.Call
+}
+
+// DeleteGuestUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) DeleteGuestUser(ctx interface{}, id interface{}) *Querier_DeleteGuestUser_Call {
+	return &Querier_DeleteGuestUser_Call{Call: _e.mock.On("DeleteGuestUser", ctx, id)}
+}
+
+func (_c *Querier_DeleteGuestUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_DeleteGuestUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteGuestUser_Call) Return(n int64, err error) *Querier_DeleteGuestUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteGuestUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (int64, error)) *Querier_DeleteGuestUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganization provides a mock function for the type Querier
+func (_mock *Querier) DeleteOrganization(ctx context.Context, id uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganization")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganization'
+type Querier_DeleteOrganization_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) DeleteOrganization(ctx interface{}, id interface{}) *Querier_DeleteOrganization_Call {
+	return &Querier_DeleteOrganization_Call{Call: _e.mock.On("DeleteOrganization", ctx, id)}
+}
+
+func (_c *Querier_DeleteOrganization_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_DeleteOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteOrganization_Call) Return(n int64, err error) *Querier_DeleteOrganization_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteOrganization_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (int64, error)) *Querier_DeleteOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationMember provides a mock function for the type Querier
+func (_mock *Querier) DeleteOrganizationMember(ctx context.Context, arg db.DeleteOrganizationMemberParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationMember")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DeleteOrganizationMemberParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DeleteOrganizationMemberParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.DeleteOrganizationMemberParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationMember'
+type Querier_DeleteOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteOrganizationMemberParams
+func (_e *Querier_Expecter) DeleteOrganizationMember(ctx interface{}, arg interface{}) *Querier_DeleteOrganizationMember_Call {
+	return &Querier_DeleteOrganizationMember_Call{Call: _e.mock.On("DeleteOrganizationMember", ctx, arg)}
+}
+
+func (_c *Querier_DeleteOrganizationMember_Call) Run(run func(ctx context.Context, arg db.DeleteOrganizationMemberParams)) *Querier_DeleteOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.DeleteOrganizationMemberParams
+		if args[1] != nil {
+			arg1 = args[1].(db.DeleteOrganizationMemberParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteOrganizationMember_Call) Return(n int64, err error) *Querier_DeleteOrganizationMember_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteOrganizationMember_Call) RunAndReturn(run func(ctx context.Context, arg db.DeleteOrganizationMemberParams) (int64, error)) *Querier_DeleteOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePredictionsByUserID provides a mock function for the type Querier
+func (_mock *Querier) DeletePredictionsByUserID(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePredictionsByUserID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeletePredictionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePredictionsByUserID'
+type Querier_DeletePredictionsByUserID_Call struct {
+	*mock.Call
+}
+
+// DeletePredictionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) DeletePredictionsByUserID(ctx interface{}, userID interface{}) *Querier_DeletePredictionsByUserID_Call {
+	return &Querier_DeletePredictionsByUserID_Call{Call: _e.mock.On("DeletePredictionsByUserID", ctx, userID)}
+}
+
+func (_c *Querier_DeletePredictionsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_DeletePredictionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeletePredictionsByUserID_Call) Return(err error) *Querier_DeletePredictionsByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeletePredictionsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_DeletePredictionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStatsByUserID provides a mock function for the type Querier
+func (_mock *Querier) DeleteStatsByUserID(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStatsByUserID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteStatsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStatsByUserID'
+type Querier_DeleteStatsByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteStatsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) DeleteStatsByUserID(ctx interface{}, userID interface{}) *Querier_DeleteStatsByUserID_Call {
+	return &Querier_DeleteStatsByUserID_Call{Call: _e.mock.On("DeleteStatsByUserID", ctx, userID)}
+}
+
+func (_c *Querier_DeleteStatsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_DeleteStatsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteStatsByUserID_Call) Return(err error) *Querier_DeleteStatsByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteStatsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_DeleteStatsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type Querier
+func (_mock *Querier) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type Querier_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) DeleteUser(ctx interface{}, id interface{}) *Querier_DeleteUser_Call {
+	return &Querier_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *Querier_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteUser_Call) Return(err error) *Querier_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Querier_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserRecoveryCodes provides a mock function for the type Querier
+func (_mock *Querier) DeleteUserRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserRecoveryCodes")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteUserRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserRecoveryCodes'
+type Querier_DeleteUserRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// DeleteUserRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) DeleteUserRecoveryCodes(ctx interface{}, userID interface{}) *Querier_DeleteUserRecoveryCodes_Call {
+	return &Querier_DeleteUserRecoveryCodes_Call{Call: _e.mock.On("DeleteUserRecoveryCodes", ctx, userID)}
+}
+
+func (_c *Querier_DeleteUserRecoveryCodes_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_DeleteUserRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteUserRecoveryCodes_Call) Return(err error) *Querier_DeleteUserRecoveryCodes_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteUserRecoveryCodes_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_DeleteUserRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserTOTP provides a mock function for the type Querier
+func (_mock *Querier) DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserTOTP")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserTOTP'
+type Querier_DeleteUserTOTP_Call struct {
+	*mock.Call
+}
+
+// DeleteUserTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) DeleteUserTOTP(ctx interface{}, userID interface{}) *Querier_DeleteUserTOTP_Call {
+	return &Querier_DeleteUserTOTP_Call{Call: _e.mock.On("DeleteUserTOTP", ctx, userID)}
+}
+
+func (_c *Querier_DeleteUserTOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_DeleteUserTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteUserTOTP_Call) Return(err error) *Querier_DeleteUserTOTP_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteUserTOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_DeleteUserTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportAdminUsers provides a mock function for the type Querier
+func (_mock *Querier) ExportAdminUsers(ctx context.Context, arg db.ExportAdminUsersParams) ([]db.ExportAdminUsersRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportAdminUsers")
+	}
+
+	var r0 []db.ExportAdminUsersRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ExportAdminUsersParams) ([]db.ExportAdminUsersRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ExportAdminUsersParams) []db.ExportAdminUsersRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ExportAdminUsersRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ExportAdminUsersParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ExportAdminUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportAdminUsers'
+type Querier_ExportAdminUsers_Call struct {
+	*mock.Call
+}
+
+// ExportAdminUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ExportAdminUsersParams
+func (_e *Querier_Expecter) ExportAdminUsers(ctx interface{}, arg interface{}) *Querier_ExportAdminUsers_Call {
+	return &Querier_ExportAdminUsers_Call{Call: _e.mock.On("ExportAdminUsers", ctx, arg)}
+}
+
+func (_c *Querier_ExportAdminUsers_Call) Run(run func(ctx context.Context, arg db.ExportAdminUsersParams)) *Querier_ExportAdminUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ExportAdminUsersParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ExportAdminUsersParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ExportAdminUsers_Call) Return(exportAdminUsersRows []db.ExportAdminUsersRow, err error) *Querier_ExportAdminUsers_Call {
+	_c.Call.Return(exportAdminUsersRows, err)
+	return _c
+}
+
+func (_c *Querier_ExportAdminUsers_Call) RunAndReturn(run func(ctx context.Context, arg db.ExportAdminUsersParams) ([]db.ExportAdminUsersRow, error)) *Querier_ExportAdminUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportPredictions provides a mock function for the type Querier
+func (_mock *Querier) ExportPredictions(ctx context.Context, arg db.ExportPredictionsParams) ([]db.ExportPredictionsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPredictions")
+	}
+
+	var r0 []db.ExportPredictionsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ExportPredictionsParams) ([]db.ExportPredictionsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ExportPredictionsParams) []db.ExportPredictionsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ExportPredictionsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ExportPredictionsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ExportPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPredictions'
+type Querier_ExportPredictions_Call struct {
+	*mock.Call
+}
+
+// ExportPredictions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ExportPredictionsParams
+func (_e *Querier_Expecter) ExportPredictions(ctx interface{}, arg interface{}) *Querier_ExportPredictions_Call {
+	return &Querier_ExportPredictions_Call{Call: _e.mock.On("ExportPredictions", ctx, arg)}
+}
+
+func (_c *Querier_ExportPredictions_Call) Run(run func(ctx context.Context, arg db.ExportPredictionsParams)) *Querier_ExportPredictions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ExportPredictionsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ExportPredictionsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ExportPredictions_Call) Return(exportPredictionsRows []db.ExportPredictionsRow, err error) *Querier_ExportPredictions_Call {
+	_c.Call.Return(exportPredictionsRows, err)
+	return _c
+}
+
+func (_c *Querier_ExportPredictions_Call) RunAndReturn(run func(ctx context.Context, arg db.ExportPredictionsParams) ([]db.ExportPredictionsRow, error)) *Querier_ExportPredictions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveAPIKeyByHash provides a mock function for the type Querier
+func (_mock *Querier) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (db.ApiKey, error) {
+	ret := _mock.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveAPIKeyByHash")
+	}
+
+	var r0 db.ApiKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.ApiKey, error)); ok {
+		return returnFunc(ctx, keyHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.ApiKey); ok {
+		r0 = returnFunc(ctx, keyHash)
+	} else {
+		r0 = ret.Get(0).(db.ApiKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetActiveAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveAPIKeyByHash'
+type Querier_GetActiveAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// GetActiveAPIKeyByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyHash string
+func (_e *Querier_Expecter) GetActiveAPIKeyByHash(ctx interface{}, keyHash interface{}) *Querier_GetActiveAPIKeyByHash_Call {
+	return &Querier_GetActiveAPIKeyByHash_Call{Call: _e.mock.On("GetActiveAPIKeyByHash", ctx, keyHash)}
+}
+
+func (_c *Querier_GetActiveAPIKeyByHash_Call) Run(run func(ctx context.Context, keyHash string)) *Querier_GetActiveAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetActiveAPIKeyByHash_Call) Return(apiKey db.ApiKey, err error) *Querier_GetActiveAPIKeyByHash_Call {
+	_c.Call.Return(apiKey, err)
+	return _c
+}
+
+func (_c *Querier_GetActiveAPIKeyByHash_Call) RunAndReturn(run func(ctx context.Context, keyHash string) (db.ApiKey, error)) *Querier_GetActiveAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveTokensByUser provides a mock function for the type Querier
+func (_mock *Querier) GetActiveTokensByUser(ctx context.Context, userID uuid.UUID) ([]db.RefreshToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveTokensByUser")
+	}
+
+	var r0 []db.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.RefreshToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.RefreshToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetActiveTokensByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveTokensByUser'
+type Querier_GetActiveTokensByUser_Call struct {
+	*mock.Call
+}
+
+// GetActiveTokensByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) GetActiveTokensByUser(ctx interface{}, userID interface{}) *Querier_GetActiveTokensByUser_Call {
+	return &Querier_GetActiveTokensByUser_Call{Call: _e.mock.On("GetActiveTokensByUser", ctx, userID)}
+}
+
+func (_c *Querier_GetActiveTokensByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_GetActiveTokensByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetActiveTokensByUser_Call) Return(refreshTokens []db.RefreshToken, err error) *Querier_GetActiveTokensByUser_Call {
+	_c.Call.Return(refreshTokens, err)
+	return _c
+}
+
+func (_c *Querier_GetActiveTokensByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]db.RefreshToken, error)) *Querier_GetActiveTokensByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAdminUserByID provides a mock function for the type Querier
+func (_mock *Querier) GetAdminUserByID(ctx context.Context, id uuid.UUID) (db.GetAdminUserByIDRow, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminUserByID")
+	}
+
+	var r0 db.GetAdminUserByIDRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.GetAdminUserByIDRow, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.GetAdminUserByIDRow); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.GetAdminUserByIDRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetAdminUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUserByID'
+type Querier_GetAdminUserByID_Call struct {
+	*mock.Call
+}
+
+// GetAdminUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) GetAdminUserByID(ctx interface{}, id interface{}) *Querier_GetAdminUserByID_Call {
+	return &Querier_GetAdminUserByID_Call{Call: _e.mock.On("GetAdminUserByID", ctx, id)}
+}
+
+func (_c *Querier_GetAdminUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetAdminUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetAdminUserByID_Call) Return(getAdminUserByIDRow db.GetAdminUserByIDRow, err error) *Querier_GetAdminUserByID_Call {
+	_c.Call.Return(getAdminUserByIDRow, err)
+	return _c
+}
+
+func (_c *Querier_GetAdminUserByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.GetAdminUserByIDRow, error)) *Querier_GetAdminUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAdminUsers provides a mock function for the type Querier
+func (_mock *Querier) GetAdminUsers(ctx context.Context, arg db.GetAdminUsersParams) ([]db.GetAdminUsersRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminUsers")
+	}
+
+	var r0 []db.GetAdminUsersRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetAdminUsersParams) ([]db.GetAdminUsersRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetAdminUsersParams) []db.GetAdminUsersRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetAdminUsersRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetAdminUsersParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetAdminUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUsers'
+type Querier_GetAdminUsers_Call struct {
+	*mock.Call
+}
+
+// GetAdminUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetAdminUsersParams
+func (_e *Querier_Expecter) GetAdminUsers(ctx interface{}, arg interface{}) *Querier_GetAdminUsers_Call {
+	return &Querier_GetAdminUsers_Call{Call: _e.mock.On("GetAdminUsers", ctx, arg)}
+}
+
+func (_c *Querier_GetAdminUsers_Call) Run(run func(ctx context.Context, arg db.GetAdminUsersParams)) *Querier_GetAdminUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetAdminUsersParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetAdminUsersParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetAdminUsers_Call) Return(getAdminUsersRows []db.GetAdminUsersRow, err error) *Querier_GetAdminUsers_Call {
+	_c.Call.Return(getAdminUsersRows, err)
+	return _c
+}
+
+func (_c *Querier_GetAdminUsers_Call) RunAndReturn(run func(ctx context.Context, arg db.GetAdminUsersParams) ([]db.GetAdminUsersRow, error)) *Querier_GetAdminUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDailyActivity provides a mock function for the type Querier
+func (_mock *Querier) GetDailyActivity(ctx context.Context, arg db.GetDailyActivityParams) ([]db.GetDailyActivityRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyActivity")
+	}
+
+	var r0 []db.GetDailyActivityRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetDailyActivityParams) ([]db.GetDailyActivityRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetDailyActivityParams) []db.GetDailyActivityRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetDailyActivityRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetDailyActivityParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetDailyActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDailyActivity'
+type Querier_GetDailyActivity_Call struct {
+	*mock.Call
+}
+
+// GetDailyActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetDailyActivityParams
+func (_e *Querier_Expecter) GetDailyActivity(ctx interface{}, arg interface{}) *Querier_GetDailyActivity_Call {
+	return &Querier_GetDailyActivity_Call{Call: _e.mock.On("GetDailyActivity", ctx, arg)}
+}
+
+func (_c *Querier_GetDailyActivity_Call) Run(run func(ctx context.Context, arg db.GetDailyActivityParams)) *Querier_GetDailyActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetDailyActivityParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetDailyActivityParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetDailyActivity_Call) Return(getDailyActivityRows []db.GetDailyActivityRow, err error) *Querier_GetDailyActivity_Call {
+	_c.Call.Return(getDailyActivityRows, err)
+	return _c
+}
+
+func (_c *Querier_GetDailyActivity_Call) RunAndReturn(run func(ctx context.Context, arg db.GetDailyActivityParams) ([]db.GetDailyActivityRow, error)) *Querier_GetDailyActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginCounts provides a mock function for the type Querier
+func (_mock *Querier) GetLoginCounts(ctx context.Context, arg db.GetLoginCountsParams) ([]db.GetLoginCountsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginCounts")
+	}
+
+	var r0 []db.GetLoginCountsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginCountsParams) ([]db.GetLoginCountsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginCountsParams) []db.GetLoginCountsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetLoginCountsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetLoginCountsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLoginCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginCounts'
+type Querier_GetLoginCounts_Call struct {
+	*mock.Call
+}
+
+// GetLoginCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetLoginCountsParams
+func (_e *Querier_Expecter) GetLoginCounts(ctx interface{}, arg interface{}) *Querier_GetLoginCounts_Call {
+	return &Querier_GetLoginCounts_Call{Call: _e.mock.On("GetLoginCounts", ctx, arg)}
+}
+
+func (_c *Querier_GetLoginCounts_Call) Run(run func(ctx context.Context, arg db.GetLoginCountsParams)) *Querier_GetLoginCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetLoginCountsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetLoginCountsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLoginCounts_Call) Return(getLoginCountsRows []db.GetLoginCountsRow, err error) *Querier_GetLoginCounts_Call {
+	_c.Call.Return(getLoginCountsRows, err)
+	return _c
+}
+
+func (_c *Querier_GetLoginCounts_Call) RunAndReturn(run func(ctx context.Context, arg db.GetLoginCountsParams) ([]db.GetLoginCountsRow, error)) *Querier_GetLoginCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginFailuresByIP provides a mock function for the type Querier
+func (_mock *Querier) GetLoginFailuresByIP(ctx context.Context, arg db.GetLoginFailuresByIPParams) (db.GetLoginFailuresByIPRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginFailuresByIP")
+	}
+
+	var r0 db.GetLoginFailuresByIPRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginFailuresByIPParams) (db.GetLoginFailuresByIPRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginFailuresByIPParams) db.GetLoginFailuresByIPRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetLoginFailuresByIPRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetLoginFailuresByIPParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLoginFailuresByIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginFailuresByIP'
+type Querier_GetLoginFailuresByIP_Call struct {
+	*mock.Call
+}
+
+// GetLoginFailuresByIP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetLoginFailuresByIPParams
+func (_e *Querier_Expecter) GetLoginFailuresByIP(ctx interface{}, arg interface{}) *Querier_GetLoginFailuresByIP_Call {
+	return &Querier_GetLoginFailuresByIP_Call{Call: _e.mock.On("GetLoginFailuresByIP", ctx, arg)}
+}
+
+func (_c *Querier_GetLoginFailuresByIP_Call) Run(run func(ctx context.Context, arg db.GetLoginFailuresByIPParams)) *Querier_GetLoginFailuresByIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetLoginFailuresByIPParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetLoginFailuresByIPParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLoginFailuresByIP_Call) Return(getLoginFailuresByIPRow db.GetLoginFailuresByIPRow, err error) *Querier_GetLoginFailuresByIP_Call {
+	_c.Call.Return(getLoginFailuresByIPRow, err)
+	return _c
+}
+
+func (_c *Querier_GetLoginFailuresByIP_Call) RunAndReturn(run func(ctx context.Context, arg db.GetLoginFailuresByIPParams) (db.GetLoginFailuresByIPRow, error)) *Querier_GetLoginFailuresByIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginFailuresByUser provides a mock function for the type Querier
+func (_mock *Querier) GetLoginFailuresByUser(ctx context.Context, arg db.GetLoginFailuresByUserParams) (db.GetLoginFailuresByUserRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginFailuresByUser")
+	}
+
+	var r0 db.GetLoginFailuresByUserRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginFailuresByUserParams) (db.GetLoginFailuresByUserRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginFailuresByUserParams) db.GetLoginFailuresByUserRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetLoginFailuresByUserRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetLoginFailuresByUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLoginFailuresByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginFailuresByUser'
+type Querier_GetLoginFailuresByUser_Call struct {
+	*mock.Call
+}
+
+// GetLoginFailuresByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetLoginFailuresByUserParams
+func (_e *Querier_Expecter) GetLoginFailuresByUser(ctx interface{}, arg interface{}) *Querier_GetLoginFailuresByUser_Call {
+	return &Querier_GetLoginFailuresByUser_Call{Call: _e.mock.On("GetLoginFailuresByUser", ctx, arg)}
+}
+
+func (_c *Querier_GetLoginFailuresByUser_Call) Run(run func(ctx context.Context, arg db.GetLoginFailuresByUserParams)) *Querier_GetLoginFailuresByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetLoginFailuresByUserParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetLoginFailuresByUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLoginFailuresByUser_Call) Return(getLoginFailuresByUserRow db.GetLoginFailuresByUserRow, err error) *Querier_GetLoginFailuresByUser_Call {
+	_c.Call.Return(getLoginFailuresByUserRow, err)
+	return _c
+}
+
+func (_c *Querier_GetLoginFailuresByUser_Call) RunAndReturn(run func(ctx context.Context, arg db.GetLoginFailuresByUserParams) (db.GetLoginFailuresByUserRow, error)) *Querier_GetLoginFailuresByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginHistoryByUser provides a mock function for the type Querier
+func (_mock *Querier) GetLoginHistoryByUser(ctx context.Context, arg db.GetLoginHistoryByUserParams) ([]db.LoginHistory, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginHistoryByUser")
+	}
+
+	var r0 []db.LoginHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginHistoryByUserParams) ([]db.LoginHistory, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginHistoryByUserParams) []db.LoginHistory); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.LoginHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetLoginHistoryByUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLoginHistoryByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginHistoryByUser'
+type Querier_GetLoginHistoryByUser_Call struct {
+	*mock.Call
+}
+
+// GetLoginHistoryByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetLoginHistoryByUserParams
+func (_e *Querier_Expecter) GetLoginHistoryByUser(ctx interface{}, arg interface{}) *Querier_GetLoginHistoryByUser_Call {
+	return &Querier_GetLoginHistoryByUser_Call{Call: _e.mock.On("GetLoginHistoryByUser", ctx, arg)}
+}
+
+func (_c *Querier_GetLoginHistoryByUser_Call) Run(run func(ctx context.Context, arg db.GetLoginHistoryByUserParams)) *Querier_GetLoginHistoryByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetLoginHistoryByUserParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetLoginHistoryByUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLoginHistoryByUser_Call) Return(loginHistorys []db.LoginHistory, err error) *Querier_GetLoginHistoryByUser_Call {
+	_c.Call.Return(loginHistorys, err)
+	return _c
+}
+
+func (_c *Querier_GetLoginHistoryByUser_Call) RunAndReturn(run func(ctx context.Context, arg db.GetLoginHistoryByUserParams) ([]db.LoginHistory, error)) *Querier_GetLoginHistoryByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganization provides a mock function for the type Querier
+func (_mock *Querier) GetOrganization(ctx context.Context, id uuid.UUID) (db.Organization, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganization")
+	}
+
+	var r0 db.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Organization, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Organization); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Organization)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganization'
+type Querier_GetOrganization_Call struct {
+	*mock.Call
+}
+
+// GetOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) GetOrganization(ctx interface{}, id interface{}) *Querier_GetOrganization_Call {
+	return &Querier_GetOrganization_Call{Call: _e.mock.On("GetOrganization", ctx, id)}
+}
+
+func (_c *Querier_GetOrganization_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganization_Call) Return(organization db.Organization, err error) *Querier_GetOrganization_Call {
+	_c.Call.Return(organization, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganization_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Organization, error)) *Querier_GetOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationByInviteCode provides a mock function for the type Querier
+func (_mock *Querier) GetOrganizationByInviteCode(ctx context.Context, inviteCode string) (db.Organization, error) {
+	ret := _mock.Called(ctx, inviteCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationByInviteCode")
+	}
+
+	var r0 db.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.Organization, error)); ok {
+		return returnFunc(ctx, inviteCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.Organization); ok {
+		r0 = returnFunc(ctx, inviteCode)
+	} else {
+		r0 = ret.Get(0).(db.Organization)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, inviteCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganizationByInviteCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationByInviteCode'
+type Querier_GetOrganizationByInviteCode_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationByInviteCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inviteCode string
+func (_e *Querier_Expecter) GetOrganizationByInviteCode(ctx interface{}, inviteCode interface{}) *Querier_GetOrganizationByInviteCode_Call {
+	return &Querier_GetOrganizationByInviteCode_Call{Call: _e.mock.On("GetOrganizationByInviteCode", ctx, inviteCode)}
+}
+
+func (_c *Querier_GetOrganizationByInviteCode_Call) Run(run func(ctx context.Context, inviteCode string)) *Querier_GetOrganizationByInviteCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganizationByInviteCode_Call) Return(organization db.Organization, err error) *Querier_GetOrganizationByInviteCode_Call {
+	_c.Call.Return(organization, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganizationByInviteCode_Call) RunAndReturn(run func(ctx context.Context, inviteCode string) (db.Organization, error)) *Querier_GetOrganizationByInviteCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationForUpdate provides a mock function for the type Querier
+func (_mock *Querier) GetOrganizationForUpdate(ctx context.Context, id uuid.UUID) (db.Organization, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationForUpdate")
+	}
+
+	var r0 db.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Organization, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Organization); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Organization)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganizationForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationForUpdate'
+type Querier_GetOrganizationForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) GetOrganizationForUpdate(ctx interface{}, id interface{}) *Querier_GetOrganizationForUpdate_Call {
+	return &Querier_GetOrganizationForUpdate_Call{Call: _e.mock.On("GetOrganizationForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetOrganizationForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetOrganizationForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganizationForUpdate_Call) Return(organization db.Organization, err error) *Querier_GetOrganizationForUpdate_Call {
+	_c.Call.Return(organization, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganizationForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Organization, error)) *Querier_GetOrganizationForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMember provides a mock function for the type Querier
+func (_mock *Querier) GetOrganizationMember(ctx context.Context, arg db.GetOrganizationMemberParams) (db.OrganizationMember, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMember")
+	}
+
+	var r0 db.OrganizationMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetOrganizationMemberParams) (db.OrganizationMember, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetOrganizationMemberParams) db.OrganizationMember); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.OrganizationMember)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetOrganizationMemberParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMember'
+type Querier_GetOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetOrganizationMemberParams
+func (_e *Querier_Expecter) GetOrganizationMember(ctx interface{}, arg interface{}) *Querier_GetOrganizationMember_Call {
+	return &Querier_GetOrganizationMember_Call{Call: _e.mock.On("GetOrganizationMember", ctx, arg)}
+}
+
+func (_c *Querier_GetOrganizationMember_Call) Run(run func(ctx context.Context, arg db.GetOrganizationMemberParams)) *Querier_GetOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetOrganizationMemberParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetOrganizationMemberParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganizationMember_Call) Return(organizationMember db.OrganizationMember, err error) *Querier_GetOrganizationMember_Call {
+	_c.Call.Return(organizationMember, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganizationMember_Call) RunAndReturn(run func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.OrganizationMember, error)) *Querier_GetOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPeriodLeaderboard provides a mock function for the type Querier
+func (_mock *Querier) GetOrganizationPeriodLeaderboard(ctx context.Context, arg db.GetOrganizationPeriodLeaderboardParams) ([]db.GetOrganizationPeriodLeaderboardRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPeriodLeaderboard")
+	}
+
+	var r0 []db.GetOrganizationPeriodLeaderboardRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetOrganizationPeriodLeaderboardParams) ([]db.GetOrganizationPeriodLeaderboardRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetOrganizationPeriodLeaderboardParams) []db.GetOrganizationPeriodLeaderboardRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetOrganizationPeriodLeaderboardRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetOrganizationPeriodLeaderboardParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganizationPeriodLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPeriodLeaderboard'
+type Querier_GetOrganizationPeriodLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPeriodLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetOrganizationPeriodLeaderboardParams
+func (_e *Querier_Expecter) GetOrganizationPeriodLeaderboard(ctx interface{}, arg interface{}) *Querier_GetOrganizationPeriodLeaderboard_Call {
+	return &Querier_GetOrganizationPeriodLeaderboard_Call{Call: _e.mock.On("GetOrganizationPeriodLeaderboard", ctx, arg)}
+}
+
+func (_c *Querier_GetOrganizationPeriodLeaderboard_Call) Run(run func(ctx context.Context, arg db.GetOrganizationPeriodLeaderboardParams)) *Querier_GetOrganizationPeriodLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetOrganizationPeriodLeaderboardParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetOrganizationPeriodLeaderboardParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganizationPeriodLeaderboard_Call) Return(getOrganizationPeriodLeaderboardRows []db.GetOrganizationPeriodLeaderboardRow, err error) *Querier_GetOrganizationPeriodLeaderboard_Call {
+	_c.Call.Return(getOrganizationPeriodLeaderboardRows, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganizationPeriodLeaderboard_Call) RunAndReturn(run func(ctx context.Context, arg db.GetOrganizationPeriodLeaderboardParams) ([]db.GetOrganizationPeriodLeaderboardRow, error)) *Querier_GetOrganizationPeriodLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationRatingLeaderboard provides a mock function for the type Querier
+func (_mock *Querier) GetOrganizationRatingLeaderboard(ctx context.Context, limit int32) ([]db.GetOrganizationRatingLeaderboardRow, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationRatingLeaderboard")
+	}
+
+	var r0 []db.GetOrganizationRatingLeaderboardRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32) ([]db.GetOrganizationRatingLeaderboardRow, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32) []db.GetOrganizationRatingLeaderboardRow); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetOrganizationRatingLeaderboardRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganizationRatingLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationRatingLeaderboard'
+type Querier_GetOrganizationRatingLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationRatingLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int32
+func (_e *Querier_Expecter) GetOrganizationRatingLeaderboard(ctx interface{}, limit interface{}) *Querier_GetOrganizationRatingLeaderboard_Call {
+	return &Querier_GetOrganizationRatingLeaderboard_Call{Call: _e.mock.On("GetOrganizationRatingLeaderboard", ctx, limit)}
+}
+
+func (_c *Querier_GetOrganizationRatingLeaderboard_Call) Run(run func(ctx context.Context, limit int32)) *Querier_GetOrganizationRatingLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int32
+		if args[1] != nil {
+			arg1 = args[1].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganizationRatingLeaderboard_Call) Return(getOrganizationRatingLeaderboardRows []db.GetOrganizationRatingLeaderboardRow, err error) *Querier_GetOrganizationRatingLeaderboard_Call {
+	_c.Call.Return(getOrganizationRatingLeaderboardRows, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganizationRatingLeaderboard_Call) RunAndReturn(run func(ctx context.Context, limit int32) ([]db.GetOrganizationRatingLeaderboardRow, error)) *Querier_GetOrganizationRatingLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationStats provides a mock function for the type Querier
+func (_mock *Querier) GetOrganizationStats(ctx context.Context, organizationID uuid.UUID) (db.GetOrganizationStatsRow, error) {
+	ret := _mock.Called(ctx, organizationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationStats")
+	}
+
+	var r0 db.GetOrganizationStatsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.GetOrganizationStatsRow, error)); ok {
+		return returnFunc(ctx, organizationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.GetOrganizationStatsRow); ok {
+		r0 = returnFunc(ctx, organizationID)
+	} else {
+		r0 = ret.Get(0).(db.GetOrganizationStatsRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganizationStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationStats'
+type Querier_GetOrganizationStats_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+func (_e *Querier_Expecter) GetOrganizationStats(ctx interface{}, organizationID interface{}) *Querier_GetOrganizationStats_Call {
+	return &Querier_GetOrganizationStats_Call{Call: _e.mock.On("GetOrganizationStats", ctx, organizationID)}
+}
+
+func (_c *Querier_GetOrganizationStats_Call) Run(run func(ctx context.Context, organizationID uuid.UUID)) *Querier_GetOrganizationStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganizationStats_Call) Return(getOrganizationStatsRow db.GetOrganizationStatsRow, err error) *Querier_GetOrganizationStats_Call {
+	_c.Call.Return(getOrganizationStatsRow, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganizationStats_Call) RunAndReturn(run func(ctx context.Context, organizationID uuid.UUID) (db.GetOrganizationStatsRow, error)) *Querier_GetOrganizationStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationTypeTotals provides a mock function for the type Querier
+func (_mock *Querier) GetOrganizationTypeTotals(ctx context.Context, organizationID uuid.UUID) ([]db.GetOrganizationTypeTotalsRow, error) {
+	ret := _mock.Called(ctx, organizationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationTypeTotals")
+	}
+
+	var r0 []db.GetOrganizationTypeTotalsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetOrganizationTypeTotalsRow, error)); ok {
+		return returnFunc(ctx, organizationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetOrganizationTypeTotalsRow); ok {
+		r0 = returnFunc(ctx, organizationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetOrganizationTypeTotalsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetOrganizationTypeTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationTypeTotals'
+type Querier_GetOrganizationTypeTotals_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationTypeTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID uuid.UUID
+func (_e *Querier_Expecter) GetOrganizationTypeTotals(ctx interface{}, organizationID interface{}) *Querier_GetOrganizationTypeTotals_Call {
+	return &Querier_GetOrganizationTypeTotals_Call{Call: _e.mock.On("GetOrganizationTypeTotals", ctx, organizationID)}
+}
+
+func (_c *Querier_GetOrganizationTypeTotals_Call) Run(run func(ctx context.Context, organizationID uuid.UUID)) *Querier_GetOrganizationTypeTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetOrganizationTypeTotals_Call) Return(getOrganizationTypeTotalsRows []db.GetOrganizationTypeTotalsRow, err error) *Querier_GetOrganizationTypeTotals_Call {
+	_c.Call.Return(getOrganizationTypeTotalsRows, err)
+	return _c
+}
+
+func (_c *Querier_GetOrganizationTypeTotals_Call) RunAndReturn(run func(ctx context.Context, organizationID uuid.UUID) ([]db.GetOrganizationTypeTotalsRow, error)) *Querier_GetOrganizationTypeTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeriodLeaderboard provides a mock function for the type Querier
+func (_mock *Querier) GetPeriodLeaderboard(ctx context.Context, arg db.GetPeriodLeaderboardParams) ([]db.GetPeriodLeaderboardRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeriodLeaderboard")
+	}
+
+	var r0 []db.GetPeriodLeaderboardRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPeriodLeaderboardParams) ([]db.GetPeriodLeaderboardRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPeriodLeaderboardParams) []db.GetPeriodLeaderboardRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetPeriodLeaderboardRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetPeriodLeaderboardParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPeriodLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeriodLeaderboard'
+type Querier_GetPeriodLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetPeriodLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetPeriodLeaderboardParams
+func (_e *Querier_Expecter) GetPeriodLeaderboard(ctx interface{}, arg interface{}) *Querier_GetPeriodLeaderboard_Call {
+	return &Querier_GetPeriodLeaderboard_Call{Call: _e.mock.On("GetPeriodLeaderboard", ctx, arg)}
+}
+
+func (_c *Querier_GetPeriodLeaderboard_Call) Run(run func(ctx context.Context, arg db.GetPeriodLeaderboardParams)) *Querier_GetPeriodLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetPeriodLeaderboardParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetPeriodLeaderboardParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPeriodLeaderboard_Call) Return(getPeriodLeaderboardRows []db.GetPeriodLeaderboardRow, err error) *Querier_GetPeriodLeaderboard_Call {
+	_c.Call.Return(getPeriodLeaderboardRows, err)
+	return _c
+}
+
+func (_c *Querier_GetPeriodLeaderboard_Call) RunAndReturn(run func(ctx context.Context, arg db.GetPeriodLeaderboardParams) ([]db.GetPeriodLeaderboardRow, error)) *Querier_GetPeriodLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeriodRank provides a mock function for the type Querier
+func (_mock *Querier) GetPeriodRank(ctx context.Context, arg db.GetPeriodRankParams) (db.GetPeriodRankRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeriodRank")
+	}
+
+	var r0 db.GetPeriodRankRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPeriodRankParams) (db.GetPeriodRankRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPeriodRankParams) db.GetPeriodRankRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetPeriodRankRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetPeriodRankParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPeriodRank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeriodRank'
+type Querier_GetPeriodRank_Call struct {
+	*mock.Call
+}
+
+// GetPeriodRank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetPeriodRankParams
+func (_e *Querier_Expecter) GetPeriodRank(ctx interface{}, arg interface{}) *Querier_GetPeriodRank_Call {
+	return &Querier_GetPeriodRank_Call{Call: _e.mock.On("GetPeriodRank", ctx, arg)}
+}
+
+func (_c *Querier_GetPeriodRank_Call) Run(run func(ctx context.Context, arg db.GetPeriodRankParams)) *Querier_GetPeriodRank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetPeriodRankParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetPeriodRankParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPeriodRank_Call) Return(getPeriodRankRow db.GetPeriodRankRow, err error) *Querier_GetPeriodRank_Call {
+	_c.Call.Return(getPeriodRankRow, err)
+	return _c
+}
+
+func (_c *Querier_GetPeriodRank_Call) RunAndReturn(run func(ctx context.Context, arg db.GetPeriodRankParams) (db.GetPeriodRankRow, error)) *Querier_GetPeriodRank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrediction provides a mock function for the type Querier
+func (_mock *Querier) GetPrediction(ctx context.Context, id uuid.UUID) (db.Prediction, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrediction")
+	}
+
+	var r0 db.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Prediction, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Prediction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Prediction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrediction'
+type Querier_GetPrediction_Call struct {
+	*mock.Call
+}
+
+// GetPrediction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) GetPrediction(ctx interface{}, id interface{}) *Querier_GetPrediction_Call {
+	return &Querier_GetPrediction_Call{Call: _e.mock.On("GetPrediction", ctx, id)}
+}
+
+func (_c *Querier_GetPrediction_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetPrediction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPrediction_Call) Return(prediction db.Prediction, err error) *Querier_GetPrediction_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Querier_GetPrediction_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Prediction, error)) *Querier_GetPrediction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictionForUpdate provides a mock function for the type Querier
+func (_mock *Querier) GetPredictionForUpdate(ctx context.Context, id uuid.UUID) (db.Prediction, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictionForUpdate")
+	}
+
+	var r0 db.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Prediction, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Prediction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Prediction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPredictionForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionForUpdate'
+type Querier_GetPredictionForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetPredictionForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) GetPredictionForUpdate(ctx interface{}, id interface{}) *Querier_GetPredictionForUpdate_Call {
+	return &Querier_GetPredictionForUpdate_Call{Call: _e.mock.On("GetPredictionForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetPredictionForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetPredictionForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPredictionForUpdate_Call) Return(prediction db.Prediction, err error) *Querier_GetPredictionForUpdate_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Querier_GetPredictionForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Prediction, error)) *Querier_GetPredictionForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictionStatusCounts provides a mock function for the type Querier
+func (_mock *Querier) GetPredictionStatusCounts(ctx context.Context, arg db.GetPredictionStatusCountsParams) ([]db.GetPredictionStatusCountsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictionStatusCounts")
+	}
+
+	var r0 []db.GetPredictionStatusCountsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionStatusCountsParams) ([]db.GetPredictionStatusCountsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionStatusCountsParams) []db.GetPredictionStatusCountsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetPredictionStatusCountsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetPredictionStatusCountsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPredictionStatusCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionStatusCounts'
+type Querier_GetPredictionStatusCounts_Call struct {
+	*mock.Call
+}
+
+// GetPredictionStatusCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetPredictionStatusCountsParams
+func (_e *Querier_Expecter) GetPredictionStatusCounts(ctx interface{}, arg interface{}) *Querier_GetPredictionStatusCounts_Call {
+	return &Querier_GetPredictionStatusCounts_Call{Call: _e.mock.On("GetPredictionStatusCounts", ctx, arg)}
+}
+
+func (_c *Querier_GetPredictionStatusCounts_Call) Run(run func(ctx context.Context, arg db.GetPredictionStatusCountsParams)) *Querier_GetPredictionStatusCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetPredictionStatusCountsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetPredictionStatusCountsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPredictionStatusCounts_Call) Return(getPredictionStatusCountsRows []db.GetPredictionStatusCountsRow, err error) *Querier_GetPredictionStatusCounts_Call {
+	_c.Call.Return(getPredictionStatusCountsRows, err)
+	return _c
+}
+
+func (_c *Querier_GetPredictionStatusCounts_Call) RunAndReturn(run func(ctx context.Context, arg db.GetPredictionStatusCountsParams) ([]db.GetPredictionStatusCountsRow, error)) *Querier_GetPredictionStatusCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictionTypeCounts provides a mock function for the type Querier
+func (_mock *Querier) GetPredictionTypeCounts(ctx context.Context, arg db.GetPredictionTypeCountsParams) ([]db.GetPredictionTypeCountsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictionTypeCounts")
+	}
+
+	var r0 []db.GetPredictionTypeCountsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionTypeCountsParams) ([]db.GetPredictionTypeCountsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionTypeCountsParams) []db.GetPredictionTypeCountsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetPredictionTypeCountsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetPredictionTypeCountsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPredictionTypeCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionTypeCounts'
+type Querier_GetPredictionTypeCounts_Call struct {
+	*mock.Call
+}
+
+// GetPredictionTypeCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetPredictionTypeCountsParams
+func (_e *Querier_Expecter) GetPredictionTypeCounts(ctx interface{}, arg interface{}) *Querier_GetPredictionTypeCounts_Call {
+	return &Querier_GetPredictionTypeCounts_Call{Call: _e.mock.On("GetPredictionTypeCounts", ctx, arg)}
+}
+
+func (_c *Querier_GetPredictionTypeCounts_Call) Run(run func(ctx context.Context, arg db.GetPredictionTypeCountsParams)) *Querier_GetPredictionTypeCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetPredictionTypeCountsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetPredictionTypeCountsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPredictionTypeCounts_Call) Return(getPredictionTypeCountsRows []db.GetPredictionTypeCountsRow, err error) *Querier_GetPredictionTypeCounts_Call {
+	_c.Call.Return(getPredictionTypeCountsRows, err)
+	return _c
+}
+
+func (_c *Querier_GetPredictionTypeCounts_Call) RunAndReturn(run func(ctx context.Context, arg db.GetPredictionTypeCountsParams) ([]db.GetPredictionTypeCountsRow, error)) *Querier_GetPredictionTypeCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictionsByUserID provides a mock function for the type Querier
+func (_mock *Querier) GetPredictionsByUserID(ctx context.Context, arg db.GetPredictionsByUserIDParams) ([]db.Prediction, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictionsByUserID")
+	}
+
+	var r0 []db.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionsByUserIDParams) ([]db.Prediction, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionsByUserIDParams) []db.Prediction); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetPredictionsByUserIDParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPredictionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionsByUserID'
+type Querier_GetPredictionsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetPredictionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetPredictionsByUserIDParams
+func (_e *Querier_Expecter) GetPredictionsByUserID(ctx interface{}, arg interface{}) *Querier_GetPredictionsByUserID_Call {
+	return &Querier_GetPredictionsByUserID_Call{Call: _e.mock.On("GetPredictionsByUserID", ctx, arg)}
+}
+
+func (_c *Querier_GetPredictionsByUserID_Call) Run(run func(ctx context.Context, arg db.GetPredictionsByUserIDParams)) *Querier_GetPredictionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetPredictionsByUserIDParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetPredictionsByUserIDParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPredictionsByUserID_Call) Return(predictions []db.Prediction, err error) *Querier_GetPredictionsByUserID_Call {
+	_c.Call.Return(predictions, err)
+	return _c
+}
+
+func (_c *Querier_GetPredictionsByUserID_Call) RunAndReturn(run func(ctx context.Context, arg db.GetPredictionsByUserIDParams) ([]db.Prediction, error)) *Querier_GetPredictionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictorPerformance provides a mock function for the type Querier
+func (_mock *Querier) GetPredictorPerformance(ctx context.Context, arg db.GetPredictorPerformanceParams) (db.GetPredictorPerformanceRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictorPerformance")
+	}
+
+	var r0 db.GetPredictorPerformanceRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictorPerformanceParams) (db.GetPredictorPerformanceRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictorPerformanceParams) db.GetPredictorPerformanceRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetPredictorPerformanceRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetPredictorPerformanceParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPredictorPerformance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictorPerformance'
+type Querier_GetPredictorPerformance_Call struct {
+	*mock.Call
+}
+
+// GetPredictorPerformance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetPredictorPerformanceParams
+func (_e *Querier_Expecter) GetPredictorPerformance(ctx interface{}, arg interface{}) *Querier_GetPredictorPerformance_Call {
+	return &Querier_GetPredictorPerformance_Call{Call: _e.mock.On("GetPredictorPerformance", ctx, arg)}
+}
+
+func (_c *Querier_GetPredictorPerformance_Call) Run(run func(ctx context.Context, arg db.GetPredictorPerformanceParams)) *Querier_GetPredictorPerformance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetPredictorPerformanceParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetPredictorPerformanceParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPredictorPerformance_Call) Return(getPredictorPerformanceRow db.GetPredictorPerformanceRow, err error) *Querier_GetPredictorPerformance_Call {
+	_c.Call.Return(getPredictorPerformanceRow, err)
+	return _c
+}
+
+func (_c *Querier_GetPredictorPerformance_Call) RunAndReturn(run func(ctx context.Context, arg db.GetPredictorPerformanceParams) (db.GetPredictorPerformanceRow, error)) *Querier_GetPredictorPerformance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRatingLeaderboard provides a mock function for the type Querier
+func (_mock *Querier) GetRatingLeaderboard(ctx context.Context, limit int32) ([]db.GetRatingLeaderboardRow, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRatingLeaderboard")
+	}
+
+	var r0 []db.GetRatingLeaderboardRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32) ([]db.GetRatingLeaderboardRow, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32) []db.GetRatingLeaderboardRow); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetRatingLeaderboardRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetRatingLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatingLeaderboard'
+type Querier_GetRatingLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetRatingLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int32
+func (_e *Querier_Expecter) GetRatingLeaderboard(ctx interface{}, limit interface{}) *Querier_GetRatingLeaderboard_Call {
+	return &Querier_GetRatingLeaderboard_Call{Call: _e.mock.On("GetRatingLeaderboard", ctx, limit)}
+}
+
+func (_c *Querier_GetRatingLeaderboard_Call) Run(run func(ctx context.Context, limit int32)) *Querier_GetRatingLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int32
+		if args[1] != nil {
+			arg1 = args[1].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetRatingLeaderboard_Call) Return(getRatingLeaderboardRows []db.GetRatingLeaderboardRow, err error) *Querier_GetRatingLeaderboard_Call {
+	_c.Call.Return(getRatingLeaderboardRows, err)
+	return _c
+}
+
+func (_c *Querier_GetRatingLeaderboard_Call) RunAndReturn(run func(ctx context.Context, limit int32) ([]db.GetRatingLeaderboardRow, error)) *Querier_GetRatingLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRatingRank provides a mock function for the type Querier
+func (_mock *Querier) GetRatingRank(ctx context.Context, userID uuid.UUID) (db.GetRatingRankRow, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRatingRank")
+	}
+
+	var r0 db.GetRatingRankRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.GetRatingRankRow, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.GetRatingRankRow); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(db.GetRatingRankRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetRatingRank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatingRank'
+type Querier_GetRatingRank_Call struct {
+	*mock.Call
+}
+
+// GetRatingRank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) GetRatingRank(ctx interface{}, userID interface{}) *Querier_GetRatingRank_Call {
+	return &Querier_GetRatingRank_Call{Call: _e.mock.On("GetRatingRank", ctx, userID)}
+}
+
+func (_c *Querier_GetRatingRank_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_GetRatingRank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetRatingRank_Call) Return(getRatingRankRow db.GetRatingRankRow, err error) *Querier_GetRatingRank_Call {
+	_c.Call.Return(getRatingRankRow, err)
+	return _c
+}
+
+func (_c *Querier_GetRatingRank_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (db.GetRatingRankRow, error)) *Querier_GetRatingRank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshTokenByHash provides a mock function for the type Querier
+func (_mock *Querier) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (db.RefreshToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokenByHash")
+	}
+
+	var r0 db.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.RefreshToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.RefreshToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(db.RefreshToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetRefreshTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokenByHash'
+type Querier_GetRefreshTokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetRefreshTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Querier_Expecter) GetRefreshTokenByHash(ctx interface{}, tokenHash interface{}) *Querier_GetRefreshTokenByHash_Call {
+	return &Querier_GetRefreshTokenByHash_Call{Call: _e.mock.On("GetRefreshTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *Querier_GetRefreshTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_GetRefreshTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetRefreshTokenByHash_Call) Return(refreshToken db.RefreshToken, err error) *Querier_GetRefreshTokenByHash_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *Querier_GetRefreshTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (db.RefreshToken, error)) *Querier_GetRefreshTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRegistrationCounts provides a mock function for the type Querier
+func (_mock *Querier) GetRegistrationCounts(ctx context.Context, arg db.GetRegistrationCountsParams) ([]db.GetRegistrationCountsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegistrationCounts")
+	}
+
+	var r0 []db.GetRegistrationCountsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetRegistrationCountsParams) ([]db.GetRegistrationCountsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetRegistrationCountsParams) []db.GetRegistrationCountsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetRegistrationCountsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetRegistrationCountsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetRegistrationCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRegistrationCounts'
+type Querier_GetRegistrationCounts_Call struct {
+	*mock.Call
+}
+
+// GetRegistrationCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetRegistrationCountsParams
+func (_e *Querier_Expecter) GetRegistrationCounts(ctx interface{}, arg interface{}) *Querier_GetRegistrationCounts_Call {
+	return &Querier_GetRegistrationCounts_Call{Call: _e.mock.On("GetRegistrationCounts", ctx, arg)}
+}
+
+func (_c *Querier_GetRegistrationCounts_Call) Run(run func(ctx context.Context, arg db.GetRegistrationCountsParams)) *Querier_GetRegistrationCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetRegistrationCountsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetRegistrationCountsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetRegistrationCounts_Call) Return(getRegistrationCountsRows []db.GetRegistrationCountsRow, err error) *Querier_GetRegistrationCounts_Call {
+	_c.Call.Return(getRegistrationCountsRows, err)
+	return _c
+}
+
+func (_c *Querier_GetRegistrationCounts_Call) RunAndReturn(run func(ctx context.Context, arg db.GetRegistrationCountsParams) ([]db.GetRegistrationCountsRow, error)) *Querier_GetRegistrationCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatsByUserID provides a mock function for the type Querier
+func (_mock *Querier) GetStatsByUserID(ctx context.Context, userID uuid.UUID) (db.Stat, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsByUserID")
+	}
+
+	var r0 db.Stat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Stat, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Stat); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(db.Stat)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetStatsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatsByUserID'
+type Querier_GetStatsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetStatsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) GetStatsByUserID(ctx interface{}, userID interface{}) *Querier_GetStatsByUserID_Call {
+	return &Querier_GetStatsByUserID_Call{Call: _e.mock.On("GetStatsByUserID", ctx, userID)}
+}
+
+func (_c *Querier_GetStatsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_GetStatsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetStatsByUserID_Call) Return(stat db.Stat, err error) *Querier_GetStatsByUserID_Call {
+	_c.Call.Return(stat, err)
+	return _c
+}
+
+func (_c *Querier_GetStatsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (db.Stat, error)) *Querier_GetStatsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatsHistory provides a mock function for the type Querier
+func (_mock *Querier) GetStatsHistory(ctx context.Context, arg db.GetStatsHistoryParams) ([]db.StatsHistory, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsHistory")
+	}
+
+	var r0 []db.StatsHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetStatsHistoryParams) ([]db.StatsHistory, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetStatsHistoryParams) []db.StatsHistory); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StatsHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetStatsHistoryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetStatsHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatsHistory'
+type Querier_GetStatsHistory_Call struct {
+	*mock.Call
+}
+
+// GetStatsHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStatsHistoryParams
+func (_e *Querier_Expecter) GetStatsHistory(ctx interface{}, arg interface{}) *Querier_GetStatsHistory_Call {
+	return &Querier_GetStatsHistory_Call{Call: _e.mock.On("GetStatsHistory", ctx, arg)}
+}
+
+func (_c *Querier_GetStatsHistory_Call) Run(run func(ctx context.Context, arg db.GetStatsHistoryParams)) *Querier_GetStatsHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetStatsHistoryParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetStatsHistoryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetStatsHistory_Call) Return(statsHistorys []db.StatsHistory, err error) *Querier_GetStatsHistory_Call {
+	_c.Call.Return(statsHistorys, err)
+	return _c
+}
+
+func (_c *Querier_GetStatsHistory_Call) RunAndReturn(run func(ctx context.Context, arg db.GetStatsHistoryParams) ([]db.StatsHistory, error)) *Querier_GetStatsHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function for the type Querier
+func (_mock *Querier) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 db.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type Querier_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *Querier_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *Querier_GetUserByEmail_Call {
+	return &Querier_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *Querier_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *Querier_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserByEmail_Call) Return(user db.User, err error) *Querier_GetUserByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_GetUserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (db.User, error)) *Querier_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function for the type Querier
+func (_mock *Querier) GetUserByID(ctx context.Context, id uuid.UUID) (db.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 db.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type Querier_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) GetUserByID(ctx interface{}, id interface{}) *Querier_GetUserByID_Call {
+	return &Querier_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+}
+
+func (_c *Querier_GetUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserByID_Call) Return(user db.User, err error) *Querier_GetUserByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.User, error)) *Querier_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByLogin provides a mock function for the type Querier
+func (_mock *Querier) GetUserByLogin(ctx context.Context, login string) (db.User, error) {
+	ret := _mock.Called(ctx, login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByLogin")
+	}
+
+	var r0 db.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return returnFunc(ctx, login)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = returnFunc(ctx, login)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, login)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
+type Querier_GetUserByLogin_Call struct {
+	*mock.Call
+}
+
+// GetUserByLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+func (_e *Querier_Expecter) GetUserByLogin(ctx interface{}, login interface{}) *Querier_GetUserByLogin_Call {
+	return &Querier_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", ctx, login)}
+}
+
+func (_c *Querier_GetUserByLogin_Call) Run(run func(ctx context.Context, login string)) *Querier_GetUserByLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserByLogin_Call) Return(user db.User, err error) *Querier_GetUserByLogin_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_GetUserByLogin_Call) RunAndReturn(run func(ctx context.Context, login string) (db.User, error)) *Querier_GetUserByLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentity provides a mock function for the type Querier
+func (_mock *Querier) GetUserIdentity(ctx context.Context, arg db.GetUserIdentityParams) (db.UserIdentity, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentity")
+	}
+
+	var r0 db.UserIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetUserIdentityParams) (db.UserIdentity, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetUserIdentityParams) db.UserIdentity); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.UserIdentity)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetUserIdentityParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentity'
+type Querier_GetUserIdentity_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetUserIdentityParams
+func (_e *Querier_Expecter) GetUserIdentity(ctx interface{}, arg interface{}) *Querier_GetUserIdentity_Call {
+	return &Querier_GetUserIdentity_Call{Call: _e.mock.On("GetUserIdentity", ctx, arg)}
+}
+
+func (_c *Querier_GetUserIdentity_Call) Run(run func(ctx context.Context, arg db.GetUserIdentityParams)) *Querier_GetUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetUserIdentityParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetUserIdentityParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserIdentity_Call) Return(userIdentity db.UserIdentity, err error) *Querier_GetUserIdentity_Call {
+	_c.Call.Return(userIdentity, err)
+	return _c
+}
+
+func (_c *Querier_GetUserIdentity_Call) RunAndReturn(run func(ctx context.Context, arg db.GetUserIdentityParams) (db.UserIdentity, error)) *Querier_GetUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserTOTP provides a mock function for the type Querier
+func (_mock *Querier) GetUserTOTP(ctx context.Context, userID uuid.UUID) (db.UserTotp, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserTOTP")
+	}
+
+	var r0 db.UserTotp
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.UserTotp, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.UserTotp); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(db.UserTotp)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTOTP'
+type Querier_GetUserTOTP_Call struct {
+	*mock.Call
+}
+
+// GetUserTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) GetUserTOTP(ctx interface{}, userID interface{}) *Querier_GetUserTOTP_Call {
+	return &Querier_GetUserTOTP_Call{Call: _e.mock.On("GetUserTOTP", ctx, userID)}
+}
+
+func (_c *Querier_GetUserTOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_GetUserTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserTOTP_Call) Return(userTotp db.UserTotp, err error) *Querier_GetUserTOTP_Call {
+	_c.Call.Return(userTotp, err)
+	return _c
+}
+
+func (_c *Querier_GetUserTOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (db.UserTotp, error)) *Querier_GetUserTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementDailyActivity provides a mock function for the type Querier
+func (_mock *Querier) IncrementDailyActivity(ctx context.Context, arg db.IncrementDailyActivityParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementDailyActivity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.IncrementDailyActivityParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_IncrementDailyActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementDailyActivity'
+type Querier_IncrementDailyActivity_Call struct {
+	*mock.Call
+}
+
+// IncrementDailyActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.IncrementDailyActivityParams
+func (_e *Querier_Expecter) IncrementDailyActivity(ctx interface{}, arg interface{}) *Querier_IncrementDailyActivity_Call {
+	return &Querier_IncrementDailyActivity_Call{Call: _e.mock.On("IncrementDailyActivity", ctx, arg)}
+}
+
+func (_c *Querier_IncrementDailyActivity_Call) Run(run func(ctx context.Context, arg db.IncrementDailyActivityParams)) *Querier_IncrementDailyActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.IncrementDailyActivityParams
+		if args[1] != nil {
+			arg1 = args[1].(db.IncrementDailyActivityParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IncrementDailyActivity_Call) Return(err error) *Querier_IncrementDailyActivity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_IncrementDailyActivity_Call) RunAndReturn(run func(ctx context.Context, arg db.IncrementDailyActivityParams) error) *Querier_IncrementDailyActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateUserEmailVerificationTokens provides a mock function for the type Querier
+func (_mock *Querier) InvalidateUserEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateUserEmailVerificationTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_InvalidateUserEmailVerificationTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateUserEmailVerificationTokens'
+type Querier_InvalidateUserEmailVerificationTokens_Call struct {
+	*mock.Call
+}
+
+// InvalidateUserEmailVerificationTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) InvalidateUserEmailVerificationTokens(ctx interface{}, userID interface{}) *Querier_InvalidateUserEmailVerificationTokens_Call {
+	return &Querier_InvalidateUserEmailVerificationTokens_Call{Call: _e.mock.On("InvalidateUserEmailVerificationTokens", ctx, userID)}
+}
+
+func (_c *Querier_InvalidateUserEmailVerificationTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_InvalidateUserEmailVerificationTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_InvalidateUserEmailVerificationTokens_Call) Return(err error) *Querier_InvalidateUserEmailVerificationTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_InvalidateUserEmailVerificationTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_InvalidateUserEmailVerificationTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateUserPasswordResetTokens provides a mock function for the type Querier
+func (_mock *Querier) InvalidateUserPasswordResetTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateUserPasswordResetTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_InvalidateUserPasswordResetTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateUserPasswordResetTokens'
+type Querier_InvalidateUserPasswordResetTokens_Call struct {
+	*mock.Call
+}
+
+// InvalidateUserPasswordResetTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) InvalidateUserPasswordResetTokens(ctx interface{}, userID interface{}) *Querier_InvalidateUserPasswordResetTokens_Call {
+	return &Querier_InvalidateUserPasswordResetTokens_Call{Call: _e.mock.On("InvalidateUserPasswordResetTokens", ctx, userID)}
+}
+
+func (_c *Querier_InvalidateUserPasswordResetTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_InvalidateUserPasswordResetTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_InvalidateUserPasswordResetTokens_Call) Return(err error) *Querier_InvalidateUserPasswordResetTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_InvalidateUserPasswordResetTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_InvalidateUserPasswordResetTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAdminPredictions provides a mock function for the type Querier
+func (_mock *Querier) ListAdminPredictions(ctx context.Context, arg db.ListAdminPredictionsParams) ([]db.ListAdminPredictionsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAdminPredictions")
+	}
+
+	var r0 []db.ListAdminPredictionsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListAdminPredictionsParams) ([]db.ListAdminPredictionsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListAdminPredictionsParams) []db.ListAdminPredictionsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListAdminPredictionsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListAdminPredictionsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListAdminPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAdminPredictions'
+type Querier_ListAdminPredictions_Call struct {
+	*mock.Call
+}
+
+// ListAdminPredictions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListAdminPredictionsParams
+func (_e *Querier_Expecter) ListAdminPredictions(ctx interface{}, arg interface{}) *Querier_ListAdminPredictions_Call {
+	return &Querier_ListAdminPredictions_Call{Call: _e.mock.On("ListAdminPredictions", ctx, arg)}
+}
+
+func (_c *Querier_ListAdminPredictions_Call) Run(run func(ctx context.Context, arg db.ListAdminPredictionsParams)) *Querier_ListAdminPredictions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListAdminPredictionsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ListAdminPredictionsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListAdminPredictions_Call) Return(listAdminPredictionsRows []db.ListAdminPredictionsRow, err error) *Querier_ListAdminPredictions_Call {
+	_c.Call.Return(listAdminPredictionsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListAdminPredictions_Call) RunAndReturn(run func(ctx context.Context, arg db.ListAdminPredictionsParams) ([]db.ListAdminPredictionsRow, error)) *Querier_ListAdminPredictions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuditEvents provides a mock function for the type Querier
+func (_mock *Querier) ListAuditEvents(ctx context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 []db.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListAuditEventsParams) ([]db.AuditEvent, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListAuditEventsParams) []db.AuditEvent); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListAuditEventsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type Querier_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListAuditEventsParams
+func (_e *Querier_Expecter) ListAuditEvents(ctx interface{}, arg interface{}) *Querier_ListAuditEvents_Call {
+	return &Querier_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents", ctx, arg)}
+}
+
+func (_c *Querier_ListAuditEvents_Call) Run(run func(ctx context.Context, arg db.ListAuditEventsParams)) *Querier_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListAuditEventsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ListAuditEventsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListAuditEvents_Call) Return(auditEvents []db.AuditEvent, err error) *Querier_ListAuditEvents_Call {
+	_c.Call.Return(auditEvents, err)
+	return _c
+}
+
+func (_c *Querier_ListAuditEvents_Call) RunAndReturn(run func(ctx context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error)) *Querier_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiredGuestIDsAfter provides a mock function for the type Querier
+func (_mock *Querier) ListExpiredGuestIDsAfter(ctx context.Context, arg db.ListExpiredGuestIDsAfterParams) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiredGuestIDsAfter")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListExpiredGuestIDsAfterParams) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListExpiredGuestIDsAfterParams) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListExpiredGuestIDsAfterParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListExpiredGuestIDsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiredGuestIDsAfter'
+type Querier_ListExpiredGuestIDsAfter_Call struct {
+	*mock.Call
+}
+
+// ListExpiredGuestIDsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListExpiredGuestIDsAfterParams
+func (_e *Querier_Expecter) ListExpiredGuestIDsAfter(ctx interface{}, arg interface{}) *Querier_ListExpiredGuestIDsAfter_Call {
+	return &Querier_ListExpiredGuestIDsAfter_Call{Call: _e.mock.On("ListExpiredGuestIDsAfter", ctx, arg)}
+}
+
+func (_c *Querier_ListExpiredGuestIDsAfter_Call) Run(run func(ctx context.Context, arg db.ListExpiredGuestIDsAfterParams)) *Querier_ListExpiredGuestIDsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListExpiredGuestIDsAfterParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ListExpiredGuestIDsAfterParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListExpiredGuestIDsAfter_Call) Return(uUIDs []uuid.UUID, err error) *Querier_ListExpiredGuestIDsAfter_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *Querier_ListExpiredGuestIDsAfter_Call) RunAndReturn(run func(ctx context.Context, arg db.ListExpiredGuestIDsAfterParams) ([]uuid.UUID, error)) *Querier_ListExpiredGuestIDsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLoginHistory provides a mock function for the type Querier
+func (_mock *Querier) ListLoginHistory(ctx context.Context, arg db.ListLoginHistoryParams) ([]db.LoginHistory, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoginHistory")
+	}
+
+	var r0 []db.LoginHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListLoginHistoryParams) ([]db.LoginHistory, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListLoginHistoryParams) []db.LoginHistory); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.LoginHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListLoginHistoryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoginHistory'
+type Querier_ListLoginHistory_Call struct {
+	*mock.Call
+}
+
+// ListLoginHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListLoginHistoryParams
+func (_e *Querier_Expecter) ListLoginHistory(ctx interface{}, arg interface{}) *Querier_ListLoginHistory_Call {
+	return &Querier_ListLoginHistory_Call{Call: _e.mock.On("ListLoginHistory", ctx, arg)}
+}
+
+func (_c *Querier_ListLoginHistory_Call) Run(run func(ctx context.Context, arg db.ListLoginHistoryParams)) *Querier_ListLoginHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListLoginHistoryParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ListLoginHistoryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListLoginHistory_Call) Return(loginHistorys []db.LoginHistory, err error) *Querier_ListLoginHistory_Call {
+	_c.Call.Return(loginHistorys, err)
+	return _c
+}
+
+func (_c *Querier_ListLoginHistory_Call) RunAndReturn(run func(ctx context.Context, arg db.ListLoginHistoryParams) ([]db.LoginHistory, error)) *Querier_ListLoginHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationMembers provides a mock function for the type Querier
+func (_mock *Querier) ListOrganizationMembers(ctx context.Context, arg db.ListOrganizationMembersParams) ([]db.ListOrganizationMembersRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationMembers")
+	}
+
+	var r0 []db.ListOrganizationMembersRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOrganizationMembersParams) ([]db.ListOrganizationMembersRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOrganizationMembersParams) []db.ListOrganizationMembersRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListOrganizationMembersRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListOrganizationMembersParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListOrganizationMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationMembers'
+type Querier_ListOrganizationMembers_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListOrganizationMembersParams
+func (_e *Querier_Expecter) ListOrganizationMembers(ctx interface{}, arg interface{}) *Querier_ListOrganizationMembers_Call {
+	return &Querier_ListOrganizationMembers_Call{Call: _e.mock.On("ListOrganizationMembers", ctx, arg)}
+}
+
+func (_c *Querier_ListOrganizationMembers_Call) Run(run func(ctx context.Context, arg db.ListOrganizationMembersParams)) *Querier_ListOrganizationMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListOrganizationMembersParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ListOrganizationMembersParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListOrganizationMembers_Call) Return(listOrganizationMembersRows []db.ListOrganizationMembersRow, err error) *Querier_ListOrganizationMembers_Call {
+	_c.Call.Return(listOrganizationMembersRows, err)
+	return _c
+}
+
+func (_c *Querier_ListOrganizationMembers_Call) RunAndReturn(run func(ctx context.Context, arg db.ListOrganizationMembersParams) ([]db.ListOrganizationMembersRow, error)) *Querier_ListOrganizationMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStatsUserIDsAfter provides a mock function for the type Querier
+func (_mock *Querier) ListStatsUserIDsAfter(ctx context.Context, arg db.ListStatsUserIDsAfterParams) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatsUserIDsAfter")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListStatsUserIDsAfterParams) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListStatsUserIDsAfterParams) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListStatsUserIDsAfterParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListStatsUserIDsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatsUserIDsAfter'
+type Querier_ListStatsUserIDsAfter_Call struct {
+	*mock.Call
+}
+
+// ListStatsUserIDsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListStatsUserIDsAfterParams
+func (_e *Querier_Expecter) ListStatsUserIDsAfter(ctx interface{}, arg interface{}) *Querier_ListStatsUserIDsAfter_Call {
+	return &Querier_ListStatsUserIDsAfter_Call{Call: _e.mock.On("ListStatsUserIDsAfter", ctx, arg)}
+}
+
+func (_c *Querier_ListStatsUserIDsAfter_Call) Run(run func(ctx context.Context, arg db.ListStatsUserIDsAfterParams)) *Querier_ListStatsUserIDsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListStatsUserIDsAfterParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ListStatsUserIDsAfterParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListStatsUserIDsAfter_Call) Return(uUIDs []uuid.UUID, err error) *Querier_ListStatsUserIDsAfter_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *Querier_ListStatsUserIDsAfter_Call) RunAndReturn(run func(ctx context.Context, arg db.ListStatsUserIDsAfterParams) ([]uuid.UUID, error)) *Querier_ListStatsUserIDsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserAPIKeys provides a mock function for the type Querier
+func (_mock *Querier) ListUserAPIKeys(ctx context.Context, userID uuid.UUID) ([]db.ApiKey, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAPIKeys")
+	}
+
+	var r0 []db.ApiKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.ApiKey, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.ApiKey); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ApiKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAPIKeys'
+type Querier_ListUserAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListUserAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) ListUserAPIKeys(ctx interface{}, userID interface{}) *Querier_ListUserAPIKeys_Call {
+	return &Querier_ListUserAPIKeys_Call{Call: _e.mock.On("ListUserAPIKeys", ctx, userID)}
+}
+
+func (_c *Querier_ListUserAPIKeys_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_ListUserAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserAPIKeys_Call) Return(apiKeys []db.ApiKey, err error) *Querier_ListUserAPIKeys_Call {
+	_c.Call.Return(apiKeys, err)
+	return _c
+}
+
+func (_c *Querier_ListUserAPIKeys_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]db.ApiKey, error)) *Querier_ListUserAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserOrganizations provides a mock function for the type Querier
+func (_mock *Querier) ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]db.ListUserOrganizationsRow, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserOrganizations")
+	}
+
+	var r0 []db.ListUserOrganizationsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.ListUserOrganizationsRow, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.ListUserOrganizationsRow); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListUserOrganizationsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserOrganizations'
+type Querier_ListUserOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListUserOrganizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) ListUserOrganizations(ctx interface{}, userID interface{}) *Querier_ListUserOrganizations_Call {
+	return &Querier_ListUserOrganizations_Call{Call: _e.mock.On("ListUserOrganizations", ctx, userID)}
+}
+
+func (_c *Querier_ListUserOrganizations_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_ListUserOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserOrganizations_Call) Return(listUserOrganizationsRows []db.ListUserOrganizationsRow, err error) *Querier_ListUserOrganizations_Call {
+	_c.Call.Return(listUserOrganizationsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListUserOrganizations_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]db.ListUserOrganizationsRow, error)) *Querier_ListUserOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserPredictionsAfter provides a mock function for the type Querier
+func (_mock *Querier) ListUserPredictionsAfter(ctx context.Context, arg db.ListUserPredictionsAfterParams) ([]db.Prediction, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserPredictionsAfter")
+	}
+
+	var r0 []db.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListUserPredictionsAfterParams) ([]db.Prediction, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListUserPredictionsAfterParams) []db.Prediction); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListUserPredictionsAfterParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserPredictionsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserPredictionsAfter'
+type Querier_ListUserPredictionsAfter_Call struct {
+	*mock.Call
+}
+
+// ListUserPredictionsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListUserPredictionsAfterParams
+func (_e *Querier_Expecter) ListUserPredictionsAfter(ctx interface{}, arg interface{}) *Querier_ListUserPredictionsAfter_Call {
+	return &Querier_ListUserPredictionsAfter_Call{Call: _e.mock.On("ListUserPredictionsAfter", ctx, arg)}
+}
+
+func (_c *Querier_ListUserPredictionsAfter_Call) Run(run func(ctx context.Context, arg db.ListUserPredictionsAfterParams)) *Querier_ListUserPredictionsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListUserPredictionsAfterParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ListUserPredictionsAfterParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserPredictionsAfter_Call) Return(predictions []db.Prediction, err error) *Querier_ListUserPredictionsAfter_Call {
+	_c.Call.Return(predictions, err)
+	return _c
+}
+
+func (_c *Querier_ListUserPredictionsAfter_Call) RunAndReturn(run func(ctx context.Context, arg db.ListUserPredictionsAfterParams) ([]db.Prediction, error)) *Querier_ListUserPredictionsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockStatsByUserID provides a mock function for the type Querier
+func (_mock *Querier) LockStatsByUserID(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockStatsByUserID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_LockStatsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockStatsByUserID'
+type Querier_LockStatsByUserID_Call struct {
+	*mock.Call
+}
+
+// LockStatsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) LockStatsByUserID(ctx interface{}, userID interface{}) *Querier_LockStatsByUserID_Call {
+	return &Querier_LockStatsByUserID_Call{Call: _e.mock.On("LockStatsByUserID", ctx, userID)}
+}
+
+func (_c *Querier_LockStatsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_LockStatsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_LockStatsByUserID_Call) Return(err error) *Querier_LockStatsByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_LockStatsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_LockStatsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkUserEmailVerified provides a mock function for the type Querier
+func (_mock *Querier) MarkUserEmailVerified(ctx context.Context, arg db.MarkUserEmailVerifiedParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkUserEmailVerified")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.MarkUserEmailVerifiedParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.MarkUserEmailVerifiedParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.MarkUserEmailVerifiedParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_MarkUserEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUserEmailVerified'
+type Querier_MarkUserEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkUserEmailVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.MarkUserEmailVerifiedParams
+func (_e *Querier_Expecter) MarkUserEmailVerified(ctx interface{}, arg interface{}) *Querier_MarkUserEmailVerified_Call {
+	return &Querier_MarkUserEmailVerified_Call{Call: _e.mock.On("MarkUserEmailVerified", ctx, arg)}
+}
+
+func (_c *Querier_MarkUserEmailVerified_Call) Run(run func(ctx context.Context, arg db.MarkUserEmailVerifiedParams)) *Querier_MarkUserEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.MarkUserEmailVerifiedParams
+		if args[1] != nil {
+			arg1 = args[1].(db.MarkUserEmailVerifiedParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkUserEmailVerified_Call) Return(n int64, err error) *Querier_MarkUserEmailVerified_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_MarkUserEmailVerified_Call) RunAndReturn(run func(ctx context.Context, arg db.MarkUserEmailVerifiedParams) (int64, error)) *Querier_MarkUserEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeUser provides a mock function for the type Querier
+func (_mock *Querier) PurgeUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_PurgeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeUser'
+type Querier_PurgeUser_Call struct {
+	*mock.Call
+}
+
+// PurgeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Querier_Expecter) PurgeUser(ctx interface{}, id interface{}) *Querier_PurgeUser_Call {
+	return &Querier_PurgeUser_Call{Call: _e.mock.On("PurgeUser", ctx, id)}
+}
+
+func (_c *Querier_PurgeUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_PurgeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_PurgeUser_Call) Return(n int64, err error) *Querier_PurgeUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_PurgeUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (int64, error)) *Querier_PurgeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignDailyActivity provides a mock function for the type Querier
+func (_mock *Querier) ReassignDailyActivity(ctx context.Context, arg db.ReassignDailyActivityParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignDailyActivity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ReassignDailyActivityParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_ReassignDailyActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignDailyActivity'
+type Querier_ReassignDailyActivity_Call struct {
+	*mock.Call
+}
+
+// ReassignDailyActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ReassignDailyActivityParams
+func (_e *Querier_Expecter) ReassignDailyActivity(ctx interface{}, arg interface{}) *Querier_ReassignDailyActivity_Call {
+	return &Querier_ReassignDailyActivity_Call{Call: _e.mock.On("ReassignDailyActivity", ctx, arg)}
+}
+
+func (_c *Querier_ReassignDailyActivity_Call) Run(run func(ctx context.Context, arg db.ReassignDailyActivityParams)) *Querier_ReassignDailyActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ReassignDailyActivityParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ReassignDailyActivityParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ReassignDailyActivity_Call) Return(err error) *Querier_ReassignDailyActivity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_ReassignDailyActivity_Call) RunAndReturn(run func(ctx context.Context, arg db.ReassignDailyActivityParams) error) *Querier_ReassignDailyActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignPredictions provides a mock function for the type Querier
+func (_mock *Querier) ReassignPredictions(ctx context.Context, arg db.ReassignPredictionsParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignPredictions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ReassignPredictionsParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_ReassignPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignPredictions'
+type Querier_ReassignPredictions_Call struct {
+	*mock.Call
+}
+
+// ReassignPredictions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ReassignPredictionsParams
+func (_e *Querier_Expecter) ReassignPredictions(ctx interface{}, arg interface{}) *Querier_ReassignPredictions_Call {
+	return &Querier_ReassignPredictions_Call{Call: _e.mock.On("ReassignPredictions", ctx, arg)}
+}
+
+func (_c *Querier_ReassignPredictions_Call) Run(run func(ctx context.Context, arg db.ReassignPredictionsParams)) *Querier_ReassignPredictions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ReassignPredictionsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ReassignPredictionsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ReassignPredictions_Call) Return(err error) *Querier_ReassignPredictions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_ReassignPredictions_Call) RunAndReturn(run func(ctx context.Context, arg db.ReassignPredictionsParams) error) *Querier_ReassignPredictions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignRatingPeriods provides a mock function for the type Querier
+func (_mock *Querier) ReassignRatingPeriods(ctx context.Context, arg db.ReassignRatingPeriodsParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignRatingPeriods")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ReassignRatingPeriodsParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_ReassignRatingPeriods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignRatingPeriods'
+type Querier_ReassignRatingPeriods_Call struct {
+	*mock.Call
+}
+
+// ReassignRatingPeriods is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ReassignRatingPeriodsParams
+func (_e *Querier_Expecter) ReassignRatingPeriods(ctx interface{}, arg interface{}) *Querier_ReassignRatingPeriods_Call {
+	return &Querier_ReassignRatingPeriods_Call{Call: _e.mock.On("ReassignRatingPeriods", ctx, arg)}
+}
+
+func (_c *Querier_ReassignRatingPeriods_Call) Run(run func(ctx context.Context, arg db.ReassignRatingPeriodsParams)) *Querier_ReassignRatingPeriods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ReassignRatingPeriodsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.ReassignRatingPeriodsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ReassignRatingPeriods_Call) Return(err error) *Querier_ReassignRatingPeriods_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_ReassignRatingPeriods_Call) RunAndReturn(run func(ctx context.Context, arg db.ReassignRatingPeriodsParams) error) *Querier_ReassignRatingPeriods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignStats provides a mock function for the type Querier
+func (_mock *Querier) ReassignStats(ctx context.Context, arg db.ReassignStatsParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignStats")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CompletePredictionParams) error); ok {
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ReassignStatsParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ReassignStatsParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ReassignStatsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Querier_CompletePrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompletePrediction'
-type Querier_CompletePrediction_Call struct {
+// Querier_ReassignStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignStats'
+type Querier_ReassignStats_Call struct {
 	*mock.Call
 }
 
-// CompletePrediction is a helper method to define mock.On call
+// ReassignStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CompletePredictionParams
-func (_e *Querier_Expecter) CompletePrediction(ctx interface{}, arg interface{}) *Querier_CompletePrediction_Call {
-	return &Querier_CompletePrediction_Call{Call: _e.mock.On("CompletePrediction", ctx, arg)}
+//   - arg db.ReassignStatsParams
+func (_e *Querier_Expecter) ReassignStats(ctx interface{}, arg interface{}) *Querier_ReassignStats_Call {
+	return &Querier_ReassignStats_Call{Call: _e.mock.On("ReassignStats", ctx, arg)}
 }
 
-func (_c *Querier_CompletePrediction_Call) Run(run func(ctx context.Context, arg db.CompletePredictionParams)) *Querier_CompletePrediction_Call {
+func (_c *Querier_ReassignStats_Call) Run(run func(ctx context.Context, arg db.ReassignStatsParams)) *Querier_ReassignStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.CompletePredictionParams
+		var arg1 db.ReassignStatsParams
 		if args[1] != nil {
-			arg1 = args[1].(db.CompletePredictionParams)
+			arg1 = args[1].(db.ReassignStatsParams)
 		}
 		run(
 			arg0,
@@ -85,97 +5965,101 @@ func (_c *Querier_CompletePrediction_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *Querier_CompletePrediction_Call) Return(err error) *Querier_CompletePrediction_Call {
-	_c.Call.Return(err)
+func (_c *Querier_ReassignStats_Call) Return(n int64, err error) *Querier_ReassignStats_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_CompletePrediction_Call) RunAndReturn(run func(ctx context.Context, arg db.CompletePredictionParams) error) *Querier_CompletePrediction_Call {
+func (_c *Querier_ReassignStats_Call) RunAndReturn(run func(ctx context.Context, arg db.ReassignStatsParams) (int64, error)) *Querier_ReassignStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountUsers provides a mock function for the type Querier
-func (_mock *Querier) CountUsers(ctx context.Context) (int64, error) {
-	ret := _mock.Called(ctx)
+// RestoreUser provides a mock function for the type Querier
+func (_mock *Querier) RestoreUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountUsers")
+		panic("no return value specified for RestoreUser")
 	}
 
 	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
-type Querier_CountUsers_Call struct {
+// Querier_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type Querier_RestoreUser_Call struct {
 	*mock.Call
 }
 
-// CountUsers is a helper method to define mock.On call
+// RestoreUser is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *Querier_Expecter) CountUsers(ctx interface{}) *Querier_CountUsers_Call {
-	return &Querier_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx)}
+//   - id uuid.UUID
+func (_e *Querier_Expecter) RestoreUser(ctx interface{}, id interface{}) *Querier_RestoreUser_Call {
+	return &Querier_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, id)}
 }
 
-func (_c *Querier_CountUsers_Call) Run(run func(ctx context.Context)) *Querier_CountUsers_Call {
+func (_c *Querier_RestoreUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_RestoreUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *Querier_CountUsers_Call) Return(n int64, err error) *Querier_CountUsers_Call {
+func (_c *Querier_RestoreUser_Call) Return(n int64, err error) *Querier_RestoreUser_Call {
 	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_CountUsers_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_CountUsers_Call {
+func (_c *Querier_RestoreUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (int64, error)) *Querier_RestoreUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateLoginHistory provides a mock function for the type Querier
-func (_mock *Querier) CreateLoginHistory(ctx context.Context, arg db.CreateLoginHistoryParams) (uuid.UUID, error) {
+// RevokeAPIKey provides a mock function for the type Querier
+func (_mock *Querier) RevokeAPIKey(ctx context.Context, arg db.RevokeAPIKeyParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateLoginHistory")
+		panic("no return value specified for RevokeAPIKey")
 	}
 
-	var r0 uuid.UUID
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateLoginHistoryParams) (uuid.UUID, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.RevokeAPIKeyParams) (int64, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateLoginHistoryParams) uuid.UUID); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.RevokeAPIKeyParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateLoginHistoryParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.RevokeAPIKeyParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -183,27 +6067,27 @@ func (_mock *Querier) CreateLoginHistory(ctx context.Context, arg db.CreateLogin
 	return r0, r1
 }
 
-// Querier_CreateLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoginHistory'
-type Querier_CreateLoginHistory_Call struct {
+// Querier_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type Querier_RevokeAPIKey_Call struct {
 	*mock.Call
 }
 
-// CreateLoginHistory is a helper method to define mock.On call
+// RevokeAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateLoginHistoryParams
-func (_e *Querier_Expecter) CreateLoginHistory(ctx interface{}, arg interface{}) *Querier_CreateLoginHistory_Call {
-	return &Querier_CreateLoginHistory_Call{Call: _e.mock.On("CreateLoginHistory", ctx, arg)}
+//   - arg db.RevokeAPIKeyParams
+func (_e *Querier_Expecter) RevokeAPIKey(ctx interface{}, arg interface{}) *Querier_RevokeAPIKey_Call {
+	return &Querier_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, arg)}
 }
 
-func (_c *Querier_CreateLoginHistory_Call) Run(run func(ctx context.Context, arg db.CreateLoginHistoryParams)) *Querier_CreateLoginHistory_Call {
+func (_c *Querier_RevokeAPIKey_Call) Run(run func(ctx context.Context, arg db.RevokeAPIKeyParams)) *Querier_RevokeAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.CreateLoginHistoryParams
+		var arg1 db.RevokeAPIKeyParams
 		if args[1] != nil {
-			arg1 = args[1].(db.CreateLoginHistoryParams)
+			arg1 = args[1].(db.RevokeAPIKeyParams)
 		}
 		run(
 			arg0,
@@ -213,35 +6097,149 @@ func (_c *Querier_CreateLoginHistory_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *Querier_CreateLoginHistory_Call) Return(uUID uuid.UUID, err error) *Querier_CreateLoginHistory_Call {
-	_c.Call.Return(uUID, err)
+func (_c *Querier_RevokeAPIKey_Call) Return(n int64, err error) *Querier_RevokeAPIKey_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_CreateLoginHistory_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateLoginHistoryParams) (uuid.UUID, error)) *Querier_CreateLoginHistory_Call {
+func (_c *Querier_RevokeAPIKey_Call) RunAndReturn(run func(ctx context.Context, arg db.RevokeAPIKeyParams) (int64, error)) *Querier_RevokeAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateNewPrediction provides a mock function for the type Querier
-func (_mock *Querier) CreateNewPrediction(ctx context.Context, arg db.CreateNewPredictionParams) (db.Prediction, error) {
+// RevokeAllUserTokens provides a mock function for the type Querier
+func (_mock *Querier) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllUserTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RevokeAllUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllUserTokens'
+type Querier_RevokeAllUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeAllUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Querier_Expecter) RevokeAllUserTokens(ctx interface{}, userID interface{}) *Querier_RevokeAllUserTokens_Call {
+	return &Querier_RevokeAllUserTokens_Call{Call: _e.mock.On("RevokeAllUserTokens", ctx, userID)}
+}
+
+func (_c *Querier_RevokeAllUserTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_RevokeAllUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RevokeAllUserTokens_Call) Return(err error) *Querier_RevokeAllUserTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RevokeAllUserTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_RevokeAllUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRefreshToken provides a mock function for the type Querier
+func (_mock *Querier) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RevokeRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshToken'
+type Querier_RevokeRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RevokeRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Querier_Expecter) RevokeRefreshToken(ctx interface{}, tokenHash interface{}) *Querier_RevokeRefreshToken_Call {
+	return &Querier_RevokeRefreshToken_Call{Call: _e.mock.On("RevokeRefreshToken", ctx, tokenHash)}
+}
+
+func (_c *Querier_RevokeRefreshToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_RevokeRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RevokeRefreshToken_Call) Return(err error) *Querier_RevokeRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RevokeRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) error) *Querier_RevokeRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRefreshTokenByID provides a mock function for the type Querier
+func (_mock *Querier) RevokeRefreshTokenByID(ctx context.Context, arg db.RevokeRefreshTokenByIDParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateNewPrediction")
+		panic("no return value specified for RevokeRefreshTokenByID")
 	}
 
-	var r0 db.Prediction
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateNewPredictionParams) (db.Prediction, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.RevokeRefreshTokenByIDParams) (int64, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateNewPredictionParams) db.Prediction); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.RevokeRefreshTokenByIDParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Prediction)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateNewPredictionParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.RevokeRefreshTokenByIDParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -249,27 +6247,27 @@ func (_mock *Querier) CreateNewPrediction(ctx context.Context, arg db.CreateNewP
 	return r0, r1
 }
 
-// Querier_CreateNewPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewPrediction'
-type Querier_CreateNewPrediction_Call struct {
+// Querier_RevokeRefreshTokenByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshTokenByID'
+type Querier_RevokeRefreshTokenByID_Call struct {
 	*mock.Call
 }
 
-// CreateNewPrediction is a helper method to define mock.On call
+// RevokeRefreshTokenByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateNewPredictionParams
-func (_e *Querier_Expecter) CreateNewPrediction(ctx interface{}, arg interface{}) *Querier_CreateNewPrediction_Call {
-	return &Querier_CreateNewPrediction_Call{Call: _e.mock.On("CreateNewPrediction", ctx, arg)}
+//   - arg db.RevokeRefreshTokenByIDParams
+func (_e *Querier_Expecter) RevokeRefreshTokenByID(ctx interface{}, arg interface{}) *Querier_RevokeRefreshTokenByID_Call {
+	return &Querier_RevokeRefreshTokenByID_Call{Call: _e.mock.On("RevokeRefreshTokenByID", ctx, arg)}
 }
 
-func (_c *Querier_CreateNewPrediction_Call) Run(run func(ctx context.Context, arg db.CreateNewPredictionParams)) *Querier_CreateNewPrediction_Call {
+func (_c *Querier_RevokeRefreshTokenByID_Call) Run(run func(ctx context.Context, arg db.RevokeRefreshTokenByIDParams)) *Querier_RevokeRefreshTokenByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.CreateNewPredictionParams
+		var arg1 db.RevokeRefreshTokenByIDParams
 		if args[1] != nil {
-			arg1 = args[1].(db.CreateNewPredictionParams)
+			arg1 = args[1].(db.RevokeRefreshTokenByIDParams)
 		}
 		run(
 			arg0,
@@ -279,37 +6277,35 @@ func (_c *Querier_CreateNewPrediction_Call) Run(run func(ctx context.Context, ar
 	return _c
 }
 
-func (_c *Querier_CreateNewPrediction_Call) Return(prediction db.Prediction, err error) *Querier_CreateNewPrediction_Call {
-	_c.Call.Return(prediction, err)
+func (_c *Querier_RevokeRefreshTokenByID_Call) Return(n int64, err error) *Querier_RevokeRefreshTokenByID_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_CreateNewPrediction_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateNewPredictionParams) (db.Prediction, error)) *Querier_CreateNewPrediction_Call {
+func (_c *Querier_RevokeRefreshTokenByID_Call) RunAndReturn(run func(ctx context.Context, arg db.RevokeRefreshTokenByIDParams) (int64, error)) *Querier_RevokeRefreshTokenByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRefreshToken provides a mock function for the type Querier
-func (_mock *Querier) CreateRefreshToken(ctx context.Context, arg db.CreateRefreshTokenParams) (uuid.UUID, error) {
+// SetPredictionFeedback provides a mock function for the type Querier
+func (_mock *Querier) SetPredictionFeedback(ctx context.Context, arg db.SetPredictionFeedbackParams) (db.Prediction, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRefreshToken")
+		panic("no return value specified for SetPredictionFeedback")
 	}
 
-	var r0 uuid.UUID
+	var r0 db.Prediction
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateRefreshTokenParams) (uuid.UUID, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.SetPredictionFeedbackParams) (db.Prediction, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateRefreshTokenParams) uuid.UUID); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.SetPredictionFeedbackParams) db.Prediction); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
-		}
+		r0 = ret.Get(0).(db.Prediction)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateRefreshTokenParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.SetPredictionFeedbackParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -317,27 +6313,27 @@ func (_mock *Querier) CreateRefreshToken(ctx context.Context, arg db.CreateRefre
 	return r0, r1
 }
 
-// Querier_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
-type Querier_CreateRefreshToken_Call struct {
+// Querier_SetPredictionFeedback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPredictionFeedback'
+type Querier_SetPredictionFeedback_Call struct {
 	*mock.Call
 }
 
-// CreateRefreshToken is a helper method to define mock.On call
+// SetPredictionFeedback is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateRefreshTokenParams
-func (_e *Querier_Expecter) CreateRefreshToken(ctx interface{}, arg interface{}) *Querier_CreateRefreshToken_Call {
-	return &Querier_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, arg)}
+//   - arg db.SetPredictionFeedbackParams
+func (_e *Querier_Expecter) SetPredictionFeedback(ctx interface{}, arg interface{}) *Querier_SetPredictionFeedback_Call {
+	return &Querier_SetPredictionFeedback_Call{Call: _e.mock.On("SetPredictionFeedback", ctx, arg)}
 }
 
-func (_c *Querier_CreateRefreshToken_Call) Run(run func(ctx context.Context, arg db.CreateRefreshTokenParams)) *Querier_CreateRefreshToken_Call {
+func (_c *Querier_SetPredictionFeedback_Call) Run(run func(ctx context.Context, arg db.SetPredictionFeedbackParams)) *Querier_SetPredictionFeedback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.CreateRefreshTokenParams
+		var arg1 db.SetPredictionFeedbackParams
 		if args[1] != nil {
-			arg1 = args[1].(db.CreateRefreshTokenParams)
+			arg1 = args[1].(db.SetPredictionFeedbackParams)
 		}
 		run(
 			arg0,
@@ -347,37 +6343,35 @@ func (_c *Querier_CreateRefreshToken_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *Querier_CreateRefreshToken_Call) Return(uUID uuid.UUID, err error) *Querier_CreateRefreshToken_Call {
-	_c.Call.Return(uUID, err)
+func (_c *Querier_SetPredictionFeedback_Call) Return(prediction db.Prediction, err error) *Querier_SetPredictionFeedback_Call {
+	_c.Call.Return(prediction, err)
 	return _c
 }
 
-func (_c *Querier_CreateRefreshToken_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateRefreshTokenParams) (uuid.UUID, error)) *Querier_CreateRefreshToken_Call {
+func (_c *Querier_SetPredictionFeedback_Call) RunAndReturn(run func(ctx context.Context, arg db.SetPredictionFeedbackParams) (db.Prediction, error)) *Querier_SetPredictionFeedback_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function for the type Querier
-func (_mock *Querier) CreateUser(ctx context.Context, arg db.CreateUserParams) (uuid.UUID, error) {
+// SetPredictionVerifiedLabel provides a mock function for the type Querier
+func (_mock *Querier) SetPredictionVerifiedLabel(ctx context.Context, arg db.SetPredictionVerifiedLabelParams) (db.Prediction, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for SetPredictionVerifiedLabel")
 	}
 
-	var r0 uuid.UUID
+	var r0 db.Prediction
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (uuid.UUID, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.SetPredictionVerifiedLabelParams) (db.Prediction, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) uuid.UUID); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.SetPredictionVerifiedLabelParams) db.Prediction); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
-		}
+		r0 = ret.Get(0).(db.Prediction)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.SetPredictionVerifiedLabelParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -385,27 +6379,27 @@ func (_mock *Querier) CreateUser(ctx context.Context, arg db.CreateUserParams) (
 	return r0, r1
 }
 
-// Querier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type Querier_CreateUser_Call struct {
+// Querier_SetPredictionVerifiedLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPredictionVerifiedLabel'
+type Querier_SetPredictionVerifiedLabel_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// SetPredictionVerifiedLabel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateUserParams
-func (_e *Querier_Expecter) CreateUser(ctx interface{}, arg interface{}) *Querier_CreateUser_Call {
-	return &Querier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg db.SetPredictionVerifiedLabelParams
+func (_e *Querier_Expecter) SetPredictionVerifiedLabel(ctx interface{}, arg interface{}) *Querier_SetPredictionVerifiedLabel_Call {
+	return &Querier_SetPredictionVerifiedLabel_Call{Call: _e.mock.On("SetPredictionVerifiedLabel", ctx, arg)}
 }
 
-func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *Querier_CreateUser_Call {
+func (_c *Querier_SetPredictionVerifiedLabel_Call) Run(run func(ctx context.Context, arg db.SetPredictionVerifiedLabelParams)) *Querier_SetPredictionVerifiedLabel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.CreateUserParams
+		var arg1 db.SetPredictionVerifiedLabelParams
 		if args[1] != nil {
-			arg1 = args[1].(db.CreateUserParams)
+			arg1 = args[1].(db.SetPredictionVerifiedLabelParams)
 		}
 		run(
 			arg0,
@@ -415,22 +6409,22 @@ func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg db.Crea
 	return _c
 }
 
-func (_c *Querier_CreateUser_Call) Return(uUID uuid.UUID, err error) *Querier_CreateUser_Call {
-	_c.Call.Return(uUID, err)
+func (_c *Querier_SetPredictionVerifiedLabel_Call) Return(prediction db.Prediction, err error) *Querier_SetPredictionVerifiedLabel_Call {
+	_c.Call.Return(prediction, err)
 	return _c
 }
 
-func (_c *Querier_CreateUser_Call) RunAndReturn(run func(ctx context.Context, arg db.CreateUserParams) (uuid.UUID, error)) *Querier_CreateUser_Call {
+func (_c *Querier_SetPredictionVerifiedLabel_Call) RunAndReturn(run func(ctx context.Context, arg db.SetPredictionVerifiedLabelParams) (db.Prediction, error)) *Querier_SetPredictionVerifiedLabel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function for the type Querier
-func (_mock *Querier) DeleteUser(ctx context.Context, id uuid.UUID) error {
+// TouchAPIKey provides a mock function for the type Querier
+func (_mock *Querier) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for TouchAPIKey")
 	}
 
 	var r0 error
@@ -442,19 +6436,19 @@ func (_mock *Querier) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return r0
 }
 
-// Querier_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type Querier_DeleteUser_Call struct {
+// Querier_TouchAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAPIKey'
+type Querier_TouchAPIKey_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// TouchAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *Querier_Expecter) DeleteUser(ctx interface{}, id interface{}) *Querier_DeleteUser_Call {
-	return &Querier_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+func (_e *Querier_Expecter) TouchAPIKey(ctx interface{}, id interface{}) *Querier_TouchAPIKey_Call {
+	return &Querier_TouchAPIKey_Call{Call: _e.mock.On("TouchAPIKey", ctx, id)}
 }
 
-func (_c *Querier_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_DeleteUser_Call {
+func (_c *Querier_TouchAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_TouchAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -472,65 +6466,54 @@ func (_c *Querier_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUI
 	return _c
 }
 
-func (_c *Querier_DeleteUser_Call) Return(err error) *Querier_DeleteUser_Call {
+func (_c *Querier_TouchAPIKey_Call) Return(err error) *Querier_TouchAPIKey_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Querier_DeleteUser_Call {
+func (_c *Querier_TouchAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Querier_TouchAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetActiveTokensByUser provides a mock function for the type Querier
-func (_mock *Querier) GetActiveTokensByUser(ctx context.Context, userID uuid.UUID) ([]db.RefreshToken, error) {
-	ret := _mock.Called(ctx, userID)
+// TouchUserIdentity provides a mock function for the type Querier
+func (_mock *Querier) TouchUserIdentity(ctx context.Context, arg db.TouchUserIdentityParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetActiveTokensByUser")
+		panic("no return value specified for TouchUserIdentity")
 	}
 
-	var r0 []db.RefreshToken
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.RefreshToken, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.RefreshToken); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.RefreshToken)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.TouchUserIdentityParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_GetActiveTokensByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveTokensByUser'
-type Querier_GetActiveTokensByUser_Call struct {
+// Querier_TouchUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchUserIdentity'
+type Querier_TouchUserIdentity_Call struct {
 	*mock.Call
 }
 
-// GetActiveTokensByUser is a helper method to define mock.On call
+// TouchUserIdentity is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uuid.UUID
-func (_e *Querier_Expecter) GetActiveTokensByUser(ctx interface{}, userID interface{}) *Querier_GetActiveTokensByUser_Call {
-	return &Querier_GetActiveTokensByUser_Call{Call: _e.mock.On("GetActiveTokensByUser", ctx, userID)}
+//   - arg db.TouchUserIdentityParams
+func (_e *Querier_Expecter) TouchUserIdentity(ctx interface{}, arg interface{}) *Querier_TouchUserIdentity_Call {
+	return &Querier_TouchUserIdentity_Call{Call: _e.mock.On("TouchUserIdentity", ctx, arg)}
 }
 
-func (_c *Querier_GetActiveTokensByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_GetActiveTokensByUser_Call {
+func (_c *Querier_TouchUserIdentity_Call) Run(run func(ctx context.Context, arg db.TouchUserIdentityParams)) *Querier_TouchUserIdentity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 db.TouchUserIdentityParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(db.TouchUserIdentityParams)
 		}
 		run(
 			arg0,
@@ -540,33 +6523,33 @@ func (_c *Querier_GetActiveTokensByUser_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *Querier_GetActiveTokensByUser_Call) Return(refreshTokens []db.RefreshToken, err error) *Querier_GetActiveTokensByUser_Call {
-	_c.Call.Return(refreshTokens, err)
+func (_c *Querier_TouchUserIdentity_Call) Return(err error) *Querier_TouchUserIdentity_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_GetActiveTokensByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]db.RefreshToken, error)) *Querier_GetActiveTokensByUser_Call {
+func (_c *Querier_TouchUserIdentity_Call) RunAndReturn(run func(ctx context.Context, arg db.TouchUserIdentityParams) error) *Querier_TouchUserIdentity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAdminUserByID provides a mock function for the type Querier
-func (_mock *Querier) GetAdminUserByID(ctx context.Context, id uuid.UUID) (db.GetAdminUserByIDRow, error) {
+// UnbanUser provides a mock function for the type Querier
+func (_mock *Querier) UnbanUser(ctx context.Context, id uuid.UUID) (int64, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAdminUserByID")
+		panic("no return value specified for UnbanUser")
 	}
 
-	var r0 db.GetAdminUserByIDRow
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.GetAdminUserByIDRow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.GetAdminUserByIDRow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(db.GetAdminUserByIDRow)
+		r0 = ret.Get(0).(int64)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
 		r1 = returnFunc(ctx, id)
@@ -576,19 +6559,19 @@ func (_mock *Querier) GetAdminUserByID(ctx context.Context, id uuid.UUID) (db.Ge
 	return r0, r1
 }
 
-// Querier_GetAdminUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUserByID'
-type Querier_GetAdminUserByID_Call struct {
+// Querier_UnbanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnbanUser'
+type Querier_UnbanUser_Call struct {
 	*mock.Call
 }
 
-// GetAdminUserByID is a helper method to define mock.On call
+// UnbanUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *Querier_Expecter) GetAdminUserByID(ctx interface{}, id interface{}) *Querier_GetAdminUserByID_Call {
-	return &Querier_GetAdminUserByID_Call{Call: _e.mock.On("GetAdminUserByID", ctx, id)}
+func (_e *Querier_Expecter) UnbanUser(ctx interface{}, id interface{}) *Querier_UnbanUser_Call {
+	return &Querier_UnbanUser_Call{Call: _e.mock.On("UnbanUser", ctx, id)}
 }
 
-func (_c *Querier_GetAdminUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetAdminUserByID_Call {
+func (_c *Querier_UnbanUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_UnbanUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -606,65 +6589,63 @@ func (_c *Querier_GetAdminUserByID_Call) Run(run func(ctx context.Context, id uu
 	return _c
 }
 
-func (_c *Querier_GetAdminUserByID_Call) Return(getAdminUserByIDRow db.GetAdminUserByIDRow, err error) *Querier_GetAdminUserByID_Call {
-	_c.Call.Return(getAdminUserByIDRow, err)
+func (_c *Querier_UnbanUser_Call) Return(n int64, err error) *Querier_UnbanUser_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetAdminUserByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.GetAdminUserByIDRow, error)) *Querier_GetAdminUserByID_Call {
+func (_c *Querier_UnbanUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (int64, error)) *Querier_UnbanUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAdminUsers provides a mock function for the type Querier
-func (_mock *Querier) GetAdminUsers(ctx context.Context, arg db.GetAdminUsersParams) ([]db.GetAdminUsersRow, error) {
-	ret := _mock.Called(ctx, arg)
+// UnlockUserLogin provides a mock function for the type Querier
+func (_mock *Querier) UnlockUserLogin(ctx context.Context, id uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAdminUsers")
+		panic("no return value specified for UnlockUserLogin")
 	}
 
-	var r0 []db.GetAdminUsersRow
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetAdminUsersParams) ([]db.GetAdminUsersRow, error)); ok {
-		return returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetAdminUsersParams) []db.GetAdminUsersRow); ok {
-		r0 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.GetAdminUsersRow)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetAdminUsersParams) error); ok {
-		r1 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetAdminUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUsers'
-type Querier_GetAdminUsers_Call struct {
+// Querier_UnlockUserLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUserLogin'
+type Querier_UnlockUserLogin_Call struct {
 	*mock.Call
 }
 
-// GetAdminUsers is a helper method to define mock.On call
+// UnlockUserLogin is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetAdminUsersParams
-func (_e *Querier_Expecter) GetAdminUsers(ctx interface{}, arg interface{}) *Querier_GetAdminUsers_Call {
-	return &Querier_GetAdminUsers_Call{Call: _e.mock.On("GetAdminUsers", ctx, arg)}
+//   - id uuid.UUID
+func (_e *Querier_Expecter) UnlockUserLogin(ctx interface{}, id interface{}) *Querier_UnlockUserLogin_Call {
+	return &Querier_UnlockUserLogin_Call{Call: _e.mock.On("UnlockUserLogin", ctx, id)}
 }
 
-func (_c *Querier_GetAdminUsers_Call) Run(run func(ctx context.Context, arg db.GetAdminUsersParams)) *Querier_GetAdminUsers_Call {
+func (_c *Querier_UnlockUserLogin_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_UnlockUserLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.GetAdminUsersParams
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(db.GetAdminUsersParams)
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -674,37 +6655,35 @@ func (_c *Querier_GetAdminUsers_Call) Run(run func(ctx context.Context, arg db.G
 	return _c
 }
 
-func (_c *Querier_GetAdminUsers_Call) Return(getAdminUsersRows []db.GetAdminUsersRow, err error) *Querier_GetAdminUsers_Call {
-	_c.Call.Return(getAdminUsersRows, err)
+func (_c *Querier_UnlockUserLogin_Call) Return(n int64, err error) *Querier_UnlockUserLogin_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetAdminUsers_Call) RunAndReturn(run func(ctx context.Context, arg db.GetAdminUsersParams) ([]db.GetAdminUsersRow, error)) *Querier_GetAdminUsers_Call {
+func (_c *Querier_UnlockUserLogin_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (int64, error)) *Querier_UnlockUserLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLoginHistoryByUser provides a mock function for the type Querier
-func (_mock *Querier) GetLoginHistoryByUser(ctx context.Context, arg db.GetLoginHistoryByUserParams) ([]db.LoginHistory, error) {
+// UpdateOrganizationInviteCode provides a mock function for the type Querier
+func (_mock *Querier) UpdateOrganizationInviteCode(ctx context.Context, arg db.UpdateOrganizationInviteCodeParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLoginHistoryByUser")
+		panic("no return value specified for UpdateOrganizationInviteCode")
 	}
 
-	var r0 []db.LoginHistory
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginHistoryByUserParams) ([]db.LoginHistory, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateOrganizationInviteCodeParams) (int64, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetLoginHistoryByUserParams) []db.LoginHistory); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateOrganizationInviteCodeParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.LoginHistory)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetLoginHistoryByUserParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UpdateOrganizationInviteCodeParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -712,27 +6691,27 @@ func (_mock *Querier) GetLoginHistoryByUser(ctx context.Context, arg db.GetLogin
 	return r0, r1
 }
 
-// Querier_GetLoginHistoryByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginHistoryByUser'
-type Querier_GetLoginHistoryByUser_Call struct {
+// Querier_UpdateOrganizationInviteCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInviteCode'
+type Querier_UpdateOrganizationInviteCode_Call struct {
 	*mock.Call
 }
 
-// GetLoginHistoryByUser is a helper method to define mock.On call
+// UpdateOrganizationInviteCode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetLoginHistoryByUserParams
-func (_e *Querier_Expecter) GetLoginHistoryByUser(ctx interface{}, arg interface{}) *Querier_GetLoginHistoryByUser_Call {
-	return &Querier_GetLoginHistoryByUser_Call{Call: _e.mock.On("GetLoginHistoryByUser", ctx, arg)}
+//   - arg db.UpdateOrganizationInviteCodeParams
+func (_e *Querier_Expecter) UpdateOrganizationInviteCode(ctx interface{}, arg interface{}) *Querier_UpdateOrganizationInviteCode_Call {
+	return &Querier_UpdateOrganizationInviteCode_Call{Call: _e.mock.On("UpdateOrganizationInviteCode", ctx, arg)}
 }
 
-func (_c *Querier_GetLoginHistoryByUser_Call) Run(run func(ctx context.Context, arg db.GetLoginHistoryByUserParams)) *Querier_GetLoginHistoryByUser_Call {
+func (_c *Querier_UpdateOrganizationInviteCode_Call) Run(run func(ctx context.Context, arg db.UpdateOrganizationInviteCodeParams)) *Querier_UpdateOrganizationInviteCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.GetLoginHistoryByUserParams
+		var arg1 db.UpdateOrganizationInviteCodeParams
 		if args[1] != nil {
-			arg1 = args[1].(db.GetLoginHistoryByUserParams)
+			arg1 = args[1].(db.UpdateOrganizationInviteCodeParams)
 		}
 		run(
 			arg0,
@@ -742,63 +6721,63 @@ func (_c *Querier_GetLoginHistoryByUser_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *Querier_GetLoginHistoryByUser_Call) Return(loginHistorys []db.LoginHistory, err error) *Querier_GetLoginHistoryByUser_Call {
-	_c.Call.Return(loginHistorys, err)
+func (_c *Querier_UpdateOrganizationInviteCode_Call) Return(n int64, err error) *Querier_UpdateOrganizationInviteCode_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetLoginHistoryByUser_Call) RunAndReturn(run func(ctx context.Context, arg db.GetLoginHistoryByUserParams) ([]db.LoginHistory, error)) *Querier_GetLoginHistoryByUser_Call {
+func (_c *Querier_UpdateOrganizationInviteCode_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateOrganizationInviteCodeParams) (int64, error)) *Querier_UpdateOrganizationInviteCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrediction provides a mock function for the type Querier
-func (_mock *Querier) GetPrediction(ctx context.Context, id uuid.UUID) (db.Prediction, error) {
-	ret := _mock.Called(ctx, id)
+// UpdateOrganizationMemberRole provides a mock function for the type Querier
+func (_mock *Querier) UpdateOrganizationMemberRole(ctx context.Context, arg db.UpdateOrganizationMemberRoleParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrediction")
+		panic("no return value specified for UpdateOrganizationMemberRole")
 	}
 
-	var r0 db.Prediction
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Prediction, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateOrganizationMemberRoleParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Prediction); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateOrganizationMemberRoleParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Prediction)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UpdateOrganizationMemberRoleParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrediction'
-type Querier_GetPrediction_Call struct {
+// Querier_UpdateOrganizationMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationMemberRole'
+type Querier_UpdateOrganizationMemberRole_Call struct {
 	*mock.Call
 }
 
-// GetPrediction is a helper method to define mock.On call
+// UpdateOrganizationMemberRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *Querier_Expecter) GetPrediction(ctx interface{}, id interface{}) *Querier_GetPrediction_Call {
-	return &Querier_GetPrediction_Call{Call: _e.mock.On("GetPrediction", ctx, id)}
+//   - arg db.UpdateOrganizationMemberRoleParams
+func (_e *Querier_Expecter) UpdateOrganizationMemberRole(ctx interface{}, arg interface{}) *Querier_UpdateOrganizationMemberRole_Call {
+	return &Querier_UpdateOrganizationMemberRole_Call{Call: _e.mock.On("UpdateOrganizationMemberRole", ctx, arg)}
 }
 
-func (_c *Querier_GetPrediction_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetPrediction_Call {
+func (_c *Querier_UpdateOrganizationMemberRole_Call) Run(run func(ctx context.Context, arg db.UpdateOrganizationMemberRoleParams)) *Querier_UpdateOrganizationMemberRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 db.UpdateOrganizationMemberRoleParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(db.UpdateOrganizationMemberRoleParams)
 		}
 		run(
 			arg0,
@@ -808,65 +6787,54 @@ func (_c *Querier_GetPrediction_Call) Run(run func(ctx context.Context, id uuid.
 	return _c
 }
 
-func (_c *Querier_GetPrediction_Call) Return(prediction db.Prediction, err error) *Querier_GetPrediction_Call {
-	_c.Call.Return(prediction, err)
+func (_c *Querier_UpdateOrganizationMemberRole_Call) Return(n int64, err error) *Querier_UpdateOrganizationMemberRole_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetPrediction_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Prediction, error)) *Querier_GetPrediction_Call {
+func (_c *Querier_UpdateOrganizationMemberRole_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateOrganizationMemberRoleParams) (int64, error)) *Querier_UpdateOrganizationMemberRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPredictionsByUserID provides a mock function for the type Querier
-func (_mock *Querier) GetPredictionsByUserID(ctx context.Context, arg db.GetPredictionsByUserIDParams) ([]db.Prediction, error) {
+// UpdateStats provides a mock function for the type Querier
+func (_mock *Querier) UpdateStats(ctx context.Context, arg db.UpdateStatsParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPredictionsByUserID")
+		panic("no return value specified for UpdateStats")
 	}
 
-	var r0 []db.Prediction
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionsByUserIDParams) ([]db.Prediction, error)); ok {
-		return returnFunc(ctx, arg)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetPredictionsByUserIDParams) []db.Prediction); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateStatsParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Prediction)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetPredictionsByUserIDParams) error); ok {
-		r1 = returnFunc(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_GetPredictionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionsByUserID'
-type Querier_GetPredictionsByUserID_Call struct {
+// Querier_UpdateStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStats'
+type Querier_UpdateStats_Call struct {
 	*mock.Call
 }
 
-// GetPredictionsByUserID is a helper method to define mock.On call
+// UpdateStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetPredictionsByUserIDParams
-func (_e *Querier_Expecter) GetPredictionsByUserID(ctx interface{}, arg interface{}) *Querier_GetPredictionsByUserID_Call {
-	return &Querier_GetPredictionsByUserID_Call{Call: _e.mock.On("GetPredictionsByUserID", ctx, arg)}
+//   - arg db.UpdateStatsParams
+func (_e *Querier_Expecter) UpdateStats(ctx interface{}, arg interface{}) *Querier_UpdateStats_Call {
+	return &Querier_UpdateStats_Call{Call: _e.mock.On("UpdateStats", ctx, arg)}
 }
 
-func (_c *Querier_GetPredictionsByUserID_Call) Run(run func(ctx context.Context, arg db.GetPredictionsByUserIDParams)) *Querier_GetPredictionsByUserID_Call {
+func (_c *Querier_UpdateStats_Call) Run(run func(ctx context.Context, arg db.UpdateStatsParams)) *Querier_UpdateStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.GetPredictionsByUserIDParams
+		var arg1 db.UpdateStatsParams
 		if args[1] != nil {
-			arg1 = args[1].(db.GetPredictionsByUserIDParams)
+			arg1 = args[1].(db.UpdateStatsParams)
 		}
 		run(
 			arg0,
@@ -876,63 +6844,54 @@ func (_c *Querier_GetPredictionsByUserID_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *Querier_GetPredictionsByUserID_Call) Return(predictions []db.Prediction, err error) *Querier_GetPredictionsByUserID_Call {
-	_c.Call.Return(predictions, err)
+func (_c *Querier_UpdateStats_Call) Return(err error) *Querier_UpdateStats_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_GetPredictionsByUserID_Call) RunAndReturn(run func(ctx context.Context, arg db.GetPredictionsByUserIDParams) ([]db.Prediction, error)) *Querier_GetPredictionsByUserID_Call {
+func (_c *Querier_UpdateStats_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateStatsParams) error) *Querier_UpdateStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRefreshTokenByHash provides a mock function for the type Querier
-func (_mock *Querier) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (db.RefreshToken, error) {
-	ret := _mock.Called(ctx, tokenHash)
+// UpdateUser provides a mock function for the type Querier
+func (_mock *Querier) UpdateUser(ctx context.Context, arg db.UpdateUserParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRefreshTokenByHash")
+		panic("no return value specified for UpdateUser")
 	}
 
-	var r0 db.RefreshToken
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.RefreshToken, error)); ok {
-		return returnFunc(ctx, tokenHash)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.RefreshToken); ok {
-		r0 = returnFunc(ctx, tokenHash)
-	} else {
-		r0 = ret.Get(0).(db.RefreshToken)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, tokenHash)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_GetRefreshTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokenByHash'
-type Querier_GetRefreshTokenByHash_Call struct {
+// Querier_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type Querier_UpdateUser_Call struct {
 	*mock.Call
 }
 
-// GetRefreshTokenByHash is a helper method to define mock.On call
+// UpdateUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenHash string
-func (_e *Querier_Expecter) GetRefreshTokenByHash(ctx interface{}, tokenHash interface{}) *Querier_GetRefreshTokenByHash_Call {
-	return &Querier_GetRefreshTokenByHash_Call{Call: _e.mock.On("GetRefreshTokenByHash", ctx, tokenHash)}
+//   - arg db.UpdateUserParams
+func (_e *Querier_Expecter) UpdateUser(ctx interface{}, arg interface{}) *Querier_UpdateUser_Call {
+	return &Querier_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
 }
 
-func (_c *Querier_GetRefreshTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_GetRefreshTokenByHash_Call {
+func (_c *Querier_UpdateUser_Call) Run(run func(ctx context.Context, arg db.UpdateUserParams)) *Querier_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 db.UpdateUserParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(db.UpdateUserParams)
 		}
 		run(
 			arg0,
@@ -942,63 +6901,54 @@ func (_c *Querier_GetRefreshTokenByHash_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *Querier_GetRefreshTokenByHash_Call) Return(refreshToken db.RefreshToken, err error) *Querier_GetRefreshTokenByHash_Call {
-	_c.Call.Return(refreshToken, err)
+func (_c *Querier_UpdateUser_Call) Return(err error) *Querier_UpdateUser_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_GetRefreshTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (db.RefreshToken, error)) *Querier_GetRefreshTokenByHash_Call {
+func (_c *Querier_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserParams) error) *Querier_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatsByUserID provides a mock function for the type Querier
-func (_mock *Querier) GetStatsByUserID(ctx context.Context, userID uuid.UUID) (db.Stat, error) {
-	ret := _mock.Called(ctx, userID)
+// UpdateUserAvatar provides a mock function for the type Querier
+func (_mock *Querier) UpdateUserAvatar(ctx context.Context, arg db.UpdateUserAvatarParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStatsByUserID")
+		panic("no return value specified for UpdateUserAvatar")
 	}
 
-	var r0 db.Stat
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Stat, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Stat); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		r0 = ret.Get(0).(db.Stat)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserAvatarParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_GetStatsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatsByUserID'
-type Querier_GetStatsByUserID_Call struct {
+// Querier_UpdateUserAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserAvatar'
+type Querier_UpdateUserAvatar_Call struct {
 	*mock.Call
 }
 
-// GetStatsByUserID is a helper method to define mock.On call
+// UpdateUserAvatar is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uuid.UUID
-func (_e *Querier_Expecter) GetStatsByUserID(ctx interface{}, userID interface{}) *Querier_GetStatsByUserID_Call {
-	return &Querier_GetStatsByUserID_Call{Call: _e.mock.On("GetStatsByUserID", ctx, userID)}
+//   - arg db.UpdateUserAvatarParams
+func (_e *Querier_Expecter) UpdateUserAvatar(ctx interface{}, arg interface{}) *Querier_UpdateUserAvatar_Call {
+	return &Querier_UpdateUserAvatar_Call{Call: _e.mock.On("UpdateUserAvatar", ctx, arg)}
 }
 
-func (_c *Querier_GetStatsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_GetStatsByUserID_Call {
+func (_c *Querier_UpdateUserAvatar_Call) Run(run func(ctx context.Context, arg db.UpdateUserAvatarParams)) *Querier_UpdateUserAvatar_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 db.UpdateUserAvatarParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(db.UpdateUserAvatarParams)
 		}
 		run(
 			arg0,
@@ -1008,63 +6958,63 @@ func (_c *Querier_GetStatsByUserID_Call) Run(run func(ctx context.Context, userI
 	return _c
 }
 
-func (_c *Querier_GetStatsByUserID_Call) Return(stat db.Stat, err error) *Querier_GetStatsByUserID_Call {
-	_c.Call.Return(stat, err)
+func (_c *Querier_UpdateUserAvatar_Call) Return(err error) *Querier_UpdateUserAvatar_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_GetStatsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (db.Stat, error)) *Querier_GetStatsByUserID_Call {
+func (_c *Querier_UpdateUserAvatar_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserAvatarParams) error) *Querier_UpdateUserAvatar_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByID provides a mock function for the type Querier
-func (_mock *Querier) GetUserByID(ctx context.Context, id uuid.UUID) (db.User, error) {
-	ret := _mock.Called(ctx, id)
+// UpdateUserByAdmin provides a mock function for the type Querier
+func (_mock *Querier) UpdateUserByAdmin(ctx context.Context, arg db.UpdateUserByAdminParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByID")
+		panic("no return value specified for UpdateUserByAdmin")
 	}
 
-	var r0 db.User
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.User, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserByAdminParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.User); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserByAdminParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UpdateUserByAdminParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
-type Querier_GetUserByID_Call struct {
+// Querier_UpdateUserByAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserByAdmin'
+type Querier_UpdateUserByAdmin_Call struct {
 	*mock.Call
 }
 
-// GetUserByID is a helper method to define mock.On call
+// UpdateUserByAdmin is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *Querier_Expecter) GetUserByID(ctx interface{}, id interface{}) *Querier_GetUserByID_Call {
-	return &Querier_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+//   - arg db.UpdateUserByAdminParams
+func (_e *Querier_Expecter) UpdateUserByAdmin(ctx interface{}, arg interface{}) *Querier_UpdateUserByAdmin_Call {
+	return &Querier_UpdateUserByAdmin_Call{Call: _e.mock.On("UpdateUserByAdmin", ctx, arg)}
 }
 
-func (_c *Querier_GetUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetUserByID_Call {
+func (_c *Querier_UpdateUserByAdmin_Call) Run(run func(ctx context.Context, arg db.UpdateUserByAdminParams)) *Querier_UpdateUserByAdmin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 db.UpdateUserByAdminParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(db.UpdateUserByAdminParams)
 		}
 		run(
 			arg0,
@@ -1074,63 +7024,54 @@ func (_c *Querier_GetUserByID_Call) Run(run func(ctx context.Context, id uuid.UU
 	return _c
 }
 
-func (_c *Querier_GetUserByID_Call) Return(user db.User, err error) *Querier_GetUserByID_Call {
-	_c.Call.Return(user, err)
+func (_c *Querier_UpdateUserByAdmin_Call) Return(n int64, err error) *Querier_UpdateUserByAdmin_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.User, error)) *Querier_GetUserByID_Call {
+func (_c *Querier_UpdateUserByAdmin_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserByAdminParams) (int64, error)) *Querier_UpdateUserByAdmin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByLogin provides a mock function for the type Querier
-func (_mock *Querier) GetUserByLogin(ctx context.Context, login string) (db.User, error) {
-	ret := _mock.Called(ctx, login)
+// UpdateUserEmail provides a mock function for the type Querier
+func (_mock *Querier) UpdateUserEmail(ctx context.Context, arg db.UpdateUserEmailParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByLogin")
+		panic("no return value specified for UpdateUserEmail")
 	}
 
-	var r0 db.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
-		return returnFunc(ctx, login)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
-		r0 = returnFunc(ctx, login)
-	} else {
-		r0 = ret.Get(0).(db.User)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, login)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserEmailParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
-type Querier_GetUserByLogin_Call struct {
+// Querier_UpdateUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserEmail'
+type Querier_UpdateUserEmail_Call struct {
 	*mock.Call
 }
 
-// GetUserByLogin is a helper method to define mock.On call
+// UpdateUserEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - login string
-func (_e *Querier_Expecter) GetUserByLogin(ctx interface{}, login interface{}) *Querier_GetUserByLogin_Call {
-	return &Querier_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", ctx, login)}
+//   - arg db.UpdateUserEmailParams
+func (_e *Querier_Expecter) UpdateUserEmail(ctx interface{}, arg interface{}) *Querier_UpdateUserEmail_Call {
+	return &Querier_UpdateUserEmail_Call{Call: _e.mock.On("UpdateUserEmail", ctx, arg)}
 }
 
-func (_c *Querier_GetUserByLogin_Call) Run(run func(ctx context.Context, login string)) *Querier_GetUserByLogin_Call {
+func (_c *Querier_UpdateUserEmail_Call) Run(run func(ctx context.Context, arg db.UpdateUserEmailParams)) *Querier_UpdateUserEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 db.UpdateUserEmailParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(db.UpdateUserEmailParams)
 		}
 		run(
 			arg0,
@@ -1140,54 +7081,54 @@ func (_c *Querier_GetUserByLogin_Call) Run(run func(ctx context.Context, login s
 	return _c
 }
 
-func (_c *Querier_GetUserByLogin_Call) Return(user db.User, err error) *Querier_GetUserByLogin_Call {
-	_c.Call.Return(user, err)
+func (_c *Querier_UpdateUserEmail_Call) Return(err error) *Querier_UpdateUserEmail_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_GetUserByLogin_Call) RunAndReturn(run func(ctx context.Context, login string) (db.User, error)) *Querier_GetUserByLogin_Call {
+func (_c *Querier_UpdateUserEmail_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserEmailParams) error) *Querier_UpdateUserEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokeAllUserTokens provides a mock function for the type Querier
-func (_mock *Querier) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
-	ret := _mock.Called(ctx, userID)
+// UpdateUserLeaderboardHidden provides a mock function for the type Querier
+func (_mock *Querier) UpdateUserLeaderboardHidden(ctx context.Context, arg db.UpdateUserLeaderboardHiddenParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokeAllUserTokens")
+		panic("no return value specified for UpdateUserLeaderboardHidden")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserLeaderboardHiddenParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Querier_RevokeAllUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllUserTokens'
-type Querier_RevokeAllUserTokens_Call struct {
+// Querier_UpdateUserLeaderboardHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserLeaderboardHidden'
+type Querier_UpdateUserLeaderboardHidden_Call struct {
 	*mock.Call
 }
 
-// RevokeAllUserTokens is a helper method to define mock.On call
+// UpdateUserLeaderboardHidden is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uuid.UUID
-func (_e *Querier_Expecter) RevokeAllUserTokens(ctx interface{}, userID interface{}) *Querier_RevokeAllUserTokens_Call {
-	return &Querier_RevokeAllUserTokens_Call{Call: _e.mock.On("RevokeAllUserTokens", ctx, userID)}
+//   - arg db.UpdateUserLeaderboardHiddenParams
+func (_e *Querier_Expecter) UpdateUserLeaderboardHidden(ctx interface{}, arg interface{}) *Querier_UpdateUserLeaderboardHidden_Call {
+	return &Querier_UpdateUserLeaderboardHidden_Call{Call: _e.mock.On("UpdateUserLeaderboardHidden", ctx, arg)}
 }
 
-func (_c *Querier_RevokeAllUserTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Querier_RevokeAllUserTokens_Call {
+func (_c *Querier_UpdateUserLeaderboardHidden_Call) Run(run func(ctx context.Context, arg db.UpdateUserLeaderboardHiddenParams)) *Querier_UpdateUserLeaderboardHidden_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 db.UpdateUserLeaderboardHiddenParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(db.UpdateUserLeaderboardHiddenParams)
 		}
 		run(
 			arg0,
@@ -1197,54 +7138,54 @@ func (_c *Querier_RevokeAllUserTokens_Call) Run(run func(ctx context.Context, us
 	return _c
 }
 
-func (_c *Querier_RevokeAllUserTokens_Call) Return(err error) *Querier_RevokeAllUserTokens_Call {
+func (_c *Querier_UpdateUserLeaderboardHidden_Call) Return(err error) *Querier_UpdateUserLeaderboardHidden_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_RevokeAllUserTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Querier_RevokeAllUserTokens_Call {
+func (_c *Querier_UpdateUserLeaderboardHidden_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserLeaderboardHiddenParams) error) *Querier_UpdateUserLeaderboardHidden_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokeRefreshToken provides a mock function for the type Querier
-func (_mock *Querier) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
-	ret := _mock.Called(ctx, tokenHash)
+// UpdateUserPassword provides a mock function for the type Querier
+func (_mock *Querier) UpdateUserPassword(ctx context.Context, arg db.UpdateUserPasswordParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokeRefreshToken")
+		panic("no return value specified for UpdateUserPassword")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserPasswordParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Querier_RevokeRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshToken'
-type Querier_RevokeRefreshToken_Call struct {
+// Querier_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type Querier_UpdateUserPassword_Call struct {
 	*mock.Call
 }
 
-// RevokeRefreshToken is a helper method to define mock.On call
+// UpdateUserPassword is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenHash string
-func (_e *Querier_Expecter) RevokeRefreshToken(ctx interface{}, tokenHash interface{}) *Querier_RevokeRefreshToken_Call {
-	return &Querier_RevokeRefreshToken_Call{Call: _e.mock.On("RevokeRefreshToken", ctx, tokenHash)}
+//   - arg db.UpdateUserPasswordParams
+func (_e *Querier_Expecter) UpdateUserPassword(ctx interface{}, arg interface{}) *Querier_UpdateUserPassword_Call {
+	return &Querier_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, arg)}
 }
 
-func (_c *Querier_RevokeRefreshToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_RevokeRefreshToken_Call {
+func (_c *Querier_UpdateUserPassword_Call) Run(run func(ctx context.Context, arg db.UpdateUserPasswordParams)) *Querier_UpdateUserPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 db.UpdateUserPasswordParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(db.UpdateUserPasswordParams)
 		}
 		run(
 			arg0,
@@ -1254,26 +7195,26 @@ func (_c *Querier_RevokeRefreshToken_Call) Run(run func(ctx context.Context, tok
 	return _c
 }
 
-func (_c *Querier_RevokeRefreshToken_Call) Return(err error) *Querier_RevokeRefreshToken_Call {
+func (_c *Querier_UpdateUserPassword_Call) Return(err error) *Querier_UpdateUserPassword_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_RevokeRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) error) *Querier_RevokeRefreshToken_Call {
+func (_c *Querier_UpdateUserPassword_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserPasswordParams) error) *Querier_UpdateUserPassword_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStats provides a mock function for the type Querier
-func (_mock *Querier) UpdateStats(ctx context.Context, arg db.UpdateStatsParams) error {
+// UpdateUserTimezone provides a mock function for the type Querier
+func (_mock *Querier) UpdateUserTimezone(ctx context.Context, arg db.UpdateUserTimezoneParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStats")
+		panic("no return value specified for UpdateUserTimezone")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateStatsParams) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserTimezoneParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -1281,27 +7222,27 @@ func (_mock *Querier) UpdateStats(ctx context.Context, arg db.UpdateStatsParams)
 	return r0
 }
 
-// Querier_UpdateStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStats'
-type Querier_UpdateStats_Call struct {
+// Querier_UpdateUserTimezone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserTimezone'
+type Querier_UpdateUserTimezone_Call struct {
 	*mock.Call
 }
 
-// UpdateStats is a helper method to define mock.On call
+// UpdateUserTimezone is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStatsParams
-func (_e *Querier_Expecter) UpdateStats(ctx interface{}, arg interface{}) *Querier_UpdateStats_Call {
-	return &Querier_UpdateStats_Call{Call: _e.mock.On("UpdateStats", ctx, arg)}
+//   - arg db.UpdateUserTimezoneParams
+func (_e *Querier_Expecter) UpdateUserTimezone(ctx interface{}, arg interface{}) *Querier_UpdateUserTimezone_Call {
+	return &Querier_UpdateUserTimezone_Call{Call: _e.mock.On("UpdateUserTimezone", ctx, arg)}
 }
 
-func (_c *Querier_UpdateStats_Call) Run(run func(ctx context.Context, arg db.UpdateStatsParams)) *Querier_UpdateStats_Call {
+func (_c *Querier_UpdateUserTimezone_Call) Run(run func(ctx context.Context, arg db.UpdateUserTimezoneParams)) *Querier_UpdateUserTimezone_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.UpdateStatsParams
+		var arg1 db.UpdateUserTimezoneParams
 		if args[1] != nil {
-			arg1 = args[1].(db.UpdateStatsParams)
+			arg1 = args[1].(db.UpdateUserTimezoneParams)
 		}
 		run(
 			arg0,
@@ -1311,26 +7252,26 @@ func (_c *Querier_UpdateStats_Call) Run(run func(ctx context.Context, arg db.Upd
 	return _c
 }
 
-func (_c *Querier_UpdateStats_Call) Return(err error) *Querier_UpdateStats_Call {
+func (_c *Querier_UpdateUserTimezone_Call) Return(err error) *Querier_UpdateUserTimezone_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_UpdateStats_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateStatsParams) error) *Querier_UpdateStats_Call {
+func (_c *Querier_UpdateUserTimezone_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserTimezoneParams) error) *Querier_UpdateUserTimezone_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function for the type Querier
-func (_mock *Querier) UpdateUser(ctx context.Context, arg db.UpdateUserParams) error {
+// UpsertStatsSnapshot provides a mock function for the type Querier
+func (_mock *Querier) UpsertStatsSnapshot(ctx context.Context, arg db.UpsertStatsSnapshotParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for UpsertStatsSnapshot")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpsertStatsSnapshotParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -1338,27 +7279,27 @@ func (_mock *Querier) UpdateUser(ctx context.Context, arg db.UpdateUserParams) e
 	return r0
 }
 
-// Querier_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type Querier_UpdateUser_Call struct {
+// Querier_UpsertStatsSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertStatsSnapshot'
+type Querier_UpsertStatsSnapshot_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
+// UpsertStatsSnapshot is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateUserParams
-func (_e *Querier_Expecter) UpdateUser(ctx interface{}, arg interface{}) *Querier_UpdateUser_Call {
-	return &Querier_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
+//   - arg db.UpsertStatsSnapshotParams
+func (_e *Querier_Expecter) UpsertStatsSnapshot(ctx interface{}, arg interface{}) *Querier_UpsertStatsSnapshot_Call {
+	return &Querier_UpsertStatsSnapshot_Call{Call: _e.mock.On("UpsertStatsSnapshot", ctx, arg)}
 }
 
-func (_c *Querier_UpdateUser_Call) Run(run func(ctx context.Context, arg db.UpdateUserParams)) *Querier_UpdateUser_Call {
+func (_c *Querier_UpsertStatsSnapshot_Call) Run(run func(ctx context.Context, arg db.UpsertStatsSnapshotParams)) *Querier_UpsertStatsSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.UpdateUserParams
+		var arg1 db.UpsertStatsSnapshotParams
 		if args[1] != nil {
-			arg1 = args[1].(db.UpdateUserParams)
+			arg1 = args[1].(db.UpsertStatsSnapshotParams)
 		}
 		run(
 			arg0,
@@ -1368,26 +7309,26 @@ func (_c *Querier_UpdateUser_Call) Run(run func(ctx context.Context, arg db.Upda
 	return _c
 }
 
-func (_c *Querier_UpdateUser_Call) Return(err error) *Querier_UpdateUser_Call {
+func (_c *Querier_UpsertStatsSnapshot_Call) Return(err error) *Querier_UpsertStatsSnapshot_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserParams) error) *Querier_UpdateUser_Call {
+func (_c *Querier_UpsertStatsSnapshot_Call) RunAndReturn(run func(ctx context.Context, arg db.UpsertStatsSnapshotParams) error) *Querier_UpsertStatsSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserAvatar provides a mock function for the type Querier
-func (_mock *Querier) UpdateUserAvatar(ctx context.Context, arg db.UpdateUserAvatarParams) error {
+// UpsertUserTOTP provides a mock function for the type Querier
+func (_mock *Querier) UpsertUserTOTP(ctx context.Context, arg db.UpsertUserTOTPParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserAvatar")
+		panic("no return value specified for UpsertUserTOTP")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserAvatarParams) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpsertUserTOTPParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -1395,27 +7336,27 @@ func (_mock *Querier) UpdateUserAvatar(ctx context.Context, arg db.UpdateUserAva
 	return r0
 }
 
-// Querier_UpdateUserAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserAvatar'
-type Querier_UpdateUserAvatar_Call struct {
+// Querier_UpsertUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertUserTOTP'
+type Querier_UpsertUserTOTP_Call struct {
 	*mock.Call
 }
 
-// UpdateUserAvatar is a helper method to define mock.On call
+// UpsertUserTOTP is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateUserAvatarParams
-func (_e *Querier_Expecter) UpdateUserAvatar(ctx interface{}, arg interface{}) *Querier_UpdateUserAvatar_Call {
-	return &Querier_UpdateUserAvatar_Call{Call: _e.mock.On("UpdateUserAvatar", ctx, arg)}
+//   - arg db.UpsertUserTOTPParams
+func (_e *Querier_Expecter) UpsertUserTOTP(ctx interface{}, arg interface{}) *Querier_UpsertUserTOTP_Call {
+	return &Querier_UpsertUserTOTP_Call{Call: _e.mock.On("UpsertUserTOTP", ctx, arg)}
 }
 
-func (_c *Querier_UpdateUserAvatar_Call) Run(run func(ctx context.Context, arg db.UpdateUserAvatarParams)) *Querier_UpdateUserAvatar_Call {
+func (_c *Querier_UpsertUserTOTP_Call) Run(run func(ctx context.Context, arg db.UpsertUserTOTPParams)) *Querier_UpsertUserTOTP_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.UpdateUserAvatarParams
+		var arg1 db.UpsertUserTOTPParams
 		if args[1] != nil {
-			arg1 = args[1].(db.UpdateUserAvatarParams)
+			arg1 = args[1].(db.UpsertUserTOTPParams)
 		}
 		run(
 			arg0,
@@ -1425,54 +7366,63 @@ func (_c *Querier_UpdateUserAvatar_Call) Run(run func(ctx context.Context, arg d
 	return _c
 }
 
-func (_c *Querier_UpdateUserAvatar_Call) Return(err error) *Querier_UpdateUserAvatar_Call {
+func (_c *Querier_UpsertUserTOTP_Call) Return(err error) *Querier_UpsertUserTOTP_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_UpdateUserAvatar_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserAvatarParams) error) *Querier_UpdateUserAvatar_Call {
+func (_c *Querier_UpsertUserTOTP_Call) RunAndReturn(run func(ctx context.Context, arg db.UpsertUserTOTPParams) error) *Querier_UpsertUserTOTP_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserPassword provides a mock function for the type Querier
-func (_mock *Querier) UpdateUserPassword(ctx context.Context, arg db.UpdateUserPasswordParams) error {
+// UseUserTOTPStep provides a mock function for the type Querier
+func (_mock *Querier) UseUserTOTPStep(ctx context.Context, arg db.UseUserTOTPStepParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserPassword")
+		panic("no return value specified for UseUserTOTPStep")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateUserPasswordParams) error); ok {
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UseUserTOTPStepParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UseUserTOTPStepParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UseUserTOTPStepParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Querier_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
-type Querier_UpdateUserPassword_Call struct {
+// Querier_UseUserTOTPStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseUserTOTPStep'
+type Querier_UseUserTOTPStep_Call struct {
 	*mock.Call
 }
 
-// UpdateUserPassword is a helper method to define mock.On call
+// UseUserTOTPStep is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateUserPasswordParams
-func (_e *Querier_Expecter) UpdateUserPassword(ctx interface{}, arg interface{}) *Querier_UpdateUserPassword_Call {
-	return &Querier_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, arg)}
+//   - arg db.UseUserTOTPStepParams
+func (_e *Querier_Expecter) UseUserTOTPStep(ctx interface{}, arg interface{}) *Querier_UseUserTOTPStep_Call {
+	return &Querier_UseUserTOTPStep_Call{Call: _e.mock.On("UseUserTOTPStep", ctx, arg)}
 }
 
-func (_c *Querier_UpdateUserPassword_Call) Run(run func(ctx context.Context, arg db.UpdateUserPasswordParams)) *Querier_UpdateUserPassword_Call {
+func (_c *Querier_UseUserTOTPStep_Call) Run(run func(ctx context.Context, arg db.UseUserTOTPStepParams)) *Querier_UseUserTOTPStep_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.UpdateUserPasswordParams
+		var arg1 db.UseUserTOTPStepParams
 		if args[1] != nil {
-			arg1 = args[1].(db.UpdateUserPasswordParams)
+			arg1 = args[1].(db.UseUserTOTPStepParams)
 		}
 		run(
 			arg0,
@@ -1482,12 +7432,12 @@ func (_c *Querier_UpdateUserPassword_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *Querier_UpdateUserPassword_Call) Return(err error) *Querier_UpdateUserPassword_Call {
-	_c.Call.Return(err)
+func (_c *Querier_UseUserTOTPStep_Call) Return(n int64, err error) *Querier_UseUserTOTPStep_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_UpdateUserPassword_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateUserPasswordParams) error) *Querier_UpdateUserPassword_Call {
+func (_c *Querier_UseUserTOTPStep_Call) RunAndReturn(run func(ctx context.Context, arg db.UseUserTOTPStepParams) (int64, error)) *Querier_UseUserTOTPStep_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -13,17 +13,197 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const countUsers = `-- name: CountUsers :one
-SELECT COUNT(id) FROM users
+const banUser = `-- name: BanUser :execrows
+UPDATE users
+SET banned_at = now(), banned_until = $1, ban_reason = $2, updated_at = now()
+WHERE id = $3
 `
 
-func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countUsers)
+type BanUserParams struct {
+	BannedUntil pgtype.Timestamptz `json:"banned_until"`
+	BanReason   *string            `json:"ban_reason"`
+	ID          uuid.UUID          `json:"id"`
+}
+
+func (q *Queries) BanUser(ctx context.Context, arg BanUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, banUser, arg.BannedUntil, arg.BanReason, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countAdminUsers = `-- name: CountAdminUsers :one
+SELECT COUNT(u.id)
+FROM users u
+LEFT JOIN stats s ON s.user_id = u.id
+LEFT JOIN (
+    SELECT user_id, MAX(created_at) AS last_login_at
+    FROM login_history
+    WHERE success = true
+    GROUP BY user_id
+) lh ON lh.user_id = u.id
+WHERE ($1::text IS NULL
+        OR u.login ILIKE '%' || $1 || '%'
+        OR u.name ILIKE '%' || $1 || '%')
+    AND ($2::text IS NULL OR u.role = $2)
+    AND ($3::boolean IS NULL OR u.deleted = $3)
+    AND ($4::boolean IS NULL
+        OR (u.banned_at IS NOT NULL AND (u.banned_until IS NULL OR u.banned_until > now())) = $4)
+    AND ($5::text IS NULL OR s.status = $5)
+    AND ($6::timestamptz IS NULL OR lh.last_login_at >= $6)
+    AND ($7::timestamptz IS NULL OR lh.last_login_at < $7)
+`
+
+type CountAdminUsersParams struct {
+	Search        *string            `json:"search"`
+	Role          *string            `json:"role"`
+	Deleted       *bool              `json:"deleted"`
+	Banned        *bool              `json:"banned"`
+	Status        *string            `json:"status"`
+	LastLoginFrom pgtype.Timestamptz `json:"last_login_from"`
+	LastLoginTo   pgtype.Timestamptz `json:"last_login_to"`
+}
+
+func (q *Queries) CountAdminUsers(ctx context.Context, arg CountAdminUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAdminUsers,
+		arg.Search,
+		arg.Role,
+		arg.Deleted,
+		arg.Banned,
+		arg.Status,
+		arg.LastLoginFrom,
+		arg.LastLoginTo,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const exportAdminUsers = `-- name: ExportAdminUsers :many
+SELECT
+    u.id,
+    u.login,
+    u.name,
+    u.role,
+    u.avatar,
+    u.deleted,
+    u.created_at,
+    u.updated_at,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
+    s.status,
+    s.rating,
+    s.files_scanned,
+    s.total_weight,
+    s.last_scanned_at,
+    lh.last_login_at
+FROM users u
+LEFT JOIN stats s ON s.user_id = u.id
+LEFT JOIN (
+    SELECT user_id, MAX(created_at) AS last_login_at
+    FROM login_history
+    WHERE success = true
+    GROUP BY user_id
+) lh ON lh.user_id = u.id
+WHERE ($1::text IS NULL
+        OR u.login ILIKE '%' || $1 || '%'
+        OR u.name ILIKE '%' || $1 || '%')
+    AND ($2::text IS NULL OR u.role = $2)
+    AND ($3::boolean IS NULL OR u.deleted = $3)
+    AND ($4::boolean IS NULL
+        OR (u.banned_at IS NOT NULL AND (u.banned_until IS NULL OR u.banned_until > now())) = $4)
+    AND ($5::text IS NULL OR s.status = $5)
+    AND ($6::timestamptz IS NULL OR lh.last_login_at >= $6)
+    AND ($7::timestamptz IS NULL OR lh.last_login_at < $7)
+    AND (u.created_at, u.id) > ($8::timestamptz, $9::uuid)
+ORDER BY u.created_at, u.id
+LIMIT $10
+`
+
+type ExportAdminUsersParams struct {
+	Search         *string            `json:"search"`
+	Role           *string            `json:"role"`
+	Deleted        *bool              `json:"deleted"`
+	Banned         *bool              `json:"banned"`
+	Status         *string            `json:"status"`
+	LastLoginFrom  pgtype.Timestamptz `json:"last_login_from"`
+	LastLoginTo    pgtype.Timestamptz `json:"last_login_to"`
+	AfterCreatedAt time.Time          `json:"after_created_at"`
+	AfterID        uuid.UUID          `json:"after_id"`
+	Limit          int32              `json:"limit"`
+}
+
+type ExportAdminUsersRow struct {
+	ID            uuid.UUID          `json:"id"`
+	Login         string             `json:"login"`
+	Name          string             `json:"name"`
+	Role          string             `json:"role"`
+	Avatar        *string            `json:"avatar"`
+	Deleted       bool               `json:"deleted"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	BannedAt      pgtype.Timestamptz `json:"banned_at"`
+	BannedUntil   pgtype.Timestamptz `json:"banned_until"`
+	BanReason     *string            `json:"ban_reason"`
+	Status        *string            `json:"status"`
+	Rating        *int32             `json:"rating"`
+	FilesScanned  *int32             `json:"files_scanned"`
+	TotalWeight   *float64           `json:"total_weight"`
+	LastScannedAt pgtype.Timestamptz `json:"last_scanned_at"`
+	LastLoginAt   interface{}        `json:"last_login_at"`
+}
+
+func (q *Queries) ExportAdminUsers(ctx context.Context, arg ExportAdminUsersParams) ([]ExportAdminUsersRow, error) {
+	rows, err := q.db.Query(ctx, exportAdminUsers,
+		arg.Search,
+		arg.Role,
+		arg.Deleted,
+		arg.Banned,
+		arg.Status,
+		arg.LastLoginFrom,
+		arg.LastLoginTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportAdminUsersRow{}
+	for rows.Next() {
+		var i ExportAdminUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Login,
+			&i.Name,
+			&i.Role,
+			&i.Avatar,
+			&i.Deleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.BannedAt,
+			&i.BannedUntil,
+			&i.BanReason,
+			&i.Status,
+			&i.Rating,
+			&i.FilesScanned,
+			&i.TotalWeight,
+			&i.LastScannedAt,
+			&i.LastLoginAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAdminUserByID = `-- name: GetAdminUserByID :one
 SELECT 
     u.id, 
@@ -34,6 +214,9 @@ SELECT
     u.deleted, 
     u.created_at, 
     u.updated_at,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
     s.status,
     s.rating,
     s.files_scanned,
@@ -60,6 +243,9 @@ type GetAdminUserByIDRow struct {
 	Deleted       bool               `json:"deleted"`
 	CreatedAt     time.Time          `json:"created_at"`
 	UpdatedAt     time.Time          `json:"updated_at"`
+	BannedAt      pgtype.Timestamptz `json:"banned_at"`
+	BannedUntil   pgtype.Timestamptz `json:"banned_until"`
+	BanReason     *string            `json:"ban_reason"`
 	Status        *string            `json:"status"`
 	Rating        *int32             `json:"rating"`
 	FilesScanned  *int32             `json:"files_scanned"`
@@ -80,6 +266,9 @@ func (q *Queries) GetAdminUserByID(ctx context.Context, id uuid.UUID) (GetAdminU
 		&i.Deleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
 		&i.Status,
 		&i.Rating,
 		&i.FilesScanned,
@@ -100,6 +289,9 @@ SELECT
     u.deleted, 
     u.created_at, 
     u.updated_at,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
     s.status,
     s.rating,
     s.files_scanned,
@@ -114,13 +306,40 @@ LEFT JOIN (
     WHERE success = true
     GROUP BY user_id
 ) lh ON lh.user_id = u.id
-ORDER BY u.created_at DESC
-LIMIT $1 OFFSET $2
+WHERE ($1::text IS NULL
+        OR u.login ILIKE '%' || $1 || '%'
+        OR u.name ILIKE '%' || $1 || '%')
+    AND ($2::text IS NULL OR u.role = $2)
+    AND ($3::boolean IS NULL OR u.deleted = $3)
+    AND ($4::boolean IS NULL
+        OR (u.banned_at IS NOT NULL AND (u.banned_until IS NULL OR u.banned_until > now())) = $4)
+    AND ($5::text IS NULL OR s.status = $5)
+    AND ($6::timestamptz IS NULL OR lh.last_login_at >= $6)
+    AND ($7::timestamptz IS NULL OR lh.last_login_at < $7)
+ORDER BY
+    CASE WHEN $8::text = 'rating' AND NOT $9::boolean THEN s.rating END DESC NULLS LAST,
+    CASE WHEN $8::text = 'rating' AND $9::boolean THEN s.rating END ASC NULLS LAST,
+    CASE WHEN $8::text = 'files_scanned' AND NOT $9::boolean THEN s.files_scanned END DESC NULLS LAST,
+    CASE WHEN $8::text = 'files_scanned' AND $9::boolean THEN s.files_scanned END ASC NULLS LAST,
+    CASE WHEN $8::text = 'last_login' AND NOT $9::boolean THEN lh.last_login_at END DESC NULLS LAST,
+    CASE WHEN $8::text = 'last_login' AND $9::boolean THEN lh.last_login_at END ASC NULLS LAST,
+    CASE WHEN $9::boolean THEN u.created_at END ASC,
+    u.created_at DESC
+LIMIT $10 OFFSET $11
 `
 
 type GetAdminUsersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Search        *string            `json:"search"`
+	Role          *string            `json:"role"`
+	Deleted       *bool              `json:"deleted"`
+	Banned        *bool              `json:"banned"`
+	Status        *string            `json:"status"`
+	LastLoginFrom pgtype.Timestamptz `json:"last_login_from"`
+	LastLoginTo   pgtype.Timestamptz `json:"last_login_to"`
+	SortBy        string             `json:"sort_by"`
+	SortAsc       bool               `json:"sort_asc"`
+	Limit         int32              `json:"limit"`
+	Offset        int32              `json:"offset"`
 }
 
 type GetAdminUsersRow struct {
@@ -132,6 +351,9 @@ type GetAdminUsersRow struct {
 	Deleted       bool               `json:"deleted"`
 	CreatedAt     time.Time          `json:"created_at"`
 	UpdatedAt     time.Time          `json:"updated_at"`
+	BannedAt      pgtype.Timestamptz `json:"banned_at"`
+	BannedUntil   pgtype.Timestamptz `json:"banned_until"`
+	BanReason     *string            `json:"ban_reason"`
 	Status        *string            `json:"status"`
 	Rating        *int32             `json:"rating"`
 	FilesScanned  *int32             `json:"files_scanned"`
@@ -141,7 +363,19 @@ type GetAdminUsersRow struct {
 }
 
 func (q *Queries) GetAdminUsers(ctx context.Context, arg GetAdminUsersParams) ([]GetAdminUsersRow, error) {
-	rows, err := q.db.Query(ctx, getAdminUsers, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getAdminUsers,
+		arg.Search,
+		arg.Role,
+		arg.Deleted,
+		arg.Banned,
+		arg.Status,
+		arg.LastLoginFrom,
+		arg.LastLoginTo,
+		arg.SortBy,
+		arg.SortAsc,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +392,9 @@ func (q *Queries) GetAdminUsers(ctx context.Context, arg GetAdminUsersParams) ([
 			&i.Deleted,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.BannedAt,
+			&i.BannedUntil,
+			&i.BanReason,
 			&i.Status,
 			&i.Rating,
 			&i.FilesScanned,
@@ -174,3 +411,67 @@ func (q *Queries) GetAdminUsers(ctx context.Context, arg GetAdminUsersParams) ([
 	}
 	return items, nil
 }
+
+const purgeUser = `-- name: PurgeUser :execrows
+DELETE FROM users
+WHERE id = $1
+`
+
+func (q *Queries) PurgeUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreUser = `-- name: RestoreUser :execrows
+UPDATE users
+SET deleted = FALSE, updated_at = now()
+WHERE id = $1 AND deleted = TRUE
+`
+
+func (q *Queries) RestoreUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unbanUser = `-- name: UnbanUser :execrows
+UPDATE users
+SET banned_at = NULL, banned_until = NULL, ban_reason = NULL, updated_at = now()
+WHERE id = $1 AND banned_at IS NOT NULL
+`
+
+func (q *Queries) UnbanUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unbanUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateUserByAdmin = `-- name: UpdateUserByAdmin :execrows
+UPDATE users
+SET
+    name = COALESCE($1, name),
+    role = COALESCE($2, role),
+    updated_at = now()
+WHERE id = $3
+`
+
+type UpdateUserByAdminParams struct {
+	Name *string   `json:"name"`
+	Role *string   `json:"role"`
+	ID   uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateUserByAdmin(ctx context.Context, arg UpdateUserByAdminParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserByAdmin, arg.Name, arg.Role, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -8,10 +8,39 @@ package db
 import (
 	"context"
 	"net/netip"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countLoginHistory = `-- name: CountLoginHistory :one
+SELECT COUNT(*) FROM login_history
+WHERE user_id = $1::uuid
+  AND ($2::boolean IS NULL OR success = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+`
+
+type CountLoginHistoryParams struct {
+	UserID      uuid.UUID          `json:"user_id"`
+	Success     *bool              `json:"success"`
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+}
+
+func (q *Queries) CountLoginHistory(ctx context.Context, arg CountLoginHistoryParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLoginHistory,
+		arg.UserID,
+		arg.Success,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createLoginHistory = `-- name: CreateLoginHistory :one
 INSERT INTO login_history (
     user_id,
@@ -26,7 +55,7 @@ INSERT INTO login_history (
 `
 
 type CreateLoginHistoryParams struct {
-	UserID        uuid.UUID   `json:"user_id"`
+	UserID        pgtype.UUID `json:"user_id"`
 	Success       bool        `json:"success"`
 	FailureReason *string     `json:"failure_reason"`
 	IpAddress     *netip.Addr `json:"ip_address"`
@@ -48,9 +77,67 @@ func (q *Queries) CreateLoginHistory(ctx context.Context, arg CreateLoginHistory
 	return id, err
 }
 
+const getLoginFailuresByIP = `-- name: GetLoginFailuresByIP :one
+SELECT
+    COUNT(*) AS failures,
+    COALESCE(MAX(created_at), 'epoch')::timestamptz AS last_failure_at
+FROM login_history
+WHERE ip_address = $1
+  AND success = FALSE
+  AND created_at > $2
+`
+
+type GetLoginFailuresByIPParams struct {
+	IpAddress *netip.Addr `json:"ip_address"`
+	Since     time.Time   `json:"since"`
+}
+
+type GetLoginFailuresByIPRow struct {
+	Failures      int64     `json:"failures"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+}
+
+func (q *Queries) GetLoginFailuresByIP(ctx context.Context, arg GetLoginFailuresByIPParams) (GetLoginFailuresByIPRow, error) {
+	row := q.db.QueryRow(ctx, getLoginFailuresByIP, arg.IpAddress, arg.Since)
+	var i GetLoginFailuresByIPRow
+	err := row.Scan(&i.Failures, &i.LastFailureAt)
+	return i, err
+}
+
+const getLoginFailuresByUser = `-- name: GetLoginFailuresByUser :one
+SELECT
+    COUNT(*) AS failures,
+    COALESCE(MAX(lh.created_at), 'epoch')::timestamptz AS last_failure_at
+FROM login_history lh
+WHERE lh.user_id = $1::uuid
+  AND lh.success = FALSE
+  AND lh.created_at > $2
+  AND lh.created_at > COALESCE(
+      (SELECT u.login_unlocked_at FROM users u WHERE u.id = $1), 'epoch')
+  AND lh.created_at > COALESCE(
+      (SELECT MAX(s.created_at) FROM login_history s WHERE s.user_id = $1 AND s.success), 'epoch')
+`
+
+type GetLoginFailuresByUserParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Since  time.Time `json:"since"`
+}
+
+type GetLoginFailuresByUserRow struct {
+	Failures      int64     `json:"failures"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+}
+
+func (q *Queries) GetLoginFailuresByUser(ctx context.Context, arg GetLoginFailuresByUserParams) (GetLoginFailuresByUserRow, error) {
+	row := q.db.QueryRow(ctx, getLoginFailuresByUser, arg.UserID, arg.Since)
+	var i GetLoginFailuresByUserRow
+	err := row.Scan(&i.Failures, &i.LastFailureAt)
+	return i, err
+}
+
 const getLoginHistoryByUser = `-- name: GetLoginHistoryByUser :many
 SELECT id, user_id, success, failure_reason, ip_address, user_agent, location, created_at FROM login_history
-WHERE user_id = $1
+WHERE user_id = $1::uuid
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
 `
@@ -89,3 +176,58 @@ func (q *Queries) GetLoginHistoryByUser(ctx context.Context, arg GetLoginHistory
 	}
 	return items, nil
 }
+
+const listLoginHistory = `-- name: ListLoginHistory :many
+SELECT id, user_id, success, failure_reason, ip_address, user_agent, location, created_at FROM login_history
+WHERE user_id = $1::uuid
+  AND ($2::boolean IS NULL OR success = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+ORDER BY created_at DESC
+LIMIT $5 OFFSET $6
+`
+
+type ListLoginHistoryParams struct {
+	UserID      uuid.UUID          `json:"user_id"`
+	Success     *bool              `json:"success"`
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+	Limit       int32              `json:"limit"`
+	Offset      int32              `json:"offset"`
+}
+
+func (q *Queries) ListLoginHistory(ctx context.Context, arg ListLoginHistoryParams) ([]LoginHistory, error) {
+	rows, err := q.db.Query(ctx, listLoginHistory,
+		arg.UserID,
+		arg.Success,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LoginHistory{}
+	for rows.Next() {
+		var i LoginHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Success,
+			&i.FailureReason,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Location,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -12,9 +12,53 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID         uuid.UUID          `json:"id"`
+	UserID     uuid.UUID          `json:"user_id"`
+	Name       string             `json:"name"`
+	Prefix     string             `json:"prefix"`
+	KeyHash    string             `json:"key_hash"`
+	Scopes     []string           `json:"scopes"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt  pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID             uuid.UUID   `json:"id"`
+	ActorID        uuid.UUID   `json:"actor_id"`
+	Action         string      `json:"action"`
+	TargetType     string      `json:"target_type"`
+	TargetID       string      `json:"target_id"`
+	Before         []byte      `json:"before"`
+	After          []byte      `json:"after"`
+	IpAddress      *netip.Addr `json:"ip_address"`
+	RequestID      *string     `json:"request_id"`
+	CreatedAt      time.Time   `json:"created_at"`
+	ImpersonatorID pgtype.UUID `json:"impersonator_id"`
+}
+
+type DailyActivity struct {
+	UserID uuid.UUID   `json:"user_id"`
+	Day    pgtype.Date `json:"day"`
+	Scans  int32       `json:"scans"`
+}
+
+type EmailVerificationToken struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Email     string             `json:"email"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	Used      bool               `json:"used"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
 type LoginHistory struct {
 	ID            uuid.UUID   `json:"id"`
-	UserID        uuid.UUID   `json:"user_id"`
+	UserID        pgtype.UUID `json:"user_id"`
 	Success       bool        `json:"success"`
 	FailureReason *string     `json:"failure_reason"`
 	IpAddress     *netip.Addr `json:"ip_address"`
@@ -23,15 +67,65 @@ type LoginHistory struct {
 	CreatedAt     time.Time   `json:"created_at"`
 }
 
+type MfaRecoveryCode struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	CodeHash  string             `json:"code_hash"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type Organization struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	InviteCode string    `json:"invite_code"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type OrganizationMember struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+	JoinedAt       time.Time `json:"joined_at"`
+}
+
+type PasswordResetToken struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	Used      bool               `json:"used"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
 type Prediction struct {
-	ID        uuid.UUID `json:"id"`
-	UserID    uuid.UUID `json:"user_id"`
-	TrashScan string    `json:"trash_scan"`
-	Status    string    `json:"status"`
-	Result    []byte    `json:"result"`
-	Error     *string   `json:"error"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"user_id"`
+	TrashScan       string             `json:"trash_scan"`
+	Status          string             `json:"status"`
+	Result          []byte             `json:"result"`
+	Error           *string            `json:"error"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	VerifiedLabel   *string            `json:"verified_label"`
+	VerifiedBy      pgtype.UUID        `json:"verified_by"`
+	VerifiedAt      pgtype.Timestamptz `json:"verified_at"`
+	FeedbackLabel   *string            `json:"feedback_label"`
+	FeedbackComment *string            `json:"feedback_comment"`
+	FeedbackAt      pgtype.Timestamptz `json:"feedback_at"`
+	Weight          *float64           `json:"weight"`
+	CompletedAt     pgtype.Timestamptz `json:"completed_at"`
+}
+
+type RatingPeriod struct {
+	UserID      uuid.UUID   `json:"user_id"`
+	Period      string      `json:"period"`
+	PeriodStart pgtype.Date `json:"period_start"`
+	RatingDelta int32       `json:"rating_delta"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
 }
 
 type RefreshToken struct {
@@ -57,16 +151,55 @@ type Stat struct {
 	LastScannedAt pgtype.Timestamptz `json:"last_scanned_at"`
 	CreatedAt     time.Time          `json:"created_at"`
 	UpdatedAt     time.Time          `json:"updated_at"`
+	CurrentStreak int32              `json:"current_streak"`
+	LongestStreak int32              `json:"longest_streak"`
+	WeightByTypes []byte             `json:"weight_by_types"`
+}
+
+type StatsHistory struct {
+	StatID       uuid.UUID   `json:"stat_id"`
+	Day          pgtype.Date `json:"day"`
+	Rating       int32       `json:"rating"`
+	FilesScanned int32       `json:"files_scanned"`
+	TotalWeight  float64     `json:"total_weight"`
+	TrashByTypes []byte      `json:"trash_by_types"`
 }
 
 type User struct {
-	ID             uuid.UUID `json:"id"`
-	Login          string    `json:"login"`
-	Name           string    `json:"name"`
-	HashedPassword string    `json:"hashed_password"`
-	Role           string    `json:"role"`
-	Avatar         *string   `json:"avatar"`
-	Deleted        bool      `json:"deleted"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID                uuid.UUID          `json:"id"`
+	Login             string             `json:"login"`
+	Name              string             `json:"name"`
+	HashedPassword    string             `json:"hashed_password"`
+	Role              string             `json:"role"`
+	Avatar            *string            `json:"avatar"`
+	Deleted           bool               `json:"deleted"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+	LoginUnlockedAt   pgtype.Timestamptz `json:"login_unlocked_at"`
+	Email             *string            `json:"email"`
+	EmailVerifiedAt   pgtype.Timestamptz `json:"email_verified_at"`
+	BannedAt          pgtype.Timestamptz `json:"banned_at"`
+	BannedUntil       pgtype.Timestamptz `json:"banned_until"`
+	BanReason         *string            `json:"ban_reason"`
+	LeaderboardHidden bool               `json:"leaderboard_hidden"`
+	Timezone          string             `json:"timezone"`
+}
+
+type UserIdentity struct {
+	ID          uuid.UUID          `json:"id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	Provider    string             `json:"provider"`
+	Subject     string             `json:"subject"`
+	Email       *string            `json:"email"`
+	CreatedAt   time.Time          `json:"created_at"`
+	LastLoginAt pgtype.Timestamptz `json:"last_login_at"`
+}
+
+type UserTotp struct {
+	UserID       uuid.UUID          `json:"user_id"`
+	Secret       string             `json:"secret"`
+	ConfirmedAt  pgtype.Timestamptz `json:"confirmed_at"`
+	LastUsedStep int64              `json:"last_used_step"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
 }
@@ -7,13 +7,15 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const completePrediction = `-- name: CompletePrediction :exec
 UPDATE predictions
-SET status = $1, result = $2, error = $3, updated_at = now()
+SET status = $1, result = $2, error = $3, completed_at = COALESCE(completed_at, now()), updated_at = now()
 WHERE id = $4
 `
 
@@ -34,24 +36,81 @@ func (q *Queries) CompletePrediction(ctx context.Context, arg CompletePrediction
 	return err
 }
 
+const countAdminPredictions = `-- name: CountAdminPredictions :one
+SELECT COUNT(p.id)
+FROM predictions p
+WHERE ($1::uuid IS NULL OR p.user_id = $1)
+    AND ($2::text IS NULL OR p.status = $2)
+    AND ($3::float8 IS NULL
+        OR (SELECT MAX(c.value::float8) FROM jsonb_each_text(p.result) c) < $3)
+    AND ($4::boolean IS NULL OR (p.feedback_at IS NOT NULL) = $4)
+    AND ($5::boolean IS NULL OR (p.verified_label IS NOT NULL) = $5)
+    AND ($6::timestamptz IS NULL OR p.created_at >= $6)
+    AND ($7::timestamptz IS NULL OR p.created_at < $7)
+`
+
+type CountAdminPredictionsParams struct {
+	UserID        pgtype.UUID        `json:"user_id"`
+	Status        *string            `json:"status"`
+	MaxConfidence *float64           `json:"max_confidence"`
+	HasFeedback   *bool              `json:"has_feedback"`
+	Verified      *bool              `json:"verified"`
+	CreatedFrom   pgtype.Timestamptz `json:"created_from"`
+	CreatedTo     pgtype.Timestamptz `json:"created_to"`
+}
+
+func (q *Queries) CountAdminPredictions(ctx context.Context, arg CountAdminPredictionsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAdminPredictions,
+		arg.UserID,
+		arg.Status,
+		arg.MaxConfidence,
+		arg.HasFeedback,
+		arg.Verified,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPredictionsByUserID = `-- name: CountPredictionsByUserID :one
+SELECT COUNT(id) FROM predictions
+WHERE user_id = $1
+`
+
+func (q *Queries) CountPredictionsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countPredictionsByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createNewPrediction = `-- name: CreateNewPrediction :one
 INSERT INTO predictions (
     user_id,
     trash_scan,
-    status
+    status,
+    weight
 ) VALUES (
-    $1, $2, $3
-) RETURNING id, user_id, trash_scan, status, result, error, created_at, updated_at
+    $1, $2, $3, $4
+) RETURNING id, user_id, trash_scan, status, result, error, created_at, updated_at, verified_label, verified_by, verified_at, feedback_label, feedback_comment, feedback_at, weight, completed_at
 `
 
 type CreateNewPredictionParams struct {
 	UserID    uuid.UUID `json:"user_id"`
 	TrashScan string    `json:"trash_scan"`
 	Status    string    `json:"status"`
+	Weight    *float64  `json:"weight"`
 }
 
 func (q *Queries) CreateNewPrediction(ctx context.Context, arg CreateNewPredictionParams) (Prediction, error) {
-	row := q.db.QueryRow(ctx, createNewPrediction, arg.UserID, arg.TrashScan, arg.Status)
+	row := q.db.QueryRow(ctx, createNewPrediction,
+		arg.UserID,
+		arg.TrashScan,
+		arg.Status,
+		arg.Weight,
+	)
 	var i Prediction
 	err := row.Scan(
 		&i.ID,
@@ -62,12 +121,143 @@ func (q *Queries) CreateNewPrediction(ctx context.Context, arg CreateNewPredicti
 		&i.Error,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VerifiedLabel,
+		&i.VerifiedBy,
+		&i.VerifiedAt,
+		&i.FeedbackLabel,
+		&i.FeedbackComment,
+		&i.FeedbackAt,
+		&i.Weight,
+		&i.CompletedAt,
 	)
 	return i, err
 }
 
+const deletePredictionsByUserID = `-- name: DeletePredictionsByUserID :exec
+DELETE FROM predictions
+WHERE user_id = $1
+`
+
+func (q *Queries) DeletePredictionsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePredictionsByUserID, userID)
+	return err
+}
+
+const exportPredictions = `-- name: ExportPredictions :many
+SELECT
+    p.id,
+    p.user_id,
+    u.login,
+    p.trash_scan,
+    p.status,
+    p.result,
+    p.error,
+    p.created_at,
+    p.updated_at,
+    p.verified_label,
+    p.verified_by,
+    p.verified_at,
+    p.feedback_label,
+    p.feedback_comment,
+    p.feedback_at,
+    p.weight
+FROM predictions p
+JOIN users u ON u.id = p.user_id
+WHERE ($1::uuid IS NULL OR p.user_id = $1)
+    AND ($2::text IS NULL OR p.status = $2)
+    AND ($3::float8 IS NULL
+        OR (SELECT MAX(c.value::float8) FROM jsonb_each_text(p.result) c) < $3)
+    AND ($4::boolean IS NULL OR (p.feedback_at IS NOT NULL) = $4)
+    AND ($5::boolean IS NULL OR (p.verified_label IS NOT NULL) = $5)
+    AND ($6::timestamptz IS NULL OR p.created_at >= $6)
+    AND ($7::timestamptz IS NULL OR p.created_at < $7)
+    AND (p.created_at, p.id) > ($8::timestamptz, $9::uuid)
+ORDER BY p.created_at, p.id
+LIMIT $10
+`
+
+type ExportPredictionsParams struct {
+	UserID         pgtype.UUID        `json:"user_id"`
+	Status         *string            `json:"status"`
+	MaxConfidence  *float64           `json:"max_confidence"`
+	HasFeedback    *bool              `json:"has_feedback"`
+	Verified       *bool              `json:"verified"`
+	CreatedFrom    pgtype.Timestamptz `json:"created_from"`
+	CreatedTo      pgtype.Timestamptz `json:"created_to"`
+	AfterCreatedAt time.Time          `json:"after_created_at"`
+	AfterID        uuid.UUID          `json:"after_id"`
+	Limit          int32              `json:"limit"`
+}
+
+type ExportPredictionsRow struct {
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"user_id"`
+	Login           string             `json:"login"`
+	TrashScan       string             `json:"trash_scan"`
+	Status          string             `json:"status"`
+	Result          []byte             `json:"result"`
+	Error           *string            `json:"error"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	VerifiedLabel   *string            `json:"verified_label"`
+	VerifiedBy      pgtype.UUID        `json:"verified_by"`
+	VerifiedAt      pgtype.Timestamptz `json:"verified_at"`
+	FeedbackLabel   *string            `json:"feedback_label"`
+	FeedbackComment *string            `json:"feedback_comment"`
+	FeedbackAt      pgtype.Timestamptz `json:"feedback_at"`
+	Weight          *float64           `json:"weight"`
+}
+
+func (q *Queries) ExportPredictions(ctx context.Context, arg ExportPredictionsParams) ([]ExportPredictionsRow, error) {
+	rows, err := q.db.Query(ctx, exportPredictions,
+		arg.UserID,
+		arg.Status,
+		arg.MaxConfidence,
+		arg.HasFeedback,
+		arg.Verified,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportPredictionsRow{}
+	for rows.Next() {
+		var i ExportPredictionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Login,
+			&i.TrashScan,
+			&i.Status,
+			&i.Result,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.VerifiedLabel,
+			&i.VerifiedBy,
+			&i.VerifiedAt,
+			&i.FeedbackLabel,
+			&i.FeedbackComment,
+			&i.FeedbackAt,
+			&i.Weight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPrediction = `-- name: GetPrediction :one
-SELECT id, user_id, trash_scan, status, result, error, created_at, updated_at FROM predictions
+SELECT id, user_id, trash_scan, status, result, error, created_at, updated_at, verified_label, verified_by, verified_at, feedback_label, feedback_comment, feedback_at, weight, completed_at FROM predictions
 WHERE id = $1
 `
 
@@ -83,12 +273,50 @@ func (q *Queries) GetPrediction(ctx context.Context, id uuid.UUID) (Prediction,
 		&i.Error,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VerifiedLabel,
+		&i.VerifiedBy,
+		&i.VerifiedAt,
+		&i.FeedbackLabel,
+		&i.FeedbackComment,
+		&i.FeedbackAt,
+		&i.Weight,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getPredictionForUpdate = `-- name: GetPredictionForUpdate :one
+SELECT id, user_id, trash_scan, status, result, error, created_at, updated_at, verified_label, verified_by, verified_at, feedback_label, feedback_comment, feedback_at, weight, completed_at FROM predictions
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetPredictionForUpdate(ctx context.Context, id uuid.UUID) (Prediction, error) {
+	row := q.db.QueryRow(ctx, getPredictionForUpdate, id)
+	var i Prediction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TrashScan,
+		&i.Status,
+		&i.Result,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.VerifiedLabel,
+		&i.VerifiedBy,
+		&i.VerifiedAt,
+		&i.FeedbackLabel,
+		&i.FeedbackComment,
+		&i.FeedbackAt,
+		&i.Weight,
+		&i.CompletedAt,
 	)
 	return i, err
 }
 
 const getPredictionsByUserID = `-- name: GetPredictionsByUserID :many
-SELECT id, user_id, trash_scan, status, result, error, created_at, updated_at FROM predictions
+SELECT id, user_id, trash_scan, status, result, error, created_at, updated_at, verified_label, verified_by, verified_at, feedback_label, feedback_comment, feedback_at, weight, completed_at FROM predictions
 WHERE user_id = $1
 ORDER BY updated_at DESC
 LIMIT $2 OFFSET $3
@@ -118,6 +346,181 @@ func (q *Queries) GetPredictionsByUserID(ctx context.Context, arg GetPredictions
 			&i.Error,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.VerifiedLabel,
+			&i.VerifiedBy,
+			&i.VerifiedAt,
+			&i.FeedbackLabel,
+			&i.FeedbackComment,
+			&i.FeedbackAt,
+			&i.Weight,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAdminPredictions = `-- name: ListAdminPredictions :many
+SELECT
+    p.id,
+    p.user_id,
+    u.login,
+    p.trash_scan,
+    p.status,
+    p.result,
+    p.error,
+    p.created_at,
+    p.updated_at,
+    p.verified_label,
+    p.verified_by,
+    p.verified_at,
+    p.feedback_label,
+    p.feedback_comment,
+    p.feedback_at,
+    p.weight
+FROM predictions p
+JOIN users u ON u.id = p.user_id
+WHERE ($1::uuid IS NULL OR p.user_id = $1)
+    AND ($2::text IS NULL OR p.status = $2)
+    AND ($3::float8 IS NULL
+        OR (SELECT MAX(c.value::float8) FROM jsonb_each_text(p.result) c) < $3)
+    AND ($4::boolean IS NULL OR (p.feedback_at IS NOT NULL) = $4)
+    AND ($5::boolean IS NULL OR (p.verified_label IS NOT NULL) = $5)
+    AND ($6::timestamptz IS NULL OR p.created_at >= $6)
+    AND ($7::timestamptz IS NULL OR p.created_at < $7)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $8 OFFSET $9
+`
+
+type ListAdminPredictionsParams struct {
+	UserID        pgtype.UUID        `json:"user_id"`
+	Status        *string            `json:"status"`
+	MaxConfidence *float64           `json:"max_confidence"`
+	HasFeedback   *bool              `json:"has_feedback"`
+	Verified      *bool              `json:"verified"`
+	CreatedFrom   pgtype.Timestamptz `json:"created_from"`
+	CreatedTo     pgtype.Timestamptz `json:"created_to"`
+	Limit         int32              `json:"limit"`
+	Offset        int32              `json:"offset"`
+}
+
+type ListAdminPredictionsRow struct {
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"user_id"`
+	Login           string             `json:"login"`
+	TrashScan       string             `json:"trash_scan"`
+	Status          string             `json:"status"`
+	Result          []byte             `json:"result"`
+	Error           *string            `json:"error"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	VerifiedLabel   *string            `json:"verified_label"`
+	VerifiedBy      pgtype.UUID        `json:"verified_by"`
+	VerifiedAt      pgtype.Timestamptz `json:"verified_at"`
+	FeedbackLabel   *string            `json:"feedback_label"`
+	FeedbackComment *string            `json:"feedback_comment"`
+	FeedbackAt      pgtype.Timestamptz `json:"feedback_at"`
+	Weight          *float64           `json:"weight"`
+}
+
+func (q *Queries) ListAdminPredictions(ctx context.Context, arg ListAdminPredictionsParams) ([]ListAdminPredictionsRow, error) {
+	rows, err := q.db.Query(ctx, listAdminPredictions,
+		arg.UserID,
+		arg.Status,
+		arg.MaxConfidence,
+		arg.HasFeedback,
+		arg.Verified,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAdminPredictionsRow{}
+	for rows.Next() {
+		var i ListAdminPredictionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Login,
+			&i.TrashScan,
+			&i.Status,
+			&i.Result,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.VerifiedLabel,
+			&i.VerifiedBy,
+			&i.VerifiedAt,
+			&i.FeedbackLabel,
+			&i.FeedbackComment,
+			&i.FeedbackAt,
+			&i.Weight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserPredictionsAfter = `-- name: ListUserPredictionsAfter :many
+SELECT id, user_id, trash_scan, status, result, error, created_at, updated_at, verified_label, verified_by, verified_at, feedback_label, feedback_comment, feedback_at, weight, completed_at FROM predictions
+WHERE user_id = $1
+    AND (created_at, id) > ($2::timestamptz, $3::uuid)
+ORDER BY created_at, id
+LIMIT $4
+`
+
+type ListUserPredictionsAfterParams struct {
+	UserID         uuid.UUID `json:"user_id"`
+	AfterCreatedAt time.Time `json:"after_created_at"`
+	AfterID        uuid.UUID `json:"after_id"`
+	Limit          int32     `json:"limit"`
+}
+
+func (q *Queries) ListUserPredictionsAfter(ctx context.Context, arg ListUserPredictionsAfterParams) ([]Prediction, error) {
+	rows, err := q.db.Query(ctx, listUserPredictionsAfter,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Prediction{}
+	for rows.Next() {
+		var i Prediction
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TrashScan,
+			&i.Status,
+			&i.Result,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.VerifiedLabel,
+			&i.VerifiedBy,
+			&i.VerifiedAt,
+			&i.FeedbackLabel,
+			&i.FeedbackComment,
+			&i.FeedbackAt,
+			&i.Weight,
+			&i.CompletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -128,3 +531,104 @@ func (q *Queries) GetPredictionsByUserID(ctx context.Context, arg GetPredictions
 	}
 	return items, nil
 }
+
+const reassignPredictions = `-- name: ReassignPredictions :exec
+UPDATE predictions
+SET user_id = $1
+WHERE user_id = $2
+`
+
+type ReassignPredictionsParams struct {
+	NewUserID uuid.UUID `json:"new_user_id"`
+	UserID    uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) ReassignPredictions(ctx context.Context, arg ReassignPredictionsParams) error {
+	_, err := q.db.Exec(ctx, reassignPredictions, arg.NewUserID, arg.UserID)
+	return err
+}
+
+const setPredictionFeedback = `-- name: SetPredictionFeedback :one
+UPDATE predictions
+SET feedback_label = $1,
+    feedback_comment = $2,
+    feedback_at = now()
+WHERE id = $3 AND user_id = $4
+RETURNING id, user_id, trash_scan, status, result, error, created_at, updated_at, verified_label, verified_by, verified_at, feedback_label, feedback_comment, feedback_at, weight, completed_at
+`
+
+type SetPredictionFeedbackParams struct {
+	FeedbackLabel   *string   `json:"feedback_label"`
+	FeedbackComment *string   `json:"feedback_comment"`
+	ID              uuid.UUID `json:"id"`
+	UserID          uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) SetPredictionFeedback(ctx context.Context, arg SetPredictionFeedbackParams) (Prediction, error) {
+	row := q.db.QueryRow(ctx, setPredictionFeedback,
+		arg.FeedbackLabel,
+		arg.FeedbackComment,
+		arg.ID,
+		arg.UserID,
+	)
+	var i Prediction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TrashScan,
+		&i.Status,
+		&i.Result,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.VerifiedLabel,
+		&i.VerifiedBy,
+		&i.VerifiedAt,
+		&i.FeedbackLabel,
+		&i.FeedbackComment,
+		&i.FeedbackAt,
+		&i.Weight,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const setPredictionVerifiedLabel = `-- name: SetPredictionVerifiedLabel :one
+UPDATE predictions
+SET verified_label = $1,
+    verified_by = $2,
+    verified_at = CASE WHEN $1::text IS NULL THEN NULL ELSE now() END,
+    updated_at = now()
+WHERE id = $3
+RETURNING id, user_id, trash_scan, status, result, error, created_at, updated_at, verified_label, verified_by, verified_at, feedback_label, feedback_comment, feedback_at, weight, completed_at
+`
+
+type SetPredictionVerifiedLabelParams struct {
+	VerifiedLabel *string     `json:"verified_label"`
+	VerifiedBy    pgtype.UUID `json:"verified_by"`
+	ID            uuid.UUID   `json:"id"`
+}
+
+func (q *Queries) SetPredictionVerifiedLabel(ctx context.Context, arg SetPredictionVerifiedLabelParams) (Prediction, error) {
+	row := q.db.QueryRow(ctx, setPredictionVerifiedLabel, arg.VerifiedLabel, arg.VerifiedBy, arg.ID)
+	var i Prediction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TrashScan,
+		&i.Status,
+		&i.Result,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.VerifiedLabel,
+		&i.VerifiedBy,
+		&i.VerifiedAt,
+		&i.FeedbackLabel,
+		&i.FeedbackComment,
+		&i.FeedbackAt,
+		&i.Weight,
+		&i.CompletedAt,
+	)
+	return i, err
+}
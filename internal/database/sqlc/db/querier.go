@@ -11,29 +11,121 @@ import (
 )
 
 type Querier interface {
+	AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) (OrganizationMember, error)
+	AddRatingDelta(ctx context.Context, arg AddRatingDeltaParams) error
+	BanUser(ctx context.Context, arg BanUserParams) (int64, error)
 	CompletePrediction(ctx context.Context, arg CompletePredictionParams) error
-	CountUsers(ctx context.Context) (int64, error)
+	ConfirmUserTOTP(ctx context.Context, userID uuid.UUID) (int64, error)
+	ConsumeEmailVerificationToken(ctx context.Context, tokenHash string) (ConsumeEmailVerificationTokenRow, error)
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (uuid.UUID, error)
+	ConsumeRecoveryCode(ctx context.Context, arg ConsumeRecoveryCodeParams) (int64, error)
+	CountAdminPredictions(ctx context.Context, arg CountAdminPredictionsParams) (int64, error)
+	CountAdminUsers(ctx context.Context, arg CountAdminUsersParams) (int64, error)
+	CountAuditEvents(ctx context.Context, arg CountAuditEventsParams) (int64, error)
+	CountLoginHistory(ctx context.Context, arg CountLoginHistoryParams) (int64, error)
+	CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error)
+	CountPredictionsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountSoleOwnedOrganizations(ctx context.Context, userID uuid.UUID) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (CreateAuditEventRow, error)
+	CreateEmailVerificationToken(ctx context.Context, arg CreateEmailVerificationTokenParams) (uuid.UUID, error)
 	CreateLoginHistory(ctx context.Context, arg CreateLoginHistoryParams) (uuid.UUID, error)
 	CreateNewPrediction(ctx context.Context, arg CreateNewPredictionParams) (Prediction, error)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (CreateOrganizationRow, error)
+	CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) (uuid.UUID, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error
 	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (uuid.UUID, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UUID, error)
+	CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (uuid.UUID, error)
+	DeleteGuestUser(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteOrganization(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (int64, error)
+	DeletePredictionsByUserID(ctx context.Context, userID uuid.UUID) error
+	DeleteStatsByUserID(ctx context.Context, userID uuid.UUID) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	DeleteUserRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+	DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error
+	ExportAdminUsers(ctx context.Context, arg ExportAdminUsersParams) ([]ExportAdminUsersRow, error)
+	ExportPredictions(ctx context.Context, arg ExportPredictionsParams) ([]ExportPredictionsRow, error)
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (ApiKey, error)
 	GetActiveTokensByUser(ctx context.Context, userID uuid.UUID) ([]RefreshToken, error)
 	GetAdminUserByID(ctx context.Context, id uuid.UUID) (GetAdminUserByIDRow, error)
 	GetAdminUsers(ctx context.Context, arg GetAdminUsersParams) ([]GetAdminUsersRow, error)
+	GetDailyActivity(ctx context.Context, arg GetDailyActivityParams) ([]GetDailyActivityRow, error)
+	GetLoginCounts(ctx context.Context, arg GetLoginCountsParams) ([]GetLoginCountsRow, error)
+	GetLoginFailuresByIP(ctx context.Context, arg GetLoginFailuresByIPParams) (GetLoginFailuresByIPRow, error)
+	GetLoginFailuresByUser(ctx context.Context, arg GetLoginFailuresByUserParams) (GetLoginFailuresByUserRow, error)
 	GetLoginHistoryByUser(ctx context.Context, arg GetLoginHistoryByUserParams) ([]LoginHistory, error)
+	GetOrganization(ctx context.Context, id uuid.UUID) (Organization, error)
+	GetOrganizationByInviteCode(ctx context.Context, inviteCode string) (Organization, error)
+	GetOrganizationForUpdate(ctx context.Context, id uuid.UUID) (Organization, error)
+	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (OrganizationMember, error)
+	GetOrganizationPeriodLeaderboard(ctx context.Context, arg GetOrganizationPeriodLeaderboardParams) ([]GetOrganizationPeriodLeaderboardRow, error)
+	GetOrganizationRatingLeaderboard(ctx context.Context, limit int32) ([]GetOrganizationRatingLeaderboardRow, error)
+	GetOrganizationStats(ctx context.Context, organizationID uuid.UUID) (GetOrganizationStatsRow, error)
+	GetOrganizationTypeTotals(ctx context.Context, organizationID uuid.UUID) ([]GetOrganizationTypeTotalsRow, error)
+	GetPeriodLeaderboard(ctx context.Context, arg GetPeriodLeaderboardParams) ([]GetPeriodLeaderboardRow, error)
+	GetPeriodRank(ctx context.Context, arg GetPeriodRankParams) (GetPeriodRankRow, error)
 	GetPrediction(ctx context.Context, id uuid.UUID) (Prediction, error)
+	GetPredictionForUpdate(ctx context.Context, id uuid.UUID) (Prediction, error)
+	GetPredictionStatusCounts(ctx context.Context, arg GetPredictionStatusCountsParams) ([]GetPredictionStatusCountsRow, error)
+	GetPredictionTypeCounts(ctx context.Context, arg GetPredictionTypeCountsParams) ([]GetPredictionTypeCountsRow, error)
 	GetPredictionsByUserID(ctx context.Context, arg GetPredictionsByUserIDParams) ([]Prediction, error)
+	GetPredictorPerformance(ctx context.Context, arg GetPredictorPerformanceParams) (GetPredictorPerformanceRow, error)
+	GetRatingLeaderboard(ctx context.Context, limit int32) ([]GetRatingLeaderboardRow, error)
+	GetRatingRank(ctx context.Context, userID uuid.UUID) (GetRatingRankRow, error)
 	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshToken, error)
+	GetRegistrationCounts(ctx context.Context, arg GetRegistrationCountsParams) ([]GetRegistrationCountsRow, error)
 	GetStatsByUserID(ctx context.Context, userID uuid.UUID) (Stat, error)
+	GetStatsHistory(ctx context.Context, arg GetStatsHistoryParams) ([]StatsHistory, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserByLogin(ctx context.Context, login string) (User, error)
+	GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error)
+	GetUserTOTP(ctx context.Context, userID uuid.UUID) (UserTotp, error)
+	IncrementDailyActivity(ctx context.Context, arg IncrementDailyActivityParams) error
+	InvalidateUserEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error
+	InvalidateUserPasswordResetTokens(ctx context.Context, userID uuid.UUID) error
+	ListAdminPredictions(ctx context.Context, arg ListAdminPredictionsParams) ([]ListAdminPredictionsRow, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListExpiredGuestIDsAfter(ctx context.Context, arg ListExpiredGuestIDsAfterParams) ([]uuid.UUID, error)
+	ListLoginHistory(ctx context.Context, arg ListLoginHistoryParams) ([]LoginHistory, error)
+	ListOrganizationMembers(ctx context.Context, arg ListOrganizationMembersParams) ([]ListOrganizationMembersRow, error)
+	ListStatsUserIDsAfter(ctx context.Context, arg ListStatsUserIDsAfterParams) ([]uuid.UUID, error)
+	ListUserAPIKeys(ctx context.Context, userID uuid.UUID) ([]ApiKey, error)
+	ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]ListUserOrganizationsRow, error)
+	ListUserPredictionsAfter(ctx context.Context, arg ListUserPredictionsAfterParams) ([]Prediction, error)
+	LockStatsByUserID(ctx context.Context, userID uuid.UUID) error
+	MarkUserEmailVerified(ctx context.Context, arg MarkUserEmailVerifiedParams) (int64, error)
+	PurgeUser(ctx context.Context, id uuid.UUID) (int64, error)
+	ReassignDailyActivity(ctx context.Context, arg ReassignDailyActivityParams) error
+	ReassignPredictions(ctx context.Context, arg ReassignPredictionsParams) error
+	ReassignRatingPeriods(ctx context.Context, arg ReassignRatingPeriodsParams) error
+	ReassignStats(ctx context.Context, arg ReassignStatsParams) (int64, error)
+	RestoreUser(ctx context.Context, id uuid.UUID) (int64, error)
+	RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (int64, error)
 	RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error
 	RevokeRefreshToken(ctx context.Context, tokenHash string) error
+	RevokeRefreshTokenByID(ctx context.Context, arg RevokeRefreshTokenByIDParams) (int64, error)
+	SetPredictionFeedback(ctx context.Context, arg SetPredictionFeedbackParams) (Prediction, error)
+	SetPredictionVerifiedLabel(ctx context.Context, arg SetPredictionVerifiedLabelParams) (Prediction, error)
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+	TouchUserIdentity(ctx context.Context, arg TouchUserIdentityParams) error
+	UnbanUser(ctx context.Context, id uuid.UUID) (int64, error)
+	UnlockUserLogin(ctx context.Context, id uuid.UUID) (int64, error)
+	UpdateOrganizationInviteCode(ctx context.Context, arg UpdateOrganizationInviteCodeParams) (int64, error)
+	UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (int64, error)
 	UpdateStats(ctx context.Context, arg UpdateStatsParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
 	UpdateUserAvatar(ctx context.Context, arg UpdateUserAvatarParams) error
+	UpdateUserByAdmin(ctx context.Context, arg UpdateUserByAdminParams) (int64, error)
+	UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error
+	UpdateUserLeaderboardHidden(ctx context.Context, arg UpdateUserLeaderboardHiddenParams) error
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpdateUserTimezone(ctx context.Context, arg UpdateUserTimezoneParams) error
+	UpsertStatsSnapshot(ctx context.Context, arg UpsertStatsSnapshotParams) error
+	UpsertUserTOTP(ctx context.Context, arg UpsertUserTOTPParams) error
+	UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
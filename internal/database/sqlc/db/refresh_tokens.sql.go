@@ -113,3 +113,22 @@ func (q *Queries) RevokeRefreshToken(ctx context.Context, tokenHash string) erro
 	_, err := q.db.Exec(ctx, revokeRefreshToken, tokenHash)
 	return err
 }
+
+const revokeRefreshTokenByID = `-- name: RevokeRefreshTokenByID :execrows
+UPDATE refresh_tokens
+SET revoked = TRUE, revoked_at = now(), updated_at = now()
+WHERE id = $1 AND user_id = $2 AND revoked = FALSE
+`
+
+type RevokeRefreshTokenByIDParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) RevokeRefreshTokenByID(ctx context.Context, arg RevokeRefreshTokenByIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeRefreshTokenByID, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
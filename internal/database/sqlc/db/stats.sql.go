@@ -12,8 +12,18 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteStatsByUserID = `-- name: DeleteStatsByUserID :exec
+DELETE FROM stats
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteStatsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteStatsByUserID, userID)
+	return err
+}
+
 const getStatsByUserID = `-- name: GetStatsByUserID :one
-SELECT id, user_id, status, rating, files_scanned, total_weight, achievements, trash_by_types, last_scanned_at, created_at, updated_at FROM stats
+SELECT id, user_id, status, rating, files_scanned, total_weight, achievements, trash_by_types, last_scanned_at, created_at, updated_at, current_streak, longest_streak, weight_by_types FROM stats
 WHERE user_id = $1
 `
 
@@ -32,10 +42,118 @@ func (q *Queries) GetStatsByUserID(ctx context.Context, userID uuid.UUID) (Stat,
 		&i.LastScannedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CurrentStreak,
+		&i.LongestStreak,
+		&i.WeightByTypes,
 	)
 	return i, err
 }
 
+const getStatsHistory = `-- name: GetStatsHistory :many
+SELECT stat_id, day, rating, files_scanned, total_weight, trash_by_types FROM stats_history
+WHERE stat_id = $1
+    AND day >= COALESCE(
+        (SELECT MAX(b.day) FROM stats_history b WHERE b.stat_id = $1 AND b.day < $2),
+        $2)
+    AND day <= $3
+ORDER BY day
+`
+
+type GetStatsHistoryParams struct {
+	StatID  uuid.UUID   `json:"stat_id"`
+	DayFrom pgtype.Date `json:"day_from"`
+	DayTo   pgtype.Date `json:"day_to"`
+}
+
+func (q *Queries) GetStatsHistory(ctx context.Context, arg GetStatsHistoryParams) ([]StatsHistory, error) {
+	rows, err := q.db.Query(ctx, getStatsHistory, arg.StatID, arg.DayFrom, arg.DayTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StatsHistory{}
+	for rows.Next() {
+		var i StatsHistory
+		if err := rows.Scan(
+			&i.StatID,
+			&i.Day,
+			&i.Rating,
+			&i.FilesScanned,
+			&i.TotalWeight,
+			&i.TrashByTypes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStatsUserIDsAfter = `-- name: ListStatsUserIDsAfter :many
+SELECT user_id FROM stats
+WHERE user_id > $1::uuid
+ORDER BY user_id
+LIMIT $2
+`
+
+type ListStatsUserIDsAfterParams struct {
+	AfterID uuid.UUID `json:"after_id"`
+	Limit   int32     `json:"limit"`
+}
+
+func (q *Queries) ListStatsUserIDsAfter(ctx context.Context, arg ListStatsUserIDsAfterParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listStatsUserIDsAfter, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockStatsByUserID = `-- name: LockStatsByUserID :exec
+SELECT id FROM stats
+WHERE user_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockStatsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockStatsByUserID, userID)
+	return err
+}
+
+const reassignStats = `-- name: ReassignStats :execrows
+UPDATE stats
+SET user_id = $1, updated_at = now()
+WHERE user_id = $2
+`
+
+type ReassignStatsParams struct {
+	NewUserID uuid.UUID `json:"new_user_id"`
+	UserID    uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) ReassignStats(ctx context.Context, arg ReassignStatsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reassignStats, arg.NewUserID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateStats = `-- name: UpdateStats :exec
 UPDATE stats
 SET
@@ -46,8 +164,11 @@ SET
     achievements = $5,
     trash_by_types = $6,
     last_scanned_at = $7,
+    current_streak = $8,
+    longest_streak = $9,
+    weight_by_types = $10,
     updated_at = now()
-WHERE id = $8
+WHERE id = $11
 `
 
 type UpdateStatsParams struct {
@@ -58,6 +179,9 @@ type UpdateStatsParams struct {
 	Achievements  []byte             `json:"achievements"`
 	TrashByTypes  []byte             `json:"trash_by_types"`
 	LastScannedAt pgtype.Timestamptz `json:"last_scanned_at"`
+	CurrentStreak int32              `json:"current_streak"`
+	LongestStreak int32              `json:"longest_streak"`
+	WeightByTypes []byte             `json:"weight_by_types"`
 	ID            uuid.UUID          `json:"id"`
 }
 
@@ -70,7 +194,42 @@ func (q *Queries) UpdateStats(ctx context.Context, arg UpdateStatsParams) error
 		arg.Achievements,
 		arg.TrashByTypes,
 		arg.LastScannedAt,
+		arg.CurrentStreak,
+		arg.LongestStreak,
+		arg.WeightByTypes,
 		arg.ID,
 	)
 	return err
 }
+
+const upsertStatsSnapshot = `-- name: UpsertStatsSnapshot :exec
+INSERT INTO stats_history (stat_id, day, rating, files_scanned, total_weight, trash_by_types)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (stat_id, day)
+DO UPDATE SET
+    rating = EXCLUDED.rating,
+    files_scanned = EXCLUDED.files_scanned,
+    total_weight = EXCLUDED.total_weight,
+    trash_by_types = EXCLUDED.trash_by_types
+`
+
+type UpsertStatsSnapshotParams struct {
+	StatID       uuid.UUID   `json:"stat_id"`
+	Day          pgtype.Date `json:"day"`
+	Rating       int32       `json:"rating"`
+	FilesScanned int32       `json:"files_scanned"`
+	TotalWeight  float64     `json:"total_weight"`
+	TrashByTypes []byte      `json:"trash_by_types"`
+}
+
+func (q *Queries) UpsertStatsSnapshot(ctx context.Context, arg UpsertStatsSnapshotParams) error {
+	_, err := q.db.Exec(ctx, upsertStatsSnapshot,
+		arg.StatID,
+		arg.Day,
+		arg.Rating,
+		arg.FilesScanned,
+		arg.TotalWeight,
+		arg.TrashByTypes,
+	)
+	return err
+}
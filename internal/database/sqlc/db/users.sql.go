@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -17,25 +18,31 @@ WITH new_user AS (
         name,
         login,
         hashed_password,
-        role
+        role,
+        email
     ) VALUES (
-        $1, $2, $3, $4
+        $1, $2, $3, $4, $5
     ) RETURNING id
 ),
 new_stats AS (
     INSERT INTO stats (user_id)
     SELECT id FROM new_user
     RETURNING id
+),
+new_history AS (
+    INSERT INTO stats_history (stat_id, day)
+    SELECT id, CURRENT_DATE - 1 FROM new_stats
 )
 SELECT new_user.id as user_id
 FROM new_user
 `
 
 type CreateUserParams struct {
-	Name           string `json:"name"`
-	Login          string `json:"login"`
-	HashedPassword string `json:"hashed_password"`
-	Role           string `json:"role"`
+	Name           string  `json:"name"`
+	Login          string  `json:"login"`
+	HashedPassword string  `json:"hashed_password"`
+	Role           string  `json:"role"`
+	Email          *string `json:"email"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UUID, error) {
@@ -44,12 +51,26 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UU
 		arg.Login,
 		arg.HashedPassword,
 		arg.Role,
+		arg.Email,
 	)
 	var user_id uuid.UUID
 	err := row.Scan(&user_id)
 	return user_id, err
 }
 
+const deleteGuestUser = `-- name: DeleteGuestUser :execrows
+DELETE FROM users
+WHERE id = $1 AND role = 'anonymous'
+`
+
+func (q *Queries) DeleteGuestUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGuestUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteUser = `-- name: DeleteUser :exec
 UPDATE users
 SET deleted = TRUE, updated_at = now()
@@ -61,8 +82,38 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, login, name, hashed_password, role, avatar, deleted, created_at, updated_at, login_unlocked_at, email, email_verified_at, banned_at, banned_until, ban_reason, leaderboard_hidden, timezone FROM users
+WHERE lower(email) = lower($1) AND deleted = FALSE
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Login,
+		&i.Name,
+		&i.HashedPassword,
+		&i.Role,
+		&i.Avatar,
+		&i.Deleted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LoginUnlockedAt,
+		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.LeaderboardHidden,
+		&i.Timezone,
+	)
+	return i, err
+}
+
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, login, name, hashed_password, role, avatar, deleted, created_at, updated_at FROM users
+SELECT id, login, name, hashed_password, role, avatar, deleted, created_at, updated_at, login_unlocked_at, email, email_verified_at, banned_at, banned_until, ban_reason, leaderboard_hidden, timezone FROM users
 WHERE id = $1 AND deleted = FALSE
 `
 
@@ -79,12 +130,20 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.Deleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LoginUnlockedAt,
+		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.LeaderboardHidden,
+		&i.Timezone,
 	)
 	return i, err
 }
 
 const getUserByLogin = `-- name: GetUserByLogin :one
-SELECT id, login, name, hashed_password, role, avatar, deleted, created_at, updated_at FROM users
+SELECT id, login, name, hashed_password, role, avatar, deleted, created_at, updated_at, login_unlocked_at, email, email_verified_at, banned_at, banned_until, ban_reason, leaderboard_hidden, timezone FROM users
 WHERE login = $1 AND deleted = FALSE
 `
 
@@ -101,10 +160,84 @@ func (q *Queries) GetUserByLogin(ctx context.Context, login string) (User, error
 		&i.Deleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LoginUnlockedAt,
+		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.LeaderboardHidden,
+		&i.Timezone,
 	)
 	return i, err
 }
 
+const listExpiredGuestIDsAfter = `-- name: ListExpiredGuestIDsAfter :many
+SELECT id FROM users
+WHERE role = 'anonymous' AND created_at < $1::timestamptz AND id > $2::uuid
+ORDER BY id
+LIMIT $3
+`
+
+type ListExpiredGuestIDsAfterParams struct {
+	CreatedBefore time.Time `json:"created_before"`
+	AfterID       uuid.UUID `json:"after_id"`
+	Limit         int32     `json:"limit"`
+}
+
+func (q *Queries) ListExpiredGuestIDsAfter(ctx context.Context, arg ListExpiredGuestIDsAfterParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listExpiredGuestIDsAfter, arg.CreatedBefore, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markUserEmailVerified = `-- name: MarkUserEmailVerified :execrows
+UPDATE users
+SET email_verified_at = now(), updated_at = now()
+WHERE id = $1 AND lower(email) = lower($2) AND deleted = FALSE
+`
+
+type MarkUserEmailVerifiedParams struct {
+	ID    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
+}
+
+func (q *Queries) MarkUserEmailVerified(ctx context.Context, arg MarkUserEmailVerifiedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markUserEmailVerified, arg.ID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unlockUserLogin = `-- name: UnlockUserLogin :execrows
+UPDATE users
+SET login_unlocked_at = now(), updated_at = now()
+WHERE id = $1 AND deleted = FALSE
+`
+
+func (q *Queries) UnlockUserLogin(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unlockUserLogin, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
 SET name = $1, updated_at = now()
@@ -137,6 +270,38 @@ func (q *Queries) UpdateUserAvatar(ctx context.Context, arg UpdateUserAvatarPara
 	return err
 }
 
+const updateUserEmail = `-- name: UpdateUserEmail :exec
+UPDATE users
+SET email = $1, email_verified_at = NULL, updated_at = now()
+WHERE id = $2
+`
+
+type UpdateUserEmailParams struct {
+	Email *string   `json:"email"`
+	ID    uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error {
+	_, err := q.db.Exec(ctx, updateUserEmail, arg.Email, arg.ID)
+	return err
+}
+
+const updateUserLeaderboardHidden = `-- name: UpdateUserLeaderboardHidden :exec
+UPDATE users
+SET leaderboard_hidden = $1, updated_at = now()
+WHERE id = $2
+`
+
+type UpdateUserLeaderboardHiddenParams struct {
+	LeaderboardHidden bool      `json:"leaderboard_hidden"`
+	ID                uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateUserLeaderboardHidden(ctx context.Context, arg UpdateUserLeaderboardHiddenParams) error {
+	_, err := q.db.Exec(ctx, updateUserLeaderboardHidden, arg.LeaderboardHidden, arg.ID)
+	return err
+}
+
 const updateUserPassword = `-- name: UpdateUserPassword :exec
 UPDATE users
 SET hashed_password = $1, updated_at = now()
@@ -152,3 +317,19 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 	_, err := q.db.Exec(ctx, updateUserPassword, arg.HashedPassword, arg.ID)
 	return err
 }
+
+const updateUserTimezone = `-- name: UpdateUserTimezone :exec
+UPDATE users
+SET timezone = $1, updated_at = now()
+WHERE id = $2
+`
+
+type UpdateUserTimezoneParams struct {
+	Timezone string    `json:"timezone"`
+	ID       uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateUserTimezone(ctx context.Context, arg UpdateUserTimezoneParams) error {
+	_, err := q.db.Exec(ctx, updateUserTimezone, arg.Timezone, arg.ID)
+	return err
+}
@@ -0,0 +1,23 @@
+-- name: IncrementDailyActivity :exec
+INSERT INTO daily_activity (user_id, day, scans)
+VALUES (@user_id, @day, 1)
+ON CONFLICT (user_id, day)
+DO UPDATE SET scans = daily_activity.scans + 1;
+
+-- name: GetDailyActivity :many
+SELECT day, scans FROM daily_activity
+WHERE user_id = @user_id
+    AND day >= sqlc.arg('day_from')
+    AND day <= sqlc.arg('day_to')
+ORDER BY day;
+
+-- name: ReassignDailyActivity :exec
+WITH moved AS (
+    DELETE FROM daily_activity
+    WHERE daily_activity.user_id = @user_id
+    RETURNING day, scans
+)
+INSERT INTO daily_activity (user_id, day, scans)
+SELECT @new_user_id::uuid, day, scans FROM moved
+ON CONFLICT (user_id, day)
+DO UPDATE SET scans = daily_activity.scans + EXCLUDED.scans;
@@ -8,6 +8,9 @@ SELECT
     u.deleted, 
     u.created_at, 
     u.updated_at,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
     s.status,
     s.rating,
     s.files_scanned,
@@ -22,8 +25,26 @@ LEFT JOIN (
     WHERE success = true
     GROUP BY user_id
 ) lh ON lh.user_id = u.id
-ORDER BY u.created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE (sqlc.narg(search)::text IS NULL
+        OR u.login ILIKE '%' || sqlc.narg(search) || '%'
+        OR u.name ILIKE '%' || sqlc.narg(search) || '%')
+    AND (sqlc.narg(role)::text IS NULL OR u.role = sqlc.narg(role))
+    AND (sqlc.narg(deleted)::boolean IS NULL OR u.deleted = sqlc.narg(deleted))
+    AND (sqlc.narg(banned)::boolean IS NULL
+        OR (u.banned_at IS NOT NULL AND (u.banned_until IS NULL OR u.banned_until > now())) = sqlc.narg(banned))
+    AND (sqlc.narg(status)::text IS NULL OR s.status = sqlc.narg(status))
+    AND (sqlc.narg(last_login_from)::timestamptz IS NULL OR lh.last_login_at >= sqlc.narg(last_login_from))
+    AND (sqlc.narg(last_login_to)::timestamptz IS NULL OR lh.last_login_at < sqlc.narg(last_login_to))
+ORDER BY
+    CASE WHEN @sort_by::text = 'rating' AND NOT @sort_asc::boolean THEN s.rating END DESC NULLS LAST,
+    CASE WHEN @sort_by::text = 'rating' AND @sort_asc::boolean THEN s.rating END ASC NULLS LAST,
+    CASE WHEN @sort_by::text = 'files_scanned' AND NOT @sort_asc::boolean THEN s.files_scanned END DESC NULLS LAST,
+    CASE WHEN @sort_by::text = 'files_scanned' AND @sort_asc::boolean THEN s.files_scanned END ASC NULLS LAST,
+    CASE WHEN @sort_by::text = 'last_login' AND NOT @sort_asc::boolean THEN lh.last_login_at END DESC NULLS LAST,
+    CASE WHEN @sort_by::text = 'last_login' AND @sort_asc::boolean THEN lh.last_login_at END ASC NULLS LAST,
+    CASE WHEN @sort_asc::boolean THEN u.created_at END ASC,
+    u.created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: GetAdminUserByID :one
 SELECT 
@@ -35,6 +56,9 @@ SELECT
     u.deleted, 
     u.created_at, 
     u.updated_at,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
     s.status,
     s.rating,
     s.files_scanned,
@@ -51,5 +75,91 @@ LEFT JOIN (
 ) lh ON lh.user_id = u.id
 WHERE u.id = $1;
 
--- name: CountUsers :one
-SELECT COUNT(id) FROM users;
+-- name: CountAdminUsers :one
+SELECT COUNT(u.id)
+FROM users u
+LEFT JOIN stats s ON s.user_id = u.id
+LEFT JOIN (
+    SELECT user_id, MAX(created_at) AS last_login_at
+    FROM login_history
+    WHERE success = true
+    GROUP BY user_id
+) lh ON lh.user_id = u.id
+WHERE (sqlc.narg(search)::text IS NULL
+        OR u.login ILIKE '%' || sqlc.narg(search) || '%'
+        OR u.name ILIKE '%' || sqlc.narg(search) || '%')
+    AND (sqlc.narg(role)::text IS NULL OR u.role = sqlc.narg(role))
+    AND (sqlc.narg(deleted)::boolean IS NULL OR u.deleted = sqlc.narg(deleted))
+    AND (sqlc.narg(banned)::boolean IS NULL
+        OR (u.banned_at IS NOT NULL AND (u.banned_until IS NULL OR u.banned_until > now())) = sqlc.narg(banned))
+    AND (sqlc.narg(status)::text IS NULL OR s.status = sqlc.narg(status))
+    AND (sqlc.narg(last_login_from)::timestamptz IS NULL OR lh.last_login_at >= sqlc.narg(last_login_from))
+    AND (sqlc.narg(last_login_to)::timestamptz IS NULL OR lh.last_login_at < sqlc.narg(last_login_to));
+
+-- name: UpdateUserByAdmin :execrows
+UPDATE users
+SET
+    name = COALESCE(sqlc.narg(name), name),
+    role = COALESCE(sqlc.narg(role), role),
+    updated_at = now()
+WHERE id = @id;
+
+-- name: BanUser :execrows
+UPDATE users
+SET banned_at = now(), banned_until = @banned_until, ban_reason = @ban_reason, updated_at = now()
+WHERE id = @id;
+
+-- name: UnbanUser :execrows
+UPDATE users
+SET banned_at = NULL, banned_until = NULL, ban_reason = NULL, updated_at = now()
+WHERE id = $1 AND banned_at IS NOT NULL;
+
+-- name: RestoreUser :execrows
+UPDATE users
+SET deleted = FALSE, updated_at = now()
+WHERE id = $1 AND deleted = TRUE;
+
+-- name: PurgeUser :execrows
+DELETE FROM users
+WHERE id = $1;
+
+-- name: ExportAdminUsers :many
+SELECT
+    u.id,
+    u.login,
+    u.name,
+    u.role,
+    u.avatar,
+    u.deleted,
+    u.created_at,
+    u.updated_at,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
+    s.status,
+    s.rating,
+    s.files_scanned,
+    s.total_weight,
+    s.last_scanned_at,
+    lh.last_login_at
+FROM users u
+LEFT JOIN stats s ON s.user_id = u.id
+LEFT JOIN (
+    SELECT user_id, MAX(created_at) AS last_login_at
+    FROM login_history
+    WHERE success = true
+    GROUP BY user_id
+) lh ON lh.user_id = u.id
+WHERE (sqlc.narg(search)::text IS NULL
+        OR u.login ILIKE '%' || sqlc.narg(search) || '%'
+        OR u.name ILIKE '%' || sqlc.narg(search) || '%')
+    AND (sqlc.narg(role)::text IS NULL OR u.role = sqlc.narg(role))
+    AND (sqlc.narg(deleted)::boolean IS NULL OR u.deleted = sqlc.narg(deleted))
+    AND (sqlc.narg(banned)::boolean IS NULL
+        OR (u.banned_at IS NOT NULL AND (u.banned_until IS NULL OR u.banned_until > now())) = sqlc.narg(banned))
+    AND (sqlc.narg(status)::text IS NULL OR s.status = sqlc.narg(status))
+    AND (sqlc.narg(last_login_from)::timestamptz IS NULL OR lh.last_login_at >= sqlc.narg(last_login_from))
+    AND (sqlc.narg(last_login_to)::timestamptz IS NULL OR lh.last_login_at < sqlc.narg(last_login_to))
+    AND (u.created_at, u.id) > (@after_created_at::timestamptz, @after_id::uuid)
+ORDER BY u.created_at, u.id
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,62 @@
+-- name: GetRegistrationCounts :many
+SELECT
+    date_trunc(@bucket::text, created_at, 'UTC')::timestamptz AS bucket,
+    COUNT(*) AS count
+FROM users
+WHERE role <> 'anonymous'
+  AND created_at >= @created_from::timestamptz
+  AND created_at < @created_to::timestamptz
+GROUP BY 1
+ORDER BY 1;
+
+-- name: GetLoginCounts :many
+SELECT
+    date_trunc(@bucket::text, created_at, 'UTC')::timestamptz AS bucket,
+    COUNT(*) FILTER (WHERE success) AS successful,
+    COUNT(*) FILTER (WHERE NOT success) AS failed
+FROM login_history
+WHERE created_at >= @created_from::timestamptz
+  AND created_at < @created_to::timestamptz
+GROUP BY 1
+ORDER BY 1;
+
+-- name: GetPredictionStatusCounts :many
+SELECT
+    date_trunc(@bucket::text, created_at, 'UTC')::timestamptz AS bucket,
+    status,
+    COUNT(*) AS count
+FROM predictions
+WHERE created_at >= @created_from::timestamptz
+  AND created_at < @created_to::timestamptz
+GROUP BY 1, 2
+ORDER BY 1, 2;
+
+-- name: GetPredictionTypeCounts :many
+SELECT
+    date_trunc(@bucket::text, p.created_at, 'UTC')::timestamptz AS bucket,
+    top.trash_type::text AS trash_type,
+    COUNT(*) AS count
+FROM predictions p
+CROSS JOIN LATERAL (
+    SELECT r.key AS trash_type
+    FROM jsonb_each_text(p.result) r
+    ORDER BY r.value::float8 DESC
+    LIMIT 1
+) top
+WHERE p.status = 'completed'
+  AND p.created_at >= @created_from::timestamptz
+  AND p.created_at < @created_to::timestamptz
+GROUP BY 1, 2
+ORDER BY 1, 3 DESC;
+
+-- name: GetPredictorPerformance :one
+SELECT
+    COUNT(*) FILTER (WHERE status = 'completed') AS completed,
+    COUNT(*) FILTER (WHERE status = 'failed') AS failed,
+    COALESCE(
+        AVG(EXTRACT(EPOCH FROM completed_at - created_at) * 1000) FILTER (WHERE completed_at IS NOT NULL),
+        0
+    )::float8 AS avg_latency_ms
+FROM predictions
+WHERE created_at >= @created_from::timestamptz
+  AND created_at < @created_to::timestamptz;
@@ -0,0 +1,20 @@
+-- name: CreateEmailVerificationToken :one
+INSERT INTO email_verification_tokens (
+    user_id,
+    email,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id;
+
+-- name: ConsumeEmailVerificationToken :one
+UPDATE email_verification_tokens
+SET used = TRUE, used_at = now()
+WHERE token_hash = $1 AND used = FALSE AND expires_at > now()
+RETURNING user_id, email;
+
+-- name: InvalidateUserEmailVerificationTokens :exec
+UPDATE email_verification_tokens
+SET used = TRUE, used_at = now()
+WHERE user_id = $1 AND used = FALSE;
@@ -0,0 +1,144 @@
+-- name: AddRatingDelta :exec
+INSERT INTO rating_periods (user_id, period, period_start, rating_delta)
+VALUES (@user_id, @period, @period_start, @rating_delta)
+ON CONFLICT (user_id, period, period_start)
+DO UPDATE SET rating_delta = rating_periods.rating_delta + EXCLUDED.rating_delta, updated_at = now();
+
+-- name: GetRatingLeaderboard :many
+SELECT
+    RANK() OVER (ORDER BY s.rating DESC) AS rank,
+    u.id AS user_id,
+    u.name,
+    s.status,
+    s.rating AS score
+FROM stats s
+JOIN users u ON u.id = s.user_id
+WHERE s.rating > 0
+    AND u.deleted = FALSE
+    AND u.leaderboard_hidden = FALSE
+    AND u.role <> 'anonymous'
+    AND (u.banned_at IS NULL OR u.banned_until <= now())
+ORDER BY s.rating DESC, u.id
+LIMIT sqlc.arg('limit');
+
+-- name: GetRatingRank :one
+SELECT ranked.rank, ranked.user_id, ranked.name, ranked.status, ranked.score
+FROM (
+    SELECT
+        RANK() OVER (ORDER BY s.rating DESC) AS rank,
+        u.id AS user_id,
+        u.name,
+        s.status,
+        s.rating AS score
+    FROM stats s
+    JOIN users u ON u.id = s.user_id
+    WHERE s.rating > 0
+        AND u.deleted = FALSE
+        AND u.leaderboard_hidden = FALSE
+        AND u.role <> 'anonymous'
+        AND (u.banned_at IS NULL OR u.banned_until <= now())
+) ranked
+WHERE ranked.user_id = @user_id;
+
+-- name: GetPeriodLeaderboard :many
+SELECT
+    RANK() OVER (ORDER BY rp.rating_delta DESC) AS rank,
+    u.id AS user_id,
+    u.name,
+    s.status,
+    rp.rating_delta AS score
+FROM rating_periods rp
+JOIN users u ON u.id = rp.user_id
+JOIN stats s ON s.user_id = rp.user_id
+WHERE rp.period = @period
+    AND rp.period_start = @period_start
+    AND rp.rating_delta > 0
+    AND u.deleted = FALSE
+    AND u.leaderboard_hidden = FALSE
+    AND u.role <> 'anonymous'
+    AND (u.banned_at IS NULL OR u.banned_until <= now())
+ORDER BY rp.rating_delta DESC, u.id
+LIMIT sqlc.arg('limit');
+
+-- name: GetPeriodRank :one
+SELECT ranked.rank, ranked.user_id, ranked.name, ranked.status, ranked.score
+FROM (
+    SELECT
+        RANK() OVER (ORDER BY rp.rating_delta DESC) AS rank,
+        u.id AS user_id,
+        u.name,
+        s.status,
+        rp.rating_delta AS score
+    FROM rating_periods rp
+    JOIN users u ON u.id = rp.user_id
+    JOIN stats s ON s.user_id = rp.user_id
+    WHERE rp.period = @period
+        AND rp.period_start = @period_start
+        AND rp.rating_delta > 0
+        AND u.deleted = FALSE
+        AND u.leaderboard_hidden = FALSE
+        AND u.role <> 'anonymous'
+        AND (u.banned_at IS NULL OR u.banned_until <= now())
+) ranked
+WHERE ranked.user_id = @user_id;
+
+-- name: GetOrganizationRatingLeaderboard :many
+SELECT
+    RANK() OVER (ORDER BY SUM(s.rating) DESC) AS rank,
+    o.id AS organization_id,
+    o.name,
+    (
+        SELECT COUNT(*) FROM organization_members om
+        JOIN users mu ON mu.id = om.user_id
+        WHERE om.organization_id = o.id
+            AND mu.deleted = FALSE
+            AND (mu.banned_at IS NULL OR mu.banned_until <= now())
+    ) AS members,
+    SUM(s.rating)::BIGINT AS score
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+JOIN users u ON u.id = m.user_id
+JOIN stats s ON s.user_id = m.user_id
+WHERE u.deleted = FALSE
+    AND (u.banned_at IS NULL OR u.banned_until <= now())
+GROUP BY o.id
+HAVING SUM(s.rating) > 0
+ORDER BY score DESC, o.id
+LIMIT sqlc.arg('limit');
+
+-- name: GetOrganizationPeriodLeaderboard :many
+SELECT
+    RANK() OVER (ORDER BY SUM(rp.rating_delta) DESC) AS rank,
+    o.id AS organization_id,
+    o.name,
+    (
+        SELECT COUNT(*) FROM organization_members om
+        JOIN users mu ON mu.id = om.user_id
+        WHERE om.organization_id = o.id
+            AND mu.deleted = FALSE
+            AND (mu.banned_at IS NULL OR mu.banned_until <= now())
+    ) AS members,
+    SUM(rp.rating_delta)::BIGINT AS score
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+JOIN users u ON u.id = m.user_id
+JOIN rating_periods rp ON rp.user_id = m.user_id
+WHERE rp.period = @period
+    AND rp.period_start = @period_start
+    AND u.deleted = FALSE
+    AND (u.banned_at IS NULL OR u.banned_until <= now())
+GROUP BY o.id
+HAVING SUM(rp.rating_delta) > 0
+ORDER BY score DESC, o.id
+LIMIT sqlc.arg('limit');
+
+-- name: ReassignRatingPeriods :exec
+WITH moved AS (
+    DELETE FROM rating_periods
+    WHERE rating_periods.user_id = @user_id
+    RETURNING period, period_start, rating_delta
+)
+INSERT INTO rating_periods (user_id, period, period_start, rating_delta)
+SELECT @new_user_id::uuid, period, period_start, rating_delta FROM moved
+ON CONFLICT (user_id, period, period_start)
+DO UPDATE SET rating_delta = rating_periods.rating_delta + EXCLUDED.rating_delta, updated_at = now();
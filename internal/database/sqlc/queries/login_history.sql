@@ -12,6 +12,44 @@ INSERT INTO login_history (
 
 -- name: GetLoginHistoryByUser :many
 SELECT * FROM login_history
-WHERE user_id = $1
+WHERE user_id = $1::uuid
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3;
+
+-- name: GetLoginFailuresByUser :one
+SELECT
+    COUNT(*) AS failures,
+    COALESCE(MAX(lh.created_at), 'epoch')::timestamptz AS last_failure_at
+FROM login_history lh
+WHERE lh.user_id = @user_id::uuid
+  AND lh.success = FALSE
+  AND lh.created_at > @since
+  AND lh.created_at > COALESCE(
+      (SELECT u.login_unlocked_at FROM users u WHERE u.id = @user_id), 'epoch')
+  AND lh.created_at > COALESCE(
+      (SELECT MAX(s.created_at) FROM login_history s WHERE s.user_id = @user_id AND s.success), 'epoch');
+
+-- name: GetLoginFailuresByIP :one
+SELECT
+    COUNT(*) AS failures,
+    COALESCE(MAX(created_at), 'epoch')::timestamptz AS last_failure_at
+FROM login_history
+WHERE ip_address = @ip_address
+  AND success = FALSE
+  AND created_at > @since;
+
+-- name: ListLoginHistory :many
+SELECT * FROM login_history
+WHERE user_id = @user_id::uuid
+  AND (sqlc.narg(success)::boolean IS NULL OR success = sqlc.narg(success))
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountLoginHistory :one
+SELECT COUNT(*) FROM login_history
+WHERE user_id = @user_id::uuid
+  AND (sqlc.narg(success)::boolean IS NULL OR success = sqlc.narg(success))
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to));
@@ -0,0 +1,130 @@
+-- name: CreateOrganization :one
+WITH new_org AS (
+    INSERT INTO organizations (name, invite_code)
+    VALUES (@name, @invite_code)
+    RETURNING *
+),
+new_owner AS (
+    INSERT INTO organization_members (organization_id, user_id, role)
+    SELECT id, @owner_id::uuid, 'owner' FROM new_org
+)
+SELECT * FROM new_org;
+
+-- name: GetOrganization :one
+SELECT * FROM organizations
+WHERE id = $1;
+
+-- name: GetOrganizationForUpdate :one
+SELECT * FROM organizations
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetOrganizationByInviteCode :one
+SELECT * FROM organizations
+WHERE invite_code = $1;
+
+-- name: UpdateOrganizationInviteCode :execrows
+UPDATE organizations
+SET invite_code = $1, updated_at = now()
+WHERE id = $2;
+
+-- name: DeleteOrganization :execrows
+DELETE FROM organizations
+WHERE id = $1;
+
+-- name: ListUserOrganizations :many
+SELECT o.id, o.name, o.invite_code, o.created_at, o.updated_at, m.role, m.joined_at
+FROM organization_members m
+JOIN organizations o ON o.id = m.organization_id
+WHERE m.user_id = $1
+ORDER BY m.joined_at;
+
+-- name: AddOrganizationMember :one
+INSERT INTO organization_members (organization_id, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (organization_id, user_id) DO NOTHING
+RETURNING *;
+
+-- name: GetOrganizationMember :one
+SELECT * FROM organization_members
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: UpdateOrganizationMemberRole :execrows
+UPDATE organization_members
+SET role = $1
+WHERE organization_id = $2 AND user_id = $3;
+
+-- name: DeleteOrganizationMember :execrows
+DELETE FROM organization_members
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: CountOrganizationOwners :one
+SELECT COUNT(*) FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1 AND m.role = 'owner'
+    AND u.deleted = FALSE;
+
+-- name: CountSoleOwnedOrganizations :one
+SELECT COUNT(*) FROM organization_members m
+WHERE m.user_id = $1 AND m.role = 'owner'
+    AND NOT EXISTS (
+        SELECT 1 FROM organization_members other
+        JOIN users u ON u.id = other.user_id
+        WHERE other.organization_id = m.organization_id
+            AND other.user_id <> m.user_id
+            AND other.role = 'owner'
+            AND u.deleted = FALSE
+    );
+
+-- name: ListOrganizationMembers :many
+SELECT
+    m.user_id,
+    u.name,
+    m.role,
+    m.joined_at,
+    s.rating,
+    s.files_scanned,
+    s.total_weight,
+    s.last_scanned_at,
+    COALESCE((
+        SELECT SUM(a.scans) FROM daily_activity a
+        WHERE a.user_id = m.user_id AND a.day >= sqlc.arg('active_since')
+    ), 0)::INT AS recent_scans
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+JOIN stats s ON s.user_id = m.user_id
+WHERE m.organization_id = @organization_id
+    AND u.deleted = FALSE
+ORDER BY s.rating DESC, m.user_id;
+
+-- name: GetOrganizationStats :one
+SELECT
+    COUNT(*) AS members,
+    COALESCE(SUM(s.rating), 0)::BIGINT AS rating,
+    COALESCE(SUM(s.files_scanned), 0)::BIGINT AS files_scanned,
+    COALESCE(SUM(s.total_weight), 0)::FLOAT AS total_weight
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+JOIN stats s ON s.user_id = m.user_id
+WHERE m.organization_id = $1
+    AND u.deleted = FALSE;
+
+-- name: GetOrganizationTypeTotals :many
+SELECT
+    t.trash_type::text AS trash_type,
+    SUM(t.count)::BIGINT AS count,
+    SUM(t.weight)::FLOAT AS weight
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+JOIN stats s ON s.user_id = m.user_id
+CROSS JOIN LATERAL (
+    SELECT c.key AS trash_type, c.value::int AS count, 0.0::float8 AS weight
+    FROM jsonb_each_text(COALESCE(s.trash_by_types, '{}')) c
+    UNION ALL
+    SELECT w.key, 0, w.value::float8
+    FROM jsonb_each_text(COALESCE(s.weight_by_types, '{}')) w
+) t
+WHERE m.organization_id = $1
+    AND u.deleted = FALSE
+GROUP BY 1
+ORDER BY 1;
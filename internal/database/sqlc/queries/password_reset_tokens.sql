@@ -0,0 +1,19 @@
+-- name: CreatePasswordResetToken :one
+INSERT INTO password_reset_tokens (
+    user_id,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3
+) RETURNING id;
+
+-- name: ConsumePasswordResetToken :one
+UPDATE password_reset_tokens
+SET used = TRUE, used_at = now()
+WHERE token_hash = $1 AND used = FALSE AND expires_at > now()
+RETURNING user_id;
+
+-- name: InvalidateUserPasswordResetTokens :exec
+UPDATE password_reset_tokens
+SET used = TRUE, used_at = now()
+WHERE user_id = $1 AND used = FALSE;
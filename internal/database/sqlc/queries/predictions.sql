@@ -2,14 +2,15 @@
 INSERT INTO predictions (
     user_id,
     trash_scan,
-    status
+    status,
+    weight
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4
 ) RETURNING *;
 
 -- name: CompletePrediction :exec
 UPDATE predictions
-SET status = $1, result = $2, error = $3, updated_at = now()
+SET status = $1, result = $2, error = $3, completed_at = COALESCE(completed_at, now()), updated_at = now()
 WHERE id = $4;
 
 -- name: GetPrediction :one
@@ -21,3 +22,120 @@ SELECT * FROM predictions
 WHERE user_id = $1
 ORDER BY updated_at DESC
 LIMIT $2 OFFSET $3;
+
+-- name: CountPredictionsByUserID :one
+SELECT COUNT(id) FROM predictions
+WHERE user_id = $1;
+
+-- name: ReassignPredictions :exec
+UPDATE predictions
+SET user_id = @new_user_id
+WHERE user_id = @user_id;
+
+-- name: DeletePredictionsByUserID :exec
+DELETE FROM predictions
+WHERE user_id = $1;
+
+-- name: ExportPredictions :many
+SELECT
+    p.id,
+    p.user_id,
+    u.login,
+    p.trash_scan,
+    p.status,
+    p.result,
+    p.error,
+    p.created_at,
+    p.updated_at,
+    p.verified_label,
+    p.verified_by,
+    p.verified_at,
+    p.feedback_label,
+    p.feedback_comment,
+    p.feedback_at,
+    p.weight
+FROM predictions p
+JOIN users u ON u.id = p.user_id
+WHERE (sqlc.narg(user_id)::uuid IS NULL OR p.user_id = sqlc.narg(user_id))
+    AND (sqlc.narg(status)::text IS NULL OR p.status = sqlc.narg(status))
+    AND (sqlc.narg(max_confidence)::float8 IS NULL
+        OR (SELECT MAX(c.value::float8) FROM jsonb_each_text(p.result) c) < sqlc.narg(max_confidence))
+    AND (sqlc.narg(has_feedback)::boolean IS NULL OR (p.feedback_at IS NOT NULL) = sqlc.narg(has_feedback))
+    AND (sqlc.narg(verified)::boolean IS NULL OR (p.verified_label IS NOT NULL) = sqlc.narg(verified))
+    AND (sqlc.narg(created_from)::timestamptz IS NULL OR p.created_at >= sqlc.narg(created_from))
+    AND (sqlc.narg(created_to)::timestamptz IS NULL OR p.created_at < sqlc.narg(created_to))
+    AND (p.created_at, p.id) > (@after_created_at::timestamptz, @after_id::uuid)
+ORDER BY p.created_at, p.id
+LIMIT sqlc.arg('limit');
+
+-- name: GetPredictionForUpdate :one
+SELECT * FROM predictions
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListAdminPredictions :many
+SELECT
+    p.id,
+    p.user_id,
+    u.login,
+    p.trash_scan,
+    p.status,
+    p.result,
+    p.error,
+    p.created_at,
+    p.updated_at,
+    p.verified_label,
+    p.verified_by,
+    p.verified_at,
+    p.feedback_label,
+    p.feedback_comment,
+    p.feedback_at,
+    p.weight
+FROM predictions p
+JOIN users u ON u.id = p.user_id
+WHERE (sqlc.narg(user_id)::uuid IS NULL OR p.user_id = sqlc.narg(user_id))
+    AND (sqlc.narg(status)::text IS NULL OR p.status = sqlc.narg(status))
+    AND (sqlc.narg(max_confidence)::float8 IS NULL
+        OR (SELECT MAX(c.value::float8) FROM jsonb_each_text(p.result) c) < sqlc.narg(max_confidence))
+    AND (sqlc.narg(has_feedback)::boolean IS NULL OR (p.feedback_at IS NOT NULL) = sqlc.narg(has_feedback))
+    AND (sqlc.narg(verified)::boolean IS NULL OR (p.verified_label IS NOT NULL) = sqlc.narg(verified))
+    AND (sqlc.narg(created_from)::timestamptz IS NULL OR p.created_at >= sqlc.narg(created_from))
+    AND (sqlc.narg(created_to)::timestamptz IS NULL OR p.created_at < sqlc.narg(created_to))
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountAdminPredictions :one
+SELECT COUNT(p.id)
+FROM predictions p
+WHERE (sqlc.narg(user_id)::uuid IS NULL OR p.user_id = sqlc.narg(user_id))
+    AND (sqlc.narg(status)::text IS NULL OR p.status = sqlc.narg(status))
+    AND (sqlc.narg(max_confidence)::float8 IS NULL
+        OR (SELECT MAX(c.value::float8) FROM jsonb_each_text(p.result) c) < sqlc.narg(max_confidence))
+    AND (sqlc.narg(has_feedback)::boolean IS NULL OR (p.feedback_at IS NOT NULL) = sqlc.narg(has_feedback))
+    AND (sqlc.narg(verified)::boolean IS NULL OR (p.verified_label IS NOT NULL) = sqlc.narg(verified))
+    AND (sqlc.narg(created_from)::timestamptz IS NULL OR p.created_at >= sqlc.narg(created_from))
+    AND (sqlc.narg(created_to)::timestamptz IS NULL OR p.created_at < sqlc.narg(created_to));
+
+-- name: SetPredictionVerifiedLabel :one
+UPDATE predictions
+SET verified_label = sqlc.narg(verified_label),
+    verified_by = sqlc.narg(verified_by),
+    verified_at = CASE WHEN sqlc.narg(verified_label)::text IS NULL THEN NULL ELSE now() END,
+    updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: SetPredictionFeedback :one
+UPDATE predictions
+SET feedback_label = sqlc.narg(feedback_label),
+    feedback_comment = sqlc.narg(feedback_comment),
+    feedback_at = now()
+WHERE id = @id AND user_id = @user_id
+RETURNING *;
+
+-- name: ListUserPredictionsAfter :many
+SELECT * FROM predictions
+WHERE user_id = @user_id
+    AND (created_at, id) > (@after_created_at::timestamptz, @after_id::uuid)
+ORDER BY created_at, id
+LIMIT sqlc.arg('limit');
@@ -26,3 +26,8 @@ WHERE user_id = $1 AND revoked = FALSE;
 SELECT * FROM refresh_tokens
 WHERE user_id = $1 AND revoked = FALSE AND expires_at > now()
 ORDER BY created_at DESC;
+
+-- name: RevokeRefreshTokenByID :execrows
+UPDATE refresh_tokens
+SET revoked = TRUE, revoked_at = now(), updated_at = now()
+WHERE id = $1 AND user_id = $2 AND revoked = FALSE;
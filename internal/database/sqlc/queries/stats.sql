@@ -2,6 +2,11 @@
 SELECT * FROM stats
 WHERE user_id = $1;
 
+-- name: LockStatsByUserID :exec
+SELECT id FROM stats
+WHERE user_id = $1
+FOR UPDATE;
+
 -- name: UpdateStats :exec
 UPDATE stats
 SET
@@ -12,5 +17,42 @@ SET
     achievements = $5,
     trash_by_types = $6,
     last_scanned_at = $7,
+    current_streak = $8,
+    longest_streak = $9,
+    weight_by_types = $10,
     updated_at = now()
-WHERE id = $8;
\ No newline at end of file
+WHERE id = $11;
+
+-- name: DeleteStatsByUserID :exec
+DELETE FROM stats
+WHERE user_id = $1;
+
+-- name: ReassignStats :execrows
+UPDATE stats
+SET user_id = @new_user_id, updated_at = now()
+WHERE user_id = @user_id;
+
+-- name: ListStatsUserIDsAfter :many
+SELECT user_id FROM stats
+WHERE user_id > @after_id::uuid
+ORDER BY user_id
+LIMIT sqlc.arg('limit');
+
+-- name: UpsertStatsSnapshot :exec
+INSERT INTO stats_history (stat_id, day, rating, files_scanned, total_weight, trash_by_types)
+VALUES (@stat_id, @day, @rating, @files_scanned, @total_weight, @trash_by_types)
+ON CONFLICT (stat_id, day)
+DO UPDATE SET
+    rating = EXCLUDED.rating,
+    files_scanned = EXCLUDED.files_scanned,
+    total_weight = EXCLUDED.total_weight,
+    trash_by_types = EXCLUDED.trash_by_types;
+
+-- name: GetStatsHistory :many
+SELECT * FROM stats_history
+WHERE stat_id = @stat_id
+    AND day >= COALESCE(
+        (SELECT MAX(b.day) FROM stats_history b WHERE b.stat_id = @stat_id AND b.day < @day_from),
+        @day_from)
+    AND day <= @day_to
+ORDER BY day;
@@ -0,0 +1,19 @@
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities (
+    user_id,
+    provider,
+    subject,
+    email,
+    last_login_at
+) VALUES (
+    $1, $2, $3, $4, now()
+) RETURNING id;
+
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities
+WHERE provider = $1 AND subject = $2;
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities
+SET last_login_at = now(), email = $2
+WHERE id = $1;
@@ -4,15 +4,20 @@ WITH new_user AS (
         name,
         login,
         hashed_password,
-        role
+        role,
+        email
     ) VALUES (
-        $1, $2, $3, $4
+        $1, $2, $3, $4, $5
     ) RETURNING id
 ),
 new_stats AS (
     INSERT INTO stats (user_id)
     SELECT id FROM new_user
     RETURNING id
+),
+new_history AS (
+    INSERT INTO stats_history (stat_id, day)
+    SELECT id, CURRENT_DATE - 1 FROM new_stats
 )
 SELECT new_user.id as user_id
 FROM new_user;
@@ -40,7 +45,46 @@ UPDATE users
 SET avatar = $1, updated_at = now()
 WHERE id = $2;
 
+-- name: UpdateUserLeaderboardHidden :exec
+UPDATE users
+SET leaderboard_hidden = $1, updated_at = now()
+WHERE id = $2;
+
+-- name: UpdateUserTimezone :exec
+UPDATE users
+SET timezone = $1, updated_at = now()
+WHERE id = $2;
+
 -- name: DeleteUser :exec
 UPDATE users
 SET deleted = TRUE, updated_at = now()
 WHERE id = $1;
+
+-- name: UnlockUserLogin :execrows
+UPDATE users
+SET login_unlocked_at = now(), updated_at = now()
+WHERE id = $1 AND deleted = FALSE;
+
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE lower(email) = lower(@email) AND deleted = FALSE;
+
+-- name: UpdateUserEmail :exec
+UPDATE users
+SET email = $1, email_verified_at = NULL, updated_at = now()
+WHERE id = $2;
+
+-- name: MarkUserEmailVerified :execrows
+UPDATE users
+SET email_verified_at = now(), updated_at = now()
+WHERE id = @id AND lower(email) = lower(@email) AND deleted = FALSE;
+
+-- name: DeleteGuestUser :execrows
+DELETE FROM users
+WHERE id = $1 AND role = 'anonymous';
+
+-- name: ListExpiredGuestIDsAfter :many
+SELECT id FROM users
+WHERE role = 'anonymous' AND created_at < @created_before::timestamptz AND id > @after_id::uuid
+ORDER BY id
+LIMIT sqlc.arg('limit');
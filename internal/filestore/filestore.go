@@ -22,6 +22,7 @@ type FileStore interface {
 	UpdateAvatar(ctx context.Context, user *models.User, file *models.File) error
 	DeleteAvatar(ctx context.Context, avatarKey string) error
 	UploadScan(ctx context.Context, userID string, file *models.File) (string, error)
+	DeleteUserObjects(ctx context.Context, userID string) error
 }
 
 type minioStore struct {
@@ -102,3 +103,21 @@ func (m *minioStore) UploadScan(ctx context.Context, userID string, file *models
 
 	return uploadInfo.Key, nil
 }
+
+// DeleteUserObjects removes every object stored for the user: avatars and scans.
+func (m *minioStore) DeleteUserObjects(ctx context.Context, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, fileUploadTimeout)
+	defer cancel()
+
+	objects := m.client.ListObjects(ctx, m.bucket, minio.ListObjectsOptions{
+		Prefix:    userID + "/",
+		Recursive: true,
+	})
+	for removeErr := range m.client.RemoveObjects(ctx, m.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if removeErr.Err != nil {
+			return removeErr.Err
+		}
+	}
+
+	return ctx.Err()
+}
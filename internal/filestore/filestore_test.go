@@ -390,6 +390,41 @@ func TestUploadScan(t *testing.T) {
 	})
 }
 
+func TestDeleteUserObjects(t *testing.T) {
+	cleanup := setupTestBucket(t)
+	defer cleanup()
+
+	store, err := NewMinioStore(testConfig)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	userID := uuid.New().String()
+	otherUserID := uuid.New().String()
+
+	upload := func(owner string) string {
+		content := []byte("scan content")
+		path, err := store.UploadScan(ctx, owner, &models.File{
+			ID:    uuid.New(),
+			Size:  int64(len(content)),
+			Entry: io.NopCloser(bytes.NewReader(content)),
+		})
+		require.NoError(t, err)
+		return path
+	}
+	upload(userID)
+	upload(userID)
+	otherPath := upload(otherUserID)
+
+	require.NoError(t, store.DeleteUserObjects(ctx, userID))
+
+	var remaining []string
+	for object := range minioClient.ListObjects(ctx, testBucket, minio.ListObjectsOptions{Recursive: true}) {
+		require.NoError(t, object.Err)
+		remaining = append(remaining, object.Key)
+	}
+	assert.Equal(t, []string{otherPath}, remaining)
+}
+
 func TestFileStoreIntegration(t *testing.T) {
 	t.Run("complete user flow with avatar and scans", func(t *testing.T) {
 		cleanup := setupTestBucket(t)
@@ -94,6 +94,63 @@ func (_c *FileStore_DeleteAvatar_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// DeleteUserObjects provides a mock function for the type FileStore
+func (_mock *FileStore) DeleteUserObjects(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserObjects")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// FileStore_DeleteUserObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserObjects'
+type FileStore_DeleteUserObjects_Call struct {
+	*mock.Call
+}
+
+// DeleteUserObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *FileStore_Expecter) DeleteUserObjects(ctx interface{}, userID interface{}) *FileStore_DeleteUserObjects_Call {
+	return &FileStore_DeleteUserObjects_Call{Call: _e.mock.On("DeleteUserObjects", ctx, userID)}
+}
+
+func (_c *FileStore_DeleteUserObjects_Call) Run(run func(ctx context.Context, userID string)) *FileStore_DeleteUserObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FileStore_DeleteUserObjects_Call) Return(err error) *FileStore_DeleteUserObjects_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *FileStore_DeleteUserObjects_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *FileStore_DeleteUserObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAvatar provides a mock function for the type FileStore
 func (_mock *FileStore) UpdateAvatar(ctx context.Context, user *models.User, file *models.File) error {
 	ret := _mock.Called(ctx, user, file)
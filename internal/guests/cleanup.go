@@ -0,0 +1,48 @@
+// Package guests removes guest accounts whose session ran out before they
+// were claimed by a registration.
+package guests
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/filestore"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+)
+
+// PurgeExpired permanently removes every guest created before createdBefore
+// together with their predictions and files, each in a transaction of its
+// own, and returns the number of guests removed. Guests claimed or removed in
+// the meantime are skipped. Any other failure stops the run.
+func PurgeExpired(ctx context.Context, s store.Store, files filestore.FileStore, createdBefore time.Time) (int, error) {
+	var count int
+	err := s.WalkExpiredGuests(ctx, createdBefore, func(guestID uuid.UUID) error {
+		// Storage objects are removed last so that a failure there rolls the
+		// database back and the guest is retried on the next run.
+		err := s.ExecTx(ctx, func(tx store.Store) error {
+			if err := tx.PurgeUser(ctx, guestID); err != nil {
+				return err
+			}
+			if err := files.DeleteUserObjects(ctx, guestID.String()); err != nil {
+				return errlocal.NewErrInternal("failed to delete guest files", err.Error(),
+					map[string]any{"guest_id": guestID.String()})
+			}
+			return nil
+		})
+		var notFound *errlocal.ErrNotFound
+		switch {
+		case errors.As(err, &notFound):
+			return nil
+		case err != nil:
+			return err
+		}
+		count++
+		return nil
+	})
+
+	return count, err
+}
@@ -0,0 +1,71 @@
+package guests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	filestoremocks "github.com/trashscanner/trashscanner_api/internal/filestore/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/store/mocks"
+)
+
+// expectGuests makes the store walk guestIDs, each purge in a transaction.
+func expectGuests(ms *mocks.Store, createdBefore time.Time, guestIDs ...uuid.UUID) {
+	ms.EXPECT().WalkExpiredGuests(mock.Anything, createdBefore, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ time.Time, fn func(uuid.UUID) error) error {
+			for _, guestID := range guestIDs {
+				if err := fn(guestID); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Once()
+	ms.EXPECT().ExecTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(store.Store) error) error {
+			return fn(ms)
+		}).Times(len(guestIDs))
+}
+
+func TestPurgeExpired(t *testing.T) {
+	ctx := context.Background()
+	createdBefore := time.Now().Add(-time.Hour)
+
+	t.Run("removes guests and their files", func(t *testing.T) {
+		claimedID := uuid.New()
+		guestID := uuid.New()
+		ms := mocks.NewStore(t)
+		files := filestoremocks.NewFileStore(t)
+
+		expectGuests(ms, createdBefore, claimedID, guestID)
+		ms.EXPECT().PurgeUser(mock.Anything, claimedID).
+			Return(errlocal.NewErrNotFound("user not found", "", nil)).Once()
+		ms.EXPECT().PurgeUser(mock.Anything, guestID).Return(nil).Once()
+		files.EXPECT().DeleteUserObjects(mock.Anything, guestID.String()).Return(nil).Once()
+
+		count, err := PurgeExpired(ctx, ms, files, createdBefore)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("file store failure stops the run", func(t *testing.T) {
+		guestID := uuid.New()
+		ms := mocks.NewStore(t)
+		files := filestoremocks.NewFileStore(t)
+
+		expectGuests(ms, createdBefore, guestID)
+		ms.EXPECT().PurgeUser(mock.Anything, guestID).Return(nil).Once()
+		files.EXPECT().DeleteUserObjects(mock.Anything, guestID.String()).Return(errors.New("minio down")).Once()
+
+		count, err := PurgeExpired(ctx, ms, files, createdBefore)
+		var internalErr *errlocal.ErrInternal
+		require.ErrorAs(t, err, &internalErr)
+		assert.Zero(t, count)
+	})
+}
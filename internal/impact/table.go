@@ -0,0 +1,91 @@
+package impact
+
+import (
+	"fmt"
+	"maps"
+	"math"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// DefaultWeights is the average weight in kg of a scanned item of each type.
+var DefaultWeights = map[string]float64{
+	models.TrashTypeCardboard: 0.15,
+	models.TrashTypeGlass:     0.35,
+	models.TrashTypeMetal:     0.03,
+	models.TrashTypePaper:     0.05,
+	models.TrashTypePlastic:   0.03,
+	models.TrashTypeTrash:     0.1,
+}
+
+// DefaultCO2Factors is the kg of CO2 emissions avoided by recycling a kg of
+// each type instead of sending it to landfill. General trash is not recycled.
+var DefaultCO2Factors = map[string]float64{
+	models.TrashTypeCardboard: 0.8,
+	models.TrashTypeGlass:     0.3,
+	models.TrashTypeMetal:     5,
+	models.TrashTypePaper:     0.9,
+	models.TrashTypePlastic:   1.5,
+	models.TrashTypeTrash:     0,
+}
+
+// Table estimates the weight of scanned items and the impact of sorting them.
+type Table struct {
+	weights    map[string]float64
+	co2Factors map[string]float64
+}
+
+// NewTable builds the table from the defaults overridden by cfg. Unknown
+// trash types are rejected so a typo does not silently keep a default.
+func NewTable(cfg config.ImpactConfig) (*Table, error) {
+	t := &Table{weights: maps.Clone(DefaultWeights), co2Factors: maps.Clone(DefaultCO2Factors)}
+	for trashType, weight := range cfg.Weights {
+		if models.NewTrashType(trashType) == models.Undefined {
+			return nil, fmt.Errorf("impact: unknown trash type %q in weights", trashType)
+		}
+		t.weights[trashType] = weight
+	}
+	for trashType, factor := range cfg.CO2Factors {
+		if models.NewTrashType(trashType) == models.Undefined {
+			return nil, fmt.Errorf("impact: unknown trash type %q in co2 factors", trashType)
+		}
+		t.co2Factors[trashType] = factor
+	}
+
+	return t, nil
+}
+
+// DefaultTable returns the table of the default weights and factors.
+func DefaultTable() *Table {
+	table, _ := NewTable(config.ImpactConfig{})
+	return table
+}
+
+// Weight returns the trash type of prediction and the weight in kg of the
+// item: as entered by the owner, or else the average of its type.
+func (t *Table) Weight(prediction *models.Prediction) (string, float64) {
+	trashType := prediction.TrashType()
+	if prediction.Weight != nil {
+		return trashType, *prediction.Weight
+	}
+	return trashType, t.weights[trashType]
+}
+
+// Impact estimates the CO2 emissions avoided by sorting the items of stat.
+func (t *Table) Impact(stat *models.Stat) *models.StatImpact {
+	impact := &models.StatImpact{CO2SavedByType: make(map[string]float64, len(stat.WeightByTypes))}
+	for trashType, weight := range stat.WeightByTypes {
+		saved := weight * t.co2Factors[trashType]
+		impact.CO2SavedByType[trashType] = round(saved)
+		impact.CO2Saved += saved
+	}
+	impact.CO2Saved = round(impact.CO2Saved)
+
+	return impact
+}
+
+// round rounds kg to whole grams.
+func round(kg float64) float64 {
+	return math.Round(kg*1000) / 1000
+}
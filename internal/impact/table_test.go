@@ -0,0 +1,66 @@
+package impact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestNewTable(t *testing.T) {
+	t.Run("overrides keep the other defaults", func(t *testing.T) {
+		table, err := NewTable(config.ImpactConfig{
+			Weights:    map[string]float64{models.TrashTypeGlass: 0.5},
+			CO2Factors: map[string]float64{models.TrashTypeMetal: 9},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 0.5, table.weights[models.TrashTypeGlass])
+		assert.Equal(t, DefaultWeights[models.TrashTypeMetal], table.weights[models.TrashTypeMetal])
+		assert.Equal(t, 9.0, table.co2Factors[models.TrashTypeMetal])
+		assert.Equal(t, 0.35, DefaultWeights[models.TrashTypeGlass], "defaults must not change")
+	})
+
+	t.Run("unknown trash type", func(t *testing.T) {
+		_, err := NewTable(config.ImpactConfig{Weights: map[string]float64{"wood": 1}})
+		assert.Error(t, err)
+
+		_, err = NewTable(config.ImpactConfig{CO2Factors: map[string]float64{"wood": 1}})
+		assert.Error(t, err)
+	})
+}
+
+func TestTableWeight(t *testing.T) {
+	table := DefaultTable()
+	prediction := &models.Prediction{Result: models.PredictionResult{
+		models.TrashTypeGlass: 0.7, models.TrashTypePlastic: 0.3,
+	}}
+
+	trashType, weight := table.Weight(prediction)
+	assert.Equal(t, models.TrashTypeGlass, trashType)
+	assert.Equal(t, DefaultWeights[models.TrashTypeGlass], weight)
+
+	entered := 1.2
+	prediction.Weight = &entered
+	prediction.Verification = &models.PredictionVerification{Label: models.TrashTypePlastic}
+	trashType, weight = table.Weight(prediction)
+	assert.Equal(t, models.TrashTypePlastic, trashType)
+	assert.Equal(t, 1.2, weight)
+}
+
+func TestTableImpact(t *testing.T) {
+	impact := DefaultTable().Impact(&models.Stat{WeightByTypes: map[string]float64{
+		models.TrashTypeMetal: 0.3,
+		models.TrashTypeGlass: 1,
+		models.TrashTypeTrash: 2,
+	}})
+
+	assert.Equal(t, 1.8, impact.CO2Saved)
+	assert.Equal(t, map[string]float64{
+		models.TrashTypeMetal: 1.5,
+		models.TrashTypeGlass: 0.3,
+		models.TrashTypeTrash: 0,
+	}, impact.CO2SavedByType)
+}
@@ -123,6 +123,15 @@ func (l *Logger) WithContext(ctx context.Context) *Logger {
 				}
 			case utils.RequestBodyKey:
 				continue
+			case utils.APIKeyCtxKey:
+				if key, ok := val.(*models.APIKey); ok {
+					fields["api_key_id"] = key.ID.String()
+				}
+			case utils.ImpersonatorCtxKey:
+				if admin, ok := val.(*models.User); ok {
+					fields["impersonator_id"] = admin.ID.String()
+					fields["impersonator_login"] = admin.Login
+				}
 			}
 		}
 	}
@@ -169,6 +169,26 @@ func TestLogger_WithContext_User(t *testing.T) {
 	assert.Equal(t, "testuser", l.Data["user_login"])
 }
 
+func TestLogger_WithContext_Impersonator(t *testing.T) {
+	cfg := config.Config{
+		Log: config.LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+	}
+	logger := NewLogger(cfg)
+
+	user := models.User{ID: uuid.New(), Login: "testuser"}
+	admin := models.User{ID: uuid.New(), Login: "admin"}
+
+	ctx := utils.SetImpersonator(utils.SetUser(context.Background(), &user), &admin)
+	l := logger.WithContext(ctx)
+
+	assert.Equal(t, user.ID.String(), l.Data["user_id"])
+	assert.Equal(t, admin.ID.String(), l.Data["impersonator_id"])
+	assert.Equal(t, "admin", l.Data["impersonator_login"])
+}
+
 func TestLogger_WithContext_RequestID(t *testing.T) {
 	cfg := config.Config{
 		Log: config.LogConfig{
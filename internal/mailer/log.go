@@ -0,0 +1,68 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/logging"
+)
+
+// LogMailer does not deliver anything. It logs every message and, when a file
+// is configured, appends it there as a JSON line. Meant for local development
+// and tests.
+type LogMailer struct {
+	logger *logging.Logger
+	from   string
+	file   string
+	mu     sync.Mutex
+}
+
+type fileRecord struct {
+	Message
+	From   string    `json:"from"`
+	SentAt time.Time `json:"sent_at"`
+}
+
+func NewLogMailer(cfg config.MailConfig, logger *logging.Logger) *LogMailer {
+	return &LogMailer{
+		logger: logger,
+		from:   cfg.From,
+		file:   cfg.File,
+	}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.logger.WithContext(ctx).
+		WithField("to", msg.To).
+		WithField("subject", msg.Subject).
+		Info("mail message")
+
+	if m.file == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(fileRecord{Message: msg, From: m.from, SentAt: time.Now().UTC()})
+	if err != nil {
+		return fmt.Errorf("failed to encode mail message: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open mail file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write mail message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/logging"
+)
+
+const (
+	DriverLog  = "log"
+	DriverSMTP = "smtp"
+)
+
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+func New(cfg config.MailConfig, logger *logging.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverSMTP:
+		return newSMTPMailer(cfg)
+	case DriverLog, "":
+		return NewLogMailer(cfg, logger), nil
+	}
+
+	return nil, fmt.Errorf("unknown mail driver %q", cfg.Driver)
+}
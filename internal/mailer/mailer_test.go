@@ -0,0 +1,83 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/logging"
+)
+
+func testLogger() *logging.Logger {
+	return logging.NewLogger(config.Config{Log: config.LogConfig{Level: "error"}})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("log", func(t *testing.T) {
+		m, err := New(config.MailConfig{Driver: DriverLog}, testLogger())
+		require.NoError(t, err)
+		assert.IsType(t, &LogMailer{}, m)
+	})
+
+	t.Run("smtp", func(t *testing.T) {
+		m, err := New(config.MailConfig{
+			Driver: DriverSMTP,
+			From:   "no-reply@example.com",
+			SMTP:   config.SMTPConfig{Host: "smtp.example.com", Port: "587", Username: "user"},
+		}, testLogger())
+		require.NoError(t, err)
+		assert.IsType(t, &smtpMailer{}, m)
+	})
+
+	t.Run("smtp_without_host", func(t *testing.T) {
+		_, err := New(config.MailConfig{Driver: DriverSMTP}, testLogger())
+		require.Error(t, err)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := New(config.MailConfig{Driver: "pigeon"}, testLogger())
+		require.Error(t, err)
+	})
+}
+
+func TestLogMailer_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.jsonl")
+	m := NewLogMailer(config.MailConfig{From: "no-reply@example.com", File: path}, testLogger())
+
+	msg := Message{To: "user@example.com", Subject: "hello", Body: "body"}
+	require.NoError(t, m.Send(context.Background(), msg))
+	require.NoError(t, m.Send(context.Background(), msg))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	require.Len(t, lines, 2)
+
+	var record fileRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, msg, record.Message)
+	assert.Equal(t, "no-reply@example.com", record.From)
+}
+
+func TestSMTPMailer_Build(t *testing.T) {
+	m, err := newSMTPMailer(config.MailConfig{
+		From: "no-reply@example.com",
+		SMTP: config.SMTPConfig{Host: "smtp.example.com", Port: "25"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:25", m.addr)
+	assert.Nil(t, m.auth)
+
+	raw := string(m.build(Message{To: "user@example.com", Subject: "hello", Body: "line1\nline2"}))
+
+	assert.Contains(t, raw, "From: no-reply@example.com\r\n")
+	assert.Contains(t, raw, "To: user@example.com\r\n")
+	assert.Contains(t, raw, "Subject: hello\r\n")
+	assert.True(t, strings.HasSuffix(raw, "\r\n\r\nline1\r\nline2"))
+}
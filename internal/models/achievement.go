@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// AchievementMetric is the stat an achievement rule compares with its
+// threshold.
+type AchievementMetric string
+
+const (
+	// AchievementMetricScans counts scans, successful or not.
+	AchievementMetricScans AchievementMetric = "files_scanned"
+	// AchievementMetricRating is the rating of the user.
+	AchievementMetricRating AchievementMetric = "rating"
+	// AchievementMetricTrashType counts scans of the trash type of the rule.
+	AchievementMetricTrashType AchievementMetric = "trash_type"
+	// AchievementMetricTypesCollected counts the trash types scanned at least once.
+	AchievementMetricTypesCollected AchievementMetric = "types_collected"
+	// AchievementMetricStreak is the longest run of consecutive days with scans.
+	AchievementMetricStreak AchievementMetric = "streak"
+)
+
+func (m AchievementMetric) IsValid() bool {
+	switch m {
+	case AchievementMetricScans, AchievementMetricRating, AchievementMetricTrashType,
+		AchievementMetricTypesCollected, AchievementMetricStreak:
+		return true
+	}
+	return false
+}
+
+// AchievementRule unlocks an achievement once Metric reaches Threshold.
+type AchievementRule struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Metric      AchievementMetric `json:"metric"`
+	TrashType   string            `json:"trash_type,omitempty"`
+	Threshold   int               `json:"threshold"`
+}
+
+// Achievement is an achievement a user unlocked.
+type Achievement struct {
+	ID         string    `json:"id"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// AchievementProgress is an achievement of the catalog as seen by one user.
+// Progress is capped at the threshold; UnlockedAt is set once it's reached.
+type AchievementProgress struct {
+	AchievementRule
+	Progress   int        `json:"progress"`
+	Unlocked   bool       `json:"unlocked"`
+	UnlockedAt *time.Time `json:"unlocked_at,omitempty"`
+}
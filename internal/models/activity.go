@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// ActivityDay is the number of scans a user made on one calendar day of
+// their time zone.
+type ActivityDay struct {
+	Day   time.Time `json:"day"`
+	Scans int       `json:"scans"`
+}
+
+// Day returns the calendar day of t in its own location as UTC midnight, so
+// that days from different zones compare and store as plain dates.
+func Day(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
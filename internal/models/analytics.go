@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+// AnalyticsBucket is the width of the time buckets analytics are grouped by.
+type AnalyticsBucket string
+
+const (
+	AnalyticsBucketDay   AnalyticsBucket = "day"
+	AnalyticsBucketWeek  AnalyticsBucket = "week"
+	AnalyticsBucketMonth AnalyticsBucket = "month"
+)
+
+func (b AnalyticsBucket) IsValid() bool {
+	switch b {
+	case AnalyticsBucketDay, AnalyticsBucketWeek, AnalyticsBucketMonth:
+		return true
+	}
+	return false
+}
+
+// AnalyticsFilter selects the range [From, To) and the bucket width.
+type AnalyticsFilter struct {
+	Bucket AnalyticsBucket
+	From   time.Time
+	To     time.Time
+}
+
+// Analytics aggregates system activity over a date range. Buckets start at
+// UTC midnight, Monday or the first of the month; empty buckets are omitted.
+type Analytics struct {
+	Bucket              AnalyticsBucket          `json:"bucket"`
+	From                time.Time                `json:"from"`
+	To                  time.Time                `json:"to"`
+	Registrations       []CountBucket            `json:"registrations"`
+	Logins              []LoginBucket            `json:"logins"`
+	PredictionsByStatus []PredictionStatusBucket `json:"predictions_by_status"`
+	PredictionsByType   []TrashTypeBucket        `json:"predictions_by_type"`
+	Predictor           PredictorPerformance     `json:"predictor"`
+}
+
+type CountBucket struct {
+	Start time.Time `json:"start"`
+	Count int64     `json:"count"`
+}
+
+type LoginBucket struct {
+	Start      time.Time `json:"start"`
+	Successful int64     `json:"successful"`
+	Failed     int64     `json:"failed"`
+}
+
+type PredictionStatusBucket struct {
+	Start  time.Time        `json:"start"`
+	Status PredictionStatus `json:"status"`
+	Count  int64            `json:"count"`
+}
+
+// TrashTypeBucket counts completed predictions by their most probable type.
+type TrashTypeBucket struct {
+	Start     time.Time `json:"start"`
+	TrashType string    `json:"trash_type"`
+	Count     int64     `json:"count"`
+}
+
+// PredictorPerformance summarizes finished predictions. Latency is the time
+// from start to the first completion, so later re-runs and moderation do not
+// count; FailureRate is the share of finished predictions that failed.
+type PredictorPerformance struct {
+	Completed    int64   `json:"completed"`
+	Failed       int64   `json:"failed"`
+	AvgLatencyMs float64 `json:"avg_latency_ms"`
+	FailureRate  float64 `json:"failure_rate"`
+}
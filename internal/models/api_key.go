@@ -0,0 +1,73 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+)
+
+// APIKeyScope limits what a request authenticated with an API key may do.
+type APIKeyScope string
+
+const (
+	ScopePredictionsRead  APIKeyScope = "predictions:read"
+	ScopePredictionsWrite APIKeyScope = "predictions:write"
+	ScopeProfileRead      APIKeyScope = "profile:read"
+	ScopeProfileWrite     APIKeyScope = "profile:write"
+)
+
+func (s APIKeyScope) IsValid() bool {
+	switch s {
+	case ScopePredictionsRead, ScopePredictionsWrite, ScopeProfileRead, ScopeProfileWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey is a long-lived credential for scripts and devices. Only the hash of
+// the key is stored; the key itself is shown once on creation.
+type APIKey struct {
+	ID         uuid.UUID     `json:"id"`
+	UserID     uuid.UUID     `json:"user_id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"`
+	KeyHash    string        `json:"-"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	ExpiresAt  *time.Time    `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+func (k *APIKey) Model(key db.ApiKey) {
+	k.ID = key.ID
+	k.UserID = key.UserID
+	k.Name = key.Name
+	k.Prefix = key.Prefix
+	k.KeyHash = key.KeyHash
+	k.Scopes = make([]APIKeyScope, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		k.Scopes[i] = APIKeyScope(scope)
+	}
+	k.CreatedAt = key.CreatedAt
+	if key.ExpiresAt.Valid {
+		expiresAt := key.ExpiresAt.Time
+		k.ExpiresAt = &expiresAt
+	}
+	if key.LastUsedAt.Valid {
+		lastUsedAt := key.LastUsedAt.Time
+		k.LastUsedAt = &lastUsedAt
+	}
+}
+
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// Allows reports whether the key grants scope. A key without scopes grants
+// nothing.
+func (k *APIKey) Allows(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
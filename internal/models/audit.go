@@ -0,0 +1,105 @@
+package models
+
+import (
+	"encoding/json"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+)
+
+// AuditAction names an administrative or security-relevant action.
+type AuditAction string
+
+const (
+	AuditUserCreate      AuditAction = "user.create"
+	AuditUserUpdate      AuditAction = "user.update"
+	AuditUserUnlock      AuditAction = "user.unlock"
+	AuditUserBan         AuditAction = "user.ban"
+	AuditUserUnban       AuditAction = "user.unban"
+	AuditUserRestore     AuditAction = "user.restore"
+	AuditUserPurge       AuditAction = "user.purge"
+	AuditSessionRevoke   AuditAction = "session.revoke"
+	AuditAccountUpdate   AuditAction = "account.update"
+	AuditAccountDelete   AuditAction = "account.delete"
+	AuditPasswordChange  AuditAction = "password.change"
+	AuditPasswordReset   AuditAction = "password.reset"
+	AuditMFAEnable       AuditAction = "mfa.enable"
+	AuditMFADisable      AuditAction = "mfa.disable"
+	AuditAPIKeyCreate    AuditAction = "api_key.create"
+	AuditAPIKeyRevoke    AuditAction = "api_key.revoke"
+	AuditUserImpersonate AuditAction = "user.impersonate"
+	AuditDataExport      AuditAction = "data.export"
+	AuditPredictionLabel AuditAction = "prediction.label"
+	AuditPredictionRerun AuditAction = "prediction.rerun"
+	AuditStatsRecompute  AuditAction = "stats.recompute"
+
+	AuditOrganizationCreate       AuditAction = "organization.create"
+	AuditOrganizationDelete       AuditAction = "organization.delete"
+	AuditOrganizationInviteReset  AuditAction = "organization.invite_reset"
+	AuditOrganizationJoin         AuditAction = "organization.join"
+	AuditOrganizationLeave        AuditAction = "organization.leave"
+	AuditOrganizationMemberUpdate AuditAction = "organization.member_update"
+	AuditOrganizationMemberRemove AuditAction = "organization.member_remove"
+)
+
+// AuditTarget is the kind of object an audited action changed.
+type AuditTarget string
+
+const (
+	AuditTargetUser         AuditTarget = "user"
+	AuditTargetSession      AuditTarget = "session"
+	AuditTargetAPIKey       AuditTarget = "api_key"
+	AuditTargetExport       AuditTarget = "export"
+	AuditTargetPrediction   AuditTarget = "prediction"
+	AuditTargetStats        AuditTarget = "stats"
+	AuditTargetOrganization AuditTarget = "organization"
+)
+
+// AuditEvent records who did what to which object. Before and After hold only
+// the fields the action changed. ImpersonatorID is set when an admin acted as
+// the actor.
+type AuditEvent struct {
+	ID             uuid.UUID       `json:"id"`
+	ActorID        uuid.UUID       `json:"actor_id"`
+	ImpersonatorID *uuid.UUID      `json:"impersonator_id,omitempty"`
+	Action         AuditAction     `json:"action"`
+	TargetType     AuditTarget     `json:"target_type"`
+	TargetID       string          `json:"target_id"`
+	Before         json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After          json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	IPAddress      *netip.Addr     `json:"ip_address,omitempty" swaggertype:"string"`
+	RequestID      string          `json:"request_id,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+func (e *AuditEvent) Model(event db.AuditEvent) {
+	e.ID = event.ID
+	e.ActorID = event.ActorID
+	if event.ImpersonatorID.Valid {
+		impersonatorID := uuid.UUID(event.ImpersonatorID.Bytes)
+		e.ImpersonatorID = &impersonatorID
+	}
+	e.Action = AuditAction(event.Action)
+	e.TargetType = AuditTarget(event.TargetType)
+	e.TargetID = event.TargetID
+	e.Before = event.Before
+	e.After = event.After
+	e.IPAddress = event.IpAddress
+	if event.RequestID != nil {
+		e.RequestID = *event.RequestID
+	}
+	e.CreatedAt = event.CreatedAt
+}
+
+// AuditFilter narrows the audit log. Zero fields do not filter.
+type AuditFilter struct {
+	ActorID        *uuid.UUID
+	ImpersonatorID *uuid.UUID
+	Action         AuditAction
+	TargetType     AuditTarget
+	TargetID       string
+	From           *time.Time
+	To             *time.Time
+}
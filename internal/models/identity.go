@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+)
+
+// UserIdentity links an account of an external OpenID Connect provider,
+// identified by its subject, to a local user.
+type UserIdentity struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Provider    string     `json:"provider"`
+	Subject     string     `json:"subject"`
+	Email       *string    `json:"email,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+}
+
+func (i *UserIdentity) Model(identity db.UserIdentity) {
+	i.ID = identity.ID
+	i.UserID = identity.UserID
+	i.Provider = identity.Provider
+	i.Subject = identity.Subject
+	i.Email = identity.Email
+	i.CreatedAt = identity.CreatedAt
+	if identity.LastLoginAt.Valid {
+		lastLoginAt := identity.LastLoginAt.Time
+		i.LastLoginAt = &lastLoginAt
+	}
+}
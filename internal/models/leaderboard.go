@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LeaderboardPeriod is the window a leaderboard ranks rating in.
+type LeaderboardPeriod string
+
+const (
+	LeaderboardAllTime LeaderboardPeriod = "all"
+	LeaderboardMonthly LeaderboardPeriod = "month"
+	LeaderboardWeekly  LeaderboardPeriod = "week"
+)
+
+// RatingPeriods are the windows rating changes are tracked in besides the
+// cumulative rating.
+var RatingPeriods = []LeaderboardPeriod{LeaderboardMonthly, LeaderboardWeekly}
+
+func (p LeaderboardPeriod) IsValid() bool {
+	switch p {
+	case LeaderboardAllTime, LeaderboardMonthly, LeaderboardWeekly:
+		return true
+	}
+	return false
+}
+
+// Start returns the UTC midnight the period containing t starts at; weeks
+// start on Monday. The all-time period has no start.
+func (p LeaderboardPeriod) Start(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	switch p {
+	case LeaderboardMonthly:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	case LeaderboardWeekly:
+		daysSinceMonday := (int(t.UTC().Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Time{}
+}
+
+// LeaderboardEntry is the place of a user on a leaderboard. Score is the
+// rating, or the rating gained within the period.
+type LeaderboardEntry struct {
+	Rank   int        `json:"rank"`
+	UserID uuid.UUID  `json:"user_id"`
+	Name   string     `json:"name"`
+	Status UserStatus `json:"status"`
+	Score  int        `json:"score"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+)
+
+// UserTOTP is the TOTP enrollment of a user. It only protects logins once it
+// has been confirmed with a valid code.
+type UserTOTP struct {
+	UserID       uuid.UUID  `json:"user_id"`
+	Secret       string     `json:"-"`
+	ConfirmedAt  *time.Time `json:"confirmed_at,omitempty"`
+	LastUsedStep int64      `json:"-"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+func (t *UserTOTP) Model(totp db.UserTotp) {
+	t.UserID = totp.UserID
+	t.Secret = totp.Secret
+	t.LastUsedStep = totp.LastUsedStep
+	t.CreatedAt = totp.CreatedAt
+	if totp.ConfirmedAt.Valid {
+		confirmedAt := totp.ConfirmedAt.Time
+		t.ConfirmedAt = &confirmedAt
+	}
+}
+
+func (t *UserTOTP) Enabled() bool {
+	return t.ConfirmedAt != nil
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+)
+
+// OrgRole is the role of a member within an organization.
+type OrgRole string
+
+const (
+	OrgRoleOwner  OrgRole = "owner"
+	OrgRoleMember OrgRole = "member"
+)
+
+func (r OrgRole) IsValid() bool {
+	switch r {
+	case OrgRoleOwner, OrgRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// Organization is a group of users, such as a school or an office, that
+// competes as one. Users join it with its invite code.
+type Organization struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	InviteCode string    `json:"-"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (o *Organization) Model(org db.Organization) {
+	o.ID = org.ID
+	o.Name = org.Name
+	o.InviteCode = org.InviteCode
+	o.CreatedAt = org.CreatedAt
+	o.UpdatedAt = org.UpdatedAt
+}
+
+// OrganizationMember is the membership of a user in an organization.
+type OrganizationMember struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           OrgRole   `json:"role"`
+	JoinedAt       time.Time `json:"joined_at"`
+}
+
+func (m *OrganizationMember) Model(member db.OrganizationMember) {
+	m.OrganizationID = member.OrganizationID
+	m.UserID = member.UserID
+	m.Role = OrgRole(member.Role)
+	m.JoinedAt = member.JoinedAt
+}
+
+// UserOrganization is an organization the user is a member of, with their
+// role in it.
+type UserOrganization struct {
+	Organization
+	Role     OrgRole   `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+func (o *UserOrganization) Model(row db.ListUserOrganizationsRow) {
+	o.Organization.Model(db.Organization{
+		ID:         row.ID,
+		Name:       row.Name,
+		InviteCode: row.InviteCode,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	})
+	o.Role = OrgRole(row.Role)
+	o.JoinedAt = row.JoinedAt
+}
+
+// MemberActivity is a member of an organization with their stats, for the
+// owners of the organization. RecentScans counts the scans since the start of
+// the window the activity was listed for.
+type MemberActivity struct {
+	UserID        uuid.UUID  `json:"user_id"`
+	Name          string     `json:"name"`
+	Role          OrgRole    `json:"role"`
+	JoinedAt      time.Time  `json:"joined_at"`
+	Rating        int        `json:"rating"`
+	FilesScanned  int        `json:"files_scanned"`
+	TotalWeight   float64    `json:"total_weight"`
+	LastScannedAt *time.Time `json:"last_scanned_at,omitempty"`
+	RecentScans   int        `json:"recent_scans"`
+}
+
+func (a *MemberActivity) Model(row db.ListOrganizationMembersRow) {
+	a.UserID = row.UserID
+	a.Name = row.Name
+	a.Role = OrgRole(row.Role)
+	a.JoinedAt = row.JoinedAt
+	a.Rating = int(row.Rating)
+	a.FilesScanned = int(row.FilesScanned)
+	a.TotalWeight = row.TotalWeight
+	if row.LastScannedAt.Valid {
+		lastScannedAt := row.LastScannedAt.Time
+		a.LastScannedAt = &lastScannedAt
+	}
+	a.RecentScans = int(row.RecentScans)
+}
+
+// OrganizationStats are the stats of the members of an organization added
+// up. Members that deleted their account are left out.
+type OrganizationStats struct {
+	Members      int            `json:"members"`
+	Rating       int            `json:"rating"`
+	FilesScanned int            `json:"files_scanned"`
+	TotalWeight  float64        `json:"total_weight"`
+	TrashByTypes map[string]int `json:"trash_by_types"`
+	// WeightByTypes is the weight in kg of the items of each trash type.
+	WeightByTypes map[string]float64 `json:"weight_by_types"`
+	// Impact is derived from WeightByTypes when the stats are served.
+	Impact *StatImpact `json:"impact,omitempty"`
+}
+
+// Stat returns the totals as the stats of a single user, for what is derived
+// from stats.
+func (s *OrganizationStats) Stat() *Stat {
+	return &Stat{
+		Rating:        s.Rating,
+		FilesScanned:  s.FilesScanned,
+		TotalWeight:   s.TotalWeight,
+		TrashByTypes:  s.TrashByTypes,
+		WeightByTypes: s.WeightByTypes,
+	}
+}
+
+// OrganizationLeaderboardEntry is the place of an organization on a
+// leaderboard. Score is the rating of its members, or the rating they gained
+// within the period.
+type OrganizationLeaderboardEntry struct {
+	Rank           int       `json:"rank"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Members        int       `json:"members"`
+	Score          int       `json:"score"`
+}
@@ -0,0 +1,40 @@
+package models
+
+// Permission names an action a role may perform.
+type Permission string
+
+const (
+	PermUsersRead           Permission = "users:read"
+	PermUsersWrite          Permission = "users:write"
+	PermUsersBan            Permission = "users:ban"
+	PermUsersImpersonate    Permission = "users:impersonate"
+	PermPredictionsRead     Permission = "predictions:read"
+	PermPredictionsModerate Permission = "predictions:moderate"
+	PermStatsRead           Permission = "stats:read"
+	PermStatsRecompute      Permission = "stats:recompute"
+	PermAuditRead           Permission = "audit:read"
+	PermDataExport          Permission = "data:export"
+)
+
+// Permissions lists every known permission.
+var Permissions = []Permission{
+	PermUsersRead,
+	PermUsersWrite,
+	PermUsersBan,
+	PermUsersImpersonate,
+	PermPredictionsRead,
+	PermPredictionsModerate,
+	PermStatsRead,
+	PermStatsRecompute,
+	PermAuditRead,
+	PermDataExport,
+}
+
+func (p Permission) IsValid() bool {
+	for _, known := range Permissions {
+		if p == known {
+			return true
+		}
+	}
+	return false
+}
@@ -113,15 +113,82 @@ type Prediction struct {
 	Status    PredictionStatus `json:"status"`
 	Result    PredictionResult `json:"result"`
 	Error     string           `json:"error"`
+	// Weight is the weight of the item in kg as entered by the owner.
+	Weight *float64 `json:"weight,omitempty"`
+
+	Verification *PredictionVerification `json:"verification,omitempty"`
+	Feedback     *PredictionFeedback     `json:"feedback,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// PredictionVerification is the trash type staff confirmed for a scan. It
+// takes precedence over the predicted result in the stats of the owner.
+type PredictionVerification struct {
+	Label string `json:"label"`
+	// VerifiedBy is nil once the staff member has been purged.
+	VerifiedBy *uuid.UUID `json:"verified_by,omitempty"`
+	VerifiedAt time.Time  `json:"verified_at"`
+}
+
+// PredictionFeedback is what the owner reported about a prediction.
+type PredictionFeedback struct {
+	Label     string    `json:"label,omitempty"`
+	Comment   string    `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PredictionFilter narrows predictions across all users. Zero fields do not
+// filter; the creation range is [CreatedFrom, CreatedTo). MaxConfidence keeps
+// predictions whose most probable type scored below it.
+type PredictionFilter struct {
+	UserID        *uuid.UUID
+	Status        *PredictionStatus
+	MaxConfidence *float64
+	HasFeedback   *bool
+	Verified      *bool
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+}
+
+// AdminPrediction is a prediction together with the login of its owner.
+type AdminPrediction struct {
+	Prediction
+	UserLogin string `json:"user_login"`
+}
+
 func (pr Prediction) IsValid() bool {
 	return pr.Status.IsValid()
 }
 
+// Confidence is the probability of the most probable type, or zero without a
+// result.
+func (pr Prediction) Confidence() float64 {
+	var confidence float64
+	for _, p := range pr.Result {
+		confidence = max(confidence, p)
+	}
+	return confidence
+}
+
+// TrashType is the verified label, or else the most probable type of the
+// result; it is empty without either.
+func (pr Prediction) TrashType() string {
+	if pr.Verification != nil {
+		return pr.Verification.Label
+	}
+
+	var trashType string
+	var confidence float64
+	for t, p := range pr.Result {
+		if p > confidence || (p == confidence && t < trashType) {
+			trashType, confidence = t, p
+		}
+	}
+	return trashType
+}
+
 func (pr *Prediction) Model(prediction db.Prediction) {
 	pr.ID = prediction.ID
 	pr.UserID = prediction.UserID
@@ -134,6 +201,28 @@ func (pr *Prediction) Model(prediction db.Prediction) {
 	if prediction.Error != nil {
 		pr.Error = *prediction.Error
 	}
+	pr.Weight = prediction.Weight
+	pr.Verification = nil
+	if prediction.VerifiedLabel != nil {
+		pr.Verification = &PredictionVerification{
+			Label:      *prediction.VerifiedLabel,
+			VerifiedAt: prediction.VerifiedAt.Time,
+		}
+		if prediction.VerifiedBy.Valid {
+			verifiedBy := uuid.UUID(prediction.VerifiedBy.Bytes)
+			pr.Verification.VerifiedBy = &verifiedBy
+		}
+	}
+	pr.Feedback = nil
+	if prediction.FeedbackAt.Valid {
+		pr.Feedback = &PredictionFeedback{CreatedAt: prediction.FeedbackAt.Time}
+		if prediction.FeedbackLabel != nil {
+			pr.Feedback.Label = *prediction.FeedbackLabel
+		}
+		if prediction.FeedbackComment != nil {
+			pr.Feedback.Comment = *prediction.FeedbackComment
+		}
+	}
 	pr.CreatedAt = prediction.CreatedAt
 	pr.UpdatedAt = prediction.UpdatedAt
 }
@@ -4,13 +4,14 @@ type Role string
 
 const (
 	RoleAdmin     Role = "admin"
+	RoleModerator Role = "moderator"
 	RoleUser      Role = "user"
 	RoleAnonymous Role = "anonymous"
 )
 
 func (r Role) IsValid() bool {
 	switch r {
-	case RoleAdmin, RoleUser, RoleAnonymous:
+	case RoleAdmin, RoleModerator, RoleUser, RoleAnonymous:
 		return true
 	default:
 		return false
@@ -39,10 +39,16 @@ type Stat struct {
 	FilesScanned  int            `json:"files_scanned"`
 	TotalWeight   float64        `json:"total_weight"`
 	LastScannedAt time.Time      `json:"last_scanned_at,omitempty"`
+	CurrentStreak int            `json:"current_streak"`
+	LongestStreak int            `json:"longest_streak"`
 	Achievements  []Achievement  `json:"-"`
 	TrashByTypes  map[string]int `json:"trash_by_types"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
+	// WeightByTypes is the weight in kg of the items of each trash type.
+	WeightByTypes map[string]float64 `json:"weight_by_types"`
+	// Impact is derived from WeightByTypes when the stats are served.
+	Impact    *StatImpact `json:"impact,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
 }
 
 func (s *Stat) Model(stat db.Stat) {
@@ -52,11 +58,32 @@ func (s *Stat) Model(stat db.Stat) {
 	s.FilesScanned = int(stat.FilesScanned)
 	s.TotalWeight = stat.TotalWeight
 	s.LastScannedAt = stat.LastScannedAt.Time
+	s.CurrentStreak = int(stat.CurrentStreak)
+	s.LongestStreak = int(stat.LongestStreak)
 	s.CreatedAt = stat.CreatedAt
 	s.UpdatedAt = stat.UpdatedAt
 	_ = json.Unmarshal(stat.TrashByTypes, &s.TrashByTypes)
+	_ = json.Unmarshal(stat.Achievements, &s.Achievements)
+	_ = json.Unmarshal(stat.WeightByTypes, &s.WeightByTypes)
 }
 
-type Achievement struct {
-	// TODO
+// StatImpact estimates the environmental impact of the sorted items.
+type StatImpact struct {
+	// CO2Saved is the estimated kg of CO2 emissions avoided.
+	CO2Saved       float64            `json:"co2_saved"`
+	CO2SavedByType map[string]float64 `json:"co2_saved_by_type"`
+}
+
+// StreakAt returns the current streak as of now, with days counted in loc.
+// A streak that wasn't extended yesterday or today has lapsed, even though
+// CurrentStreak keeps its value until the next scan.
+func (s *Stat) StreakAt(now time.Time, loc *time.Location) int {
+	if s.LastScannedAt.IsZero() {
+		return 0
+	}
+	lastDay := Day(s.LastScannedAt.In(loc))
+	if lastDay.Before(Day(now.In(loc)).AddDate(0, 0, -1)) {
+		return 0
+	}
+	return s.CurrentStreak
 }
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+)
+
+// StatSnapshot is the state of the stats of a user at the end of a calendar
+// day of their time zone. The earliest snapshot of every user is the baseline
+// their history starts from.
+type StatSnapshot struct {
+	Day          time.Time      `json:"day"`
+	Rating       int            `json:"rating"`
+	FilesScanned int            `json:"files_scanned"`
+	TotalWeight  float64        `json:"total_weight"`
+	TrashByTypes map[string]int `json:"trash_by_types"`
+}
+
+func (s *StatSnapshot) Model(snapshot db.StatsHistory) {
+	s.Day = snapshot.Day.Time
+	s.Rating = int(snapshot.Rating)
+	s.FilesScanned = int(snapshot.FilesScanned)
+	s.TotalWeight = snapshot.TotalWeight
+	_ = json.Unmarshal(snapshot.TrashByTypes, &s.TrashByTypes)
+}
+
+// TrendPeriod is the length of the periods stats trends are broken into.
+type TrendPeriod string
+
+const (
+	TrendDaily   TrendPeriod = "day"
+	TrendWeekly  TrendPeriod = "week"
+	TrendMonthly TrendPeriod = "month"
+)
+
+func (p TrendPeriod) IsValid() bool {
+	switch p {
+	case TrendDaily, TrendWeekly, TrendMonthly:
+		return true
+	}
+	return false
+}
+
+// Start returns the first day of the period containing day, a UTC midnight as
+// returned by Day; weeks start on Monday.
+func (p TrendPeriod) Start(day time.Time) time.Time {
+	switch p {
+	case TrendWeekly:
+		return LeaderboardWeekly.Start(day)
+	case TrendMonthly:
+		return LeaderboardMonthly.Start(day)
+	}
+	return Day(day.UTC())
+}
+
+// Next returns the first day of the period after the one starting on start.
+func (p TrendPeriod) Next(start time.Time) time.Time {
+	switch p {
+	case TrendWeekly:
+		return start.AddDate(0, 0, 7)
+	case TrendMonthly:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
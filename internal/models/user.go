@@ -5,21 +5,27 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
 )
 
 type User struct {
-	ID             uuid.UUID  `json:"id"`
-	Login          string     `json:"login"`
-	Name           string     `json:"name"`
-	HashedPassword string     `json:"-"`
-	Role           Role       `json:"role"`
-	Avatar         *string    `json:"avatar,omitempty"`
-	Stat           *Stat      `json:"stat,omitempty"`
-	Deleted        bool       `json:"-"`
-	LastLoginAt    *time.Time `json:"last_login_at,omitempty"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      time.Time  `json:"updated_at"`
+	ID                uuid.UUID  `json:"id"`
+	Login             string     `json:"login"`
+	Name              string     `json:"name"`
+	HashedPassword    string     `json:"-"`
+	Role              Role       `json:"role"`
+	Avatar            *string    `json:"avatar,omitempty"`
+	Email             *string    `json:"email,omitempty"`
+	EmailVerified     bool       `json:"email_verified"`
+	Stat              *Stat      `json:"stat,omitempty"`
+	Deleted           bool       `json:"-"`
+	Ban               *Ban       `json:"-"`
+	LastLoginAt       *time.Time `json:"last_login_at,omitempty"`
+	LeaderboardHidden bool       `json:"leaderboard_hidden"`
+	Timezone          string     `json:"timezone"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
 }
 
 func (u *User) Model(user db.User) {
@@ -29,11 +35,29 @@ func (u *User) Model(user db.User) {
 	u.HashedPassword = user.HashedPassword
 	u.Role = Role(user.Role)
 	u.Avatar = user.Avatar
+	u.Email = user.Email
+	u.EmailVerified = user.Email != nil && user.EmailVerifiedAt.Valid
 	u.Deleted = user.Deleted
+	u.Ban = NewBan(user.BannedAt, user.BannedUntil, user.BanReason)
+	u.LeaderboardHidden = user.LeaderboardHidden
+	u.Timezone = user.Timezone
 	u.CreatedAt = user.CreatedAt
 	u.UpdatedAt = user.UpdatedAt
 }
 
+// Location returns the time zone the user's days are counted in. Unknown
+// zones fall back to UTC.
+func (u *User) Location() *time.Location {
+	if u.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 func (u *User) WithStat(stat db.Stat) {
 	if u.Stat == nil {
 		u.Stat = &Stat{}
@@ -41,6 +65,34 @@ func (u *User) WithStat(stat db.Stat) {
 	u.Stat.Model(stat)
 }
 
+// Ban blocks a user from logging in and from using the API. A nil Until
+// means the ban does not expire.
+type Ban struct {
+	BannedAt time.Time  `json:"banned_at"`
+	Until    *time.Time `json:"until,omitempty"`
+	Reason   string     `json:"reason"`
+}
+
+func NewBan(bannedAt, until pgtype.Timestamptz, reason *string) *Ban {
+	if !bannedAt.Valid {
+		return nil
+	}
+
+	ban := &Ban{BannedAt: bannedAt.Time}
+	if until.Valid {
+		ban.Until = &until.Time
+	}
+	if reason != nil {
+		ban.Reason = *reason
+	}
+	return ban
+}
+
+// Active reports whether the ban is still in force at now.
+func (b *Ban) Active(now time.Time) bool {
+	return b != nil && (b.Until == nil || now.Before(*b.Until))
+}
+
 type RefreshToken db.RefreshToken
 
 func NewRefreshFromClaims(hash string, claims jwt.RegisteredClaims) *RefreshToken {
@@ -52,3 +104,52 @@ func NewRefreshFromClaims(hash string, claims jwt.RegisteredClaims) *RefreshToke
 }
 
 type LoginHistory db.LoginHistory
+
+// LoginHistoryFilter narrows a user's login history. Nil fields do not filter.
+type LoginHistoryFilter struct {
+	Success *bool
+	From    *time.Time
+	To      *time.Time
+}
+
+type PasswordResetToken db.PasswordResetToken
+
+type EmailVerificationToken db.EmailVerificationToken
+
+// LoginFailures summarizes failed login attempts that still count towards a lockout.
+type LoginFailures struct {
+	Count         int       `json:"count"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+}
+
+type UserSort string
+
+const (
+	UserSortCreatedAt    UserSort = "created_at"
+	UserSortRating       UserSort = "rating"
+	UserSortFilesScanned UserSort = "files_scanned"
+	UserSortLastLogin    UserSort = "last_login"
+)
+
+func (s UserSort) Valid() bool {
+	switch s {
+	case UserSortCreatedAt, UserSortRating, UserSortFilesScanned, UserSortLastLogin:
+		return true
+	}
+	return false
+}
+
+// UserFilter narrows and orders the admin user list. Nil fields do not filter.
+type UserFilter struct {
+	// Search matches a substring of the login or the name, case-insensitively.
+	Search  string
+	Role    *Role
+	Deleted *bool
+	// Banned matches users whose ban is in force, or with false, everyone else.
+	Banned        *bool
+	Status        *UserStatus
+	LastLoginFrom *time.Time
+	LastLoginTo   *time.Time
+	SortBy        UserSort
+	SortAsc       bool
+}
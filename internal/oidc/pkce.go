@@ -0,0 +1,24 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+const (
+	codeChallengeMethod = "S256"
+	codeVerifierSize    = 32
+)
+
+// NewCodeVerifier returns a random PKCE code verifier (RFC 7636).
+func NewCodeVerifier() (string, error) {
+	return utils.GenerateToken(codeVerifierSize)
+}
+
+// CodeChallenge derives the S256 challenge sent with the authorization request.
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,137 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/oidc"
+	"github.com/trashscanner/trashscanner_api/internal/oidc/oidctest"
+)
+
+func authenticate(t *testing.T, srv *oidctest.Server, provider *oidc.Provider) (*oidc.Identity, error) {
+	t.Helper()
+
+	verifier, err := oidc.NewCodeVerifier()
+	require.NoError(t, err)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", oidc.CodeChallenge(verifier))
+	require.NoError(t, err)
+
+	code, state := srv.Authorize(t, authURL)
+	require.Equal(t, "state-1", state)
+
+	return provider.Authenticate(context.Background(), code, verifier, "nonce-1")
+}
+
+func TestProvider_Authenticate(t *testing.T) {
+	srv := oidctest.NewServer(t)
+	srv.SetUser(oidctest.User{
+		Subject:           "subject-42",
+		Email:             "jane@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "jane",
+	})
+	provider := oidc.NewProvider("test", srv.Config(), nil)
+
+	identity, err := authenticate(t, srv, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, "test", identity.Provider)
+	assert.Equal(t, "subject-42", identity.Subject)
+	assert.Equal(t, "jane@example.com", identity.Email)
+	assert.True(t, identity.EmailVerified)
+	assert.Equal(t, "jane", identity.PreferredUsername)
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	srv := oidctest.NewServer(t)
+	provider := oidc.NewProvider("test", srv.Config(), nil)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", "challenge")
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	query := u.Query()
+	assert.Equal(t, srv.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, oidctest.ClientID, query.Get("client_id"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, "challenge", query.Get("code_challenge"))
+	assert.Equal(t, "nonce-1", query.Get("nonce"))
+}
+
+func TestProvider_RejectsWrongVerifier(t *testing.T) {
+	srv := oidctest.NewServer(t)
+	provider := oidc.NewProvider("test", srv.Config(), nil)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", oidc.CodeChallenge("verifier"))
+	require.NoError(t, err)
+	code, _ := srv.Authorize(t, authURL)
+
+	_, err = provider.Authenticate(context.Background(), code, "other-verifier", "nonce-1")
+	require.Error(t, err)
+}
+
+func TestProvider_VerifyIDToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(claims jwt.MapClaims)
+	}{
+		{name: "wrong_nonce", modify: func(c jwt.MapClaims) { c["nonce"] = "other" }},
+		{name: "wrong_audience", modify: func(c jwt.MapClaims) { c["aud"] = "other-client" }},
+		{name: "wrong_issuer", modify: func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{name: "expired", modify: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		{name: "missing_expiry", modify: func(c jwt.MapClaims) { delete(c, "exp") }},
+		{name: "missing_subject", modify: func(c jwt.MapClaims) { c["sub"] = "" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := oidctest.NewServer(t)
+			srv.ModifyClaims = tt.modify
+			provider := oidc.NewProvider("test", srv.Config(), nil)
+
+			_, err := authenticate(t, srv, provider)
+			require.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+		})
+	}
+
+	t.Run("foreign_signature", func(t *testing.T) {
+		srv := oidctest.NewServer(t)
+		other := oidctest.NewServer(t)
+		provider := oidc.NewProvider("test", srv.Config(), nil)
+
+		token := other.SignIDToken(t, jwt.MapClaims{
+			"iss":   srv.URL,
+			"aud":   oidctest.ClientID,
+			"sub":   "subject-1",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"nonce": "nonce-1",
+		})
+
+		_, err := provider.VerifyIDToken(context.Background(), token, "nonce-1")
+		require.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+
+	t.Run("email_verified_as_string", func(t *testing.T) {
+		srv := oidctest.NewServer(t)
+		srv.ModifyClaims = func(c jwt.MapClaims) { c["email_verified"] = "true" }
+		provider := oidc.NewProvider("test", srv.Config(), nil)
+
+		identity, err := authenticate(t, srv, provider)
+		require.NoError(t, err)
+		assert.True(t, identity.EmailVerified)
+	})
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// RFC 7636, appendix B
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		oidc.CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
@@ -26,15 +26,22 @@ type Predictor struct {
 	log               *logging.Logger
 	client            predictRequester
 	store             store.Store
+	rules             stats.Rules
 	scansInProcessing map[string]struct{}
 	limiter           atomic.Int32
 	limitRate         int32
 }
 
-func NewPredictor(logger *logging.Logger, store store.Store, cfg config.PredictorConfig) *Predictor {
+func NewPredictor(
+	logger *logging.Logger,
+	store store.Store,
+	cfg config.PredictorConfig,
+	rules stats.Rules,
+) *Predictor {
 	return &Predictor{
 		log:               logger.WithPredictorTag(),
 		store:             store,
+		rules:             rules,
 		scansInProcessing: make(map[string]struct{}, cfg.MaxPredictionsInProcessing),
 		client:            newPredictorClient(cfg, logger),
 		limiter:           atomic.Int32{},
@@ -42,21 +49,16 @@ func NewPredictor(logger *logging.Logger, store store.Store, cfg config.Predicto
 	}
 }
 
-func (pr *Predictor) Predict(ctx context.Context, scanURL string) (*models.Prediction, error) {
-	if pr.limiter.Load() >= pr.limitRate {
-		return nil, errlocal.NewErrToManyRequests("to many predictions in processing")
-	}
-	pr.limiter.Add(1)
-
-	if !pr.tryPutScanInProcessing(scanURL) {
-		pr.limiter.Add(-1)
-		return nil, errlocal.NewErrConflict("scan already in processing", "",
-			map[string]any{"scan": scanURL})
+// Predict starts the prediction of a scan. weight is the weight of the item
+// in kg if the owner weighed it.
+func (pr *Predictor) Predict(ctx context.Context, scanURL string, weight *float64) (*models.Prediction, error) {
+	if err := pr.reserve(scanURL); err != nil {
+		return nil, err
 	}
 	user := utils.GetUser(ctx)
 
 	pr.log.WithContext(ctx).Debugf("scan %s start processing", scanURL)
-	prediction, err := pr.store.StartPrediction(ctx, user.ID, scanURL)
+	prediction, err := pr.store.StartPrediction(ctx, user.ID, scanURL, weight)
 	if err != nil {
 		pr.limiter.Add(-1)
 		pr.deleteScanFromProcessing(scanURL)
@@ -72,12 +74,7 @@ func (pr *Predictor) processPrediction(ctx context.Context, scanURL string, pred
 	defer func() { pr.limiter.Add(-1); pr.deleteScanFromProcessing(scanURL) }()
 	logger := pr.log.WithContext(ctx)
 
-	optsHeader := http.Header{}
-	if requestID, ok := utils.GetRequestID(ctx); ok {
-		optsHeader.Add("X-Request-ID", requestID)
-	}
-
-	resp, reqErr := pr.client.RequestPredict(ctx, scanURL, prediction.ID, optsHeader)
+	resp, reqErr := pr.client.RequestPredict(ctx, scanURL, prediction.ID, requestHeaders(ctx))
 	if reqErr != nil {
 		logger.Errorf("error while process prediction %s: %v", prediction.ID.String(), reqErr)
 		prediction.Error = reqErr.Error()
@@ -92,13 +89,85 @@ func (pr *Predictor) processPrediction(ctx context.Context, scanURL string, pred
 			return err
 		}
 
-		return stats.UpdateStats(ctx, s, prediction)
+		return stats.UpdateStats(ctx, s, pr.rules, prediction)
 	}); completeErr != nil {
 		logger.Errorf("error while complete prediction %s: %v", prediction.ID.String(), completeErr)
 		_ = pr.store.CompletePrediction(ctx, prediction.ID, nil, completeErr)
 	}
 }
 
+// Rerun sends the scan of an existing prediction to the predictor again. The
+// prediction keeps its ID; once the new result arrives it replaces the old
+// one and the stats of the owner are revised in the same transaction.
+func (pr *Predictor) Rerun(ctx context.Context, prediction *models.Prediction) error {
+	if err := pr.reserve(prediction.TrashScan); err != nil {
+		return err
+	}
+
+	pr.log.WithContext(ctx).Debugf("prediction %s start reprocessing", prediction.ID.String())
+	go pr.reprocessPrediction(utils.CopyContext(ctx), prediction.ID, prediction.TrashScan)
+
+	return nil
+}
+
+func (pr *Predictor) reprocessPrediction(ctx context.Context, predictionID uuid.UUID, scanURL string) {
+	defer func() { pr.limiter.Add(-1); pr.deleteScanFromProcessing(scanURL) }()
+	logger := pr.log.WithContext(ctx)
+
+	var result models.PredictionResult
+	resp, reqErr := pr.client.RequestPredict(ctx, scanURL, predictionID, requestHeaders(ctx))
+	if reqErr != nil {
+		logger.Errorf("error while reprocess prediction %s: %v", predictionID.String(), reqErr)
+	} else {
+		result = models.NewPredictionResult(resp.Result)
+	}
+
+	if err := pr.store.ExecTx(ctx, func(s store.Store) error {
+		before, err := s.GetPredictionForUpdate(ctx, predictionID)
+		if err != nil {
+			return err
+		}
+		if err := s.CompletePrediction(ctx, predictionID, result, reqErr); err != nil {
+			return err
+		}
+
+		after := *before
+		after.Result, after.Error, after.Status = result, "", models.PredictionCompletedStatus
+		if reqErr != nil {
+			after.Result, after.Error, after.Status = nil, reqErr.Error(), models.PredictionFailedStatus
+		}
+		return stats.ReviseStats(ctx, s, pr.rules, before, &after)
+	}); err != nil {
+		logger.Errorf("error while complete reprocessed prediction %s: %v", predictionID.String(), err)
+	}
+}
+
+// reserve takes a processing slot for scanURL; the caller frees it once the
+// prediction is complete.
+func (pr *Predictor) reserve(scanURL string) error {
+	if pr.limiter.Load() >= pr.limitRate {
+		return errlocal.NewErrToManyRequests("to many predictions in processing")
+	}
+	pr.limiter.Add(1)
+
+	if !pr.tryPutScanInProcessing(scanURL) {
+		pr.limiter.Add(-1)
+		return errlocal.NewErrConflict("scan already in processing", "",
+			map[string]any{"scan": scanURL})
+	}
+
+	return nil
+}
+
+func requestHeaders(ctx context.Context) http.Header {
+	optsHeader := http.Header{}
+	if requestID, ok := utils.GetRequestID(ctx); ok {
+		optsHeader.Add("X-Request-ID", requestID)
+	}
+
+	return optsHeader
+}
+
 func (pr *Predictor) tryPutScanInProcessing(scanURL string) bool {
 	pr.mu.Lock()
 	defer pr.mu.Unlock()
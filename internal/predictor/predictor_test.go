@@ -14,6 +14,8 @@ import (
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/logging"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
+	"github.com/trashscanner/trashscanner_api/internal/store"
 	"github.com/trashscanner/trashscanner_api/internal/store/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/testdata"
 	"github.com/trashscanner/trashscanner_api/internal/utils"
@@ -38,6 +40,7 @@ func (s *predictorTestSuite) SetupTest() {
 		limiter:           atomic.Int32{},
 		limitRate:         10,
 		scansInProcessing: make(map[string]struct{}),
+		rules:             stats.DefaultRules(),
 	}
 
 	s.mClient = newMockPredictRequester(s.T())
@@ -51,8 +54,8 @@ func (s *predictorTestSuite) TestSuccessPredict() {
 	predictionID := uuid.New()
 
 	s.mStore.EXPECT().
-		StartPrediction(mock.Anything, testPrediction.UserID, testPrediction.TrashScan).
-		Run(func(_ context.Context, _ uuid.UUID, _ string) {
+		StartPrediction(mock.Anything, testPrediction.UserID, testPrediction.TrashScan, (*float64)(nil)).
+		Run(func(_ context.Context, _ uuid.UUID, _ string, _ *float64) {
 			testPrediction.ID = predictionID
 		}).Return(&testPrediction, nil).Once()
 
@@ -67,7 +70,7 @@ func (s *predictorTestSuite) TestSuccessPredict() {
 
 	s.mStore.EXPECT().ExecTx(mock.Anything, mock.Anything).Return(nil).Once()
 
-	result, err := s.predictor.Predict(s.ctx, testdata.ScanURL)
+	result, err := s.predictor.Predict(s.ctx, testdata.ScanURL, nil)
 	s.NoError(err)
 
 	s.Equal(&testPrediction, result)
@@ -80,7 +83,7 @@ func (s *predictorTestSuite) TestTooManyRequests() {
 		scanURL := testdata.User1ID.String() + "/scans/" + uuid.NewString()
 		prediction := uuid.New()
 		s.mStore.EXPECT().
-			StartPrediction(mock.Anything, testdata.User1.ID, scanURL).
+			StartPrediction(mock.Anything, testdata.User1.ID, scanURL, (*float64)(nil)).
 			Return(&models.Prediction{ID: prediction, TrashScan: scanURL}, nil).Once()
 		s.mClient.EXPECT().RequestPredict(mock.Anything, scanURL, prediction, mock.Anything).
 			Run(func(ctx context.Context, scanURL string, predictionID uuid.UUID, optHeaders ...http.Header) {
@@ -94,13 +97,13 @@ func (s *predictorTestSuite) TestTooManyRequests() {
 			}, nil).Once()
 		s.mStore.EXPECT().ExecTx(mock.Anything, mock.Anything).Return(nil).Once()
 
-		res, err := s.predictor.Predict(s.ctx, scanURL)
+		res, err := s.predictor.Predict(s.ctx, scanURL, nil)
 		s.NoError(err)
 		s.Equal(res.ID, prediction)
 		s.Equal(res.TrashScan, scanURL)
 	}
 
-	_, err := s.predictor.Predict(s.ctx, uuid.NewString())
+	_, err := s.predictor.Predict(s.ctx, uuid.NewString(), nil)
 	var tooManyReqErr *errlocal.ErrToManyRequests
 	s.ErrorAs(err, &tooManyReqErr)
 
@@ -112,7 +115,7 @@ func (s *predictorTestSuite) TestAlreadyProcessing() {
 	prediction := uuid.New()
 
 	s.mStore.EXPECT().
-		StartPrediction(mock.Anything, testdata.User1.ID, scanURL).
+		StartPrediction(mock.Anything, testdata.User1.ID, scanURL, (*float64)(nil)).
 		Return(&models.Prediction{ID: prediction, TrashScan: scanURL}, nil).Once()
 	s.mClient.EXPECT().RequestPredict(mock.Anything, scanURL, prediction, mock.Anything).
 		Run(func(ctx context.Context, scanURL string, predictionID uuid.UUID, optHeaders ...http.Header) {
@@ -127,11 +130,11 @@ func (s *predictorTestSuite) TestAlreadyProcessing() {
 
 	s.mStore.EXPECT().ExecTx(mock.Anything, mock.Anything).Return(nil).Once()
 
-	res, err := s.predictor.Predict(s.ctx, scanURL)
+	res, err := s.predictor.Predict(s.ctx, scanURL, nil)
 	s.NoError(err)
 	s.Equal(res.ID, prediction)
 	s.Equal(res.TrashScan, scanURL)
-	_, err = s.predictor.Predict(s.ctx, scanURL)
+	_, err = s.predictor.Predict(s.ctx, scanURL, nil)
 
 	var tooManyReqErr *errlocal.ErrConflict
 	s.ErrorAs(err, &tooManyReqErr)
@@ -143,10 +146,10 @@ func (s *predictorTestSuite) TestPredict_StartPredictionError() {
 	scanURL := testdata.User1ID.String() + "/scans/" + uuid.NewString()
 
 	s.mStore.EXPECT().
-		StartPrediction(mock.Anything, testdata.User1.ID, scanURL).
+		StartPrediction(mock.Anything, testdata.User1.ID, scanURL, (*float64)(nil)).
 		Return(nil, errlocal.NewErrInternal("db error", "", nil)).Once()
 
-	result, err := s.predictor.Predict(s.ctx, scanURL)
+	result, err := s.predictor.Predict(s.ctx, scanURL, nil)
 	s.Error(err)
 	s.Nil(result)
 	s.Equal(int32(0), s.predictor.limiter.Load())
@@ -157,8 +160,8 @@ func (s *predictorTestSuite) TestPredict_RequestPredictError() {
 	predictionID := uuid.New()
 
 	s.mStore.EXPECT().
-		StartPrediction(mock.Anything, testPrediction.UserID, testPrediction.TrashScan).
-		Run(func(_ context.Context, _ uuid.UUID, _ string) {
+		StartPrediction(mock.Anything, testPrediction.UserID, testPrediction.TrashScan, (*float64)(nil)).
+		Run(func(_ context.Context, _ uuid.UUID, _ string, _ *float64) {
 			testPrediction.ID = predictionID
 		}).Return(&testPrediction, nil).Once()
 
@@ -168,7 +171,7 @@ func (s *predictorTestSuite) TestPredict_RequestPredictError() {
 
 	s.mStore.EXPECT().ExecTx(mock.Anything, mock.Anything).Return(nil).Once()
 
-	result, err := s.predictor.Predict(s.ctx, testdata.ScanURL)
+	result, err := s.predictor.Predict(s.ctx, testdata.ScanURL, nil)
 	s.NoError(err)
 	s.Equal(&testPrediction, result)
 
@@ -180,8 +183,8 @@ func (s *predictorTestSuite) TestPredict_CompletePredictionError() {
 	predictionID := uuid.New()
 
 	s.mStore.EXPECT().
-		StartPrediction(mock.Anything, testPrediction.UserID, testPrediction.TrashScan).
-		Run(func(_ context.Context, _ uuid.UUID, _ string) {
+		StartPrediction(mock.Anything, testPrediction.UserID, testPrediction.TrashScan, (*float64)(nil)).
+		Run(func(_ context.Context, _ uuid.UUID, _ string, _ *float64) {
 			testPrediction.ID = predictionID
 		}).Return(&testPrediction, nil).Once()
 
@@ -200,13 +203,68 @@ func (s *predictorTestSuite) TestPredict_CompletePredictionError() {
 		CompletePrediction(mock.Anything, predictionID, models.PredictionResult(nil),
 			errlocal.NewErrInternal("tx error", "", nil)).Return(nil).Once()
 
-	result, err := s.predictor.Predict(s.ctx, testdata.ScanURL)
+	result, err := s.predictor.Predict(s.ctx, testdata.ScanURL, nil)
 	s.NoError(err)
 	s.Equal(&testPrediction, result)
 
 	time.Sleep(time.Second)
 }
 
+func (s *predictorTestSuite) TestRerun() {
+	user := testdata.User1
+	stat := *user.Stat
+	stat.TrashByTypes = map[string]int{}
+	user.Stat = &stat
+	failed := models.Prediction{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		TrashScan: testdata.ScanURL,
+		Status:    models.PredictionFailedStatus,
+		Error:     "predictor unavailable",
+	}
+	result := models.PredictionResult{models.TrashTypeGlass: 0.8}
+	done := make(chan *models.Stat, 1)
+
+	s.mClient.EXPECT().RequestPredict(mock.Anything, failed.TrashScan, failed.ID, mock.Anything).
+		Return(&predictResponse{ID: failed.ID, Result: map[uint8]float64{uint8(models.Glass): 0.8}}, nil).Once()
+	s.mStore.EXPECT().ExecTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+			return fn(s.mStore)
+		}).Once()
+	s.mStore.EXPECT().GetPredictionForUpdate(mock.Anything, failed.ID).Return(&failed, nil).Once()
+	s.mStore.EXPECT().CompletePrediction(mock.Anything, failed.ID, result, nil).Return(nil).Once()
+	s.mStore.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+	s.mStore.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+	var updated *models.Stat
+	s.mStore.EXPECT().UpdateStats(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, stat *models.Stat) { updated = stat }).Return(nil).Once()
+	s.mStore.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	s.mStore.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).
+		Run(func(context.Context, uuid.UUID, int, time.Time) { done <- updated }).Return(nil).Once()
+
+	s.Require().NoError(s.predictor.Rerun(s.ctx, &failed))
+
+	select {
+	case updated := <-done:
+		s.Equal(testdata.User1.Stat.Rating+10, updated.Rating)
+		s.Equal(testdata.User1.Stat.FilesScanned, updated.FilesScanned)
+		s.Equal(1, updated.TrashByTypes[models.TrashTypeGlass])
+	case <-time.After(time.Second):
+		s.Fail("stats were not revised")
+	}
+}
+
+func (s *predictorTestSuite) TestRerun_AlreadyProcessing() {
+	prediction := models.Prediction{ID: uuid.New(), TrashScan: testdata.ScanURL}
+	s.predictor.scansInProcessing[prediction.TrashScan] = struct{}{}
+
+	err := s.predictor.Rerun(s.ctx, &prediction)
+
+	var conflictErr *errlocal.ErrConflict
+	s.ErrorAs(err, &conflictErr)
+	s.Equal(int32(0), s.predictor.limiter.Load())
+}
+
 func (s *predictorTestSuite) TestTryPutScanInProcessing() {
 	scanURL := "test/scan/url"
 
@@ -246,12 +304,13 @@ func (s *predictorTestSuite) TestNewPredictor() {
 		MaxPredictionsInProcessing: 5,
 	}
 
-	predictor := NewPredictor(logger, store, cfg)
+	predictor := NewPredictor(logger, store, cfg, stats.DefaultRules())
 
 	s.NotNil(predictor)
 	s.NotNil(predictor.log)
 	s.NotNil(predictor.client)
 	s.NotNil(predictor.store)
+	s.NotNil(predictor.rules.Scoring)
 	s.Equal(int32(5), predictor.limitRate)
 	s.Equal(int32(0), predictor.limiter.Load())
 	s.NotNil(predictor.scansInProcessing)
@@ -0,0 +1,95 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// DefaultPermissions maps roles to the permissions they have unless the
+// configuration overrides them.
+var DefaultPermissions = map[models.Role][]models.Permission{
+	models.RoleAdmin: models.Permissions,
+	models.RoleModerator: {
+		models.PermUsersRead,
+		models.PermPredictionsRead,
+		models.PermPredictionsModerate,
+		models.PermStatsRead,
+	},
+	models.RoleUser:      {},
+	models.RoleAnonymous: {},
+}
+
+// Policy resolves the permissions of a role.
+type Policy struct {
+	roles map[models.Role]map[models.Permission]struct{}
+}
+
+// NewPolicy builds the policy from DefaultPermissions and the overrides in cfg.
+// Unknown roles or permissions are rejected so typos do not silently revoke
+// access.
+func NewPolicy(cfg config.RBACConfig) (*Policy, error) {
+	policy := &Policy{roles: make(map[models.Role]map[models.Permission]struct{})}
+	for role, permissions := range DefaultPermissions {
+		policy.set(role, permissions)
+	}
+
+	for name, names := range cfg.Roles {
+		role := models.Role(name)
+		if !role.IsValid() {
+			return nil, fmt.Errorf("rbac: unknown role %q", name)
+		}
+
+		permissions := make([]models.Permission, 0, len(names))
+		for _, permName := range names {
+			permission := models.Permission(permName)
+			if !permission.IsValid() {
+				return nil, fmt.Errorf("rbac: unknown permission %q for role %q", permName, name)
+			}
+			permissions = append(permissions, permission)
+		}
+		policy.set(role, permissions)
+	}
+
+	return policy, nil
+}
+
+// DefaultPolicy returns the policy without configuration overrides.
+func DefaultPolicy() *Policy {
+	policy, _ := NewPolicy(config.RBACConfig{})
+	return policy
+}
+
+func (p *Policy) set(role models.Role, permissions []models.Permission) {
+	set := make(map[models.Permission]struct{}, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = struct{}{}
+	}
+	p.roles[role] = set
+}
+
+// Allows reports whether role has every one of permissions.
+func (p *Policy) Allows(role models.Role, permissions ...models.Permission) bool {
+	granted, ok := p.roles[role]
+	if !ok {
+		return false
+	}
+	for _, permission := range permissions {
+		if _, ok := granted[permission]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Permissions returns the sorted permissions of role.
+func (p *Policy) Permissions(role models.Role) []models.Permission {
+	permissions := make([]models.Permission, 0, len(p.roles[role]))
+	for permission := range p.roles[role] {
+		permissions = append(permissions, permission)
+	}
+	slices.Sort(permissions)
+	return permissions
+}
@@ -0,0 +1,44 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	policy := DefaultPolicy()
+
+	assert.True(t, policy.Allows(models.RoleAdmin, models.Permissions...))
+	assert.True(t, policy.Allows(models.RoleModerator, models.PermPredictionsModerate, models.PermUsersRead))
+	assert.False(t, policy.Allows(models.RoleModerator, models.PermUsersBan))
+	assert.False(t, policy.Allows(models.RoleModerator, models.PermUsersWrite))
+	assert.False(t, policy.Allows(models.RoleUser, models.PermUsersRead))
+	assert.False(t, policy.Allows(models.Role("unknown"), models.PermUsersRead))
+	assert.True(t, policy.Allows(models.RoleUser), "no permissions required")
+}
+
+func TestNewPolicy(t *testing.T) {
+	t.Run("override replaces role permissions", func(t *testing.T) {
+		policy, err := NewPolicy(config.RBACConfig{Roles: map[string][]string{
+			"moderator": {"stats:read"},
+		}})
+		require.NoError(t, err)
+
+		assert.Equal(t, []models.Permission{models.PermStatsRead}, policy.Permissions(models.RoleModerator))
+		assert.True(t, policy.Allows(models.RoleAdmin, models.PermUsersWrite), "other roles keep defaults")
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		_, err := NewPolicy(config.RBACConfig{Roles: map[string][]string{"superuser": {"users:read"}}})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown permission", func(t *testing.T) {
+		_, err := NewPolicy(config.RBACConfig{Roles: map[string][]string{"user": {"users:delete"}}})
+		require.Error(t, err)
+	})
+}
@@ -1,8 +1,9 @@
-// Package rbac provides role-based access control middleware.
+// Package rbac provides role- and permission-based access control middleware.
 package rbac
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/gorilla/mux"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
@@ -10,18 +11,36 @@ import (
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
-// RequireRole returns middleware that allows only users with specific roles.
-func RequireRole(writeError func(http.ResponseWriter, *http.Request, error), roles ...models.Role) mux.MiddlewareFunc {
-	allowed := make(map[models.Role]struct{}, len(roles))
-	for _, role := range roles {
-		allowed[role] = struct{}{}
+// RequireOrgRole returns middleware that allows only members with specific
+// roles in the organization the request is about. The membership must have
+// been put in the context before; requests without one are denied.
+func RequireOrgRole(
+	writeError func(http.ResponseWriter, *http.Request, error), roles ...models.OrgRole,
+) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			member := utils.GetOrgMember(r.Context())
+			if member == nil || !slices.Contains(roles, member.Role) {
+				writeError(w, r, errlocal.NewErrForbidden("access denied", "insufficient organization role", nil))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
 	}
+}
 
+// RequirePermission returns middleware that allows only users whose role has
+// all of the given permissions under policy.
+func RequirePermission(
+	writeError func(http.ResponseWriter, *http.Request, error), policy *Policy, permissions ...models.Permission,
+) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			user := utils.GetUser(r.Context())
-			if _, ok := allowed[user.Role]; !ok {
-				writeError(w, r, errlocal.NewErrForbidden("access denied", "insufficient role", nil))
+			if !policy.Allows(user.Role, permissions...) {
+				writeError(w, r, errlocal.NewErrForbidden("access denied", "missing permission",
+					map[string]any{"permissions": permissions}))
 				return
 			}
 
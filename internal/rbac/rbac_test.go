@@ -13,42 +13,42 @@ import (
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
-func TestRequireRole(t *testing.T) {
+func TestRequirePermission(t *testing.T) {
 	tests := []struct {
-		name          string
-		userRole      models.Role
-		allowedRoles  []models.Role
-		expectedCode  int
-		expectedError bool
+		name         string
+		userRole     models.Role
+		permissions  []models.Permission
+		expectedCode int
 	}{
 		{
-			name:          "admin allowed",
-			userRole:      models.RoleAdmin,
-			allowedRoles:  []models.Role{models.RoleAdmin},
-			expectedCode:  http.StatusOK,
-			expectedError: false,
+			name:         "admin allowed",
+			userRole:     models.RoleAdmin,
+			permissions:  []models.Permission{models.PermUsersWrite},
+			expectedCode: http.StatusOK,
 		},
 		{
-			name:          "user denied",
-			userRole:      models.RoleUser,
-			allowedRoles:  []models.Role{models.RoleAdmin},
-			expectedCode:  http.StatusForbidden,
-			expectedError: true,
+			name:         "moderator allowed to read",
+			userRole:     models.RoleModerator,
+			permissions:  []models.Permission{models.PermUsersRead},
+			expectedCode: http.StatusOK,
 		},
 		{
-			name:          "user allowed alongside admin",
-			userRole:      models.RoleUser,
-			allowedRoles:  []models.Role{models.RoleUser, models.RoleAdmin},
-			expectedCode:  http.StatusOK,
-			expectedError: false,
+			name:         "moderator denied to write",
+			userRole:     models.RoleModerator,
+			permissions:  []models.Permission{models.PermUsersRead, models.PermUsersWrite},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "user denied",
+			userRole:     models.RoleUser,
+			permissions:  []models.Permission{models.PermUsersRead},
+			expectedCode: http.StatusForbidden,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			writeErrCalled := false
 			writeErr := func(w http.ResponseWriter, r *http.Request, err error) {
-				writeErrCalled = true
 				var localErr errlocal.LocalError
 				if errors.As(err, &localErr) {
 					w.WriteHeader(localErr.Code())
@@ -57,17 +57,62 @@ func TestRequireRole(t *testing.T) {
 				w.WriteHeader(http.StatusInternalServerError)
 			}
 
-			user := &models.User{
-				ID:   uuid.New(),
-				Role: tt.userRole,
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req = req.WithContext(utils.SetUser(req.Context(), &models.User{ID: uuid.New(), Role: tt.userRole}))
+
+			mw := RequirePermission(writeErr, DefaultPolicy(), tt.permissions...)
+			h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedCode, rr.Code)
+		})
+	}
+}
+
+func TestRequireOrgRole(t *testing.T) {
+	tests := []struct {
+		name         string
+		member       *models.OrganizationMember
+		expectedCode int
+	}{
+		{
+			name:         "owner allowed",
+			member:       &models.OrganizationMember{Role: models.OrgRoleOwner},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "member denied",
+			member:       &models.OrganizationMember{Role: models.OrgRoleMember},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "no membership denied",
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeErr := func(w http.ResponseWriter, r *http.Request, err error) {
+				var localErr errlocal.LocalError
+				if errors.As(err, &localErr) {
+					w.WriteHeader(localErr.Code())
+					return
+				}
+				w.WriteHeader(http.StatusInternalServerError)
 			}
+
 			req := httptest.NewRequest(http.MethodGet, "/", nil)
-			req = req.WithContext(utils.SetUser(req.Context(), user))
+			if tt.member != nil {
+				req = req.WithContext(utils.SetOrgMember(req.Context(), tt.member))
+			}
 
-			mw := RequireRole(writeErr, tt.allowedRoles...)
-			nextCalled := false
+			mw := RequireOrgRole(writeErr, models.OrgRoleOwner)
 			h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				nextCalled = true
 				w.WriteHeader(http.StatusOK)
 			}))
 
@@ -75,8 +120,6 @@ func TestRequireRole(t *testing.T) {
 			h.ServeHTTP(rr, req)
 
 			assert.Equal(t, tt.expectedCode, rr.Code)
-			assert.Equal(t, tt.expectedError, writeErrCalled)
-			assert.Equal(t, !tt.expectedError, nextCalled)
 		})
 	}
 }
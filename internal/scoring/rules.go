@@ -0,0 +1,145 @@
+package scoring
+
+import (
+	"fmt"
+	"maps"
+	"math"
+
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// Built-in rules used unless the configuration overrides them.
+const (
+	DefaultPoints            = 10
+	DefaultConfidenceWeight  = 0
+	DefaultFailurePenalty    = 0
+	DefaultStreakBonusPerDay = 2
+	DefaultMaxStreakBonus    = 10
+)
+
+// Tier is the status users have from MinRating on.
+type Tier struct {
+	Status    models.UserStatus
+	MinRating int
+}
+
+// DefaultTiers are the status thresholds unless the configuration replaces
+// them.
+var DefaultTiers = []Tier{
+	{Status: models.UserStatusNewbie, MinRating: 0},
+	{Status: models.UserStatusEcoScout, MinRating: 100},
+	{Status: models.UserStatusGreenGuard, MinRating: 300},
+	{Status: models.UserStatusEcoWarrior, MinRating: 500},
+	{Status: models.UserStatusNatureHero, MinRating: 1000},
+	{Status: models.UserStatusEarthDefend, MinRating: 1500},
+	{Status: models.UserStatusEcoChampion, MinRating: 3000},
+	{Status: models.UserStatusPlanetGuard, MinRating: 5000},
+	{Status: models.UserStatusEcoLegend, MinRating: 10000},
+}
+
+// Rules decide how much rating scans earn and the status a rating gives.
+type Rules struct {
+	defaultPoints     int
+	points            map[string]int
+	confidenceWeight  float64
+	failurePenalty    int
+	streakBonusPerDay int
+	maxStreakBonus    int
+	tiers             []Tier
+}
+
+// NewRules builds the rules from the defaults overridden by cfg. Unknown
+// trash types and statuses are rejected, and tiers must start at zero and
+// rise strictly, so that every rating has exactly one status.
+func NewRules(cfg config.ScoringConfig) (*Rules, error) {
+	r := &Rules{
+		defaultPoints:     valueOr(cfg.DefaultPoints, DefaultPoints),
+		points:            maps.Clone(cfg.Points),
+		confidenceWeight:  valueOr(cfg.ConfidenceWeight, DefaultConfidenceWeight),
+		failurePenalty:    valueOr(cfg.FailurePenalty, DefaultFailurePenalty),
+		streakBonusPerDay: valueOr(cfg.StreakBonusPerDay, DefaultStreakBonusPerDay),
+		maxStreakBonus:    valueOr(cfg.MaxStreakBonus, DefaultMaxStreakBonus),
+		tiers:             DefaultTiers,
+	}
+	for trashType := range r.points {
+		if models.NewTrashType(trashType) == models.Undefined {
+			return nil, fmt.Errorf("scoring: unknown trash type %q in points", trashType)
+		}
+	}
+
+	if len(cfg.Tiers) == 0 {
+		return r, nil
+	}
+	r.tiers = make([]Tier, 0, len(cfg.Tiers))
+	for i, tierCfg := range cfg.Tiers {
+		tier := Tier{Status: models.UserStatus(tierCfg.Status), MinRating: tierCfg.MinRating}
+		if !tier.Status.Valid() {
+			return nil, fmt.Errorf("scoring: unknown status %q in tiers", tierCfg.Status)
+		}
+		if i == 0 && tier.MinRating != 0 {
+			return nil, fmt.Errorf("scoring: first tier %q must start at rating 0", tier.Status)
+		}
+		if i > 0 && tier.MinRating <= r.tiers[i-1].MinRating {
+			return nil, fmt.Errorf("scoring: tier %q must start above tier %q", tier.Status, r.tiers[i-1].Status)
+		}
+		r.tiers = append(r.tiers, tier)
+	}
+
+	return r, nil
+}
+
+// Default returns the built-in rules.
+func Default() *Rules {
+	rules, _ := NewRules(config.ScoringConfig{})
+	return rules
+}
+
+// Points is the rating a successful or verified prediction earns: the
+// points of its trash type, scaled down by a lack of confidence. A verified
+// label is certain.
+func (r *Rules) Points(prediction *models.Prediction) int {
+	points, ok := r.points[prediction.TrashType()]
+	if !ok {
+		points = r.defaultPoints
+	}
+
+	confidence := 1.0
+	if prediction.Verification == nil {
+		confidence = prediction.Confidence()
+	}
+	scale := 1 - r.confidenceWeight + r.confidenceWeight*confidence
+
+	return int(math.Round(float64(points) * scale))
+}
+
+// FailurePenalty is the rating a failed prediction costs.
+func (r *Rules) FailurePenalty() int {
+	return r.failurePenalty
+}
+
+// StreakBonus is the rating earned for reaching day streak of a streak: some
+// points for every day past the first, capped so that long streaks don't
+// outweigh the scans themselves.
+func (r *Rules) StreakBonus(streak int) int {
+	return min(max(streak-1, 0)*r.streakBonusPerDay, r.maxStreakBonus)
+}
+
+// Status returns the status of the highest tier rating reaches.
+func (r *Rules) Status(rating int) models.UserStatus {
+	status := r.tiers[0].Status
+	for _, tier := range r.tiers {
+		if rating < tier.MinRating {
+			break
+		}
+		status = tier.Status
+	}
+	return status
+}
+
+func valueOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
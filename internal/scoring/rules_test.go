@@ -0,0 +1,132 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestNewRules(t *testing.T) {
+	t.Run("overrides keep the other defaults", func(t *testing.T) {
+		rules, err := NewRules(config.ScoringConfig{
+			Points:         map[string]int{models.TrashTypeGlass: 15},
+			FailurePenalty: ptr(3),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, DefaultPoints, rules.defaultPoints)
+		assert.Equal(t, 15, rules.points[models.TrashTypeGlass])
+		assert.Equal(t, 3, rules.FailurePenalty())
+		assert.Equal(t, DefaultTiers, rules.tiers)
+	})
+
+	t.Run("custom tiers", func(t *testing.T) {
+		rules, err := NewRules(config.ScoringConfig{Tiers: []config.TierConfig{
+			{Status: string(models.UserStatusNewbie), MinRating: 0},
+			{Status: string(models.UserStatusEcoLegend), MinRating: 50},
+		}})
+		require.NoError(t, err)
+
+		assert.Equal(t, models.UserStatusNewbie, rules.Status(49))
+		assert.Equal(t, models.UserStatusEcoLegend, rules.Status(50))
+	})
+
+	tests := []struct {
+		name string
+		cfg  config.ScoringConfig
+	}{
+		{"unknown trash type", config.ScoringConfig{Points: map[string]int{"wood": 1}}},
+		{"unknown status", config.ScoringConfig{Tiers: []config.TierConfig{{Status: "wizard"}}}},
+		{"first tier above zero", config.ScoringConfig{Tiers: []config.TierConfig{
+			{Status: string(models.UserStatusNewbie), MinRating: 10},
+		}}},
+		{"tiers not rising", config.ScoringConfig{Tiers: []config.TierConfig{
+			{Status: string(models.UserStatusNewbie), MinRating: 0},
+			{Status: string(models.UserStatusEcoScout), MinRating: 100},
+			{Status: string(models.UserStatusGreenGuard), MinRating: 100},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRules(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRulesPoints(t *testing.T) {
+	prediction := &models.Prediction{Result: models.PredictionResult{
+		models.TrashTypeGlass: 0.6, models.TrashTypePlastic: 0.4,
+	}}
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, DefaultPoints, Default().Points(prediction))
+	})
+
+	t.Run("per type", func(t *testing.T) {
+		rules, err := NewRules(config.ScoringConfig{Points: map[string]int{
+			models.TrashTypeGlass: 20, models.TrashTypePlastic: 5,
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, 20, rules.Points(prediction))
+	})
+
+	t.Run("confidence weighting", func(t *testing.T) {
+		rules, err := NewRules(config.ScoringConfig{ConfidenceWeight: ptr(0.5)})
+		require.NoError(t, err)
+		assert.Equal(t, 8, rules.Points(prediction))
+
+		verified := *prediction
+		verified.Verification = &models.PredictionVerification{Label: models.TrashTypeMetal}
+		assert.Equal(t, DefaultPoints, rules.Points(&verified))
+	})
+}
+
+func TestRulesStreakBonus(t *testing.T) {
+	rules := Default()
+	assert.Equal(t, 0, rules.StreakBonus(1))
+	assert.Equal(t, DefaultStreakBonusPerDay, rules.StreakBonus(2))
+	assert.Equal(t, 4*DefaultStreakBonusPerDay, rules.StreakBonus(5))
+	assert.Equal(t, DefaultMaxStreakBonus, rules.StreakBonus(100))
+}
+
+func TestRulesStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		rating   int
+		expected models.UserStatus
+	}{
+		{"newbie - rating 0", 0, models.UserStatusNewbie},
+		{"newbie - rating 99", 99, models.UserStatusNewbie},
+		{"eco_scout - rating 100", 100, models.UserStatusEcoScout},
+		{"eco_scout - rating 299", 299, models.UserStatusEcoScout},
+		{"green_guard - rating 300", 300, models.UserStatusGreenGuard},
+		{"green_guard - rating 499", 499, models.UserStatusGreenGuard},
+		{"eco_warrior - rating 500", 500, models.UserStatusEcoWarrior},
+		{"eco_warrior - rating 999", 999, models.UserStatusEcoWarrior},
+		{"nature_hero - rating 1000", 1000, models.UserStatusNatureHero},
+		{"nature_hero - rating 1499", 1499, models.UserStatusNatureHero},
+		{"earth_defender - rating 1500", 1500, models.UserStatusEarthDefend},
+		{"earth_defender - rating 2999", 2999, models.UserStatusEarthDefend},
+		{"eco_champion - rating 3000", 3000, models.UserStatusEcoChampion},
+		{"eco_champion - rating 4999", 4999, models.UserStatusEcoChampion},
+		{"planet_guardian - rating 5000", 5000, models.UserStatusPlanetGuard},
+		{"planet_guardian - rating 9999", 9999, models.UserStatusPlanetGuard},
+		{"eco_legend - rating 10000", 10000, models.UserStatusEcoLegend},
+		{"eco_legend - rating 100000", 100000, models.UserStatusEcoLegend},
+	}
+
+	rules := Default()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rules.Status(tt.rating))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+)
+
+// Recompute rebuilds the stats of the user from their predictions with rules,
+// for after the rules changed. Predictions are replayed in the order they were
+// made, each counted on the day it was made; those still processing are
+// counted once they complete. Achievements unlocked before are kept.
+//
+// The recomputed stats are recorded as the state of today in the history of
+// the user. Earlier snapshots, the periodic leaderboards and the daily
+// activity are left as they are: they record what was earned at the time.
+//
+// The stats stay locked until the transaction of store ends, so predictions
+// completing meanwhile are counted after the recompute instead of being lost.
+func Recompute(ctx context.Context, store store.Store, rules Rules, userID uuid.UUID, now time.Time) error {
+	if err := store.LockStats(ctx, userID); err != nil {
+		return err
+	}
+	user, err := store.GetUser(ctx, userID, true)
+	if err != nil {
+		return err
+	}
+
+	loc := user.Location()
+	stat := &models.Stat{ID: user.Stat.ID, Achievements: user.Stat.Achievements}
+	initStats(stat)
+	if err := store.WalkUserPredictions(ctx, userID, func(prediction models.Prediction) error {
+		if prediction.Status == models.PredictionProcessingStatus {
+			return nil
+		}
+		countScanAt(stat, rules, prediction.CreatedAt, loc)
+		scorePrediction(stat, rules, &prediction, 1)
+		stat.Rating = max(stat.Rating, 0)
+		return nil
+	}); err != nil {
+		return err
+	}
+	stat.Status = rules.Scoring.Status(stat.Rating)
+	rules.Achievements.Unlock(stat, now)
+
+	if err := store.UpdateStats(ctx, stat); err != nil {
+		return err
+	}
+	return store.SnapshotStats(ctx, stat, now.In(loc))
+}
+
+// RecomputeAll recomputes the stats of every user, each in a transaction of
+// its own, and returns the number of users recomputed. Users deleted in the
+// meantime are skipped. Any other failure stops the run; the users done
+// before it keep their new stats.
+func RecomputeAll(ctx context.Context, s store.Store, rules Rules, now time.Time) (int, error) {
+	var count int
+	err := s.WalkStatsUsers(ctx, func(userID uuid.UUID) error {
+		err := s.ExecTx(ctx, func(tx store.Store) error {
+			return Recompute(ctx, tx, rules, userID, now)
+		})
+		var notFound *errlocal.ErrNotFound
+		switch {
+		case errors.As(err, &notFound):
+			return nil
+		case err != nil:
+			return err
+		}
+		count++
+		return nil
+	})
+
+	return count, err
+}
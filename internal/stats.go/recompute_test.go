@@ -0,0 +1,171 @@
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/scoring"
+	"github.com/trashscanner/trashscanner_api/internal/store"
+	"github.com/trashscanner/trashscanner_api/internal/store/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+)
+
+// expectPredictions makes the store walk the predictions of userID.
+func expectPredictions(ms *mocks.Store, userID uuid.UUID, predictions ...models.Prediction) {
+	ms.EXPECT().WalkUserPredictions(mock.Anything, userID, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ uuid.UUID, fn func(models.Prediction) error) error {
+			for _, prediction := range predictions {
+				if err := fn(prediction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Once()
+}
+
+func TestRecompute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 12, 12, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 9, 0, 0, 0, time.UTC) }
+	newUser := func() models.User {
+		user := testdata.User1
+		user.Timezone = "UTC"
+		user.Stat = &models.Stat{
+			ID:           uuid.New(),
+			Status:       models.UserStatusEcoLegend,
+			Rating:       20000,
+			FilesScanned: 99,
+			TrashByTypes: map[string]int{models.TrashTypeMetal: 1, models.TrashTypeGlass: 1},
+			Achievements: []models.Achievement{{ID: "first_scan", UnlockedAt: day(1)}},
+		}
+		return user
+	}
+	completed := func(at time.Time, result models.PredictionResult) models.Prediction {
+		return models.Prediction{
+			UserID:    testdata.User1ID,
+			Status:    models.PredictionCompletedStatus,
+			Result:    result,
+			CreatedAt: at,
+		}
+	}
+	failed := models.Prediction{
+		UserID:    testdata.User1ID,
+		Status:    models.PredictionFailedStatus,
+		Error:     "predictor unavailable",
+		CreatedAt: day(11),
+	}
+	processing := models.Prediction{UserID: testdata.User1ID, Status: models.PredictionProcessingStatus}
+
+	t.Run("replays predictions with the rules", func(t *testing.T) {
+		user := newUser()
+		scoringRules, err := scoring.NewRules(config.ScoringConfig{
+			Points: map[string]int{models.TrashTypeGlass: 30},
+		})
+		require.NoError(t, err)
+		rules := DefaultRules()
+		rules.Scoring = scoringRules
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		expectPredictions(ms, user.ID,
+			completed(day(10), models.PredictionResult{models.TrashTypeGlass: 0.7, models.TrashTypeMetal: 0.3}),
+			completed(day(10), models.PredictionResult{models.TrashTypePlastic: 0.9}),
+			failed,
+			processing,
+		)
+		ms.EXPECT().UpdateStats(mock.Anything, mock.AnythingOfType("*models.Stat")).
+			Run(func(_ context.Context, stat *models.Stat) {
+				assert.Equal(t, user.Stat.ID, stat.ID)
+				assert.Equal(t, 3, stat.FilesScanned)
+				// 30 for glass, 10 for plastic and the bonus for the second day.
+				assert.Equal(t, 40+scoring.DefaultStreakBonusPerDay, stat.Rating)
+				assert.Equal(t, models.UserStatusNewbie, stat.Status)
+				assert.Equal(t, map[string]int{models.TrashTypeGlass: 1, models.TrashTypePlastic: 1}, stat.TrashByTypes)
+				assert.Equal(t, 2, stat.CurrentStreak)
+				assert.Equal(t, day(11), stat.LastScannedAt)
+				require.NotEmpty(t, stat.Achievements)
+				assert.Equal(t, day(1), stat.Achievements[0].UnlockedAt)
+			}).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		require.NoError(t, Recompute(ctx, ms, rules, user.ID, now))
+	})
+
+	t.Run("failure penalty keeps the rating at zero", func(t *testing.T) {
+		user := newUser()
+		penalty := 5
+		scoringRules, err := scoring.NewRules(config.ScoringConfig{FailurePenalty: &penalty})
+		require.NoError(t, err)
+		rules := DefaultRules()
+		rules.Scoring = scoringRules
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		expectPredictions(ms, user.ID, failed,
+			completed(day(11), models.PredictionResult{models.TrashTypeGlass: 0.9}))
+		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			return stat.Rating == 10 && stat.FilesScanned == 2
+		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		require.NoError(t, Recompute(ctx, ms, rules, user.ID, now))
+	})
+
+	t.Run("stats are locked before they are read", func(t *testing.T) {
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, testdata.User1ID).
+			Return(errlocal.NewErrInternal("failed to lock user stats", "", nil)).Once()
+
+		err := Recompute(ctx, ms, DefaultRules(), testdata.User1ID, now)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestRecomputeAll(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	deletedID := uuid.New()
+
+	user := testdata.User1
+	stat := *user.Stat
+	user.Stat = &stat
+
+	ms := mocks.NewStore(t)
+	ms.EXPECT().WalkStatsUsers(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(uuid.UUID) error) error {
+			for _, userID := range []uuid.UUID{deletedID, user.ID} {
+				if err := fn(userID); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Once()
+	ms.EXPECT().ExecTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(store.Store) error) error {
+			return fn(ms)
+		}).Twice()
+	ms.EXPECT().LockStats(mock.Anything, deletedID).Return(nil).Once()
+	ms.EXPECT().GetUser(mock.Anything, deletedID, true).
+		Return(nil, errlocal.NewErrNotFound("user not found", "", nil)).Once()
+	ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+	ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+	expectPredictions(ms, user.ID)
+	ms.EXPECT().UpdateStats(mock.Anything, mock.Anything).Return(nil).Once()
+	ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	count, err := RecomputeAll(ctx, ms, DefaultRules(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
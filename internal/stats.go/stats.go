@@ -4,64 +4,243 @@ import (
 	"context"
 	"time"
 
+	"github.com/trashscanner/trashscanner_api/internal/achievements"
+	"github.com/trashscanner/trashscanner_api/internal/config"
+	"github.com/trashscanner/trashscanner_api/internal/impact"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/scoring"
 	"github.com/trashscanner/trashscanner_api/internal/store"
 )
 
+// minWeight is the weight in kg below which the weight of a type is taken to
+// be zero.
+const minWeight = 1e-6
+
+// Rules are everything stats are computed with.
+type Rules struct {
+	Scoring      *scoring.Rules
+	Achievements *achievements.Catalog
+	Weights      *impact.Table
+}
+
+// NewRules builds the rules from the scoring, achievements and impact
+// sections of cfg.
+func NewRules(cfg config.Config) (Rules, error) {
+	scoringRules, err := scoring.NewRules(cfg.Scoring)
+	if err != nil {
+		return Rules{}, err
+	}
+	catalog, err := achievements.NewCatalog(cfg.Achievements)
+	if err != nil {
+		return Rules{}, err
+	}
+	weights, err := impact.NewTable(cfg.Impact)
+	if err != nil {
+		return Rules{}, err
+	}
+
+	return Rules{Scoring: scoringRules, Achievements: catalog, Weights: weights}, nil
+}
+
+// DefaultRules returns the built-in rules.
+func DefaultRules() Rules {
+	return Rules{
+		Scoring:      scoring.Default(),
+		Achievements: achievements.DefaultCatalog(),
+		Weights:      impact.DefaultTable(),
+	}
+}
+
+// UpdateStats counts newPrediction in the stats of its owner. It runs in the
+// transaction of store, which holds the stats locked until it ends.
 func UpdateStats(
 	ctx context.Context,
 	store store.Store,
+	rules Rules,
 	newPrediction *models.Prediction,
 ) error {
+	if err := store.LockStats(ctx, newPrediction.UserID); err != nil {
+		return err
+	}
 	user, err := store.GetUser(ctx, newPrediction.UserID, true)
 	if err != nil {
 		return err
 	}
 
 	currentStats := user.Stat
-	if currentStats.TrashByTypes == nil {
-		currentStats.TrashByTypes = make(map[string]int)
+	initStats(currentStats)
+
+	now := time.Now()
+	rating := currentStats.Rating
+	if err := countScan(ctx, store, rules, user, now); err != nil {
+		return err
 	}
+	scorePrediction(currentStats, rules, newPrediction, 1)
+	currentStats.Rating = max(currentStats.Rating, 0)
+	currentStats.Status = rules.Scoring.Status(currentStats.Rating)
+	rules.Achievements.Unlock(currentStats, now)
 
-	currentStats.FilesScanned++
-	currentStats.LastScannedAt = time.Now()
+	return saveStats(ctx, store, user, currentStats.Rating-rating, now)
+}
 
-	if newPrediction.Error == "" {
-		currentStats.Rating += 10
+// ReviseStats replaces what before contributed to the stats of its owner with
+// what after contributes, for a prediction that was re-run or verified. A
+// prediction that was still processing hasn't been counted yet, so it is
+// counted as a new scan. Achievements unlocked before are kept. Like
+// UpdateStats, it holds the stats locked until the transaction ends.
+func ReviseStats(
+	ctx context.Context,
+	store store.Store,
+	rules Rules,
+	before, after *models.Prediction,
+) error {
+	if err := store.LockStats(ctx, after.UserID); err != nil {
+		return err
+	}
+	user, err := store.GetUser(ctx, after.UserID, true)
+	if err != nil {
+		return err
+	}
 
-		for k := range newPrediction.Result {
-			currentStats.TrashByTypes[k] += 1
+	currentStats := user.Stat
+	initStats(currentStats)
+
+	now := time.Now()
+	rating := currentStats.Rating
+	if before.Status == models.PredictionProcessingStatus {
+		if err := countScan(ctx, store, rules, user, now); err != nil {
+			return err
 		}
-		currentStats.Status = calculateUserStatus(currentStats)
+	} else {
+		scorePrediction(currentStats, rules, before, -1)
 	}
+	scorePrediction(currentStats, rules, after, 1)
+	currentStats.Rating = max(currentStats.Rating, 0)
+	currentStats.Status = rules.Scoring.Status(currentStats.Rating)
+	rules.Achievements.Unlock(currentStats, now)
 
-	return store.UpdateStats(ctx, currentStats)
+	return saveStats(ctx, store, user, currentStats.Rating-rating, now)
 }
 
-func calculateUserStatus(stat *models.Stat) models.UserStatus {
-	if stat.Rating >= 10000 {
-		return models.UserStatusEcoLegend
+// saveStats stores the stats of user, records them as the state of today in
+// their history and counts the rating change towards the periodic leaderboards
+// of now. A revision counts in the current period, not in the one of the
+// revised scan.
+func saveStats(
+	ctx context.Context,
+	store store.Store,
+	user *models.User,
+	ratingDelta int,
+	now time.Time,
+) error {
+	if err := store.UpdateStats(ctx, user.Stat); err != nil {
+		return err
+	}
+	if err := store.SnapshotStats(ctx, user.Stat, now.In(user.Location())); err != nil {
+		return err
+	}
+	if ratingDelta == 0 {
+		return nil
 	}
-	if stat.Rating >= 5000 {
-		return models.UserStatusPlanetGuard
+	return store.AddRatingDelta(ctx, user.ID, ratingDelta, now)
+}
+
+func initStats(stat *models.Stat) {
+	if stat.TrashByTypes == nil {
+		stat.TrashByTypes = make(map[string]int)
+	}
+	if stat.WeightByTypes == nil {
+		stat.WeightByTypes = make(map[string]float64)
+	}
+}
+
+// countScan counts a new scan of user at now: in the scan total, the daily
+// activity and the streak. The first scan of each day that extends a streak
+// earns the streak bonus.
+func countScan(ctx context.Context, store store.Store, rules Rules, user *models.User, now time.Time) error {
+	loc := user.Location()
+	countScanAt(user.Stat, rules, now, loc)
+
+	return store.RecordActivity(ctx, user.ID, now.In(loc))
+}
+
+// countScanAt counts a scan at now in the scan total and the streak of stat,
+// with days counted in loc.
+func countScanAt(stat *models.Stat, rules Rules, now time.Time, loc *time.Location) {
+	stat.FilesScanned++
+	if countScanDay(stat, now, loc) {
+		stat.Rating += rules.Scoring.StreakBonus(stat.CurrentStreak)
 	}
-	if stat.Rating >= 3000 {
-		return models.UserStatusEcoChampion
+}
+
+// countScanDay records a scan at now in LastScannedAt and in the streak of
+// consecutive days with scans, with days counted in loc. It reports whether
+// this is the first scan of the day.
+func countScanDay(stat *models.Stat, now time.Time, loc *time.Location) bool {
+	today := models.Day(now.In(loc))
+	lastDay := models.Day(stat.LastScannedAt.In(loc))
+	firstToday := true
+
+	switch {
+	case stat.LastScannedAt.IsZero():
+		stat.CurrentStreak = 1
+	case lastDay.Equal(today):
+		stat.CurrentStreak = max(stat.CurrentStreak, 1)
+		firstToday = false
+	case lastDay.Equal(today.AddDate(0, 0, -1)):
+		stat.CurrentStreak++
+	default:
+		stat.CurrentStreak = 1
 	}
-	if stat.Rating >= 1500 {
-		return models.UserStatusEarthDefend
+	stat.LongestStreak = max(stat.LongestStreak, stat.CurrentStreak)
+	stat.LastScannedAt = now
+
+	return firstToday
+}
+
+// scored reports whether a finished prediction earns rating: it succeeded or
+// staff identified the trash on the scan.
+func scored(prediction *models.Prediction) bool {
+	return prediction.Error == "" || prediction.Verification != nil
+}
+
+// scorePrediction adds sign times what a finished prediction contributes to
+// stat: the points, trash type and weight of a scored prediction, or the
+// penalty of a failed one.
+func scorePrediction(stat *models.Stat, rules Rules, prediction *models.Prediction, sign int) {
+	if !scored(prediction) {
+		stat.Rating -= sign * rules.Scoring.FailurePenalty()
+		return
 	}
-	if stat.Rating >= 1000 {
-		return models.UserStatusNatureHero
+
+	stat.Rating += sign * rules.Scoring.Points(prediction)
+	if trashType := prediction.TrashType(); trashType != "" {
+		addTrashType(stat, trashType, sign)
 	}
-	if stat.Rating >= 500 {
-		return models.UserStatusEcoWarrior
+	addWeight(stat, rules.Weights, prediction, sign)
+}
+
+// addWeight adds sign times the weight of prediction to the weight of its
+// type, and recomputes TotalWeight as the sum of the types so that rounding
+// errors of revisions don't add up.
+func addWeight(stat *models.Stat, weights *impact.Table, prediction *models.Prediction, sign int) {
+	trashType, weight := weights.Weight(prediction)
+	if trashType != "" {
+		stat.WeightByTypes[trashType] += float64(sign) * weight
+		if stat.WeightByTypes[trashType] <= minWeight {
+			delete(stat.WeightByTypes, trashType)
+		}
 	}
-	if stat.Rating >= 300 {
-		return models.UserStatusGreenGuard
+
+	stat.TotalWeight = 0
+	for _, typeWeight := range stat.WeightByTypes {
+		stat.TotalWeight += typeWeight
 	}
-	if stat.Rating >= 100 {
-		return models.UserStatusEcoScout
+}
+
+func addTrashType(stat *models.Stat, trashType string, sign int) {
+	stat.TrashByTypes[trashType] += sign
+	if stat.TrashByTypes[trashType] <= 0 {
+		delete(stat.TrashByTypes, trashType)
 	}
-	return models.UserStatusNewbie
 }
@@ -8,11 +8,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/impact"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/scoring"
 	"github.com/trashscanner/trashscanner_api/internal/store/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/testdata"
 )
 
+var rules = DefaultRules()
+
 func TestUpdateStats(t *testing.T) {
 	ctx := context.Background()
 	t.Run("success", func(t *testing.T) {
@@ -21,7 +25,9 @@ func TestUpdateStats(t *testing.T) {
 		prediction := testdata.PredictionCompleted
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
 
 		ms.EXPECT().UpdateStats(mock.Anything, mock.AnythingOfType("*models.Stat")).
 			Run(func(_ context.Context, updatedStat *models.Stat) {
@@ -31,8 +37,10 @@ func TestUpdateStats(t *testing.T) {
 				assert.Equal(t, currentStats.Status, updatedStat.Status)
 				assert.Equal(t, currentStats.Rating+10, updatedStat.Rating)
 			}).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.NoError(t, err)
 	})
 
@@ -42,12 +50,16 @@ func TestUpdateStats(t *testing.T) {
 		prediction := testdata.PredictionCompleted
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
 		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
 			return stat.TrashByTypes != nil && stat.TrashByTypes["metal"] == 1
 		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.NoError(t, err)
 	})
 
@@ -59,14 +71,17 @@ func TestUpdateStats(t *testing.T) {
 		prediction.Result = nil
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
 		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
 			return stat.Rating == currentStats.Rating && // Рейтинг не изменился
 				stat.FilesScanned == currentStats.FilesScanned+1 &&
 				!stat.LastScannedAt.IsZero()
 		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.NoError(t, err)
 	})
 
@@ -74,10 +89,11 @@ func TestUpdateStats(t *testing.T) {
 		prediction := testdata.PredictionCompleted
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, prediction.UserID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, prediction.UserID, true).
 			Return(nil, errlocal.NewErrNotFound("user not found", "", nil))
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.Error(t, err)
 		var notFoundErr *errlocal.ErrNotFound
 		assert.ErrorAs(t, err, &notFoundErr)
@@ -88,17 +104,37 @@ func TestUpdateStats(t *testing.T) {
 		prediction := testdata.PredictionCompleted
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
 		ms.EXPECT().UpdateStats(mock.Anything, mock.Anything).
 			Return(errlocal.NewErrInternal("db error", "", nil))
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.Error(t, err)
 		var internalErr *errlocal.ErrInternal
 		assert.ErrorAs(t, err, &internalErr)
 	})
 
-	t.Run("multiple trash types", func(t *testing.T) {
+	t.Run("add rating delta error", func(t *testing.T) {
+		user := testdata.User1
+		prediction := testdata.PredictionCompleted
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).
+			Return(errlocal.NewErrInternal("db error", "", nil)).Once()
+
+		err := UpdateStats(ctx, ms, rules, &prediction)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+
+	t.Run("only the winning type is counted", func(t *testing.T) {
 		user := testdata.User1
 		user.Stat.TrashByTypes = map[string]int{}
 		prediction := testdata.PredictionCompleted
@@ -110,16 +146,16 @@ func TestUpdateStats(t *testing.T) {
 		}
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
 		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
-			return stat.TrashByTypes["plastic"] == 1 &&
-				stat.TrashByTypes["metal"] == 1 &&
-				stat.TrashByTypes["cardboard"] == 1 &&
-				stat.TrashByTypes["glass"] == 1 &&
-				len(stat.TrashByTypes) == 4
+			return stat.TrashByTypes["plastic"] == 1 && len(stat.TrashByTypes) == 1
 		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.NoError(t, err)
 	})
 
@@ -130,64 +166,334 @@ func TestUpdateStats(t *testing.T) {
 		prediction := testdata.PredictionCompleted
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
 		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
 			return stat.Rating == 105 && stat.Status == models.UserStatusEcoScout
 		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.NoError(t, err)
 	})
 
 	t.Run("last scanned at updated", func(t *testing.T) {
 		user := testdata.User1
+		stat := *user.Stat
+		user.Stat = &stat
 		oldTime := time.Now().Add(-24 * time.Hour)
 		user.Stat.LastScannedAt = oldTime
+		user.Stat.CurrentStreak = 1
 		prediction := testdata.PredictionCompleted
 
 		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil)
 		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil)
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
 		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
 			return stat.LastScannedAt.After(oldTime) &&
-				time.Since(stat.LastScannedAt) < time.Second
+				time.Since(stat.LastScannedAt) < time.Second &&
+				stat.CurrentStreak == 2
 		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10+scoring.DefaultStreakBonusPerDay, mock.Anything).
+			Return(nil).Once()
 
-		err := UpdateStats(ctx, ms, &prediction)
+		err := UpdateStats(ctx, ms, rules, &prediction)
 		assert.NoError(t, err)
 	})
 }
 
-func TestCalculateUserStatus(t *testing.T) {
+func TestUpdateStats_Achievements(t *testing.T) {
+	ctx := context.Background()
+	user := testdata.User1
+	unlockedAt := time.Now().Add(-time.Hour)
+	user.Stat = &models.Stat{
+		ID:           user.ID,
+		FilesScanned: 9,
+		TrashByTypes: map[string]int{models.TrashTypeMetal: 1},
+		Achievements: []models.Achievement{{ID: "first_scan", UnlockedAt: unlockedAt}},
+	}
+	prediction := testdata.PredictionCompleted
+
+	ms := mocks.NewStore(t)
+	ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+	ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+	ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+	ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+		return len(stat.Achievements) == 2 &&
+			stat.Achievements[0].UnlockedAt.Equal(unlockedAt) &&
+			stat.Achievements[1].ID == "scans_10"
+	})).Return(nil).Once()
+	ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, UpdateStats(ctx, ms, rules, &prediction))
+}
+
+func TestUpdateStats_Weight(t *testing.T) {
+	ctx := context.Background()
+	newUser := func() models.User {
+		user := testdata.User1
+		user.Stat = &models.Stat{
+			ID:            user.ID,
+			TotalWeight:   1,
+			WeightByTypes: map[string]float64{models.TrashTypeGlass: 1},
+		}
+		return user
+	}
+
+	t.Run("average weight of the type", func(t *testing.T) {
+		user := newUser()
+		prediction := testdata.PredictionCompleted
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.AnythingOfType("*models.Stat")).
+			Run(func(_ context.Context, stat *models.Stat) {
+				metal := impact.DefaultWeights[models.TrashTypeMetal]
+				assert.Equal(t, metal, stat.WeightByTypes[models.TrashTypeMetal])
+				assert.InDelta(t, 1+metal, stat.TotalWeight, 1e-9)
+			}).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, UpdateStats(ctx, ms, rules, &prediction))
+	})
+
+	t.Run("weight entered by the owner", func(t *testing.T) {
+		user := newUser()
+		prediction := testdata.PredictionCompleted
+		weight := 0.5
+		prediction.Weight = &weight
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			return stat.WeightByTypes[models.TrashTypeMetal] == 0.5 && stat.TotalWeight == 1.5
+		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, UpdateStats(ctx, ms, rules, &prediction))
+	})
+
+	t.Run("failed prediction weighs nothing", func(t *testing.T) {
+		user := newUser()
+		prediction := testdata.PredictionCompleted
+		prediction.Error, prediction.Result = "prediction failed", nil
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			return stat.TotalWeight == 1 && len(stat.WeightByTypes) == 1
+		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, UpdateStats(ctx, ms, rules, &prediction))
+	})
+
+	t.Run("verified label moves the weight", func(t *testing.T) {
+		user := newUser()
+		weight := 1.0
+		before := models.Prediction{
+			UserID: user.ID,
+			Status: models.PredictionCompletedStatus,
+			Result: models.PredictionResult{models.TrashTypeGlass: 0.6},
+			Weight: &weight,
+		}
+		after := before
+		after.Verification = &models.PredictionVerification{Label: models.TrashTypePlastic}
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			_, glass := stat.WeightByTypes[models.TrashTypeGlass]
+			return !glass && stat.WeightByTypes[models.TrashTypePlastic] == 1 && stat.TotalWeight == 1
+		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, ReviseStats(ctx, ms, rules, &before, &after))
+	})
+}
+
+func TestCountScanDay(t *testing.T) {
+	now := time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)
+
 	tests := []struct {
-		name     string
-		rating   int
-		expected models.UserStatus
+		name            string
+		lastScannedAt   time.Time
+		current         int
+		longest         int
+		expectedCurrent int
+		expectedLongest int
+		firstToday      bool
 	}{
-		{"newbie - rating 0", 0, models.UserStatusNewbie},
-		{"newbie - rating 99", 99, models.UserStatusNewbie},
-		{"eco_scout - rating 100", 100, models.UserStatusEcoScout},
-		{"eco_scout - rating 299", 299, models.UserStatusEcoScout},
-		{"green_guard - rating 300", 300, models.UserStatusGreenGuard},
-		{"green_guard - rating 499", 499, models.UserStatusGreenGuard},
-		{"eco_warrior - rating 500", 500, models.UserStatusEcoWarrior},
-		{"eco_warrior - rating 999", 999, models.UserStatusEcoWarrior},
-		{"nature_hero - rating 1000", 1000, models.UserStatusNatureHero},
-		{"nature_hero - rating 1499", 1499, models.UserStatusNatureHero},
-		{"earth_defender - rating 1500", 1500, models.UserStatusEarthDefend},
-		{"earth_defender - rating 2999", 2999, models.UserStatusEarthDefend},
-		{"eco_champion - rating 3000", 3000, models.UserStatusEcoChampion},
-		{"eco_champion - rating 4999", 4999, models.UserStatusEcoChampion},
-		{"planet_guardian - rating 5000", 5000, models.UserStatusPlanetGuard},
-		{"planet_guardian - rating 9999", 9999, models.UserStatusPlanetGuard},
-		{"eco_legend - rating 10000", 10000, models.UserStatusEcoLegend},
-		{"eco_legend - rating 100000", 100000, models.UserStatusEcoLegend},
+		{"first scan", time.Time{}, 0, 0, 1, 1, true},
+		{"same day", now.Add(-time.Hour), 3, 5, 3, 5, false},
+		{"next day", now.Add(-12 * time.Hour), 3, 3, 4, 4, true},
+		{"day skipped", now.AddDate(0, 0, -2), 7, 7, 1, 7, true},
+		{"same day before streaks were counted", now.Add(-time.Hour), 0, 0, 1, 1, false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			stat := &models.Stat{Rating: tt.rating}
-			result := calculateUserStatus(stat)
-			assert.Equal(t, tt.expected, result)
+			stat := &models.Stat{LastScannedAt: tt.lastScannedAt, CurrentStreak: tt.current, LongestStreak: tt.longest}
+
+			firstToday := countScanDay(stat, now, time.UTC)
+
+			assert.Equal(t, tt.expectedCurrent, stat.CurrentStreak)
+			assert.Equal(t, tt.expectedLongest, stat.LongestStreak)
+			assert.Equal(t, tt.firstToday, firstToday)
+			assert.Equal(t, now, stat.LastScannedAt)
 		})
 	}
+
+	t.Run("days are counted in the user's time zone", func(t *testing.T) {
+		loc, err := time.LoadLocation("Asia/Tokyo")
+		assert.NoError(t, err)
+		// 23:00 UTC on March 9 is already March 10 in Tokyo, the same day as now.
+		stat := &models.Stat{LastScannedAt: time.Date(2026, 3, 9, 23, 0, 0, 0, time.UTC), CurrentStreak: 2}
+
+		assert.False(t, countScanDay(stat, now, loc))
+		assert.Equal(t, 2, stat.CurrentStreak)
+
+		stat = &models.Stat{LastScannedAt: time.Date(2026, 3, 9, 23, 0, 0, 0, time.UTC), CurrentStreak: 2}
+		assert.True(t, countScanDay(stat, now, time.UTC))
+		assert.Equal(t, 3, stat.CurrentStreak)
+	})
+}
+
+func TestUpdateStats_Activity(t *testing.T) {
+	ctx := context.Background()
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	user := testdata.User1
+	user.Timezone = loc.String()
+	user.Stat = &models.Stat{ID: user.ID, TrashByTypes: map[string]int{}}
+	prediction := testdata.PredictionCompleted
+
+	t.Run("scan and snapshot are recorded in the user's time zone", func(t *testing.T) {
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.MatchedBy(func(day time.Time) bool {
+			return day.Location().String() == loc.String()
+		})).Return(nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, user.Stat, mock.MatchedBy(func(day time.Time) bool {
+			return day.Location().String() == loc.String()
+		})).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, UpdateStats(ctx, ms, rules, &prediction))
+	})
+
+	t.Run("snapshot error", func(t *testing.T) {
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).
+			Return(errlocal.NewErrInternal("db error", "", nil)).Once()
+
+		err := UpdateStats(ctx, ms, rules, &prediction)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+
+	t.Run("record activity error", func(t *testing.T) {
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).
+			Return(errlocal.NewErrInternal("db error", "", nil)).Once()
+
+		err := UpdateStats(ctx, ms, rules, &prediction)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestReviseStats(t *testing.T) {
+	ctx := context.Background()
+	newUser := func() models.User {
+		user := testdata.User1
+		user.Stat = &models.Stat{
+			ID:           user.ID,
+			Status:       models.UserStatusEcoScout,
+			Rating:       100,
+			FilesScanned: 10,
+			TrashByTypes: map[string]int{models.TrashTypeMetal: 2},
+		}
+		return user
+	}
+	completed := models.Prediction{
+		UserID: testdata.User1ID,
+		Status: models.PredictionCompletedStatus,
+		Result: models.PredictionResult{models.TrashTypeMetal: 0.9},
+	}
+
+	t.Run("verified label replaces the predicted type", func(t *testing.T) {
+		user := newUser()
+		verified := completed
+		verified.Verification = &models.PredictionVerification{Label: models.TrashTypeGlass}
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			return stat.Rating == 100 && stat.FilesScanned == 10 &&
+				stat.TrashByTypes[models.TrashTypeMetal] == 1 && stat.TrashByTypes[models.TrashTypeGlass] == 1
+		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, ReviseStats(ctx, ms, rules, &completed, &verified))
+	})
+
+	t.Run("failed re-run takes the credit back", func(t *testing.T) {
+		user := newUser()
+		failed := completed
+		failed.Status, failed.Result, failed.Error = models.PredictionFailedStatus, nil, "predictor unavailable"
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			return stat.Rating == 90 && stat.Status == models.UserStatusNewbie &&
+				stat.TrashByTypes[models.TrashTypeMetal] == 1
+		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, -10, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, ReviseStats(ctx, ms, rules, &completed, &failed))
+	})
+
+	t.Run("stuck prediction counts as a new scan", func(t *testing.T) {
+		user := newUser()
+		processing := models.Prediction{UserID: user.ID, Status: models.PredictionProcessingStatus}
+
+		ms := mocks.NewStore(t)
+		ms.EXPECT().LockStats(mock.Anything, user.ID).Return(nil).Once()
+		ms.EXPECT().GetUser(mock.Anything, user.ID, true).Return(&user, nil).Once()
+		ms.EXPECT().RecordActivity(mock.Anything, user.ID, mock.Anything).Return(nil).Once()
+		ms.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(stat *models.Stat) bool {
+			return stat.Rating == 110 && stat.FilesScanned == 11 && !stat.LastScannedAt.IsZero() &&
+				stat.TrashByTypes[models.TrashTypeMetal] == 3
+		})).Return(nil).Once()
+		ms.EXPECT().SnapshotStats(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		ms.EXPECT().AddRatingDelta(mock.Anything, user.ID, 10, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, ReviseStats(ctx, ms, rules, &processing, &completed))
+	})
 }
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// RecordActivity counts a scan on the calendar day of day, taken in the
+// location of day.
+func (s *pgStore) RecordActivity(ctx context.Context, userID uuid.UUID, day time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.IncrementDailyActivity(ctx, db.IncrementDailyActivityParams{
+		UserID: userID,
+		Day:    activityDay(day),
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to record activity", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+
+	return nil
+}
+
+// GetActivity returns the days from from to to, both inclusive, on which the
+// user scanned anything. Days without scans are left out.
+func (s *pgStore) GetActivity(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.ActivityDay, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.GetDailyActivity(ctx, db.GetDailyActivityParams{
+		UserID:  userID,
+		DayFrom: activityDay(from),
+		DayTo:   activityDay(to),
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get activity", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+
+	days := make([]models.ActivityDay, 0, len(rows))
+	for _, row := range rows {
+		days = append(days, models.ActivityDay{Day: row.Day.Time, Scans: int(row.Scans)})
+	}
+
+	return days, nil
+}
+
+// SetUserTimezone changes the IANA time zone the user's days are counted in.
+func (s *pgStore) SetUserTimezone(ctx context.Context, userID uuid.UUID, timezone string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.UpdateUserTimezone(ctx, db.UpdateUserTimezoneParams{
+		ID:       userID,
+		Timezone: timezone,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to update timezone", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+
+	return nil
+}
+
+func activityDay(t time.Time) pgtype.Date {
+	return pgtype.Date{Time: models.Day(t), Valid: true}
+}
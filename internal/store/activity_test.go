@@ -0,0 +1,96 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestRecordActivity(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("day is taken in the location of the time", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+
+		mockQ.EXPECT().IncrementDailyActivity(mock.Anything, db.IncrementDailyActivityParams{
+			UserID: userID,
+			Day:    pgtype.Date{Time: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), Valid: true},
+		}).Return(nil).Once()
+
+		// 20:00 UTC on March 10 is already March 11 in Tokyo.
+		at := time.Date(2026, 3, 10, 20, 0, 0, 0, time.UTC).In(tokyo)
+		require.NoError(t, store.RecordActivity(context.Background(), userID, at))
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().IncrementDailyActivity(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		err := store.RecordActivity(context.Background(), userID, time.Now())
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestGetActivity(t *testing.T) {
+	userID := uuid.New()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+		mockQ.EXPECT().GetDailyActivity(mock.Anything, db.GetDailyActivityParams{
+			UserID:  userID,
+			DayFrom: pgtype.Date{Time: from, Valid: true},
+			DayTo:   pgtype.Date{Time: to, Valid: true},
+		}).Return([]db.GetDailyActivityRow{{Day: pgtype.Date{Time: day, Valid: true}, Scans: 3}}, nil).Once()
+
+		days, err := store.GetActivity(context.Background(), userID, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.ActivityDay{{Day: day, Scans: 3}}, days)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetDailyActivity(mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		_, err := store.GetActivity(context.Background(), userID, from, to)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestSetUserTimezone(t *testing.T) {
+	userID := uuid.New()
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().UpdateUserTimezone(mock.Anything, db.UpdateUserTimezoneParams{
+		ID:       userID,
+		Timezone: "Europe/Berlin",
+	}).Return(nil).Once()
+
+	require.NoError(t, store.SetUserTimezone(context.Background(), userID, "Europe/Berlin"))
+}
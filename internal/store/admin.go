@@ -2,18 +2,44 @@ package store
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
-func (s *pgStore) GetAdminUsers(ctx context.Context, limit, offset int32) ([]models.User, error) {
+// likeEscaper makes a search string match literally inside an ILIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// GetAdminUsers returns a page of the users matching filter.
+func (s *pgStore) GetAdminUsers(
+	ctx context.Context, filter models.UserFilter, limit, offset int32,
+) ([]models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	where := userFilterParams(filter)
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = models.UserSortCreatedAt
+	}
 	rows, err := s.q.GetAdminUsers(ctx, db.GetAdminUsersParams{
-		Limit:  limit,
-		Offset: offset,
+		Search:        where.Search,
+		Role:          where.Role,
+		Deleted:       where.Deleted,
+		Banned:        where.Banned,
+		Status:        where.Status,
+		LastLoginFrom: where.LastLoginFrom,
+		LastLoginTo:   where.LastLoginTo,
+		SortBy:        string(sortBy),
+		SortAsc:       filter.SortAsc,
+		Limit:         limit,
+		Offset:        offset,
 	})
 	if err != nil {
 		return nil, errlocal.NewErrInternal("failed to get admin users list", err.Error(), nil)
@@ -21,50 +47,57 @@ func (s *pgStore) GetAdminUsers(ctx context.Context, limit, offset int32) ([]mod
 
 	users := make([]models.User, 0, len(rows))
 	for _, row := range rows {
-		user := models.User{
-			ID:        row.ID,
-			Login:     row.Login,
-			Name:      row.Name,
-			Role:      models.Role(row.Role),
-			Avatar:    row.Avatar,
-			Deleted:   row.Deleted,
-			CreatedAt: row.CreatedAt,
-			UpdatedAt: row.UpdatedAt,
-		}
+		users = append(users, adminUserFromRow(row))
+	}
 
-		if row.LastLoginAt != nil {
-			if t, ok := row.LastLoginAt.(time.Time); ok {
-				user.LastLoginAt = &t
-			}
-		}
+	return users, nil
+}
+
+// adminUserFromRow maps a row of the admin user list; users without stats get
+// a nil Stat.
+func adminUserFromRow(row db.GetAdminUsersRow) models.User {
+	user := models.User{
+		ID:        row.ID,
+		Login:     row.Login,
+		Name:      row.Name,
+		Role:      models.Role(row.Role),
+		Avatar:    row.Avatar,
+		Deleted:   row.Deleted,
+		Ban:       models.NewBan(row.BannedAt, row.BannedUntil, row.BanReason),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
 
-		if row.Status != nil ||
-			row.Rating != nil ||
-			row.FilesScanned != nil ||
-			row.TotalWeight != nil ||
-			row.LastScannedAt.Valid {
-			user.Stat = &models.Stat{}
-			if row.Status != nil {
-				user.Stat.Status = models.UserStatus(*row.Status)
-			}
-			if row.Rating != nil {
-				user.Stat.Rating = int(*row.Rating)
-			}
-			if row.FilesScanned != nil {
-				user.Stat.FilesScanned = int(*row.FilesScanned)
-			}
-			if row.TotalWeight != nil {
-				user.Stat.TotalWeight = *row.TotalWeight
-			}
-			if row.LastScannedAt.Valid {
-				user.Stat.LastScannedAt = row.LastScannedAt.Time
-			}
+	if row.LastLoginAt != nil {
+		if t, ok := row.LastLoginAt.(time.Time); ok {
+			user.LastLoginAt = &t
 		}
+	}
 
-		users = append(users, user)
+	if row.Status != nil ||
+		row.Rating != nil ||
+		row.FilesScanned != nil ||
+		row.TotalWeight != nil ||
+		row.LastScannedAt.Valid {
+		user.Stat = &models.Stat{}
+		if row.Status != nil {
+			user.Stat.Status = models.UserStatus(*row.Status)
+		}
+		if row.Rating != nil {
+			user.Stat.Rating = int(*row.Rating)
+		}
+		if row.FilesScanned != nil {
+			user.Stat.FilesScanned = int(*row.FilesScanned)
+		}
+		if row.TotalWeight != nil {
+			user.Stat.TotalWeight = *row.TotalWeight
+		}
+		if row.LastScannedAt.Valid {
+			user.Stat.LastScannedAt = row.LastScannedAt.Time
+		}
 	}
 
-	return users, nil
+	return user
 }
 
 func (s *pgStore) GetAdminUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
@@ -83,6 +116,7 @@ func (s *pgStore) GetAdminUserByID(ctx context.Context, id uuid.UUID) (*models.U
 		Role:      models.Role(row.Role),
 		Avatar:    row.Avatar,
 		Deleted:   row.Deleted,
+		Ban:       models.NewBan(row.BannedAt, row.BannedUntil, row.BanReason),
 		CreatedAt: row.CreatedAt,
 		UpdatedAt: row.UpdatedAt,
 	}
@@ -119,11 +153,137 @@ func (s *pgStore) GetAdminUserByID(ctx context.Context, id uuid.UUID) (*models.U
 	return user, nil
 }
 
-func (s *pgStore) CountUsers(ctx context.Context) (int64, error) {
-	count, err := s.q.CountUsers(ctx)
+// CountAdminUsers counts the users matching filter, ignoring its sort order.
+func (s *pgStore) CountAdminUsers(ctx context.Context, filter models.UserFilter) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	count, err := s.q.CountAdminUsers(ctx, userFilterParams(filter))
 	if err != nil {
 		return 0, errlocal.NewErrInternal("failed to count users", err.Error(), nil)
 	}
 
 	return count, nil
 }
+
+func userFilterParams(filter models.UserFilter) db.CountAdminUsersParams {
+	params := db.CountAdminUsersParams{
+		Deleted: filter.Deleted,
+		Banned:  filter.Banned,
+	}
+	if filter.Search != "" {
+		params.Search = utils.Ptr(likeEscaper.Replace(filter.Search))
+	}
+	if filter.Role != nil {
+		params.Role = utils.Ptr(string(*filter.Role))
+	}
+	if filter.Status != nil {
+		params.Status = utils.Ptr(string(*filter.Status))
+	}
+	if filter.LastLoginFrom != nil {
+		params.LastLoginFrom = pgtype.Timestamptz{Time: *filter.LastLoginFrom, Valid: true}
+	}
+	if filter.LastLoginTo != nil {
+		params.LastLoginTo = pgtype.Timestamptz{Time: *filter.LastLoginTo, Valid: true}
+	}
+
+	return params
+}
+
+// UpdateUserByAdmin changes the name and role of a user; nil values are kept.
+func (s *pgStore) UpdateUserByAdmin(ctx context.Context, id uuid.UUID, name *string, role *models.Role) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	params := db.UpdateUserByAdminParams{ID: id, Name: name}
+	if role != nil {
+		params.Role = utils.Ptr(string(*role))
+	}
+
+	rows, err := s.q.UpdateUserByAdmin(ctx, params)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to update user", err.Error(), map[string]any{"user_id": id.String()})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("user not found", "", map[string]any{"user_id": id.String()})
+	}
+
+	return nil
+}
+
+func (s *pgStore) BanUser(ctx context.Context, id uuid.UUID, until *time.Time, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	params := db.BanUserParams{ID: id, BanReason: &reason}
+	if until != nil {
+		params.BannedUntil = pgtype.Timestamptz{Time: *until, Valid: true}
+	}
+
+	rows, err := s.q.BanUser(ctx, params)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to ban user", err.Error(), map[string]any{"user_id": id.String()})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("user not found", "", map[string]any{"user_id": id.String()})
+	}
+
+	return nil
+}
+
+func (s *pgStore) UnbanUser(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.UnbanUser(ctx, id)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to unban user", err.Error(), map[string]any{"user_id": id.String()})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("banned user not found", "", map[string]any{"user_id": id.String()})
+	}
+
+	return nil
+}
+
+// RestoreUser undoes a soft delete.
+func (s *pgStore) RestoreUser(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.RestoreUser(ctx, id)
+	if err != nil {
+		if strings.Contains(err.Error(), "SQLSTATE 23505") {
+			return errlocal.NewErrConflict("email of the user is taken by another account", err.Error(),
+				map[string]any{"user_id": id.String()})
+		}
+		return errlocal.NewErrInternal("failed to restore user", err.Error(), map[string]any{"user_id": id.String()})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("deleted user not found", "", map[string]any{"user_id": id.String()})
+	}
+
+	return nil
+}
+
+// PurgeUser permanently removes a user together with their predictions. Rows
+// of other tables go with the user through foreign key cascades.
+func (s *pgStore) PurgeUser(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.DeletePredictionsByUserID(ctx, id); err != nil {
+		return errlocal.NewErrInternal("failed to delete user predictions", err.Error(),
+			map[string]any{"user_id": id.String()})
+	}
+
+	rows, err := s.q.PurgeUser(ctx, id)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to purge user", err.Error(), map[string]any{"user_id": id.String()})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("user not found", "", map[string]any{"user_id": id.String()})
+	}
+
+	return nil
+}
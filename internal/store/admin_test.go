@@ -15,6 +15,7 @@ import (
 
 	"github.com/trashscanner/trashscanner_api/internal/database/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
 )
 
@@ -62,11 +63,12 @@ func TestPgStore_GetAdminUsers(t *testing.T) {
 		}
 
 		q.EXPECT().GetAdminUsers(mock.Anything, db.GetAdminUsersParams{
+			SortBy: string(models.UserSortCreatedAt),
 			Limit:  10,
 			Offset: 0,
 		}).Return(rows, nil)
 
-		users, err := store.GetAdminUsers(context.Background(), 10, 0)
+		users, err := store.GetAdminUsers(context.Background(), models.UserFilter{}, 10, 0)
 		require.NoError(t, err)
 		assert.Len(t, users, 2)
 
@@ -87,6 +89,36 @@ func TestPgStore_GetAdminUsers(t *testing.T) {
 		assert.Nil(t, users[1].Stat)
 	})
 
+	t.Run("filtered and sorted", func(t *testing.T) {
+		q := mocks.NewQuerier(t)
+		store := &pgStore{q: q}
+
+		role := models.RoleModerator
+		banned := false
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		q.EXPECT().GetAdminUsers(mock.Anything, db.GetAdminUsersParams{
+			Search:        stringPtr(`50\%\_off`),
+			Role:          stringPtr("moderator"),
+			Banned:        &banned,
+			LastLoginFrom: pgtype.Timestamptz{Time: from, Valid: true},
+			SortBy:        "rating",
+			SortAsc:       true,
+			Limit:         20,
+			Offset:        40,
+		}).Return([]db.GetAdminUsersRow{}, nil).Once()
+
+		users, err := store.GetAdminUsers(context.Background(), models.UserFilter{
+			Search:        "50%_off",
+			Role:          &role,
+			Banned:        &banned,
+			LastLoginFrom: &from,
+			SortBy:        models.UserSortRating,
+			SortAsc:       true,
+		}, 20, 40)
+		require.NoError(t, err)
+		assert.Empty(t, users)
+	})
+
 	t.Run("error", func(t *testing.T) {
 		q := mocks.NewQuerier(t)
 		store := &pgStore{q: q}
@@ -94,7 +126,7 @@ func TestPgStore_GetAdminUsers(t *testing.T) {
 		q.EXPECT().GetAdminUsers(mock.Anything, mock.AnythingOfType("db.GetAdminUsersParams")).
 			Return(nil, sql.ErrConnDone)
 
-		users, err := store.GetAdminUsers(context.Background(), 10, 0)
+		users, err := store.GetAdminUsers(context.Background(), models.UserFilter{}, 10, 0)
 		assert.Error(t, err)
 		assert.Nil(t, users)
 	})
@@ -209,14 +241,23 @@ func TestPgStore_GetAdminUserByID(t *testing.T) {
 	})
 }
 
-func TestPgStore_CountUsers(t *testing.T) {
+func TestPgStore_CountAdminUsers(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		q := mocks.NewQuerier(t)
 		store := &pgStore{q: q}
 
-		q.EXPECT().CountUsers(mock.Anything).Return(int64(42), nil)
-
-		count, err := store.CountUsers(context.Background())
+		deleted := true
+		status := models.UserStatusEcoScout
+		q.EXPECT().CountAdminUsers(mock.Anything, db.CountAdminUsersParams{
+			Deleted: &deleted,
+			Status:  stringPtr("eco_scout"),
+		}).Return(int64(42), nil)
+
+		count, err := store.CountAdminUsers(context.Background(), models.UserFilter{
+			Deleted: &deleted,
+			Status:  &status,
+			SortBy:  models.UserSortLastLogin,
+		})
 		require.NoError(t, err)
 		assert.Equal(t, int64(42), count)
 	})
@@ -225,10 +266,91 @@ func TestPgStore_CountUsers(t *testing.T) {
 		q := mocks.NewQuerier(t)
 		store := &pgStore{q: q}
 
-		q.EXPECT().CountUsers(mock.Anything).Return(int64(0), sql.ErrConnDone)
+		q.EXPECT().CountAdminUsers(mock.Anything, db.CountAdminUsersParams{}).Return(int64(0), sql.ErrConnDone)
 
-		count, err := store.CountUsers(context.Background())
+		count, err := store.CountAdminUsers(context.Background(), models.UserFilter{})
 		assert.Error(t, err)
 		assert.Equal(t, int64(0), count)
 	})
 }
+
+func TestPgStore_UpdateUserByAdmin(t *testing.T) {
+	q := mocks.NewQuerier(t)
+	store := &pgStore{q: q}
+	userID := uuid.New()
+	role := models.RoleModerator
+
+	q.EXPECT().UpdateUserByAdmin(mock.Anything, db.UpdateUserByAdminParams{
+		ID:   userID,
+		Role: stringPtr("moderator"),
+	}).Return(int64(1), nil).Once()
+
+	require.NoError(t, store.UpdateUserByAdmin(context.Background(), userID, nil, &role))
+}
+
+func TestPgStore_BanUser(t *testing.T) {
+	userID := uuid.New()
+	until := time.Now().Add(time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		q := mocks.NewQuerier(t)
+		store := &pgStore{q: q}
+
+		q.EXPECT().BanUser(mock.Anything, db.BanUserParams{
+			ID:          userID,
+			BannedUntil: pgtype.Timestamptz{Time: until, Valid: true},
+			BanReason:   stringPtr("spam"),
+		}).Return(int64(1), nil).Once()
+
+		require.NoError(t, store.BanUser(context.Background(), userID, &until, "spam"))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		q := mocks.NewQuerier(t)
+		store := &pgStore{q: q}
+
+		q.EXPECT().BanUser(mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+
+		err := store.BanUser(context.Background(), userID, nil, "spam")
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestPgStore_RestoreUser(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("not deleted", func(t *testing.T) {
+		q := mocks.NewQuerier(t)
+		store := &pgStore{q: q}
+
+		q.EXPECT().RestoreUser(mock.Anything, userID).Return(int64(0), nil).Once()
+
+		err := store.RestoreUser(context.Background(), userID)
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("email taken", func(t *testing.T) {
+		q := mocks.NewQuerier(t)
+		store := &pgStore{q: q}
+
+		q.EXPECT().RestoreUser(mock.Anything, userID).
+			Return(int64(0), fmt.Errorf("duplicate key (SQLSTATE 23505)")).Once()
+
+		err := store.RestoreUser(context.Background(), userID)
+		var conflictErr *errlocal.ErrConflict
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func TestPgStore_PurgeUser(t *testing.T) {
+	q := mocks.NewQuerier(t)
+	store := &pgStore{q: q}
+	userID := uuid.New()
+
+	q.EXPECT().DeletePredictionsByUserID(mock.Anything, userID).Return(nil).Once()
+	q.EXPECT().PurgeUser(mock.Anything, userID).Return(int64(1), nil).Once()
+
+	require.NoError(t, store.PurgeUser(context.Background(), userID))
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// GetAnalytics aggregates registrations, logins and predictions in the range
+// of filter.
+func (s *pgStore) GetAnalytics(ctx context.Context, filter models.AnalyticsFilter) (*models.Analytics, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	bucket := string(filter.Bucket)
+	details := map[string]any{"bucket": bucket, "from": filter.From, "to": filter.To}
+	analytics := &models.Analytics{Bucket: filter.Bucket, From: filter.From, To: filter.To}
+
+	registrations, err := s.q.GetRegistrationCounts(ctx, db.GetRegistrationCountsParams{
+		Bucket:      bucket,
+		CreatedFrom: filter.From,
+		CreatedTo:   filter.To,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to count registrations", err.Error(), details)
+	}
+	analytics.Registrations = make([]models.CountBucket, len(registrations))
+	for i, row := range registrations {
+		analytics.Registrations[i] = models.CountBucket{Start: row.Bucket, Count: row.Count}
+	}
+
+	logins, err := s.q.GetLoginCounts(ctx, db.GetLoginCountsParams{
+		Bucket:      bucket,
+		CreatedFrom: filter.From,
+		CreatedTo:   filter.To,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to count logins", err.Error(), details)
+	}
+	analytics.Logins = make([]models.LoginBucket, len(logins))
+	for i, row := range logins {
+		analytics.Logins[i] = models.LoginBucket{Start: row.Bucket, Successful: row.Successful, Failed: row.Failed}
+	}
+
+	statuses, err := s.q.GetPredictionStatusCounts(ctx, db.GetPredictionStatusCountsParams{
+		Bucket:      bucket,
+		CreatedFrom: filter.From,
+		CreatedTo:   filter.To,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to count predictions", err.Error(), details)
+	}
+	analytics.PredictionsByStatus = make([]models.PredictionStatusBucket, len(statuses))
+	for i, row := range statuses {
+		analytics.PredictionsByStatus[i] = models.PredictionStatusBucket{
+			Start:  row.Bucket,
+			Status: models.PredictionStatus(row.Status),
+			Count:  row.Count,
+		}
+	}
+
+	types, err := s.q.GetPredictionTypeCounts(ctx, db.GetPredictionTypeCountsParams{
+		Bucket:      bucket,
+		CreatedFrom: filter.From,
+		CreatedTo:   filter.To,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to count predictions by type", err.Error(), details)
+	}
+	analytics.PredictionsByType = make([]models.TrashTypeBucket, len(types))
+	for i, row := range types {
+		analytics.PredictionsByType[i] = models.TrashTypeBucket{Start: row.Bucket, TrashType: row.TrashType, Count: row.Count}
+	}
+
+	performance, err := s.q.GetPredictorPerformance(ctx, db.GetPredictorPerformanceParams{
+		CreatedFrom: filter.From,
+		CreatedTo:   filter.To,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get predictor performance", err.Error(), details)
+	}
+	analytics.Predictor = models.PredictorPerformance{
+		Completed:    performance.Completed,
+		Failed:       performance.Failed,
+		AvgLatencyMs: performance.AvgLatencyMs,
+	}
+	if finished := performance.Completed + performance.Failed; finished > 0 {
+		analytics.Predictor.FailureRate = float64(performance.Failed) / float64(finished)
+	}
+
+	return analytics, nil
+}
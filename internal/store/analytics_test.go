@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestGetAnalytics(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	day := from.AddDate(0, 0, 1)
+	filter := models.AnalyticsFilter{Bucket: models.AnalyticsBucketDay, From: from, To: to}
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetRegistrationCounts(mock.Anything, db.GetRegistrationCountsParams{
+			Bucket: "day", CreatedFrom: from, CreatedTo: to,
+		}).Return([]db.GetRegistrationCountsRow{{Bucket: day, Count: 3}}, nil).Once()
+		mockQ.EXPECT().GetLoginCounts(mock.Anything, db.GetLoginCountsParams{
+			Bucket: "day", CreatedFrom: from, CreatedTo: to,
+		}).Return([]db.GetLoginCountsRow{{Bucket: day, Successful: 10, Failed: 2}}, nil).Once()
+		mockQ.EXPECT().GetPredictionStatusCounts(mock.Anything, db.GetPredictionStatusCountsParams{
+			Bucket: "day", CreatedFrom: from, CreatedTo: to,
+		}).Return([]db.GetPredictionStatusCountsRow{{Bucket: day, Status: "completed", Count: 6}}, nil).Once()
+		mockQ.EXPECT().GetPredictionTypeCounts(mock.Anything, db.GetPredictionTypeCountsParams{
+			Bucket: "day", CreatedFrom: from, CreatedTo: to,
+		}).Return([]db.GetPredictionTypeCountsRow{{Bucket: day, TrashType: "glass", Count: 4}}, nil).Once()
+		mockQ.EXPECT().GetPredictorPerformance(mock.Anything, db.GetPredictorPerformanceParams{
+			CreatedFrom: from, CreatedTo: to,
+		}).Return(db.GetPredictorPerformanceRow{Completed: 6, Failed: 2, AvgLatencyMs: 850}, nil).Once()
+
+		analytics, err := store.GetAnalytics(context.Background(), filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.CountBucket{{Start: day, Count: 3}}, analytics.Registrations)
+		assert.Equal(t, []models.LoginBucket{{Start: day, Successful: 10, Failed: 2}}, analytics.Logins)
+		assert.Equal(t, models.PredictionCompletedStatus, analytics.PredictionsByStatus[0].Status)
+		assert.Equal(t, "glass", analytics.PredictionsByType[0].TrashType)
+		assert.Equal(t, 850.0, analytics.Predictor.AvgLatencyMs)
+		assert.Equal(t, 0.25, analytics.Predictor.FailureRate)
+	})
+
+	t.Run("no finished predictions", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetRegistrationCounts(mock.Anything, mock.Anything).
+			Return([]db.GetRegistrationCountsRow{}, nil).Once()
+		mockQ.EXPECT().GetLoginCounts(mock.Anything, mock.Anything).Return([]db.GetLoginCountsRow{}, nil).Once()
+		mockQ.EXPECT().GetPredictionStatusCounts(mock.Anything, mock.Anything).
+			Return([]db.GetPredictionStatusCountsRow{}, nil).Once()
+		mockQ.EXPECT().GetPredictionTypeCounts(mock.Anything, mock.Anything).
+			Return([]db.GetPredictionTypeCountsRow{}, nil).Once()
+		mockQ.EXPECT().GetPredictorPerformance(mock.Anything, mock.Anything).
+			Return(db.GetPredictorPerformanceRow{}, nil).Once()
+
+		analytics, err := store.GetAnalytics(context.Background(), filter)
+
+		require.NoError(t, err)
+		assert.NotNil(t, analytics.Registrations)
+		assert.Zero(t, analytics.Predictor.FailureRate)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetRegistrationCounts(mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		_, err := store.GetAnalytics(context.Background(), filter)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func (s *pgStore) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+	var expiresAt pgtype.Timestamptz
+	if key.ExpiresAt != nil {
+		expiresAt = pgtype.Timestamptz{Time: *key.ExpiresAt, Valid: true}
+	}
+
+	dbKey, err := s.q.CreateAPIKey(ctx, db.CreateAPIKeyParams{
+		UserID:    key.UserID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		KeyHash:   key.KeyHash,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to create api key", err.Error(),
+			map[string]any{"user_id": key.UserID})
+	}
+	key.Model(dbKey)
+
+	return nil
+}
+
+// GetAPIKeyByHash returns the key with the given hash unless it was revoked.
+// Expiry is left to the caller.
+func (s *pgStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbKey, err := s.q.GetActiveAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("api key not found", "no active api key with given hash", nil)
+		}
+		return nil, errlocal.NewErrInternal("failed to get api key", err.Error(), nil)
+	}
+
+	var key models.APIKey
+	key.Model(dbKey)
+
+	return &key, nil
+}
+
+func (s *pgStore) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbKeys, err := s.q.ListUserAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to list api keys", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	keys := make([]models.APIKey, len(dbKeys))
+	for i, dbKey := range dbKeys {
+		keys[i].Model(dbKey)
+	}
+
+	return keys, nil
+}
+
+func (s *pgStore) RevokeAPIKey(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.RevokeAPIKey(ctx, db.RevokeAPIKeyParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to revoke api key", err.Error(),
+			map[string]any{"user_id": userID, "api_key_id": id})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("api key not found", "no active api key with given ID for user",
+			map[string]any{"user_id": userID, "api_key_id": id})
+	}
+
+	return nil
+}
+
+// TouchAPIKey records the use of a key. Writes are coalesced to at most one
+// per minute per key.
+func (s *pgStore) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.TouchAPIKey(ctx, id); err != nil {
+		return errlocal.NewErrInternal("failed to update api key", err.Error(),
+			map[string]any{"api_key_id": id})
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	expiresAt := time.Now().Add(time.Hour)
+	id := uuid.New()
+	mockQ.EXPECT().CreateAPIKey(mock.Anything, db.CreateAPIKeyParams{
+		UserID:    testdata.User1ID,
+		Name:      "scanner",
+		Prefix:    "tsk_abcdefgh",
+		KeyHash:   "hash",
+		Scopes:    []string{"predictions:write"},
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}).Return(db.ApiKey{
+		ID:        id,
+		UserID:    testdata.User1ID,
+		Name:      "scanner",
+		Prefix:    "tsk_abcdefgh",
+		KeyHash:   "hash",
+		Scopes:    []string{"predictions:write"},
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}, nil).Once()
+
+	key := &models.APIKey{
+		UserID:    testdata.User1ID,
+		Name:      "scanner",
+		Prefix:    "tsk_abcdefgh",
+		KeyHash:   "hash",
+		Scopes:    []models.APIKeyScope{models.ScopePredictionsWrite},
+		ExpiresAt: &expiresAt,
+	}
+	require.NoError(t, store.CreateAPIKey(context.Background(), key))
+
+	assert.Equal(t, id, key.ID)
+	assert.True(t, key.Allows(models.ScopePredictionsWrite))
+	assert.False(t, key.Allows(models.ScopeProfileWrite))
+}
+
+func TestGetAPIKeyByHash(t *testing.T) {
+	t.Run("Active key", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetActiveAPIKeyByHash(mock.Anything, "hash").Return(db.ApiKey{
+			ID:     uuid.New(),
+			UserID: testdata.User1ID,
+			Scopes: []string{},
+		}, nil).Once()
+
+		key, err := store.GetAPIKeyByHash(context.Background(), "hash")
+
+		require.NoError(t, err)
+		assert.Equal(t, testdata.User1ID, key.UserID)
+		assert.False(t, key.Allows(models.ScopeProfileWrite), "a key without scopes grants nothing")
+	})
+
+	t.Run("Unknown or revoked key", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetActiveAPIKeyByHash(mock.Anything, "hash").Return(db.ApiKey{}, pgx.ErrNoRows).Once()
+
+		_, err := store.GetAPIKeyByHash(context.Background(), "hash")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	t.Run("Revoked", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		id := uuid.New()
+		mockQ.EXPECT().RevokeAPIKey(mock.Anything, db.RevokeAPIKeyParams{ID: id, UserID: testdata.User1ID}).
+			Return(1, nil).Once()
+
+		assert.NoError(t, store.RevokeAPIKey(context.Background(), testdata.User1ID, id))
+	})
+
+	t.Run("Key of another user", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().RevokeAPIKey(mock.Anything, mock.Anything).Return(0, nil).Once()
+
+		err := store.RevokeAPIKey(context.Background(), testdata.User1ID, uuid.New())
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
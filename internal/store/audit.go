@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func (s *pgStore) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	params := db.CreateAuditEventParams{
+		ActorID:    event.ActorID,
+		Action:     string(event.Action),
+		TargetType: string(event.TargetType),
+		TargetID:   event.TargetID,
+		Before:     event.Before,
+		After:      event.After,
+		IpAddress:  event.IPAddress,
+	}
+	if event.RequestID != "" {
+		params.RequestID = &event.RequestID
+	}
+	if event.ImpersonatorID != nil {
+		params.ImpersonatorID = pgtype.UUID{Bytes: *event.ImpersonatorID, Valid: true}
+	}
+
+	row, err := s.q.CreateAuditEvent(ctx, params)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to create audit event", err.Error(),
+			map[string]any{"action": event.Action, "target_id": event.TargetID})
+	}
+	event.ID = row.ID
+	event.CreatedAt = row.CreatedAt
+
+	return nil
+}
+
+// ListAuditEvents returns a page of the audit events matching filter, newest
+// first.
+func (s *pgStore) ListAuditEvents(
+	ctx context.Context,
+	filter models.AuditFilter,
+	limit, offset int32,
+) ([]models.AuditEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	where := auditFilterParams(filter)
+	rows, err := s.q.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		ActorID:        where.ActorID,
+		Action:         where.Action,
+		TargetType:     where.TargetType,
+		TargetID:       where.TargetID,
+		CreatedFrom:    where.CreatedFrom,
+		CreatedTo:      where.CreatedTo,
+		ImpersonatorID: where.ImpersonatorID,
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get audit events", err.Error(), nil)
+	}
+
+	events := make([]models.AuditEvent, len(rows))
+	for i := range rows {
+		events[i].Model(rows[i])
+	}
+
+	return events, nil
+}
+
+func (s *pgStore) CountAuditEvents(ctx context.Context, filter models.AuditFilter) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	count, err := s.q.CountAuditEvents(ctx, auditFilterParams(filter))
+	if err != nil {
+		return 0, errlocal.NewErrInternal("failed to count audit events", err.Error(), nil)
+	}
+
+	return count, nil
+}
+
+func auditFilterParams(filter models.AuditFilter) db.CountAuditEventsParams {
+	var params db.CountAuditEventsParams
+	if filter.ActorID != nil {
+		params.ActorID = pgtype.UUID{Bytes: *filter.ActorID, Valid: true}
+	}
+	if filter.Action != "" {
+		params.Action = utils.Ptr(string(filter.Action))
+	}
+	if filter.TargetType != "" {
+		params.TargetType = utils.Ptr(string(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		params.TargetID = &filter.TargetID
+	}
+	if filter.ImpersonatorID != nil {
+		params.ImpersonatorID = pgtype.UUID{Bytes: *filter.ImpersonatorID, Valid: true}
+	}
+	if filter.From != nil {
+		params.CreatedFrom = pgtype.Timestamptz{Time: *filter.From, Valid: true}
+	}
+	if filter.To != nil {
+		params.CreatedTo = pgtype.Timestamptz{Time: *filter.To, Valid: true}
+	}
+
+	return params
+}
@@ -0,0 +1,130 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestCreateAuditEvent(t *testing.T) {
+	actorID := uuid.New()
+	ip := netip.MustParseAddr("192.0.2.1")
+	event := func() *models.AuditEvent {
+		return &models.AuditEvent{
+			ActorID:    actorID,
+			Action:     models.AuditUserBan,
+			TargetType: models.AuditTargetUser,
+			TargetID:   "target",
+			After:      []byte(`{"reason":"spam"}`),
+			IPAddress:  &ip,
+			RequestID:  "req-1",
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		row := db.CreateAuditEventRow{ID: uuid.New(), CreatedAt: time.Now()}
+
+		mockQ.EXPECT().CreateAuditEvent(mock.Anything, db.CreateAuditEventParams{
+			ActorID:    actorID,
+			Action:     "user.ban",
+			TargetType: "user",
+			TargetID:   "target",
+			After:      []byte(`{"reason":"spam"}`),
+			IpAddress:  &ip,
+			RequestID:  stringPtr("req-1"),
+		}).Return(row, nil).Once()
+
+		e := event()
+		require.NoError(t, store.CreateAuditEvent(context.Background(), e))
+		assert.Equal(t, row.ID, e.ID)
+		assert.Equal(t, row.CreatedAt, e.CreatedAt)
+	})
+
+	t.Run("impersonated", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		adminID := uuid.New()
+
+		mockQ.EXPECT().CreateAuditEvent(mock.Anything, mock.MatchedBy(func(p db.CreateAuditEventParams) bool {
+			return p.ActorID == actorID && p.ImpersonatorID == pgtype.UUID{Bytes: adminID, Valid: true}
+		})).Return(db.CreateAuditEventRow{ID: uuid.New()}, nil).Once()
+
+		e := event()
+		e.ImpersonatorID = &adminID
+		require.NoError(t, store.CreateAuditEvent(context.Background(), e))
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().CreateAuditEvent(mock.Anything, mock.Anything).
+			Return(db.CreateAuditEventRow{}, errors.New("db down")).Once()
+
+		err := store.CreateAuditEvent(context.Background(), event())
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestListAuditEvents(t *testing.T) {
+	actorID := uuid.New()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := models.AuditFilter{ActorID: &actorID, Action: models.AuditUserPurge, From: &from}
+
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().ListAuditEvents(mock.Anything, db.ListAuditEventsParams{
+		ActorID:     pgtype.UUID{Bytes: actorID, Valid: true},
+		Action:      stringPtr("user.purge"),
+		CreatedFrom: pgtype.Timestamptz{Time: from, Valid: true},
+		Limit:       50,
+		Offset:      0,
+	}).Return([]db.AuditEvent{{
+		ID:         uuid.New(),
+		ActorID:    actorID,
+		Action:     "user.purge",
+		TargetType: "user",
+		TargetID:   "target",
+		RequestID:  stringPtr("req-1"),
+	}}, nil).Once()
+
+	events, err := store.ListAuditEvents(context.Background(), filter, 50, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AuditUserPurge, events[0].Action)
+	assert.Equal(t, models.AuditTargetUser, events[0].TargetType)
+	assert.Equal(t, "req-1", events[0].RequestID)
+}
+
+func TestCountAuditEvents(t *testing.T) {
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().CountAuditEvents(mock.Anything, db.CountAuditEventsParams{
+		TargetType: stringPtr("session"),
+		TargetID:   stringPtr("s1"),
+	}).Return(int64(2), nil).Once()
+
+	count, err := store.CountAuditEvents(context.Background(), models.AuditFilter{
+		TargetType: models.AuditTargetSession,
+		TargetID:   "s1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
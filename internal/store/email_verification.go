@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// emailIndexName is the unique index on active users' emails.
+const emailIndexName = "idx_users_email_lower"
+
+// UpdateUserEmail replaces the user's email and resets its verification.
+// A nil email removes the address from the account.
+func (s *pgStore) UpdateUserEmail(ctx context.Context, id uuid.UUID, email *string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if email != nil {
+		if err := s.checkEmailAvailable(ctx, *email, id); err != nil {
+			return err
+		}
+	}
+
+	if err := s.q.UpdateUserEmail(ctx, db.UpdateUserEmailParams{
+		ID:    id,
+		Email: email,
+	}); err != nil {
+		if strings.Contains(err.Error(), "SQLSTATE 23505") {
+			return errlocal.NewErrConflict("user with this email already exists", err.Error(),
+				map[string]any{"user_id": id})
+		}
+		return errlocal.NewErrInternal("failed to update user email", err.Error(),
+			map[string]any{"user_id": id})
+	}
+
+	return nil
+}
+
+// checkEmailAvailable returns a conflict when the email, compared
+// case-insensitively, belongs to an active user other than owner.
+func (s *pgStore) checkEmailAvailable(ctx context.Context, email string, owner uuid.UUID) error {
+	existing, err := s.q.GetUserByEmail(ctx, email)
+	if err == nil {
+		if existing.ID == owner {
+			return nil
+		}
+		return errlocal.NewErrConflict("user with this email already exists", "email already taken",
+			map[string]any{"email": email})
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return errlocal.NewErrInternal("failed to check existing email", err.Error(),
+			map[string]any{"email": email})
+	}
+
+	return nil
+}
+
+func (s *pgStore) InsertEmailVerificationToken(ctx context.Context, token *models.EmailVerificationToken) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	id, err := s.q.CreateEmailVerificationToken(ctx, db.CreateEmailVerificationTokenParams{
+		UserID:    token.UserID,
+		Email:     token.Email,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to create email verification token", err.Error(),
+			map[string]any{"user_id": token.UserID})
+	}
+	token.ID = id
+
+	return nil
+}
+
+// ConsumeEmailVerificationToken marks an unused, unexpired token as used and
+// returns the user and the address it was issued for.
+func (s *pgStore) ConsumeEmailVerificationToken(ctx context.Context, tokenHash string) (uuid.UUID, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	row, err := s.q.ConsumeEmailVerificationToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, "", errlocal.NewErrNotFound("email verification token not found",
+				"no usable token with given hash", nil)
+		}
+		return uuid.Nil, "", errlocal.NewErrInternal("failed to consume email verification token", err.Error(), nil)
+	}
+
+	return row.UserID, row.Email, nil
+}
+
+// VerifyUserEmail marks the user's email as verified. It fails with not found
+// when the user's current email no longer matches the verified address.
+func (s *pgStore) VerifyUserEmail(ctx context.Context, id uuid.UUID, email string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.MarkUserEmailVerified(ctx, db.MarkUserEmailVerifiedParams{
+		ID:    id,
+		Email: email,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to verify user email", err.Error(),
+			map[string]any{"user_id": id})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("user email not found", "no user with given ID and email",
+			map[string]any{"user_id": id})
+	}
+
+	return nil
+}
+
+func (s *pgStore) InvalidateEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.InvalidateUserEmailVerificationTokens(ctx, userID); err != nil {
+		return errlocal.NewErrInternal("failed to invalidate email verification tokens", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+)
+
+func TestUpdateUserEmail(t *testing.T) {
+	email := "user@example.com"
+
+	t.Run("Update email successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).Return(db.User{}, pgx.ErrNoRows).Once()
+		mockQ.EXPECT().UpdateUserEmail(mock.Anything, db.UpdateUserEmailParams{
+			ID:    testdata.User1ID,
+			Email: &email,
+		}).Return(nil).Once()
+
+		assert.NoError(t, store.UpdateUserEmail(context.Background(), testdata.User1ID, &email))
+	})
+
+	t.Run("Same user keeps email in another case", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).
+			Return(db.User{ID: testdata.User1ID}, nil).Once()
+		mockQ.EXPECT().UpdateUserEmail(mock.Anything, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, store.UpdateUserEmail(context.Background(), testdata.User1ID, &email))
+	})
+
+	t.Run("Email taken by another user", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).Return(db.User{ID: uuid.New()}, nil).Once()
+
+		err := store.UpdateUserEmail(context.Background(), testdata.User1ID, &email)
+
+		var conflictErr *errlocal.ErrConflict
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+
+	t.Run("Email taken concurrently", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).Return(db.User{}, pgx.ErrNoRows).Once()
+		mockQ.EXPECT().UpdateUserEmail(mock.Anything, mock.Anything).
+			Return(errors.New(`duplicate key value violates unique constraint "idx_users_email_lower" (SQLSTATE 23505)`)).
+			Once()
+
+		err := store.UpdateUserEmail(context.Background(), testdata.User1ID, &email)
+
+		var conflictErr *errlocal.ErrConflict
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+
+	t.Run("Update email fails", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).Return(db.User{}, pgx.ErrNoRows).Once()
+		mockQ.EXPECT().UpdateUserEmail(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+		err := store.UpdateUserEmail(context.Background(), testdata.User1ID, &email)
+
+		var localErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &localErr)
+	})
+}
+
+func TestGetUserByEmail(t *testing.T) {
+	t.Run("Get user successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		email := "User@Example.com"
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).
+			Return(db.User{ID: testdata.User1ID, Email: &email}, nil).Once()
+
+		user, err := store.GetUserByEmail(context.Background(), email)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testdata.User1ID, user.ID)
+		assert.False(t, user.EmailVerified)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, "nobody@example.com").
+			Return(db.User{}, pgx.ErrNoRows).Once()
+
+		_, err := store.GetUserByEmail(context.Background(), "nobody@example.com")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestInsertEmailVerificationToken(t *testing.T) {
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	id := uuid.New()
+	token := &models.EmailVerificationToken{
+		UserID:    testdata.User1ID,
+		Email:     "user@example.com",
+		TokenHash: "hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	mockQ.EXPECT().CreateEmailVerificationToken(mock.Anything, db.CreateEmailVerificationTokenParams{
+		UserID:    token.UserID,
+		Email:     token.Email,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	}).Return(id, nil).Once()
+
+	assert.NoError(t, store.InsertEmailVerificationToken(context.Background(), token))
+	assert.Equal(t, id, token.ID)
+}
+
+func TestConsumeEmailVerificationToken(t *testing.T) {
+	t.Run("Consume token successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ConsumeEmailVerificationToken(mock.Anything, "hash").
+			Return(db.ConsumeEmailVerificationTokenRow{UserID: testdata.User1ID, Email: "user@example.com"}, nil).Once()
+
+		userID, email, err := store.ConsumeEmailVerificationToken(context.Background(), "hash")
+
+		assert.NoError(t, err)
+		assert.Equal(t, testdata.User1ID, userID)
+		assert.Equal(t, "user@example.com", email)
+	})
+
+	t.Run("Token used or expired", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ConsumeEmailVerificationToken(mock.Anything, "hash").
+			Return(db.ConsumeEmailVerificationTokenRow{}, pgx.ErrNoRows).Once()
+
+		_, _, err := store.ConsumeEmailVerificationToken(context.Background(), "hash")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestVerifyUserEmail(t *testing.T) {
+	t.Run("Verify email successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().MarkUserEmailVerified(mock.Anything, db.MarkUserEmailVerifiedParams{
+			ID:    testdata.User1ID,
+			Email: "user@example.com",
+		}).Return(1, nil).Once()
+
+		assert.NoError(t, store.VerifyUserEmail(context.Background(), testdata.User1ID, "user@example.com"))
+	})
+
+	t.Run("Email changed since token was issued", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().MarkUserEmailVerified(mock.Anything, mock.Anything).Return(0, nil).Once()
+
+		err := store.VerifyUserEmail(context.Background(), testdata.User1ID, "old@example.com")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestInvalidateEmailVerificationTokens(t *testing.T) {
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().InvalidateUserEmailVerificationTokens(mock.Anything, testdata.User1ID).Return(nil).Once()
+
+	assert.NoError(t, store.InvalidateEmailVerificationTokens(context.Background(), testdata.User1ID))
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// exportBatchSize is the number of rows an export reads per query. Exports
+// page by (created_at, id) so that only one batch is held in memory and each
+// query stays within connTimeout however large the table is.
+const exportBatchSize = 500
+
+// ExportUsers calls fn for every user matching filter, oldest first. The sort
+// order of filter is ignored. An error from fn stops the export and is
+// returned as is.
+func (s *pgStore) ExportUsers(ctx context.Context, filter models.UserFilter, fn func(models.User) error) error {
+	where := userFilterParams(filter)
+	params := db.ExportAdminUsersParams{
+		Search:        where.Search,
+		Role:          where.Role,
+		Deleted:       where.Deleted,
+		Banned:        where.Banned,
+		Status:        where.Status,
+		LastLoginFrom: where.LastLoginFrom,
+		LastLoginTo:   where.LastLoginTo,
+		Limit:         exportBatchSize,
+	}
+
+	for {
+		rows, err := s.exportUsersBatch(ctx, params)
+		if err != nil {
+			return errlocal.NewErrInternal("failed to export users", err.Error(), nil)
+		}
+		for _, row := range rows {
+			if err := fn(adminUserFromRow(db.GetAdminUsersRow(row))); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportBatchSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		params.AfterCreatedAt, params.AfterID = last.CreatedAt, last.ID
+	}
+}
+
+func (s *pgStore) exportUsersBatch(
+	ctx context.Context, params db.ExportAdminUsersParams,
+) ([]db.ExportAdminUsersRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	return s.q.ExportAdminUsers(ctx, params)
+}
+
+// ExportPredictions calls fn for every prediction matching filter, oldest
+// first. An error from fn stops the export and is returned as is.
+func (s *pgStore) ExportPredictions(
+	ctx context.Context, filter models.PredictionFilter, fn func(models.AdminPrediction) error,
+) error {
+	where := predictionFilterParams(filter)
+	params := db.ExportPredictionsParams{
+		UserID:        where.UserID,
+		Status:        where.Status,
+		MaxConfidence: where.MaxConfidence,
+		HasFeedback:   where.HasFeedback,
+		Verified:      where.Verified,
+		CreatedFrom:   where.CreatedFrom,
+		CreatedTo:     where.CreatedTo,
+		Limit:         exportBatchSize,
+	}
+
+	for {
+		rows, err := s.exportPredictionsBatch(ctx, params)
+		if err != nil {
+			return errlocal.NewErrInternal("failed to export predictions", err.Error(), nil)
+		}
+		for _, row := range rows {
+			prediction := models.AdminPrediction{UserLogin: row.Login}
+			prediction.Model(db.Prediction{
+				ID:              row.ID,
+				UserID:          row.UserID,
+				TrashScan:       row.TrashScan,
+				Status:          row.Status,
+				Result:          row.Result,
+				Error:           row.Error,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+				VerifiedLabel:   row.VerifiedLabel,
+				VerifiedBy:      row.VerifiedBy,
+				VerifiedAt:      row.VerifiedAt,
+				FeedbackLabel:   row.FeedbackLabel,
+				FeedbackComment: row.FeedbackComment,
+				FeedbackAt:      row.FeedbackAt,
+				Weight:          row.Weight,
+			})
+			if err := fn(prediction); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportBatchSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		params.AfterCreatedAt, params.AfterID = last.CreatedAt, last.ID
+	}
+}
+
+func (s *pgStore) exportPredictionsBatch(
+	ctx context.Context, params db.ExportPredictionsParams,
+) ([]db.ExportPredictionsRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	return s.q.ExportPredictions(ctx, params)
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestExportUsers(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("pages by creation time", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		role := models.RoleModerator
+
+		firstBatch := make([]db.ExportAdminUsersRow, exportBatchSize)
+		for i := range firstBatch {
+			firstBatch[i] = db.ExportAdminUsersRow{ID: uuid.New(), Role: "moderator", CreatedAt: start.Add(time.Duration(i))}
+		}
+		last := firstBatch[exportBatchSize-1]
+
+		mockQ.EXPECT().ExportAdminUsers(mock.Anything, mock.MatchedBy(func(p db.ExportAdminUsersParams) bool {
+			return p.AfterID == uuid.Nil && p.Role != nil && *p.Role == "moderator" && p.Limit == exportBatchSize
+		})).Return(firstBatch, nil).Once()
+		mockQ.EXPECT().ExportAdminUsers(mock.Anything, mock.MatchedBy(func(p db.ExportAdminUsersParams) bool {
+			return p.AfterID == last.ID && p.AfterCreatedAt.Equal(last.CreatedAt)
+		})).Return([]db.ExportAdminUsersRow{{ID: uuid.New(), Role: "moderator", Rating: utils.Ptr(int32(7))}}, nil).Once()
+
+		var users []models.User
+		err := store.ExportUsers(context.Background(), models.UserFilter{Role: &role}, func(user models.User) error {
+			users = append(users, user)
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, users, exportBatchSize+1)
+		assert.Equal(t, firstBatch[0].ID, users[0].ID)
+		require.NotNil(t, users[exportBatchSize].Stat)
+		assert.Equal(t, 7, users[exportBatchSize].Stat.Rating)
+	})
+
+	t.Run("callback error stops the export", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		stop := errors.New("client gone")
+
+		mockQ.EXPECT().ExportAdminUsers(mock.Anything, mock.Anything).
+			Return([]db.ExportAdminUsersRow{{ID: uuid.New()}, {ID: uuid.New()}}, nil).Once()
+
+		calls := 0
+		err := store.ExportUsers(context.Background(), models.UserFilter{}, func(models.User) error {
+			calls++
+			return stop
+		})
+
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ExportAdminUsers(mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		err := store.ExportUsers(context.Background(), models.UserFilter{}, func(models.User) error { return nil })
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestExportPredictions(t *testing.T) {
+	userID := uuid.New()
+	status := models.PredictionCompletedStatus
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().ExportPredictions(mock.Anything, mock.MatchedBy(func(p db.ExportPredictionsParams) bool {
+		return p.UserID.Valid && p.UserID.Bytes == userID && *p.Status == "completed" &&
+			p.CreatedFrom.Time.Equal(from) && !p.CreatedTo.Valid
+	})).Return([]db.ExportPredictionsRow{{
+		ID:            uuid.New(),
+		UserID:        userID,
+		Login:         "user",
+		TrashScan:     "scans/1.jpg",
+		Status:        "completed",
+		Result:        []byte(`{"glass":0.9}`),
+		VerifiedLabel: utils.Ptr("plastic"),
+		VerifiedAt:    pgtype.Timestamptz{Time: from, Valid: true},
+		Weight:        utils.Ptr(0.25),
+	}}, nil).Once()
+
+	var predictions []models.AdminPrediction
+	err := store.ExportPredictions(context.Background(), models.PredictionFilter{
+		UserID:      &userID,
+		Status:      &status,
+		CreatedFrom: &from,
+	}, func(prediction models.AdminPrediction) error {
+		predictions = append(predictions, prediction)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, predictions, 1)
+	assert.Equal(t, "user", predictions[0].UserLogin)
+	assert.Equal(t, models.PredictionResult{"glass": 0.9}, predictions[0].Result)
+	require.NotNil(t, predictions[0].Verification)
+	assert.Equal(t, "plastic", predictions[0].Verification.Label)
+	assert.Equal(t, utils.Ptr(0.25), predictions[0].Weight)
+}
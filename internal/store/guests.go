@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+)
+
+// ClaimGuest moves the predictions, stats, periodic ratings and daily activity
+// of the guest onto the user and deletes the guest. Ratings and activity are
+// added to what the user already has for the same period or day. The user's own stats row is replaced, so it must only be
+// called for a freshly created user and inside ExecTx.
+func (s *pgStore) ClaimGuest(ctx context.Context, guestID, userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	details := map[string]any{"guest_id": guestID.String(), "user_id": userID.String()}
+
+	if err := s.q.ReassignPredictions(ctx, db.ReassignPredictionsParams{
+		NewUserID: userID,
+		UserID:    guestID,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to move guest predictions", err.Error(), details)
+	}
+
+	if err := s.q.ReassignRatingPeriods(ctx, db.ReassignRatingPeriodsParams{
+		UserID:    guestID,
+		NewUserID: userID,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to move guest ratings", err.Error(), details)
+	}
+
+	if err := s.q.ReassignDailyActivity(ctx, db.ReassignDailyActivityParams{
+		UserID:    guestID,
+		NewUserID: userID,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to move guest activity", err.Error(), details)
+	}
+
+	if err := s.q.DeleteStatsByUserID(ctx, userID); err != nil {
+		return errlocal.NewErrInternal("failed to move guest stats", err.Error(), details)
+	}
+	rows, err := s.q.ReassignStats(ctx, db.ReassignStatsParams{
+		NewUserID: userID,
+		UserID:    guestID,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to move guest stats", err.Error(), details)
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("guest not found", "", details)
+	}
+
+	rows, err = s.q.DeleteGuestUser(ctx, guestID)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to delete guest", err.Error(), details)
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("guest not found", "", details)
+	}
+
+	return nil
+}
+
+// WalkExpiredGuests calls fn with the ID of every guest created before
+// createdBefore, in ID order. Guests are read in batches like exports, so fn
+// may delete the guest it is called with. An error from fn stops the walk and
+// is returned as is.
+func (s *pgStore) WalkExpiredGuests(ctx context.Context, createdBefore time.Time, fn func(uuid.UUID) error) error {
+	params := db.ListExpiredGuestIDsAfterParams{CreatedBefore: createdBefore, Limit: exportBatchSize}
+
+	for {
+		guestIDs, err := s.expiredGuestsBatch(ctx, params)
+		if err != nil {
+			return errlocal.NewErrInternal("failed to list expired guests", err.Error(), nil)
+		}
+		for _, guestID := range guestIDs {
+			if err := fn(guestID); err != nil {
+				return err
+			}
+		}
+		if len(guestIDs) < exportBatchSize {
+			return nil
+		}
+		params.AfterID = guestIDs[len(guestIDs)-1]
+	}
+}
+
+func (s *pgStore) expiredGuestsBatch(
+	ctx context.Context, params db.ListExpiredGuestIDsAfterParams,
+) ([]uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	return s.q.ListExpiredGuestIDsAfter(ctx, params)
+}
@@ -0,0 +1,149 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+)
+
+func TestClaimGuest(t *testing.T) {
+	ctx := context.Background()
+	guestID := uuid.New()
+	userID := uuid.New()
+	reassign := db.ReassignPredictionsParams{NewUserID: userID, UserID: guestID}
+	reassignStats := db.ReassignStatsParams{NewUserID: userID, UserID: guestID}
+	reassignRatings := db.ReassignRatingPeriodsParams{UserID: guestID, NewUserID: userID}
+	reassignActivity := db.ReassignDailyActivityParams{UserID: guestID, NewUserID: userID}
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ReassignPredictions(mock.Anything, reassign).Return(nil).Once()
+		mockQ.EXPECT().ReassignRatingPeriods(mock.Anything, reassignRatings).Return(nil).Once()
+		mockQ.EXPECT().ReassignDailyActivity(mock.Anything, reassignActivity).Return(nil).Once()
+		mockQ.EXPECT().DeleteStatsByUserID(mock.Anything, userID).Return(nil).Once()
+		mockQ.EXPECT().ReassignStats(mock.Anything, reassignStats).Return(int64(1), nil).Once()
+		mockQ.EXPECT().DeleteGuestUser(mock.Anything, guestID).Return(int64(1), nil).Once()
+
+		assert.NoError(t, store.ClaimGuest(ctx, guestID, userID))
+	})
+
+	t.Run("guest stats missing", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ReassignPredictions(mock.Anything, reassign).Return(nil).Once()
+		mockQ.EXPECT().ReassignRatingPeriods(mock.Anything, reassignRatings).Return(nil).Once()
+		mockQ.EXPECT().ReassignDailyActivity(mock.Anything, reassignActivity).Return(nil).Once()
+		mockQ.EXPECT().DeleteStatsByUserID(mock.Anything, userID).Return(nil).Once()
+		mockQ.EXPECT().ReassignStats(mock.Anything, reassignStats).Return(int64(0), nil).Once()
+
+		err := store.ClaimGuest(ctx, guestID, userID)
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("not a guest", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ReassignPredictions(mock.Anything, reassign).Return(nil).Once()
+		mockQ.EXPECT().ReassignRatingPeriods(mock.Anything, reassignRatings).Return(nil).Once()
+		mockQ.EXPECT().ReassignDailyActivity(mock.Anything, reassignActivity).Return(nil).Once()
+		mockQ.EXPECT().DeleteStatsByUserID(mock.Anything, userID).Return(nil).Once()
+		mockQ.EXPECT().ReassignStats(mock.Anything, reassignStats).Return(int64(1), nil).Once()
+		mockQ.EXPECT().DeleteGuestUser(mock.Anything, guestID).Return(int64(0), nil).Once()
+
+		err := store.ClaimGuest(ctx, guestID, userID)
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ReassignPredictions(mock.Anything, reassign).Return(errors.New("db down")).Once()
+
+		err := store.ClaimGuest(ctx, guestID, userID)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+
+	t.Run("ratings not moved", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ReassignPredictions(mock.Anything, reassign).Return(nil).Once()
+		mockQ.EXPECT().ReassignRatingPeriods(mock.Anything, reassignRatings).Return(errors.New("db down")).Once()
+
+		err := store.ClaimGuest(ctx, guestID, userID)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+
+	t.Run("activity not moved", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ReassignPredictions(mock.Anything, reassign).Return(nil).Once()
+		mockQ.EXPECT().ReassignRatingPeriods(mock.Anything, reassignRatings).Return(nil).Once()
+		mockQ.EXPECT().ReassignDailyActivity(mock.Anything, reassignActivity).Return(errors.New("db down")).Once()
+
+		err := store.ClaimGuest(ctx, guestID, userID)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestWalkExpiredGuests(t *testing.T) {
+	createdBefore := time.Now().Add(-time.Hour)
+
+	t.Run("pages by guest id", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		firstBatch := make([]uuid.UUID, exportBatchSize)
+		for i := range firstBatch {
+			firstBatch[i] = uuid.New()
+		}
+		mockQ.EXPECT().ListExpiredGuestIDsAfter(mock.Anything, db.ListExpiredGuestIDsAfterParams{
+			CreatedBefore: createdBefore,
+			Limit:         exportBatchSize,
+		}).Return(firstBatch, nil).Once()
+		mockQ.EXPECT().ListExpiredGuestIDsAfter(mock.Anything, db.ListExpiredGuestIDsAfterParams{
+			CreatedBefore: createdBefore,
+			AfterID:       firstBatch[exportBatchSize-1],
+			Limit:         exportBatchSize,
+		}).Return([]uuid.UUID{}, nil).Once()
+
+		var count int
+		require.NoError(t, store.WalkExpiredGuests(context.Background(), createdBefore, func(uuid.UUID) error {
+			count++
+			return nil
+		}))
+		assert.Equal(t, exportBatchSize, count)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ListExpiredGuestIDsAfter(mock.Anything, mock.Anything).
+			Return(nil, errors.New("db down")).Once()
+
+		err := store.WalkExpiredGuests(context.Background(), createdBefore, func(uuid.UUID) error { return nil })
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func (s *pgStore) GetUserIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbIdentity, err := s.q.GetUserIdentity(ctx, db.GetUserIdentityParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("identity not found", "no identity for given provider and subject",
+				map[string]any{"provider": provider})
+		}
+		return nil, errlocal.NewErrInternal("failed to get identity", err.Error(),
+			map[string]any{"provider": provider})
+	}
+
+	var identity models.UserIdentity
+	identity.Model(dbIdentity)
+
+	return &identity, nil
+}
+
+// CreateUserIdentity links the provider subject to identity.UserID. A subject
+// can only be linked once per provider.
+func (s *pgStore) CreateUserIdentity(ctx context.Context, identity *models.UserIdentity) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	id, err := s.q.CreateUserIdentity(ctx, db.CreateUserIdentityParams{
+		UserID:   identity.UserID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "SQLSTATE 23505") {
+			return errlocal.NewErrConflict("identity already linked", err.Error(),
+				map[string]any{"provider": identity.Provider})
+		}
+		return errlocal.NewErrInternal("failed to create identity", err.Error(),
+			map[string]any{"provider": identity.Provider, "user_id": identity.UserID})
+	}
+	identity.ID = id
+
+	return nil
+}
+
+// TouchUserIdentity records a login through the identity and refreshes the
+// email reported by the provider.
+func (s *pgStore) TouchUserIdentity(ctx context.Context, identity *models.UserIdentity) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.TouchUserIdentity(ctx, db.TouchUserIdentityParams{
+		ID:    identity.ID,
+		Email: identity.Email,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to update identity", err.Error(),
+			map[string]any{"identity_id": identity.ID})
+	}
+
+	return nil
+}
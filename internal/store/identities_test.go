@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+)
+
+func TestGetUserIdentity(t *testing.T) {
+	t.Run("Linked identity", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserIdentity(mock.Anything, db.GetUserIdentityParams{
+			Provider: "google",
+			Subject:  "sub-1",
+		}).Return(db.UserIdentity{
+			ID:       uuid.New(),
+			UserID:   testdata.User1ID,
+			Provider: "google",
+			Subject:  "sub-1",
+		}, nil).Once()
+
+		identity, err := store.GetUserIdentity(context.Background(), "google", "sub-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, testdata.User1ID, identity.UserID)
+		assert.Nil(t, identity.LastLoginAt)
+	})
+
+	t.Run("Not linked", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserIdentity(mock.Anything, mock.Anything).Return(db.UserIdentity{}, pgx.ErrNoRows).Once()
+
+		_, err := store.GetUserIdentity(context.Background(), "google", "sub-1")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestCreateUserIdentity(t *testing.T) {
+	t.Run("Link created", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		id := uuid.New()
+		mockQ.EXPECT().CreateUserIdentity(mock.Anything, db.CreateUserIdentityParams{
+			UserID:   testdata.User1ID,
+			Provider: "google",
+			Subject:  "sub-1",
+		}).Return(id, nil).Once()
+
+		identity := &models.UserIdentity{UserID: testdata.User1ID, Provider: "google", Subject: "sub-1"}
+		require.NoError(t, store.CreateUserIdentity(context.Background(), identity))
+		assert.Equal(t, id, identity.ID)
+	})
+
+	t.Run("Subject already linked", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().CreateUserIdentity(mock.Anything, mock.Anything).
+			Return(uuid.Nil, errors.New("duplicate key value violates unique constraint (SQLSTATE 23505)")).Once()
+
+		err := store.CreateUserIdentity(context.Background(),
+			&models.UserIdentity{UserID: testdata.User1ID, Provider: "google", Subject: "sub-1"})
+
+		var conflictErr *errlocal.ErrConflict
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
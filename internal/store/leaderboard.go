@@ -0,0 +1,186 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// GetLeaderboard returns the top limit users of the period containing at.
+// Users who hid themselves, guests, banned and deleted users are not ranked.
+func (s *pgStore) GetLeaderboard(
+	ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32,
+) ([]models.LeaderboardEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	entries := []models.LeaderboardEntry{}
+	if period == models.LeaderboardAllTime {
+		rows, err := s.q.GetRatingLeaderboard(ctx, limit)
+		if err != nil {
+			return nil, errlocal.NewErrInternal("failed to get leaderboard", err.Error(),
+				map[string]any{"period": period})
+		}
+		for _, row := range rows {
+			entries = append(entries, leaderboardEntry(db.GetPeriodRankRow(row)))
+		}
+		return entries, nil
+	}
+
+	rows, err := s.q.GetPeriodLeaderboard(ctx, db.GetPeriodLeaderboardParams{
+		Period:      string(period),
+		PeriodStart: periodStart(period, at),
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get leaderboard", err.Error(),
+			map[string]any{"period": period})
+	}
+	for _, row := range rows {
+		entries = append(entries, leaderboardEntry(db.GetPeriodRankRow(row)))
+	}
+
+	return entries, nil
+}
+
+// GetLeaderboardEntry returns the place of a user on the leaderboard of the
+// period containing at, however far from the top. Users that aren't ranked
+// are not found.
+func (s *pgStore) GetLeaderboardEntry(
+	ctx context.Context, period models.LeaderboardPeriod, at time.Time, userID uuid.UUID,
+) (*models.LeaderboardEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	var row db.GetPeriodRankRow
+	var err error
+	if period == models.LeaderboardAllTime {
+		var ratingRow db.GetRatingRankRow
+		ratingRow, err = s.q.GetRatingRank(ctx, userID)
+		row = db.GetPeriodRankRow(ratingRow)
+	} else {
+		row, err = s.q.GetPeriodRank(ctx, db.GetPeriodRankParams{
+			Period:      string(period),
+			PeriodStart: periodStart(period, at),
+			UserID:      userID,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("user is not ranked", err.Error(),
+				map[string]any{"user_id": userID.String(), "period": period})
+		}
+		return nil, errlocal.NewErrInternal("failed to get leaderboard rank", err.Error(),
+			map[string]any{"user_id": userID.String(), "period": period})
+	}
+
+	entry := leaderboardEntry(row)
+	return &entry, nil
+}
+
+// AddRatingDelta adds delta to the rating the user gained in each of the
+// rating periods containing at.
+func (s *pgStore) AddRatingDelta(ctx context.Context, userID uuid.UUID, delta int, at time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	for _, period := range models.RatingPeriods {
+		if err := s.q.AddRatingDelta(ctx, db.AddRatingDeltaParams{
+			UserID:      userID,
+			Period:      string(period),
+			PeriodStart: periodStart(period, at),
+			RatingDelta: int32(delta),
+		}); err != nil {
+			return errlocal.NewErrInternal("failed to update period rating", err.Error(),
+				map[string]any{"user_id": userID.String(), "period": period})
+		}
+	}
+
+	return nil
+}
+
+// SetLeaderboardHidden hides the user from leaderboards or shows them again.
+func (s *pgStore) SetLeaderboardHidden(ctx context.Context, userID uuid.UUID, hidden bool) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.UpdateUserLeaderboardHidden(ctx, db.UpdateUserLeaderboardHiddenParams{
+		ID:                userID,
+		LeaderboardHidden: hidden,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to update leaderboard visibility", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+
+	return nil
+}
+
+func periodStart(period models.LeaderboardPeriod, at time.Time) pgtype.Date {
+	return pgtype.Date{Time: period.Start(at), Valid: true}
+}
+
+func leaderboardEntry(row db.GetPeriodRankRow) models.LeaderboardEntry {
+	return models.LeaderboardEntry{
+		Rank:   int(row.Rank),
+		UserID: row.UserID,
+		Name:   row.Name,
+		Status: models.UserStatus(row.Status),
+		Score:  int(row.Score),
+	}
+}
+
+// GetOrganizationLeaderboard returns the top limit organizations of the period
+// containing at, by the rating of their members. Deleted and banned members
+// don't count; members who hid themselves from leaderboards do, as they are
+// not named.
+func (s *pgStore) GetOrganizationLeaderboard(
+	ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32,
+) ([]models.OrganizationLeaderboardEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	entries := []models.OrganizationLeaderboardEntry{}
+	if period == models.LeaderboardAllTime {
+		rows, err := s.q.GetOrganizationRatingLeaderboard(ctx, limit)
+		if err != nil {
+			return nil, errlocal.NewErrInternal("failed to get organization leaderboard", err.Error(),
+				map[string]any{"period": period})
+		}
+		for _, row := range rows {
+			entries = append(entries, organizationLeaderboardEntry(db.GetOrganizationPeriodLeaderboardRow(row)))
+		}
+		return entries, nil
+	}
+
+	rows, err := s.q.GetOrganizationPeriodLeaderboard(ctx, db.GetOrganizationPeriodLeaderboardParams{
+		Period:      string(period),
+		PeriodStart: periodStart(period, at),
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get organization leaderboard", err.Error(),
+			map[string]any{"period": period})
+	}
+	for _, row := range rows {
+		entries = append(entries, organizationLeaderboardEntry(row))
+	}
+
+	return entries, nil
+}
+
+func organizationLeaderboardEntry(row db.GetOrganizationPeriodLeaderboardRow) models.OrganizationLeaderboardEntry {
+	return models.OrganizationLeaderboardEntry{
+		Rank:           int(row.Rank),
+		OrganizationID: row.OrganizationID,
+		Name:           row.Name,
+		Members:        int(row.Members),
+		Score:          int(row.Score),
+	}
+}
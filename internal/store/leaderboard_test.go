@@ -0,0 +1,204 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// Wednesday, so the week started on March 9 and the month on March 1.
+var leaderboardNow = time.Date(2026, 3, 11, 15, 0, 0, 0, time.UTC)
+
+func TestGetLeaderboard(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("all time", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetRatingLeaderboard(mock.Anything, int32(10)).Return([]db.GetRatingLeaderboardRow{
+			{Rank: 1, UserID: userID, Name: "user", Status: "eco_scout", Score: 150},
+		}, nil).Once()
+
+		entries, err := store.GetLeaderboard(context.Background(), models.LeaderboardAllTime, leaderboardNow, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.LeaderboardEntry{
+			{Rank: 1, UserID: userID, Name: "user", Status: models.UserStatusEcoScout, Score: 150},
+		}, entries)
+	})
+
+	t.Run("weekly", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetPeriodLeaderboard(mock.Anything, db.GetPeriodLeaderboardParams{
+			Period:      "week",
+			PeriodStart: pgtype.Date{Time: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), Valid: true},
+			Limit:       10,
+		}).Return([]db.GetPeriodLeaderboardRow{}, nil).Once()
+
+		entries, err := store.GetLeaderboard(context.Background(), models.LeaderboardWeekly, leaderboardNow, 10)
+
+		require.NoError(t, err)
+		assert.NotNil(t, entries)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetRatingLeaderboard(mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		_, err := store.GetLeaderboard(context.Background(), models.LeaderboardAllTime, leaderboardNow, 10)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestGetLeaderboardEntry(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("monthly", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetPeriodRank(mock.Anything, db.GetPeriodRankParams{
+			Period:      "month",
+			PeriodStart: pgtype.Date{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+			UserID:      userID,
+		}).Return(db.GetPeriodRankRow{Rank: 42, UserID: userID, Name: "user", Status: "newbie", Score: 30}, nil).Once()
+
+		entry, err := store.GetLeaderboardEntry(context.Background(), models.LeaderboardMonthly, leaderboardNow, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 42, entry.Rank)
+		assert.Equal(t, 30, entry.Score)
+	})
+
+	t.Run("not ranked", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetRatingRank(mock.Anything, userID).Return(db.GetRatingRankRow{}, pgx.ErrNoRows).Once()
+
+		_, err := store.GetLeaderboardEntry(context.Background(), models.LeaderboardAllTime, leaderboardNow, userID)
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestAddRatingDelta(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().AddRatingDelta(mock.Anything, db.AddRatingDeltaParams{
+			UserID:      userID,
+			Period:      "month",
+			PeriodStart: pgtype.Date{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+			RatingDelta: -10,
+		}).Return(nil).Once()
+		mockQ.EXPECT().AddRatingDelta(mock.Anything, db.AddRatingDeltaParams{
+			UserID:      userID,
+			Period:      "week",
+			PeriodStart: pgtype.Date{Time: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), Valid: true},
+			RatingDelta: -10,
+		}).Return(nil).Once()
+
+		assert.NoError(t, store.AddRatingDelta(context.Background(), userID, -10, leaderboardNow))
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().AddRatingDelta(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+		err := store.AddRatingDelta(context.Background(), userID, 10, leaderboardNow)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestLeaderboardPeriodStart(t *testing.T) {
+	sunday := time.Date(2026, 3, 15, 23, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 3, 16, 1, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), models.LeaderboardWeekly.Start(sunday))
+	assert.Equal(t, time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC), models.LeaderboardWeekly.Start(monday))
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), models.LeaderboardMonthly.Start(sunday))
+	assert.True(t, models.LeaderboardAllTime.Start(sunday).IsZero())
+}
+
+func TestGetOrganizationLeaderboard(t *testing.T) {
+	orgID := uuid.New()
+
+	t.Run("all time", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationRatingLeaderboard(mock.Anything, int32(10)).
+			Return([]db.GetOrganizationRatingLeaderboardRow{
+				{Rank: 1, OrganizationID: orgID, Name: "Green School", Members: 12, Score: 1500},
+			}, nil).Once()
+
+		entries, err := store.GetOrganizationLeaderboard(context.Background(), models.LeaderboardAllTime,
+			leaderboardNow, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.OrganizationLeaderboardEntry{
+			{Rank: 1, OrganizationID: orgID, Name: "Green School", Members: 12, Score: 1500},
+		}, entries)
+	})
+
+	t.Run("monthly", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationPeriodLeaderboard(mock.Anything, db.GetOrganizationPeriodLeaderboardParams{
+			Period:      "month",
+			PeriodStart: pgtype.Date{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+			Limit:       10,
+		}).Return([]db.GetOrganizationPeriodLeaderboardRow{}, nil).Once()
+
+		entries, err := store.GetOrganizationLeaderboard(context.Background(), models.LeaderboardMonthly,
+			leaderboardNow, 10)
+
+		require.NoError(t, err)
+		assert.NotNil(t, entries)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationRatingLeaderboard(mock.Anything, mock.Anything).
+			Return(nil, errors.New("db down")).Once()
+
+		_, err := store.GetOrganizationLeaderboard(context.Background(), models.LeaderboardAllTime,
+			leaderboardNow, 10)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
@@ -2,8 +2,11 @@ package store
 
 import (
 	"context"
+	"net/netip"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
@@ -64,3 +67,111 @@ func (s *pgStore) GetLoginHistory(ctx context.Context, userID uuid.UUID) ([]mode
 
 	return res, nil
 }
+
+// ListLoginHistory returns a page of the login attempts of a user matching
+// filter, newest first.
+func (s *pgStore) ListLoginHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	filter models.LoginHistoryFilter,
+	limit, offset int32,
+) ([]models.LoginHistory, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	where := loginHistoryFilterParams(userID, filter)
+	rows, err := s.q.ListLoginHistory(ctx, db.ListLoginHistoryParams{
+		UserID:      where.UserID,
+		Success:     where.Success,
+		CreatedFrom: where.CreatedFrom,
+		CreatedTo:   where.CreatedTo,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get login history", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	res := make([]models.LoginHistory, len(rows))
+	for i := range rows {
+		res[i] = models.LoginHistory(rows[i])
+	}
+
+	return res, nil
+}
+
+func (s *pgStore) CountLoginHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	filter models.LoginHistoryFilter,
+) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	count, err := s.q.CountLoginHistory(ctx, loginHistoryFilterParams(userID, filter))
+	if err != nil {
+		return 0, errlocal.NewErrInternal("failed to count login history", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	return count, nil
+}
+
+func loginHistoryFilterParams(userID uuid.UUID, filter models.LoginHistoryFilter) db.CountLoginHistoryParams {
+	params := db.CountLoginHistoryParams{UserID: userID, Success: filter.Success}
+	if filter.From != nil {
+		params.CreatedFrom = pgtype.Timestamptz{Time: *filter.From, Valid: true}
+	}
+	if filter.To != nil {
+		params.CreatedTo = pgtype.Timestamptz{Time: *filter.To, Valid: true}
+	}
+
+	return params
+}
+
+func (s *pgStore) GetLoginFailures(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+) (*models.LoginFailures, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	row, err := s.q.GetLoginFailuresByUser(ctx, db.GetLoginFailuresByUserParams{
+		UserID: userID,
+		Since:  since,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get login failures", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	return &models.LoginFailures{
+		Count:         int(row.Failures),
+		LastFailureAt: row.LastFailureAt,
+	}, nil
+}
+
+func (s *pgStore) GetLoginFailuresByIP(
+	ctx context.Context,
+	ip netip.Addr,
+	since time.Time,
+) (*models.LoginFailures, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	row, err := s.q.GetLoginFailuresByIP(ctx, db.GetLoginFailuresByIPParams{
+		IpAddress: &ip,
+		Since:     since,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get login failures", err.Error(),
+			map[string]any{"ip_address": ip.String()})
+	}
+
+	return &models.LoginFailures{
+		Count:         int(row.Failures),
+		LastFailureAt: row.LastFailureAt,
+	}, nil
+}
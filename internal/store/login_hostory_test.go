@@ -3,8 +3,10 @@ package store
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
@@ -25,7 +27,7 @@ func TestInsertLoginHistory(t *testing.T) {
 		}
 
 		loginHistory := models.LoginHistory{
-			UserID:    testdata.User1ID,
+			UserID:    pgtype.UUID{Bytes: testdata.User1ID, Valid: true},
 			Success:   true,
 			IpAddress: testdata.LoginHistory1.IpAddress,
 			UserAgent: testdata.LoginHistory1.UserAgent,
@@ -58,7 +60,7 @@ func TestInsertLoginHistory(t *testing.T) {
 
 		failureReason := "Invalid password"
 		loginHistory := models.LoginHistory{
-			UserID:        testdata.User1ID,
+			UserID:        pgtype.UUID{Bytes: testdata.User1ID, Valid: true},
 			Success:       false,
 			FailureReason: &failureReason,
 			IpAddress:     testdata.LoginHistory2.IpAddress,
@@ -67,7 +69,7 @@ func TestInsertLoginHistory(t *testing.T) {
 		}
 
 		mockQ.EXPECT().CreateLoginHistory(mock.Anything, mock.MatchedBy(func(params db.CreateLoginHistoryParams) bool {
-			return params.UserID == testdata.User1ID &&
+			return params.UserID.Bytes == testdata.User1ID &&
 				params.Success == false &&
 				params.FailureReason != nil &&
 				*params.FailureReason == failureReason
@@ -90,12 +92,12 @@ func TestInsertLoginHistory(t *testing.T) {
 
 		newID := uuid.New()
 		loginHistory := models.LoginHistory{
-			UserID:  testdata.User2ID,
+			UserID:  pgtype.UUID{Bytes: testdata.User2ID, Valid: true},
 			Success: true,
 		}
 
 		mockQ.EXPECT().CreateLoginHistory(mock.Anything, db.CreateLoginHistoryParams{
-			UserID:  testdata.User2ID,
+			UserID:  pgtype.UUID{Bytes: testdata.User2ID, Valid: true},
 			Success: true,
 		}).Return(newID, nil).Once()
 
@@ -171,7 +173,7 @@ func TestGetLoginHistory(t *testing.T) {
 		for i := range firstPage {
 			firstPage[i] = db.LoginHistory{
 				ID:      uuid.New(),
-				UserID:  testdata.User1ID,
+				UserID:  pgtype.UUID{Bytes: testdata.User1ID, Valid: true},
 				Success: true,
 			}
 		}
@@ -261,7 +263,7 @@ func TestGetLoginHistory(t *testing.T) {
 		for i := range exactPage {
 			exactPage[i] = db.LoginHistory{
 				ID:      uuid.New(),
-				UserID:  testdata.User1ID,
+				UserID:  pgtype.UUID{Bytes: testdata.User1ID, Valid: true},
 				Success: true,
 			}
 		}
@@ -284,3 +286,107 @@ func TestGetLoginHistory(t *testing.T) {
 		assert.Len(t, history, 100)
 	})
 }
+
+func TestGetLoginFailures(t *testing.T) {
+	since := time.Now().Add(-15 * time.Minute)
+	lastFailure := time.Now().Add(-time.Minute)
+
+	t.Run("By user", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetLoginFailuresByUser(mock.Anything, db.GetLoginFailuresByUserParams{
+			UserID: testdata.User1ID,
+			Since:  since,
+		}).Return(db.GetLoginFailuresByUserRow{Failures: 3, LastFailureAt: lastFailure}, nil).Once()
+
+		failures, err := store.GetLoginFailures(context.Background(), testdata.User1ID, since)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.LoginFailures{Count: 3, LastFailureAt: lastFailure}, failures)
+	})
+
+	t.Run("By IP", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		ip := testdata.TestIPAddress
+
+		mockQ.EXPECT().GetLoginFailuresByIP(mock.Anything, db.GetLoginFailuresByIPParams{
+			IpAddress: &ip,
+			Since:     since,
+		}).Return(db.GetLoginFailuresByIPRow{Failures: 7, LastFailureAt: lastFailure}, nil).Once()
+
+		failures, err := store.GetLoginFailuresByIP(context.Background(), ip, since)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, failures.Count)
+	})
+
+	t.Run("Query fails", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetLoginFailuresByUser(mock.Anything, mock.Anything).
+			Return(db.GetLoginFailuresByUserRow{}, assert.AnError).Once()
+
+		_, err := store.GetLoginFailures(context.Background(), testdata.User1ID, since)
+
+		var localErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &localErr)
+	})
+}
+
+func TestListLoginHistory(t *testing.T) {
+	success := false
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := models.LoginHistoryFilter{Success: &success, From: &from}
+
+	t.Run("filtered page", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ListLoginHistory(mock.Anything, db.ListLoginHistoryParams{
+			UserID:      testdata.User1ID,
+			Success:     &success,
+			CreatedFrom: pgtype.Timestamptz{Time: from, Valid: true},
+			Limit:       10,
+			Offset:      20,
+		}).Return([]db.LoginHistory{testdata.DBLoginHistory1}, nil).Once()
+
+		history, err := store.ListLoginHistory(context.Background(), testdata.User1ID, filter, 10, 20)
+
+		assert.NoError(t, err)
+		assert.Len(t, history, 1)
+		assert.Equal(t, testdata.LoginHistory1ID, history[0].ID)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ListLoginHistory(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+		history, err := store.ListLoginHistory(context.Background(), testdata.User1ID, filter, 10, 0)
+
+		assert.Nil(t, history)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestCountLoginHistory(t *testing.T) {
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().CountLoginHistory(mock.Anything, db.CountLoginHistoryParams{
+		UserID:    testdata.User1ID,
+		CreatedTo: pgtype.Timestamptz{Time: to, Valid: true},
+	}).Return(int64(3), nil).Once()
+
+	count, err := store.CountLoginHistory(context.Background(), testdata.User1ID, models.LoginHistoryFilter{To: &to})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
@@ -0,0 +1,149 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// SaveUserTOTP stores a new, unconfirmed TOTP secret for the user, replacing
+// any previous enrollment.
+func (s *pgStore) SaveUserTOTP(ctx context.Context, userID uuid.UUID, secret string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.UpsertUserTOTP(ctx, db.UpsertUserTOTPParams{
+		UserID: userID,
+		Secret: secret,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to save totp secret", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	return nil
+}
+
+func (s *pgStore) GetUserTOTP(ctx context.Context, userID uuid.UUID) (*models.UserTOTP, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbTOTP, err := s.q.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("totp not enrolled", "no totp for given user",
+				map[string]any{"user_id": userID})
+		}
+		return nil, errlocal.NewErrInternal("failed to get totp", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	var totp models.UserTOTP
+	totp.Model(dbTOTP)
+
+	return &totp, nil
+}
+
+func (s *pgStore) ConfirmUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.ConfirmUserTOTP(ctx, userID)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to confirm totp", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("totp enrollment not found", "no unconfirmed totp for given user",
+			map[string]any{"user_id": userID})
+	}
+
+	return nil
+}
+
+// UseTOTPStep records the time step of an accepted code. It fails with not
+// found when the step is not newer than the last accepted one, so every code
+// is accepted at most once.
+func (s *pgStore) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.UseUserTOTPStep(ctx, db.UseUserTOTPStepParams{
+		UserID: userID,
+		Step:   step,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to record totp step", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("totp code already used", "step is not newer than the last used one",
+			map[string]any{"user_id": userID})
+	}
+
+	return nil
+}
+
+func (s *pgStore) DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.DeleteUserTOTP(ctx, userID); err != nil {
+		return errlocal.NewErrInternal("failed to delete totp", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+	if err := s.q.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return errlocal.NewErrInternal("failed to delete recovery codes", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes drops all recovery codes of the user and stores the
+// given hashes instead. Call it inside ExecTx to keep the swap atomic.
+func (s *pgStore) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return errlocal.NewErrInternal("failed to delete recovery codes", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	for _, hash := range codeHashes {
+		if err := s.q.CreateRecoveryCode(ctx, db.CreateRecoveryCodeParams{
+			UserID:   userID,
+			CodeHash: hash,
+		}); err != nil {
+			return errlocal.NewErrInternal("failed to create recovery code", err.Error(),
+				map[string]any{"user_id": userID})
+		}
+	}
+
+	return nil
+}
+
+func (s *pgStore) ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.ConsumeRecoveryCode(ctx, db.ConsumeRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to consume recovery code", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("recovery code not found", "no unused recovery code with given hash",
+			map[string]any{"user_id": userID})
+	}
+
+	return nil
+}
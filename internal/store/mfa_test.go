@@ -0,0 +1,116 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+)
+
+func TestGetUserTOTP(t *testing.T) {
+	t.Run("Get confirmed totp", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		confirmedAt := time.Now()
+		mockQ.EXPECT().GetUserTOTP(mock.Anything, testdata.User1ID).Return(db.UserTotp{
+			UserID:      testdata.User1ID,
+			Secret:      "SECRET",
+			ConfirmedAt: pgtype.Timestamptz{Time: confirmedAt, Valid: true},
+		}, nil).Once()
+
+		totp, err := store.GetUserTOTP(context.Background(), testdata.User1ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "SECRET", totp.Secret)
+		assert.True(t, totp.Enabled())
+	})
+
+	t.Run("Not enrolled", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetUserTOTP(mock.Anything, testdata.User1ID).Return(db.UserTotp{}, pgx.ErrNoRows).Once()
+
+		_, err := store.GetUserTOTP(context.Background(), testdata.User1ID)
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestUseTOTPStep(t *testing.T) {
+	t.Run("Newer step accepted", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().UseUserTOTPStep(mock.Anything, db.UseUserTOTPStepParams{
+			UserID: testdata.User1ID,
+			Step:   42,
+		}).Return(1, nil).Once()
+
+		assert.NoError(t, store.UseTOTPStep(context.Background(), testdata.User1ID, 42))
+	})
+
+	t.Run("Replayed step rejected", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().UseUserTOTPStep(mock.Anything, mock.Anything).Return(0, nil).Once()
+
+		err := store.UseTOTPStep(context.Background(), testdata.User1ID, 42)
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestReplaceRecoveryCodes(t *testing.T) {
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().DeleteUserRecoveryCodes(mock.Anything, testdata.User1ID).Return(nil).Once()
+	for _, hash := range []string{"hash1", "hash2"} {
+		mockQ.EXPECT().CreateRecoveryCode(mock.Anything, db.CreateRecoveryCodeParams{
+			UserID:   testdata.User1ID,
+			CodeHash: hash,
+		}).Return(nil).Once()
+	}
+
+	assert.NoError(t, store.ReplaceRecoveryCodes(context.Background(), testdata.User1ID, []string{"hash1", "hash2"}))
+}
+
+func TestConsumeRecoveryCode(t *testing.T) {
+	t.Run("Consume code successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ConsumeRecoveryCode(mock.Anything, db.ConsumeRecoveryCodeParams{
+			UserID:   testdata.User1ID,
+			CodeHash: "hash",
+		}).Return(1, nil).Once()
+
+		assert.NoError(t, store.ConsumeRecoveryCode(context.Background(), testdata.User1ID, "hash"))
+	})
+
+	t.Run("Code used or unknown", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ConsumeRecoveryCode(mock.Anything, mock.Anything).Return(0, nil).Once()
+
+		err := store.ConsumeRecoveryCode(context.Background(), testdata.User1ID, "hash")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
@@ -12,6 +12,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	"github.com/trashscanner/trashscanner_api/internal/models"
 	"github.com/trashscanner/trashscanner_api/internal/store"
+	"net/netip"
+	"time"
 )
 
 // NewStore creates a new instance of Store. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -41,6 +43,201 @@ func (_m *Store) EXPECT() *Store_Expecter {
 	return &Store_Expecter{mock: &_m.Mock}
 }
 
+// AddOrganizationMember provides a mock function for the type Store
+func (_mock *Store) AddOrganizationMember(ctx context.Context, member *models.OrganizationMember) error {
+	ret := _mock.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddOrganizationMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.OrganizationMember) error); ok {
+		r0 = returnFunc(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_AddOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddOrganizationMember'
+type Store_AddOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// AddOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *models.OrganizationMember
+func (_e *Store_Expecter) AddOrganizationMember(ctx interface{}, member interface{}) *Store_AddOrganizationMember_Call {
+	return &Store_AddOrganizationMember_Call{Call: _e.mock.On("AddOrganizationMember", ctx, member)}
+}
+
+func (_c *Store_AddOrganizationMember_Call) Run(run func(ctx context.Context, member *models.OrganizationMember)) *Store_AddOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.OrganizationMember
+		if args[1] != nil {
+			arg1 = args[1].(*models.OrganizationMember)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_AddOrganizationMember_Call) Return(err error) *Store_AddOrganizationMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_AddOrganizationMember_Call) RunAndReturn(run func(ctx context.Context, member *models.OrganizationMember) error) *Store_AddOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRatingDelta provides a mock function for the type Store
+func (_mock *Store) AddRatingDelta(ctx context.Context, userID uuid.UUID, delta int, at time.Time) error {
+	ret := _mock.Called(ctx, userID, delta, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRatingDelta")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, delta, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_AddRatingDelta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRatingDelta'
+type Store_AddRatingDelta_Call struct {
+	*mock.Call
+}
+
+// AddRatingDelta is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - delta int
+//   - at time.Time
+func (_e *Store_Expecter) AddRatingDelta(ctx interface{}, userID interface{}, delta interface{}, at interface{}) *Store_AddRatingDelta_Call {
+	return &Store_AddRatingDelta_Call{Call: _e.mock.On("AddRatingDelta", ctx, userID, delta, at)}
+}
+
+func (_c *Store_AddRatingDelta_Call) Run(run func(ctx context.Context, userID uuid.UUID, delta int, at time.Time)) *Store_AddRatingDelta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_AddRatingDelta_Call) Return(err error) *Store_AddRatingDelta_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_AddRatingDelta_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, delta int, at time.Time) error) *Store_AddRatingDelta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BanUser provides a mock function for the type Store
+func (_mock *Store) BanUser(ctx context.Context, id uuid.UUID, until *time.Time, reason string) error {
+	ret := _mock.Called(ctx, id, until, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BanUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, string) error); ok {
+		r0 = returnFunc(ctx, id, until, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_BanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BanUser'
+type Store_BanUser_Call struct {
+	*mock.Call
+}
+
+// BanUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - until *time.Time
+//   - reason string
+func (_e *Store_Expecter) BanUser(ctx interface{}, id interface{}, until interface{}, reason interface{}) *Store_BanUser_Call {
+	return &Store_BanUser_Call{Call: _e.mock.On("BanUser", ctx, id, until, reason)}
+}
+
+func (_c *Store_BanUser_Call) Run(run func(ctx context.Context, id uuid.UUID, until *time.Time, reason string)) *Store_BanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *time.Time
+		if args[2] != nil {
+			arg2 = args[2].(*time.Time)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_BanUser_Call) Return(err error) *Store_BanUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_BanUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, until *time.Time, reason string) error) *Store_BanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BeginTx provides a mock function for the type Store
 func (_mock *Store) BeginTx(ctx context.Context) (pgx.Tx, error) {
 	ret := _mock.Called(ctx)
@@ -103,6 +300,69 @@ func (_c *Store_BeginTx_Call) RunAndReturn(run func(ctx context.Context) (pgx.Tx
 	return _c
 }
 
+// ClaimGuest provides a mock function for the type Store
+func (_mock *Store) ClaimGuest(ctx context.Context, guestID uuid.UUID, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, guestID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimGuest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, guestID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_ClaimGuest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimGuest'
+type Store_ClaimGuest_Call struct {
+	*mock.Call
+}
+
+// ClaimGuest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guestID uuid.UUID
+//   - userID uuid.UUID
+func (_e *Store_Expecter) ClaimGuest(ctx interface{}, guestID interface{}, userID interface{}) *Store_ClaimGuest_Call {
+	return &Store_ClaimGuest_Call{Call: _e.mock.On("ClaimGuest", ctx, guestID, userID)}
+}
+
+func (_c *Store_ClaimGuest_Call) Run(run func(ctx context.Context, guestID uuid.UUID, userID uuid.UUID)) *Store_ClaimGuest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ClaimGuest_Call) Return(err error) *Store_ClaimGuest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_ClaimGuest_Call) RunAndReturn(run func(ctx context.Context, guestID uuid.UUID, userID uuid.UUID) error) *Store_ClaimGuest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type Store
 func (_mock *Store) Close() {
 	_mock.Called()
@@ -205,6 +465,63 @@ func (_c *Store_CompletePrediction_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// ConfirmUserTOTP provides a mock function for the type Store
+func (_mock *Store) ConfirmUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmUserTOTP")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_ConfirmUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmUserTOTP'
+type Store_ConfirmUserTOTP_Call struct {
+	*mock.Call
+}
+
+// ConfirmUserTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) ConfirmUserTOTP(ctx interface{}, userID interface{}) *Store_ConfirmUserTOTP_Call {
+	return &Store_ConfirmUserTOTP_Call{Call: _e.mock.On("ConfirmUserTOTP", ctx, userID)}
+}
+
+func (_c *Store_ConfirmUserTOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_ConfirmUserTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ConfirmUserTOTP_Call) Return(err error) *Store_ConfirmUserTOTP_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_ConfirmUserTOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Store_ConfirmUserTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Conn provides a mock function for the type Store
 func (_mock *Store) Conn() *pgxpool.Pool {
 	ret := _mock.Called()
@@ -251,104 +568,129 @@ func (_c *Store_Conn_Call) RunAndReturn(run func() *pgxpool.Pool) *Store_Conn_Ca
 	return _c
 }
 
-// CountUsers provides a mock function for the type Store
-func (_mock *Store) CountUsers(ctx context.Context) (int64, error) {
-	ret := _mock.Called(ctx)
+// ConsumeEmailVerificationToken provides a mock function for the type Store
+func (_mock *Store) ConsumeEmailVerificationToken(ctx context.Context, tokenHash string) (uuid.UUID, string, error) {
+	ret := _mock.Called(ctx, tokenHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountUsers")
+		panic("no return value specified for ConsumeEmailVerificationToken")
 	}
 
-	var r0 int64
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return returnFunc(ctx)
+	var r0 uuid.UUID
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, string, error)); ok {
+		return returnFunc(ctx, tokenHash)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, tokenHash)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) string); ok {
+		r1 = returnFunc(ctx, tokenHash)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, tokenHash)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// Store_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
-type Store_CountUsers_Call struct {
+// Store_ConsumeEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeEmailVerificationToken'
+type Store_ConsumeEmailVerificationToken_Call struct {
 	*mock.Call
 }
 
-// CountUsers is a helper method to define mock.On call
+// ConsumeEmailVerificationToken is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *Store_Expecter) CountUsers(ctx interface{}) *Store_CountUsers_Call {
-	return &Store_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx)}
+//   - tokenHash string
+func (_e *Store_Expecter) ConsumeEmailVerificationToken(ctx interface{}, tokenHash interface{}) *Store_ConsumeEmailVerificationToken_Call {
+	return &Store_ConsumeEmailVerificationToken_Call{Call: _e.mock.On("ConsumeEmailVerificationToken", ctx, tokenHash)}
 }
 
-func (_c *Store_CountUsers_Call) Run(run func(ctx context.Context)) *Store_CountUsers_Call {
+func (_c *Store_ConsumeEmailVerificationToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Store_ConsumeEmailVerificationToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_CountUsers_Call) Return(n int64, err error) *Store_CountUsers_Call {
-	_c.Call.Return(n, err)
+func (_c *Store_ConsumeEmailVerificationToken_Call) Return(uUID uuid.UUID, s string, err error) *Store_ConsumeEmailVerificationToken_Call {
+	_c.Call.Return(uUID, s, err)
 	return _c
 }
 
-func (_c *Store_CountUsers_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Store_CountUsers_Call {
+func (_c *Store_ConsumeEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (uuid.UUID, string, error)) *Store_ConsumeEmailVerificationToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function for the type Store
-func (_mock *Store) CreateUser(ctx context.Context, user *models.User) error {
-	ret := _mock.Called(ctx, user)
+// ConsumePasswordResetToken provides a mock function for the type Store
+func (_mock *Store) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, tokenHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for ConsumePasswordResetToken")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.User) error); ok {
-		r0 = returnFunc(ctx, user)
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, tokenHash)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Store_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type Store_CreateUser_Call struct {
+// Store_ConsumePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumePasswordResetToken'
+type Store_ConsumePasswordResetToken_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// ConsumePasswordResetToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *models.User
-func (_e *Store_Expecter) CreateUser(ctx interface{}, user interface{}) *Store_CreateUser_Call {
-	return &Store_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user)}
+//   - tokenHash string
+func (_e *Store_Expecter) ConsumePasswordResetToken(ctx interface{}, tokenHash interface{}) *Store_ConsumePasswordResetToken_Call {
+	return &Store_ConsumePasswordResetToken_Call{Call: _e.mock.On("ConsumePasswordResetToken", ctx, tokenHash)}
 }
 
-func (_c *Store_CreateUser_Call) Run(run func(ctx context.Context, user *models.User)) *Store_CreateUser_Call {
+func (_c *Store_ConsumePasswordResetToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Store_ConsumePasswordResetToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *models.User
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*models.User)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -358,46 +700,47 @@ func (_c *Store_CreateUser_Call) Run(run func(ctx context.Context, user *models.
 	return _c
 }
 
-func (_c *Store_CreateUser_Call) Return(err error) *Store_CreateUser_Call {
-	_c.Call.Return(err)
+func (_c *Store_ConsumePasswordResetToken_Call) Return(uUID uuid.UUID, err error) *Store_ConsumePasswordResetToken_Call {
+	_c.Call.Return(uUID, err)
 	return _c
 }
 
-func (_c *Store_CreateUser_Call) RunAndReturn(run func(ctx context.Context, user *models.User) error) *Store_CreateUser_Call {
+func (_c *Store_ConsumePasswordResetToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (uuid.UUID, error)) *Store_ConsumePasswordResetToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function for the type Store
-func (_mock *Store) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	ret := _mock.Called(ctx, id)
+// ConsumeRecoveryCode provides a mock function for the type Store
+func (_mock *Store) ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	ret := _mock.Called(ctx, userID, codeHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for ConsumeRecoveryCode")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, codeHash)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type Store_DeleteUser_Call struct {
+// Store_ConsumeRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeRecoveryCode'
+type Store_ConsumeRecoveryCode_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// ConsumeRecoveryCode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *Store_Expecter) DeleteUser(ctx interface{}, id interface{}) *Store_DeleteUser_Call {
-	return &Store_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+//   - userID uuid.UUID
+//   - codeHash string
+func (_e *Store_Expecter) ConsumeRecoveryCode(ctx interface{}, userID interface{}, codeHash interface{}) *Store_ConsumeRecoveryCode_Call {
+	return &Store_ConsumeRecoveryCode_Call{Call: _e.mock.On("ConsumeRecoveryCode", ctx, userID, codeHash)}
 }
 
-func (_c *Store_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_DeleteUser_Call {
+func (_c *Store_ConsumeRecoveryCode_Call) Run(run func(ctx context.Context, userID uuid.UUID, codeHash string)) *Store_ConsumeRecoveryCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -407,62 +750,76 @@ func (_c *Store_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUID)
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_DeleteUser_Call) Return(err error) *Store_DeleteUser_Call {
+func (_c *Store_ConsumeRecoveryCode_Call) Return(err error) *Store_ConsumeRecoveryCode_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_DeleteUser_Call {
+func (_c *Store_ConsumeRecoveryCode_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, codeHash string) error) *Store_ConsumeRecoveryCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecTx provides a mock function for the type Store
-func (_mock *Store) ExecTx(ctx context.Context, fn func(store.Store) error) error {
-	ret := _mock.Called(ctx, fn)
+// CountAdminPredictions provides a mock function for the type Store
+func (_mock *Store) CountAdminPredictions(ctx context.Context, filter models.PredictionFilter) (int64, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExecTx")
+		panic("no return value specified for CountAdminPredictions")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, func(store.Store) error) error); ok {
-		r0 = returnFunc(ctx, fn)
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.PredictionFilter) (int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.PredictionFilter) int64); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.PredictionFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Store_ExecTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecTx'
-type Store_ExecTx_Call struct {
+// Store_CountAdminPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAdminPredictions'
+type Store_CountAdminPredictions_Call struct {
 	*mock.Call
 }
 
-// ExecTx is a helper method to define mock.On call
+// CountAdminPredictions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fn func(store.Store) error
-func (_e *Store_Expecter) ExecTx(ctx interface{}, fn interface{}) *Store_ExecTx_Call {
-	return &Store_ExecTx_Call{Call: _e.mock.On("ExecTx", ctx, fn)}
+//   - filter models.PredictionFilter
+func (_e *Store_Expecter) CountAdminPredictions(ctx interface{}, filter interface{}) *Store_CountAdminPredictions_Call {
+	return &Store_CountAdminPredictions_Call{Call: _e.mock.On("CountAdminPredictions", ctx, filter)}
 }
 
-func (_c *Store_ExecTx_Call) Run(run func(ctx context.Context, fn func(store.Store) error)) *Store_ExecTx_Call {
+func (_c *Store_CountAdminPredictions_Call) Run(run func(ctx context.Context, filter models.PredictionFilter)) *Store_CountAdminPredictions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 func(store.Store) error
+		var arg1 models.PredictionFilter
 		if args[1] != nil {
-			arg1 = args[1].(func(store.Store) error)
+			arg1 = args[1].(models.PredictionFilter)
 		}
 		run(
 			arg0,
@@ -472,65 +829,63 @@ func (_c *Store_ExecTx_Call) Run(run func(ctx context.Context, fn func(store.Sto
 	return _c
 }
 
-func (_c *Store_ExecTx_Call) Return(err error) *Store_ExecTx_Call {
-	_c.Call.Return(err)
+func (_c *Store_CountAdminPredictions_Call) Return(n int64, err error) *Store_CountAdminPredictions_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Store_ExecTx_Call) RunAndReturn(run func(ctx context.Context, fn func(store.Store) error) error) *Store_ExecTx_Call {
+func (_c *Store_CountAdminPredictions_Call) RunAndReturn(run func(ctx context.Context, filter models.PredictionFilter) (int64, error)) *Store_CountAdminPredictions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAdminUserByID provides a mock function for the type Store
-func (_mock *Store) GetAdminUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
-	ret := _mock.Called(ctx, id)
+// CountAdminUsers provides a mock function for the type Store
+func (_mock *Store) CountAdminUsers(ctx context.Context, filter models.UserFilter) (int64, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAdminUserByID")
+		panic("no return value specified for CountAdminUsers")
 	}
 
-	var r0 *models.User
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.User, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.UserFilter) (int64, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.User); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.UserFilter) int64); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.User)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.UserFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Store_GetAdminUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUserByID'
-type Store_GetAdminUserByID_Call struct {
+// Store_CountAdminUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAdminUsers'
+type Store_CountAdminUsers_Call struct {
 	*mock.Call
 }
 
-// GetAdminUserByID is a helper method to define mock.On call
+// CountAdminUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *Store_Expecter) GetAdminUserByID(ctx interface{}, id interface{}) *Store_GetAdminUserByID_Call {
-	return &Store_GetAdminUserByID_Call{Call: _e.mock.On("GetAdminUserByID", ctx, id)}
+//   - filter models.UserFilter
+func (_e *Store_Expecter) CountAdminUsers(ctx interface{}, filter interface{}) *Store_CountAdminUsers_Call {
+	return &Store_CountAdminUsers_Call{Call: _e.mock.On("CountAdminUsers", ctx, filter)}
 }
 
-func (_c *Store_GetAdminUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetAdminUserByID_Call {
+func (_c *Store_CountAdminUsers_Call) Run(run func(ctx context.Context, filter models.UserFilter)) *Store_CountAdminUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 models.UserFilter
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(models.UserFilter)
 		}
 		run(
 			arg0,
@@ -540,131 +895,122 @@ func (_c *Store_GetAdminUserByID_Call) Run(run func(ctx context.Context, id uuid
 	return _c
 }
 
-func (_c *Store_GetAdminUserByID_Call) Return(user *models.User, err error) *Store_GetAdminUserByID_Call {
-	_c.Call.Return(user, err)
+func (_c *Store_CountAdminUsers_Call) Return(n int64, err error) *Store_CountAdminUsers_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Store_GetAdminUserByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.User, error)) *Store_GetAdminUserByID_Call {
+func (_c *Store_CountAdminUsers_Call) RunAndReturn(run func(ctx context.Context, filter models.UserFilter) (int64, error)) *Store_CountAdminUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAdminUsers provides a mock function for the type Store
-func (_mock *Store) GetAdminUsers(ctx context.Context, limit int32, offset int32) ([]models.User, error) {
-	ret := _mock.Called(ctx, limit, offset)
+// CountAuditEvents provides a mock function for the type Store
+func (_mock *Store) CountAuditEvents(ctx context.Context, filter models.AuditFilter) (int64, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAdminUsers")
+		panic("no return value specified for CountAuditEvents")
 	}
 
-	var r0 []models.User
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int32, int32) ([]models.User, error)); ok {
-		return returnFunc(ctx, limit, offset)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.AuditFilter) (int64, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int32, int32) []models.User); ok {
-		r0 = returnFunc(ctx, limit, offset)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.AuditFilter) int64); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.User)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
-		r1 = returnFunc(ctx, limit, offset)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.AuditFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Store_GetAdminUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUsers'
-type Store_GetAdminUsers_Call struct {
+// Store_CountAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAuditEvents'
+type Store_CountAuditEvents_Call struct {
 	*mock.Call
 }
 
-// GetAdminUsers is a helper method to define mock.On call
+// CountAuditEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - limit int32
-//   - offset int32
-func (_e *Store_Expecter) GetAdminUsers(ctx interface{}, limit interface{}, offset interface{}) *Store_GetAdminUsers_Call {
-	return &Store_GetAdminUsers_Call{Call: _e.mock.On("GetAdminUsers", ctx, limit, offset)}
+//   - filter models.AuditFilter
+func (_e *Store_Expecter) CountAuditEvents(ctx interface{}, filter interface{}) *Store_CountAuditEvents_Call {
+	return &Store_CountAuditEvents_Call{Call: _e.mock.On("CountAuditEvents", ctx, filter)}
 }
 
-func (_c *Store_GetAdminUsers_Call) Run(run func(ctx context.Context, limit int32, offset int32)) *Store_GetAdminUsers_Call {
+func (_c *Store_CountAuditEvents_Call) Run(run func(ctx context.Context, filter models.AuditFilter)) *Store_CountAuditEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int32
+		var arg1 models.AuditFilter
 		if args[1] != nil {
-			arg1 = args[1].(int32)
-		}
-		var arg2 int32
-		if args[2] != nil {
-			arg2 = args[2].(int32)
+			arg1 = args[1].(models.AuditFilter)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_GetAdminUsers_Call) Return(users []models.User, err error) *Store_GetAdminUsers_Call {
-	_c.Call.Return(users, err)
+func (_c *Store_CountAuditEvents_Call) Return(n int64, err error) *Store_CountAuditEvents_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Store_GetAdminUsers_Call) RunAndReturn(run func(ctx context.Context, limit int32, offset int32) ([]models.User, error)) *Store_GetAdminUsers_Call {
+func (_c *Store_CountAuditEvents_Call) RunAndReturn(run func(ctx context.Context, filter models.AuditFilter) (int64, error)) *Store_CountAuditEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLoginHistory provides a mock function for the type Store
-func (_mock *Store) GetLoginHistory(ctx context.Context, userID uuid.UUID) ([]models.LoginHistory, error) {
-	ret := _mock.Called(ctx, userID)
+// CountLoginHistory provides a mock function for the type Store
+func (_mock *Store) CountLoginHistory(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter) (int64, error) {
+	ret := _mock.Called(ctx, userID, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLoginHistory")
+		panic("no return value specified for CountLoginHistory")
 	}
 
-	var r0 []models.LoginHistory
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.LoginHistory, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.LoginHistoryFilter) (int64, error)); ok {
+		return returnFunc(ctx, userID, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.LoginHistory); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.LoginHistoryFilter) int64); ok {
+		r0 = returnFunc(ctx, userID, filter)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.LoginHistory)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.LoginHistoryFilter) error); ok {
+		r1 = returnFunc(ctx, userID, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Store_GetLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginHistory'
-type Store_GetLoginHistory_Call struct {
+// Store_CountLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLoginHistory'
+type Store_CountLoginHistory_Call struct {
 	*mock.Call
 }
 
-// GetLoginHistory is a helper method to define mock.On call
+// CountLoginHistory is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *Store_Expecter) GetLoginHistory(ctx interface{}, userID interface{}) *Store_GetLoginHistory_Call {
-	return &Store_GetLoginHistory_Call{Call: _e.mock.On("GetLoginHistory", ctx, userID)}
+//   - filter models.LoginHistoryFilter
+func (_e *Store_Expecter) CountLoginHistory(ctx interface{}, userID interface{}, filter interface{}) *Store_CountLoginHistory_Call {
+	return &Store_CountLoginHistory_Call{Call: _e.mock.On("CountLoginHistory", ctx, userID, filter)}
 }
 
-func (_c *Store_GetLoginHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_GetLoginHistory_Call {
+func (_c *Store_CountLoginHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter)) *Store_CountLoginHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -674,65 +1020,68 @@ func (_c *Store_GetLoginHistory_Call) Run(run func(ctx context.Context, userID u
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 models.LoginHistoryFilter
+		if args[2] != nil {
+			arg2 = args[2].(models.LoginHistoryFilter)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_GetLoginHistory_Call) Return(loginHistorys []models.LoginHistory, err error) *Store_GetLoginHistory_Call {
-	_c.Call.Return(loginHistorys, err)
+func (_c *Store_CountLoginHistory_Call) Return(n int64, err error) *Store_CountLoginHistory_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Store_GetLoginHistory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.LoginHistory, error)) *Store_GetLoginHistory_Call {
+func (_c *Store_CountLoginHistory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter) (int64, error)) *Store_CountLoginHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrediction provides a mock function for the type Store
-func (_mock *Store) GetPrediction(ctx context.Context, id uuid.UUID) (*models.Prediction, error) {
-	ret := _mock.Called(ctx, id)
+// CountOrganizationOwners provides a mock function for the type Store
+func (_mock *Store) CountOrganizationOwners(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, orgID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrediction")
+		panic("no return value specified for CountOrganizationOwners")
 	}
 
-	var r0 *models.Prediction
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Prediction, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, orgID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Prediction); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, orgID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Prediction)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
+		r1 = returnFunc(ctx, orgID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Store_GetPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrediction'
-type Store_GetPrediction_Call struct {
+// Store_CountOrganizationOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOrganizationOwners'
+type Store_CountOrganizationOwners_Call struct {
 	*mock.Call
 }
 
-// GetPrediction is a helper method to define mock.On call
+// CountOrganizationOwners is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *Store_Expecter) GetPrediction(ctx interface{}, id interface{}) *Store_GetPrediction_Call {
-	return &Store_GetPrediction_Call{Call: _e.mock.On("GetPrediction", ctx, id)}
+//   - orgID uuid.UUID
+func (_e *Store_Expecter) CountOrganizationOwners(ctx interface{}, orgID interface{}) *Store_CountOrganizationOwners_Call {
+	return &Store_CountOrganizationOwners_Call{Call: _e.mock.On("CountOrganizationOwners", ctx, orgID)}
 }
 
-func (_c *Store_GetPrediction_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetPrediction_Call {
+func (_c *Store_CountOrganizationOwners_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *Store_CountOrganizationOwners_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -750,59 +1099,55 @@ func (_c *Store_GetPrediction_Call) Run(run func(ctx context.Context, id uuid.UU
 	return _c
 }
 
-func (_c *Store_GetPrediction_Call) Return(prediction *models.Prediction, err error) *Store_GetPrediction_Call {
-	_c.Call.Return(prediction, err)
+func (_c *Store_CountOrganizationOwners_Call) Return(n int64, err error) *Store_CountOrganizationOwners_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Store_GetPrediction_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.Prediction, error)) *Store_GetPrediction_Call {
+func (_c *Store_CountOrganizationOwners_Call) RunAndReturn(run func(ctx context.Context, orgID uuid.UUID) (int64, error)) *Store_CountOrganizationOwners_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPredictionsByUserID provides a mock function for the type Store
-func (_mock *Store) GetPredictionsByUserID(ctx context.Context, userID uuid.UUID, offset int, limit int) ([]*models.Prediction, error) {
-	ret := _mock.Called(ctx, userID, offset, limit)
+// CountPredictions provides a mock function for the type Store
+func (_mock *Store) CountPredictions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPredictionsByUserID")
+		panic("no return value specified for CountPredictions")
 	}
 
-	var r0 []*models.Prediction
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*models.Prediction, error)); ok {
-		return returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*models.Prediction); ok {
-		r0 = returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Prediction)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Store_GetPredictionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionsByUserID'
-type Store_GetPredictionsByUserID_Call struct {
+// Store_CountPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPredictions'
+type Store_CountPredictions_Call struct {
 	*mock.Call
 }
 
-// GetPredictionsByUserID is a helper method to define mock.On call
+// CountPredictions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-//   - offset int
-//   - limit int
-func (_e *Store_Expecter) GetPredictionsByUserID(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *Store_GetPredictionsByUserID_Call {
-	return &Store_GetPredictionsByUserID_Call{Call: _e.mock.On("GetPredictionsByUserID", ctx, userID, offset, limit)}
+func (_e *Store_Expecter) CountPredictions(ctx interface{}, userID interface{}) *Store_CountPredictions_Call {
+	return &Store_CountPredictions_Call{Call: _e.mock.On("CountPredictions", ctx, userID)}
 }
 
-func (_c *Store_GetPredictionsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID, offset int, limit int)) *Store_GetPredictionsByUserID_Call {
+func (_c *Store_CountPredictions_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_CountPredictions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -812,83 +1157,71 @@ func (_c *Store_GetPredictionsByUserID_Call) Run(run func(ctx context.Context, u
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_GetPredictionsByUserID_Call) Return(predictions []*models.Prediction, err error) *Store_GetPredictionsByUserID_Call {
-	_c.Call.Return(predictions, err)
+func (_c *Store_CountPredictions_Call) Return(n int64, err error) *Store_CountPredictions_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Store_GetPredictionsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, offset int, limit int) ([]*models.Prediction, error)) *Store_GetPredictionsByUserID_Call {
+func (_c *Store_CountPredictions_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (int64, error)) *Store_CountPredictions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRefreshTokenByHash provides a mock function for the type Store
-func (_mock *Store) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
-	ret := _mock.Called(ctx, tokenHash)
+// CountSoleOwnedOrganizations provides a mock function for the type Store
+func (_mock *Store) CountSoleOwnedOrganizations(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRefreshTokenByHash")
+		panic("no return value specified for CountSoleOwnedOrganizations")
 	}
 
-	var r0 *models.RefreshToken
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.RefreshToken, error)); ok {
-		return returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.RefreshToken); ok {
-		r0 = returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.RefreshToken)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Store_GetRefreshTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokenByHash'
-type Store_GetRefreshTokenByHash_Call struct {
+// Store_CountSoleOwnedOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSoleOwnedOrganizations'
+type Store_CountSoleOwnedOrganizations_Call struct {
 	*mock.Call
 }
 
-// GetRefreshTokenByHash is a helper method to define mock.On call
+// CountSoleOwnedOrganizations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenHash string
-func (_e *Store_Expecter) GetRefreshTokenByHash(ctx interface{}, tokenHash interface{}) *Store_GetRefreshTokenByHash_Call {
-	return &Store_GetRefreshTokenByHash_Call{Call: _e.mock.On("GetRefreshTokenByHash", ctx, tokenHash)}
+//   - userID uuid.UUID
+func (_e *Store_Expecter) CountSoleOwnedOrganizations(ctx interface{}, userID interface{}) *Store_CountSoleOwnedOrganizations_Call {
+	return &Store_CountSoleOwnedOrganizations_Call{Call: _e.mock.On("CountSoleOwnedOrganizations", ctx, userID)}
 }
 
-func (_c *Store_GetRefreshTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *Store_GetRefreshTokenByHash_Call {
+func (_c *Store_CountSoleOwnedOrganizations_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_CountSoleOwnedOrganizations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -898,70 +1231,173 @@ func (_c *Store_GetRefreshTokenByHash_Call) Run(run func(ctx context.Context, to
 	return _c
 }
 
-func (_c *Store_GetRefreshTokenByHash_Call) Return(refreshToken *models.RefreshToken, err error) *Store_GetRefreshTokenByHash_Call {
-	_c.Call.Return(refreshToken, err)
+func (_c *Store_CountSoleOwnedOrganizations_Call) Return(n int64, err error) *Store_CountSoleOwnedOrganizations_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Store_GetRefreshTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*models.RefreshToken, error)) *Store_GetRefreshTokenByHash_Call {
+func (_c *Store_CountSoleOwnedOrganizations_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (int64, error)) *Store_CountSoleOwnedOrganizations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function for the type Store
-func (_mock *Store) GetUser(ctx context.Context, id uuid.UUID, withStats bool) (*models.User, error) {
-	ret := _mock.Called(ctx, id, withStats)
+// CreateAPIKey provides a mock function for the type Store
+func (_mock *Store) CreateAPIKey(ctx context.Context, key *models.APIKey) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUser")
+		panic("no return value specified for CreateAPIKey")
 	}
 
-	var r0 *models.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) (*models.User, error)); ok {
-		return returnFunc(ctx, id, withStats)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.APIKey) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) *models.User); ok {
-		r0 = returnFunc(ctx, id, withStats)
+	return r0
+}
+
+// Store_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type Store_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *models.APIKey
+func (_e *Store_Expecter) CreateAPIKey(ctx interface{}, key interface{}) *Store_CreateAPIKey_Call {
+	return &Store_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, key)}
+}
+
+func (_c *Store_CreateAPIKey_Call) Run(run func(ctx context.Context, key *models.APIKey)) *Store_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.APIKey
+		if args[1] != nil {
+			arg1 = args[1].(*models.APIKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_CreateAPIKey_Call) Return(err error) *Store_CreateAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_CreateAPIKey_Call) RunAndReturn(run func(ctx context.Context, key *models.APIKey) error) *Store_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAuditEvent provides a mock function for the type Store
+func (_mock *Store) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.AuditEvent) error); ok {
+		r0 = returnFunc(ctx, event)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.User)
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_CreateAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvent'
+type Store_CreateAuditEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *models.AuditEvent
+func (_e *Store_Expecter) CreateAuditEvent(ctx interface{}, event interface{}) *Store_CreateAuditEvent_Call {
+	return &Store_CreateAuditEvent_Call{Call: _e.mock.On("CreateAuditEvent", ctx, event)}
+}
+
+func (_c *Store_CreateAuditEvent_Call) Run(run func(ctx context.Context, event *models.AuditEvent)) *Store_CreateAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.AuditEvent
+		if args[1] != nil {
+			arg1 = args[1].(*models.AuditEvent)
 		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_CreateAuditEvent_Call) Return(err error) *Store_CreateAuditEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_CreateAuditEvent_Call) RunAndReturn(run func(ctx context.Context, event *models.AuditEvent) error) *Store_CreateAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function for the type Store
+func (_mock *Store) CreateOrganization(ctx context.Context, org *models.Organization, ownerID uuid.UUID) error {
+	ret := _mock.Called(ctx, org, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
-		r1 = returnFunc(ctx, id, withStats)
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Organization, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, org, ownerID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Store_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type Store_GetUser_Call struct {
+// Store_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type Store_CreateOrganization_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// CreateOrganization is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-//   - withStats bool
-func (_e *Store_Expecter) GetUser(ctx interface{}, id interface{}, withStats interface{}) *Store_GetUser_Call {
-	return &Store_GetUser_Call{Call: _e.mock.On("GetUser", ctx, id, withStats)}
+//   - org *models.Organization
+//   - ownerID uuid.UUID
+func (_e *Store_Expecter) CreateOrganization(ctx interface{}, org interface{}, ownerID interface{}) *Store_CreateOrganization_Call {
+	return &Store_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, org, ownerID)}
 }
 
-func (_c *Store_GetUser_Call) Run(run func(ctx context.Context, id uuid.UUID, withStats bool)) *Store_GetUser_Call {
+func (_c *Store_CreateOrganization_Call) Run(run func(ctx context.Context, org *models.Organization, ownerID uuid.UUID)) *Store_CreateOrganization_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 *models.Organization
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(*models.Organization)
 		}
-		var arg2 bool
+		var arg2 uuid.UUID
 		if args[2] != nil {
-			arg2 = args[2].(bool)
+			arg2 = args[2].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -972,65 +1408,4779 @@ func (_c *Store_GetUser_Call) Run(run func(ctx context.Context, id uuid.UUID, wi
 	return _c
 }
 
-func (_c *Store_GetUser_Call) Return(user *models.User, err error) *Store_GetUser_Call {
-	_c.Call.Return(user, err)
+func (_c *Store_CreateOrganization_Call) Return(err error) *Store_CreateOrganization_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_GetUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, withStats bool) (*models.User, error)) *Store_GetUser_Call {
+func (_c *Store_CreateOrganization_Call) RunAndReturn(run func(ctx context.Context, org *models.Organization, ownerID uuid.UUID) error) *Store_CreateOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByLogin provides a mock function for the type Store
-func (_mock *Store) GetUserByLogin(ctx context.Context, login string) (*models.User, error) {
-	ret := _mock.Called(ctx, login)
+// CreateUser provides a mock function for the type Store
+func (_mock *Store) CreateUser(ctx context.Context, user *models.User) error {
+	ret := _mock.Called(ctx, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByLogin")
+		panic("no return value specified for CreateUser")
 	}
 
-	var r0 *models.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
-		return returnFunc(ctx, login)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
-		r0 = returnFunc(ctx, login)
+	return r0
+}
+
+// Store_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type Store_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *models.User
+func (_e *Store_Expecter) CreateUser(ctx interface{}, user interface{}) *Store_CreateUser_Call {
+	return &Store_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user)}
+}
+
+func (_c *Store_CreateUser_Call) Run(run func(ctx context.Context, user *models.User)) *Store_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.User
+		if args[1] != nil {
+			arg1 = args[1].(*models.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_CreateUser_Call) Return(err error) *Store_CreateUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_CreateUser_Call) RunAndReturn(run func(ctx context.Context, user *models.User) error) *Store_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUserIdentity provides a mock function for the type Store
+func (_mock *Store) CreateUserIdentity(ctx context.Context, identity *models.UserIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.User)
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_CreateUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserIdentity'
+type Store_CreateUserIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *models.UserIdentity
+func (_e *Store_Expecter) CreateUserIdentity(ctx interface{}, identity interface{}) *Store_CreateUserIdentity_Call {
+	return &Store_CreateUserIdentity_Call{Call: _e.mock.On("CreateUserIdentity", ctx, identity)}
+}
+
+func (_c *Store_CreateUserIdentity_Call) Run(run func(ctx context.Context, identity *models.UserIdentity)) *Store_CreateUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.UserIdentity
+		if args[1] != nil {
+			arg1 = args[1].(*models.UserIdentity)
 		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_CreateUserIdentity_Call) Return(err error) *Store_CreateUserIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_CreateUserIdentity_Call) RunAndReturn(run func(ctx context.Context, identity *models.UserIdentity) error) *Store_CreateUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganization provides a mock function for the type Store
+func (_mock *Store) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganization")
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, login)
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_DeleteOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganization'
+type Store_DeleteOrganization_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) DeleteOrganization(ctx interface{}, id interface{}) *Store_DeleteOrganization_Call {
+	return &Store_DeleteOrganization_Call{Call: _e.mock.On("DeleteOrganization", ctx, id)}
+}
+
+func (_c *Store_DeleteOrganization_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_DeleteOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_DeleteOrganization_Call) Return(err error) *Store_DeleteOrganization_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_DeleteOrganization_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_DeleteOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type Store
+func (_mock *Store) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type Store_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) DeleteUser(ctx interface{}, id interface{}) *Store_DeleteUser_Call {
+	return &Store_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *Store_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_DeleteUser_Call) Return(err error) *Store_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserTOTP provides a mock function for the type Store
+func (_mock *Store) DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserTOTP")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_DeleteUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserTOTP'
+type Store_DeleteUserTOTP_Call struct {
+	*mock.Call
+}
+
+// DeleteUserTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) DeleteUserTOTP(ctx interface{}, userID interface{}) *Store_DeleteUserTOTP_Call {
+	return &Store_DeleteUserTOTP_Call{Call: _e.mock.On("DeleteUserTOTP", ctx, userID)}
+}
+
+func (_c *Store_DeleteUserTOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_DeleteUserTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_DeleteUserTOTP_Call) Return(err error) *Store_DeleteUserTOTP_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_DeleteUserTOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Store_DeleteUserTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecTx provides a mock function for the type Store
+func (_mock *Store) ExecTx(ctx context.Context, fn func(store.Store) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(store.Store) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_ExecTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecTx'
+type Store_ExecTx_Call struct {
+	*mock.Call
+}
+
+// ExecTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.Store) error
+func (_e *Store_Expecter) ExecTx(ctx interface{}, fn interface{}) *Store_ExecTx_Call {
+	return &Store_ExecTx_Call{Call: _e.mock.On("ExecTx", ctx, fn)}
+}
+
+func (_c *Store_ExecTx_Call) Run(run func(ctx context.Context, fn func(store.Store) error)) *Store_ExecTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(store.Store) error
+		if args[1] != nil {
+			arg1 = args[1].(func(store.Store) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ExecTx_Call) Return(err error) *Store_ExecTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_ExecTx_Call) RunAndReturn(run func(ctx context.Context, fn func(store.Store) error) error) *Store_ExecTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportPredictions provides a mock function for the type Store
+func (_mock *Store) ExportPredictions(ctx context.Context, filter models.PredictionFilter, fn func(models.AdminPrediction) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPredictions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.PredictionFilter, func(models.AdminPrediction) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_ExportPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPredictions'
+type Store_ExportPredictions_Call struct {
+	*mock.Call
+}
+
+// ExportPredictions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.PredictionFilter
+//   - fn func(models.AdminPrediction) error
+func (_e *Store_Expecter) ExportPredictions(ctx interface{}, filter interface{}, fn interface{}) *Store_ExportPredictions_Call {
+	return &Store_ExportPredictions_Call{Call: _e.mock.On("ExportPredictions", ctx, filter, fn)}
+}
+
+func (_c *Store_ExportPredictions_Call) Run(run func(ctx context.Context, filter models.PredictionFilter, fn func(models.AdminPrediction) error)) *Store_ExportPredictions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.PredictionFilter
+		if args[1] != nil {
+			arg1 = args[1].(models.PredictionFilter)
+		}
+		var arg2 func(models.AdminPrediction) error
+		if args[2] != nil {
+			arg2 = args[2].(func(models.AdminPrediction) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ExportPredictions_Call) Return(err error) *Store_ExportPredictions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_ExportPredictions_Call) RunAndReturn(run func(ctx context.Context, filter models.PredictionFilter, fn func(models.AdminPrediction) error) error) *Store_ExportPredictions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUsers provides a mock function for the type Store
+func (_mock *Store) ExportUsers(ctx context.Context, filter models.UserFilter, fn func(models.User) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUsers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.UserFilter, func(models.User) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_ExportUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUsers'
+type Store_ExportUsers_Call struct {
+	*mock.Call
+}
+
+// ExportUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.UserFilter
+//   - fn func(models.User) error
+func (_e *Store_Expecter) ExportUsers(ctx interface{}, filter interface{}, fn interface{}) *Store_ExportUsers_Call {
+	return &Store_ExportUsers_Call{Call: _e.mock.On("ExportUsers", ctx, filter, fn)}
+}
+
+func (_c *Store_ExportUsers_Call) Run(run func(ctx context.Context, filter models.UserFilter, fn func(models.User) error)) *Store_ExportUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.UserFilter
+		if args[1] != nil {
+			arg1 = args[1].(models.UserFilter)
+		}
+		var arg2 func(models.User) error
+		if args[2] != nil {
+			arg2 = args[2].(func(models.User) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ExportUsers_Call) Return(err error) *Store_ExportUsers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_ExportUsers_Call) RunAndReturn(run func(ctx context.Context, filter models.UserFilter, fn func(models.User) error) error) *Store_ExportUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeyByHash provides a mock function for the type Store
+func (_mock *Store) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	ret := _mock.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeyByHash")
+	}
+
+	var r0 *models.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.APIKey, error)); ok {
+		return returnFunc(ctx, keyHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.APIKey); ok {
+		r0 = returnFunc(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeyByHash'
+type Store_GetAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeyByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyHash string
+func (_e *Store_Expecter) GetAPIKeyByHash(ctx interface{}, keyHash interface{}) *Store_GetAPIKeyByHash_Call {
+	return &Store_GetAPIKeyByHash_Call{Call: _e.mock.On("GetAPIKeyByHash", ctx, keyHash)}
+}
+
+func (_c *Store_GetAPIKeyByHash_Call) Run(run func(ctx context.Context, keyHash string)) *Store_GetAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetAPIKeyByHash_Call) Return(aPIKey *models.APIKey, err error) *Store_GetAPIKeyByHash_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *Store_GetAPIKeyByHash_Call) RunAndReturn(run func(ctx context.Context, keyHash string) (*models.APIKey, error)) *Store_GetAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveSessions provides a mock function for the type Store
+func (_mock *Store) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]models.RefreshToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSessions")
+	}
+
+	var r0 []models.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RefreshToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RefreshToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetActiveSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSessions'
+type Store_GetActiveSessions_Call struct {
+	*mock.Call
+}
+
+// GetActiveSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) GetActiveSessions(ctx interface{}, userID interface{}) *Store_GetActiveSessions_Call {
+	return &Store_GetActiveSessions_Call{Call: _e.mock.On("GetActiveSessions", ctx, userID)}
+}
+
+func (_c *Store_GetActiveSessions_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_GetActiveSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetActiveSessions_Call) Return(refreshTokens []models.RefreshToken, err error) *Store_GetActiveSessions_Call {
+	_c.Call.Return(refreshTokens, err)
+	return _c
+}
+
+func (_c *Store_GetActiveSessions_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.RefreshToken, error)) *Store_GetActiveSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActivity provides a mock function for the type Store
+func (_mock *Store) GetActivity(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time) ([]models.ActivityDay, error) {
+	ret := _mock.Called(ctx, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivity")
+	}
+
+	var r0 []models.ActivityDay
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) ([]models.ActivityDay, error)); ok {
+		return returnFunc(ctx, userID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) []models.ActivityDay); ok {
+		r0 = returnFunc(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ActivityDay)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivity'
+type Store_GetActivity_Call struct {
+	*mock.Call
+}
+
+// GetActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *Store_Expecter) GetActivity(ctx interface{}, userID interface{}, from interface{}, to interface{}) *Store_GetActivity_Call {
+	return &Store_GetActivity_Call{Call: _e.mock.On("GetActivity", ctx, userID, from, to)}
+}
+
+func (_c *Store_GetActivity_Call) Run(run func(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time)) *Store_GetActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetActivity_Call) Return(activityDays []models.ActivityDay, err error) *Store_GetActivity_Call {
+	_c.Call.Return(activityDays, err)
+	return _c
+}
+
+func (_c *Store_GetActivity_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time) ([]models.ActivityDay, error)) *Store_GetActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAdminUserByID provides a mock function for the type Store
+func (_mock *Store) GetAdminUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminUserByID")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetAdminUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUserByID'
+type Store_GetAdminUserByID_Call struct {
+	*mock.Call
+}
+
+// GetAdminUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) GetAdminUserByID(ctx interface{}, id interface{}) *Store_GetAdminUserByID_Call {
+	return &Store_GetAdminUserByID_Call{Call: _e.mock.On("GetAdminUserByID", ctx, id)}
+}
+
+func (_c *Store_GetAdminUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetAdminUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetAdminUserByID_Call) Return(user *models.User, err error) *Store_GetAdminUserByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Store_GetAdminUserByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.User, error)) *Store_GetAdminUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAdminUsers provides a mock function for the type Store
+func (_mock *Store) GetAdminUsers(ctx context.Context, filter models.UserFilter, limit int32, offset int32) ([]models.User, error) {
+	ret := _mock.Called(ctx, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminUsers")
+	}
+
+	var r0 []models.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.UserFilter, int32, int32) ([]models.User, error)); ok {
+		return returnFunc(ctx, filter, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.UserFilter, int32, int32) []models.User); ok {
+		r0 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.UserFilter, int32, int32) error); ok {
+		r1 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetAdminUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminUsers'
+type Store_GetAdminUsers_Call struct {
+	*mock.Call
+}
+
+// GetAdminUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.UserFilter
+//   - limit int32
+//   - offset int32
+func (_e *Store_Expecter) GetAdminUsers(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *Store_GetAdminUsers_Call {
+	return &Store_GetAdminUsers_Call{Call: _e.mock.On("GetAdminUsers", ctx, filter, limit, offset)}
+}
+
+func (_c *Store_GetAdminUsers_Call) Run(run func(ctx context.Context, filter models.UserFilter, limit int32, offset int32)) *Store_GetAdminUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.UserFilter
+		if args[1] != nil {
+			arg1 = args[1].(models.UserFilter)
+		}
+		var arg2 int32
+		if args[2] != nil {
+			arg2 = args[2].(int32)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetAdminUsers_Call) Return(users []models.User, err error) *Store_GetAdminUsers_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *Store_GetAdminUsers_Call) RunAndReturn(run func(ctx context.Context, filter models.UserFilter, limit int32, offset int32) ([]models.User, error)) *Store_GetAdminUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnalytics provides a mock function for the type Store
+func (_mock *Store) GetAnalytics(ctx context.Context, filter models.AnalyticsFilter) (*models.Analytics, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalytics")
+	}
+
+	var r0 *models.Analytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.AnalyticsFilter) (*models.Analytics, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.AnalyticsFilter) *models.Analytics); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Analytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalytics'
+type Store_GetAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetAnalytics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.AnalyticsFilter
+func (_e *Store_Expecter) GetAnalytics(ctx interface{}, filter interface{}) *Store_GetAnalytics_Call {
+	return &Store_GetAnalytics_Call{Call: _e.mock.On("GetAnalytics", ctx, filter)}
+}
+
+func (_c *Store_GetAnalytics_Call) Run(run func(ctx context.Context, filter models.AnalyticsFilter)) *Store_GetAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(models.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetAnalytics_Call) Return(analytics *models.Analytics, err error) *Store_GetAnalytics_Call {
+	_c.Call.Return(analytics, err)
+	return _c
+}
+
+func (_c *Store_GetAnalytics_Call) RunAndReturn(run func(ctx context.Context, filter models.AnalyticsFilter) (*models.Analytics, error)) *Store_GetAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaderboard provides a mock function for the type Store
+func (_mock *Store) GetLeaderboard(ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32) ([]models.LeaderboardEntry, error) {
+	ret := _mock.Called(ctx, period, at, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderboard")
+	}
+
+	var r0 []models.LeaderboardEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.LeaderboardPeriod, time.Time, int32) ([]models.LeaderboardEntry, error)); ok {
+		return returnFunc(ctx, period, at, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.LeaderboardPeriod, time.Time, int32) []models.LeaderboardEntry); ok {
+		r0 = returnFunc(ctx, period, at, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LeaderboardEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.LeaderboardPeriod, time.Time, int32) error); ok {
+		r1 = returnFunc(ctx, period, at, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderboard'
+type Store_GetLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - period models.LeaderboardPeriod
+//   - at time.Time
+//   - limit int32
+func (_e *Store_Expecter) GetLeaderboard(ctx interface{}, period interface{}, at interface{}, limit interface{}) *Store_GetLeaderboard_Call {
+	return &Store_GetLeaderboard_Call{Call: _e.mock.On("GetLeaderboard", ctx, period, at, limit)}
+}
+
+func (_c *Store_GetLeaderboard_Call) Run(run func(ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32)) *Store_GetLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.LeaderboardPeriod
+		if args[1] != nil {
+			arg1 = args[1].(models.LeaderboardPeriod)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetLeaderboard_Call) Return(leaderboardEntrys []models.LeaderboardEntry, err error) *Store_GetLeaderboard_Call {
+	_c.Call.Return(leaderboardEntrys, err)
+	return _c
+}
+
+func (_c *Store_GetLeaderboard_Call) RunAndReturn(run func(ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32) ([]models.LeaderboardEntry, error)) *Store_GetLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaderboardEntry provides a mock function for the type Store
+func (_mock *Store) GetLeaderboardEntry(ctx context.Context, period models.LeaderboardPeriod, at time.Time, userID uuid.UUID) (*models.LeaderboardEntry, error) {
+	ret := _mock.Called(ctx, period, at, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderboardEntry")
+	}
+
+	var r0 *models.LeaderboardEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.LeaderboardPeriod, time.Time, uuid.UUID) (*models.LeaderboardEntry, error)); ok {
+		return returnFunc(ctx, period, at, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.LeaderboardPeriod, time.Time, uuid.UUID) *models.LeaderboardEntry); ok {
+		r0 = returnFunc(ctx, period, at, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LeaderboardEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.LeaderboardPeriod, time.Time, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, period, at, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetLeaderboardEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderboardEntry'
+type Store_GetLeaderboardEntry_Call struct {
+	*mock.Call
+}
+
+// GetLeaderboardEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - period models.LeaderboardPeriod
+//   - at time.Time
+//   - userID uuid.UUID
+func (_e *Store_Expecter) GetLeaderboardEntry(ctx interface{}, period interface{}, at interface{}, userID interface{}) *Store_GetLeaderboardEntry_Call {
+	return &Store_GetLeaderboardEntry_Call{Call: _e.mock.On("GetLeaderboardEntry", ctx, period, at, userID)}
+}
+
+func (_c *Store_GetLeaderboardEntry_Call) Run(run func(ctx context.Context, period models.LeaderboardPeriod, at time.Time, userID uuid.UUID)) *Store_GetLeaderboardEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.LeaderboardPeriod
+		if args[1] != nil {
+			arg1 = args[1].(models.LeaderboardPeriod)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetLeaderboardEntry_Call) Return(leaderboardEntry *models.LeaderboardEntry, err error) *Store_GetLeaderboardEntry_Call {
+	_c.Call.Return(leaderboardEntry, err)
+	return _c
+}
+
+func (_c *Store_GetLeaderboardEntry_Call) RunAndReturn(run func(ctx context.Context, period models.LeaderboardPeriod, at time.Time, userID uuid.UUID) (*models.LeaderboardEntry, error)) *Store_GetLeaderboardEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginFailures provides a mock function for the type Store
+func (_mock *Store) GetLoginFailures(ctx context.Context, userID uuid.UUID, since time.Time) (*models.LoginFailures, error) {
+	ret := _mock.Called(ctx, userID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginFailures")
+	}
+
+	var r0 *models.LoginFailures
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*models.LoginFailures, error)); ok {
+		return returnFunc(ctx, userID, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *models.LoginFailures); ok {
+		r0 = returnFunc(ctx, userID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoginFailures)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetLoginFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginFailures'
+type Store_GetLoginFailures_Call struct {
+	*mock.Call
+}
+
+// GetLoginFailures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - since time.Time
+func (_e *Store_Expecter) GetLoginFailures(ctx interface{}, userID interface{}, since interface{}) *Store_GetLoginFailures_Call {
+	return &Store_GetLoginFailures_Call{Call: _e.mock.On("GetLoginFailures", ctx, userID, since)}
+}
+
+func (_c *Store_GetLoginFailures_Call) Run(run func(ctx context.Context, userID uuid.UUID, since time.Time)) *Store_GetLoginFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetLoginFailures_Call) Return(loginFailures *models.LoginFailures, err error) *Store_GetLoginFailures_Call {
+	_c.Call.Return(loginFailures, err)
+	return _c
+}
+
+func (_c *Store_GetLoginFailures_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, since time.Time) (*models.LoginFailures, error)) *Store_GetLoginFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginFailuresByIP provides a mock function for the type Store
+func (_mock *Store) GetLoginFailuresByIP(ctx context.Context, ip netip.Addr, since time.Time) (*models.LoginFailures, error) {
+	ret := _mock.Called(ctx, ip, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginFailuresByIP")
+	}
+
+	var r0 *models.LoginFailures
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, netip.Addr, time.Time) (*models.LoginFailures, error)); ok {
+		return returnFunc(ctx, ip, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, netip.Addr, time.Time) *models.LoginFailures); ok {
+		r0 = returnFunc(ctx, ip, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoginFailures)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, netip.Addr, time.Time) error); ok {
+		r1 = returnFunc(ctx, ip, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetLoginFailuresByIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginFailuresByIP'
+type Store_GetLoginFailuresByIP_Call struct {
+	*mock.Call
+}
+
+// GetLoginFailuresByIP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ip netip.Addr
+//   - since time.Time
+func (_e *Store_Expecter) GetLoginFailuresByIP(ctx interface{}, ip interface{}, since interface{}) *Store_GetLoginFailuresByIP_Call {
+	return &Store_GetLoginFailuresByIP_Call{Call: _e.mock.On("GetLoginFailuresByIP", ctx, ip, since)}
+}
+
+func (_c *Store_GetLoginFailuresByIP_Call) Run(run func(ctx context.Context, ip netip.Addr, since time.Time)) *Store_GetLoginFailuresByIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 netip.Addr
+		if args[1] != nil {
+			arg1 = args[1].(netip.Addr)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetLoginFailuresByIP_Call) Return(loginFailures *models.LoginFailures, err error) *Store_GetLoginFailuresByIP_Call {
+	_c.Call.Return(loginFailures, err)
+	return _c
+}
+
+func (_c *Store_GetLoginFailuresByIP_Call) RunAndReturn(run func(ctx context.Context, ip netip.Addr, since time.Time) (*models.LoginFailures, error)) *Store_GetLoginFailuresByIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginHistory provides a mock function for the type Store
+func (_mock *Store) GetLoginHistory(ctx context.Context, userID uuid.UUID) ([]models.LoginHistory, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginHistory")
+	}
+
+	var r0 []models.LoginHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.LoginHistory, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.LoginHistory); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LoginHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginHistory'
+type Store_GetLoginHistory_Call struct {
+	*mock.Call
+}
+
+// GetLoginHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) GetLoginHistory(ctx interface{}, userID interface{}) *Store_GetLoginHistory_Call {
+	return &Store_GetLoginHistory_Call{Call: _e.mock.On("GetLoginHistory", ctx, userID)}
+}
+
+func (_c *Store_GetLoginHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_GetLoginHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetLoginHistory_Call) Return(loginHistorys []models.LoginHistory, err error) *Store_GetLoginHistory_Call {
+	_c.Call.Return(loginHistorys, err)
+	return _c
+}
+
+func (_c *Store_GetLoginHistory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.LoginHistory, error)) *Store_GetLoginHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganization provides a mock function for the type Store
+func (_mock *Store) GetOrganization(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganization")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganization'
+type Store_GetOrganization_Call struct {
+	*mock.Call
+}
+
+// GetOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) GetOrganization(ctx interface{}, id interface{}) *Store_GetOrganization_Call {
+	return &Store_GetOrganization_Call{Call: _e.mock.On("GetOrganization", ctx, id)}
+}
+
+func (_c *Store_GetOrganization_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetOrganization_Call) Return(organization *models.Organization, err error) *Store_GetOrganization_Call {
+	_c.Call.Return(organization, err)
+	return _c
+}
+
+func (_c *Store_GetOrganization_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.Organization, error)) *Store_GetOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationByInviteCode provides a mock function for the type Store
+func (_mock *Store) GetOrganizationByInviteCode(ctx context.Context, code string) (*models.Organization, error) {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationByInviteCode")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.Organization, error)); ok {
+		return returnFunc(ctx, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.Organization); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetOrganizationByInviteCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationByInviteCode'
+type Store_GetOrganizationByInviteCode_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationByInviteCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *Store_Expecter) GetOrganizationByInviteCode(ctx interface{}, code interface{}) *Store_GetOrganizationByInviteCode_Call {
+	return &Store_GetOrganizationByInviteCode_Call{Call: _e.mock.On("GetOrganizationByInviteCode", ctx, code)}
+}
+
+func (_c *Store_GetOrganizationByInviteCode_Call) Run(run func(ctx context.Context, code string)) *Store_GetOrganizationByInviteCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetOrganizationByInviteCode_Call) Return(organization *models.Organization, err error) *Store_GetOrganizationByInviteCode_Call {
+	_c.Call.Return(organization, err)
+	return _c
+}
+
+func (_c *Store_GetOrganizationByInviteCode_Call) RunAndReturn(run func(ctx context.Context, code string) (*models.Organization, error)) *Store_GetOrganizationByInviteCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationForUpdate provides a mock function for the type Store
+func (_mock *Store) GetOrganizationForUpdate(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationForUpdate")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetOrganizationForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationForUpdate'
+type Store_GetOrganizationForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) GetOrganizationForUpdate(ctx interface{}, id interface{}) *Store_GetOrganizationForUpdate_Call {
+	return &Store_GetOrganizationForUpdate_Call{Call: _e.mock.On("GetOrganizationForUpdate", ctx, id)}
+}
+
+func (_c *Store_GetOrganizationForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetOrganizationForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetOrganizationForUpdate_Call) Return(organization *models.Organization, err error) *Store_GetOrganizationForUpdate_Call {
+	_c.Call.Return(organization, err)
+	return _c
+}
+
+func (_c *Store_GetOrganizationForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.Organization, error)) *Store_GetOrganizationForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationLeaderboard provides a mock function for the type Store
+func (_mock *Store) GetOrganizationLeaderboard(ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32) ([]models.OrganizationLeaderboardEntry, error) {
+	ret := _mock.Called(ctx, period, at, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationLeaderboard")
+	}
+
+	var r0 []models.OrganizationLeaderboardEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.LeaderboardPeriod, time.Time, int32) ([]models.OrganizationLeaderboardEntry, error)); ok {
+		return returnFunc(ctx, period, at, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.LeaderboardPeriod, time.Time, int32) []models.OrganizationLeaderboardEntry); ok {
+		r0 = returnFunc(ctx, period, at, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationLeaderboardEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.LeaderboardPeriod, time.Time, int32) error); ok {
+		r1 = returnFunc(ctx, period, at, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetOrganizationLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationLeaderboard'
+type Store_GetOrganizationLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - period models.LeaderboardPeriod
+//   - at time.Time
+//   - limit int32
+func (_e *Store_Expecter) GetOrganizationLeaderboard(ctx interface{}, period interface{}, at interface{}, limit interface{}) *Store_GetOrganizationLeaderboard_Call {
+	return &Store_GetOrganizationLeaderboard_Call{Call: _e.mock.On("GetOrganizationLeaderboard", ctx, period, at, limit)}
+}
+
+func (_c *Store_GetOrganizationLeaderboard_Call) Run(run func(ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32)) *Store_GetOrganizationLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.LeaderboardPeriod
+		if args[1] != nil {
+			arg1 = args[1].(models.LeaderboardPeriod)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetOrganizationLeaderboard_Call) Return(organizationLeaderboardEntrys []models.OrganizationLeaderboardEntry, err error) *Store_GetOrganizationLeaderboard_Call {
+	_c.Call.Return(organizationLeaderboardEntrys, err)
+	return _c
+}
+
+func (_c *Store_GetOrganizationLeaderboard_Call) RunAndReturn(run func(ctx context.Context, period models.LeaderboardPeriod, at time.Time, limit int32) ([]models.OrganizationLeaderboardEntry, error)) *Store_GetOrganizationLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMember provides a mock function for the type Store
+func (_mock *Store) GetOrganizationMember(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (*models.OrganizationMember, error) {
+	ret := _mock.Called(ctx, orgID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMember")
+	}
+
+	var r0 *models.OrganizationMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.OrganizationMember, error)); ok {
+		return returnFunc(ctx, orgID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.OrganizationMember); ok {
+		r0 = returnFunc(ctx, orgID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orgID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMember'
+type Store_GetOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+func (_e *Store_Expecter) GetOrganizationMember(ctx interface{}, orgID interface{}, userID interface{}) *Store_GetOrganizationMember_Call {
+	return &Store_GetOrganizationMember_Call{Call: _e.mock.On("GetOrganizationMember", ctx, orgID, userID)}
+}
+
+func (_c *Store_GetOrganizationMember_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID)) *Store_GetOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetOrganizationMember_Call) Return(organizationMember *models.OrganizationMember, err error) *Store_GetOrganizationMember_Call {
+	_c.Call.Return(organizationMember, err)
+	return _c
+}
+
+func (_c *Store_GetOrganizationMember_Call) RunAndReturn(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (*models.OrganizationMember, error)) *Store_GetOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationStats provides a mock function for the type Store
+func (_mock *Store) GetOrganizationStats(ctx context.Context, orgID uuid.UUID) (*models.OrganizationStats, error) {
+	ret := _mock.Called(ctx, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationStats")
+	}
+
+	var r0 *models.OrganizationStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrganizationStats, error)); ok {
+		return returnFunc(ctx, orgID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrganizationStats); ok {
+		r0 = returnFunc(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetOrganizationStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationStats'
+type Store_GetOrganizationStats_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+func (_e *Store_Expecter) GetOrganizationStats(ctx interface{}, orgID interface{}) *Store_GetOrganizationStats_Call {
+	return &Store_GetOrganizationStats_Call{Call: _e.mock.On("GetOrganizationStats", ctx, orgID)}
+}
+
+func (_c *Store_GetOrganizationStats_Call) Run(run func(ctx context.Context, orgID uuid.UUID)) *Store_GetOrganizationStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetOrganizationStats_Call) Return(organizationStats *models.OrganizationStats, err error) *Store_GetOrganizationStats_Call {
+	_c.Call.Return(organizationStats, err)
+	return _c
+}
+
+func (_c *Store_GetOrganizationStats_Call) RunAndReturn(run func(ctx context.Context, orgID uuid.UUID) (*models.OrganizationStats, error)) *Store_GetOrganizationStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrediction provides a mock function for the type Store
+func (_mock *Store) GetPrediction(ctx context.Context, id uuid.UUID) (*models.Prediction, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrediction")
+	}
+
+	var r0 *models.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Prediction, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Prediction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrediction'
+type Store_GetPrediction_Call struct {
+	*mock.Call
+}
+
+// GetPrediction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) GetPrediction(ctx interface{}, id interface{}) *Store_GetPrediction_Call {
+	return &Store_GetPrediction_Call{Call: _e.mock.On("GetPrediction", ctx, id)}
+}
+
+func (_c *Store_GetPrediction_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetPrediction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetPrediction_Call) Return(prediction *models.Prediction, err error) *Store_GetPrediction_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Store_GetPrediction_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.Prediction, error)) *Store_GetPrediction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictionForUpdate provides a mock function for the type Store
+func (_mock *Store) GetPredictionForUpdate(ctx context.Context, id uuid.UUID) (*models.Prediction, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictionForUpdate")
+	}
+
+	var r0 *models.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Prediction, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Prediction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetPredictionForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionForUpdate'
+type Store_GetPredictionForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetPredictionForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) GetPredictionForUpdate(ctx interface{}, id interface{}) *Store_GetPredictionForUpdate_Call {
+	return &Store_GetPredictionForUpdate_Call{Call: _e.mock.On("GetPredictionForUpdate", ctx, id)}
+}
+
+func (_c *Store_GetPredictionForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetPredictionForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetPredictionForUpdate_Call) Return(prediction *models.Prediction, err error) *Store_GetPredictionForUpdate_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Store_GetPredictionForUpdate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.Prediction, error)) *Store_GetPredictionForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictionsByUserID provides a mock function for the type Store
+func (_mock *Store) GetPredictionsByUserID(ctx context.Context, userID uuid.UUID, offset int, limit int) ([]*models.Prediction, error) {
+	ret := _mock.Called(ctx, userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictionsByUserID")
+	}
+
+	var r0 []*models.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*models.Prediction, error)); ok {
+		return returnFunc(ctx, userID, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*models.Prediction); ok {
+		r0 = returnFunc(ctx, userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetPredictionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictionsByUserID'
+type Store_GetPredictionsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetPredictionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - offset int
+//   - limit int
+func (_e *Store_Expecter) GetPredictionsByUserID(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *Store_GetPredictionsByUserID_Call {
+	return &Store_GetPredictionsByUserID_Call{Call: _e.mock.On("GetPredictionsByUserID", ctx, userID, offset, limit)}
+}
+
+func (_c *Store_GetPredictionsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID, offset int, limit int)) *Store_GetPredictionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetPredictionsByUserID_Call) Return(predictions []*models.Prediction, err error) *Store_GetPredictionsByUserID_Call {
+	_c.Call.Return(predictions, err)
+	return _c
+}
+
+func (_c *Store_GetPredictionsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, offset int, limit int) ([]*models.Prediction, error)) *Store_GetPredictionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshTokenByHash provides a mock function for the type Store
+func (_mock *Store) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokenByHash")
+	}
+
+	var r0 *models.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.RefreshToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.RefreshToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetRefreshTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokenByHash'
+type Store_GetRefreshTokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetRefreshTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Store_Expecter) GetRefreshTokenByHash(ctx interface{}, tokenHash interface{}) *Store_GetRefreshTokenByHash_Call {
+	return &Store_GetRefreshTokenByHash_Call{Call: _e.mock.On("GetRefreshTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *Store_GetRefreshTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *Store_GetRefreshTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetRefreshTokenByHash_Call) Return(refreshToken *models.RefreshToken, err error) *Store_GetRefreshTokenByHash_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *Store_GetRefreshTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*models.RefreshToken, error)) *Store_GetRefreshTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatsHistory provides a mock function for the type Store
+func (_mock *Store) GetStatsHistory(ctx context.Context, statID uuid.UUID, from time.Time, to time.Time) ([]models.StatSnapshot, error) {
+	ret := _mock.Called(ctx, statID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsHistory")
+	}
+
+	var r0 []models.StatSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) ([]models.StatSnapshot, error)); ok {
+		return returnFunc(ctx, statID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) []models.StatSnapshot); ok {
+		r0 = returnFunc(ctx, statID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StatSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, statID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetStatsHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatsHistory'
+type Store_GetStatsHistory_Call struct {
+	*mock.Call
+}
+
+// GetStatsHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - statID uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *Store_Expecter) GetStatsHistory(ctx interface{}, statID interface{}, from interface{}, to interface{}) *Store_GetStatsHistory_Call {
+	return &Store_GetStatsHistory_Call{Call: _e.mock.On("GetStatsHistory", ctx, statID, from, to)}
+}
+
+func (_c *Store_GetStatsHistory_Call) Run(run func(ctx context.Context, statID uuid.UUID, from time.Time, to time.Time)) *Store_GetStatsHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetStatsHistory_Call) Return(statSnapshots []models.StatSnapshot, err error) *Store_GetStatsHistory_Call {
+	_c.Call.Return(statSnapshots, err)
+	return _c
+}
+
+func (_c *Store_GetStatsHistory_Call) RunAndReturn(run func(ctx context.Context, statID uuid.UUID, from time.Time, to time.Time) ([]models.StatSnapshot, error)) *Store_GetStatsHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function for the type Store
+func (_mock *Store) GetUser(ctx context.Context, id uuid.UUID, withStats bool) (*models.User, error) {
+	ret := _mock.Called(ctx, id, withStats)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) (*models.User, error)); ok {
+		return returnFunc(ctx, id, withStats)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) *models.User); ok {
+		r0 = returnFunc(ctx, id, withStats)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, id, withStats)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type Store_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - withStats bool
+func (_e *Store_Expecter) GetUser(ctx interface{}, id interface{}, withStats interface{}) *Store_GetUser_Call {
+	return &Store_GetUser_Call{Call: _e.mock.On("GetUser", ctx, id, withStats)}
+}
+
+func (_c *Store_GetUser_Call) Run(run func(ctx context.Context, id uuid.UUID, withStats bool)) *Store_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetUser_Call) Return(user *models.User, err error) *Store_GetUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Store_GetUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, withStats bool) (*models.User, error)) *Store_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function for the type Store
+func (_mock *Store) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type Store_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *Store_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *Store_GetUserByEmail_Call {
+	return &Store_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *Store_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *Store_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetUserByEmail_Call) Return(user *models.User, err error) *Store_GetUserByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Store_GetUserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*models.User, error)) *Store_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByLogin provides a mock function for the type Store
+func (_mock *Store) GetUserByLogin(ctx context.Context, login string) (*models.User, error) {
+	ret := _mock.Called(ctx, login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByLogin")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
+		return returnFunc(ctx, login)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
+		r0 = returnFunc(ctx, login)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, login)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
+type Store_GetUserByLogin_Call struct {
+	*mock.Call
+}
+
+// GetUserByLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+func (_e *Store_Expecter) GetUserByLogin(ctx interface{}, login interface{}) *Store_GetUserByLogin_Call {
+	return &Store_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", ctx, login)}
+}
+
+func (_c *Store_GetUserByLogin_Call) Run(run func(ctx context.Context, login string)) *Store_GetUserByLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetUserByLogin_Call) Return(user *models.User, err error) *Store_GetUserByLogin_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Store_GetUserByLogin_Call) RunAndReturn(run func(ctx context.Context, login string) (*models.User, error)) *Store_GetUserByLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentity provides a mock function for the type Store
+func (_mock *Store) GetUserIdentity(ctx context.Context, provider string, subject string) (*models.UserIdentity, error) {
+	ret := _mock.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentity")
+	}
+
+	var r0 *models.UserIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*models.UserIdentity, error)); ok {
+		return returnFunc(ctx, provider, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *models.UserIdentity); ok {
+		r0 = returnFunc(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentity'
+type Store_GetUserIdentity_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *Store_Expecter) GetUserIdentity(ctx interface{}, provider interface{}, subject interface{}) *Store_GetUserIdentity_Call {
+	return &Store_GetUserIdentity_Call{Call: _e.mock.On("GetUserIdentity", ctx, provider, subject)}
+}
+
+func (_c *Store_GetUserIdentity_Call) Run(run func(ctx context.Context, provider string, subject string)) *Store_GetUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetUserIdentity_Call) Return(userIdentity *models.UserIdentity, err error) *Store_GetUserIdentity_Call {
+	_c.Call.Return(userIdentity, err)
+	return _c
+}
+
+func (_c *Store_GetUserIdentity_Call) RunAndReturn(run func(ctx context.Context, provider string, subject string) (*models.UserIdentity, error)) *Store_GetUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserTOTP provides a mock function for the type Store
+func (_mock *Store) GetUserTOTP(ctx context.Context, userID uuid.UUID) (*models.UserTOTP, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserTOTP")
+	}
+
+	var r0 *models.UserTOTP
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.UserTOTP, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.UserTOTP); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserTOTP)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_GetUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTOTP'
+type Store_GetUserTOTP_Call struct {
+	*mock.Call
+}
+
+// GetUserTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) GetUserTOTP(ctx interface{}, userID interface{}) *Store_GetUserTOTP_Call {
+	return &Store_GetUserTOTP_Call{Call: _e.mock.On("GetUserTOTP", ctx, userID)}
+}
+
+func (_c *Store_GetUserTOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_GetUserTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_GetUserTOTP_Call) Return(userTOTP *models.UserTOTP, err error) *Store_GetUserTOTP_Call {
+	_c.Call.Return(userTOTP, err)
+	return _c
+}
+
+func (_c *Store_GetUserTOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*models.UserTOTP, error)) *Store_GetUserTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertEmailVerificationToken provides a mock function for the type Store
+func (_mock *Store) InsertEmailVerificationToken(ctx context.Context, token *models.EmailVerificationToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertEmailVerificationToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.EmailVerificationToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_InsertEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertEmailVerificationToken'
+type Store_InsertEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// InsertEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *models.EmailVerificationToken
+func (_e *Store_Expecter) InsertEmailVerificationToken(ctx interface{}, token interface{}) *Store_InsertEmailVerificationToken_Call {
+	return &Store_InsertEmailVerificationToken_Call{Call: _e.mock.On("InsertEmailVerificationToken", ctx, token)}
+}
+
+func (_c *Store_InsertEmailVerificationToken_Call) Run(run func(ctx context.Context, token *models.EmailVerificationToken)) *Store_InsertEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.EmailVerificationToken
+		if args[1] != nil {
+			arg1 = args[1].(*models.EmailVerificationToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_InsertEmailVerificationToken_Call) Return(err error) *Store_InsertEmailVerificationToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_InsertEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, token *models.EmailVerificationToken) error) *Store_InsertEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertLoginHistory provides a mock function for the type Store
+func (_mock *Store) InsertLoginHistory(ctx context.Context, loginHistory *models.LoginHistory) error {
+	ret := _mock.Called(ctx, loginHistory)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertLoginHistory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.LoginHistory) error); ok {
+		r0 = returnFunc(ctx, loginHistory)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_InsertLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertLoginHistory'
+type Store_InsertLoginHistory_Call struct {
+	*mock.Call
+}
+
+// InsertLoginHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loginHistory *models.LoginHistory
+func (_e *Store_Expecter) InsertLoginHistory(ctx interface{}, loginHistory interface{}) *Store_InsertLoginHistory_Call {
+	return &Store_InsertLoginHistory_Call{Call: _e.mock.On("InsertLoginHistory", ctx, loginHistory)}
+}
+
+func (_c *Store_InsertLoginHistory_Call) Run(run func(ctx context.Context, loginHistory *models.LoginHistory)) *Store_InsertLoginHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.LoginHistory
+		if args[1] != nil {
+			arg1 = args[1].(*models.LoginHistory)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_InsertLoginHistory_Call) Return(err error) *Store_InsertLoginHistory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_InsertLoginHistory_Call) RunAndReturn(run func(ctx context.Context, loginHistory *models.LoginHistory) error) *Store_InsertLoginHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertPasswordResetToken provides a mock function for the type Store
+func (_mock *Store) InsertPasswordResetToken(ctx context.Context, token *models.PasswordResetToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertPasswordResetToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.PasswordResetToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_InsertPasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertPasswordResetToken'
+type Store_InsertPasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// InsertPasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *models.PasswordResetToken
+func (_e *Store_Expecter) InsertPasswordResetToken(ctx interface{}, token interface{}) *Store_InsertPasswordResetToken_Call {
+	return &Store_InsertPasswordResetToken_Call{Call: _e.mock.On("InsertPasswordResetToken", ctx, token)}
+}
+
+func (_c *Store_InsertPasswordResetToken_Call) Run(run func(ctx context.Context, token *models.PasswordResetToken)) *Store_InsertPasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.PasswordResetToken
+		if args[1] != nil {
+			arg1 = args[1].(*models.PasswordResetToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_InsertPasswordResetToken_Call) Return(err error) *Store_InsertPasswordResetToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_InsertPasswordResetToken_Call) RunAndReturn(run func(ctx context.Context, token *models.PasswordResetToken) error) *Store_InsertPasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertRefreshToken provides a mock function for the type Store
+func (_mock *Store) InsertRefreshToken(ctx context.Context, refreshToken *models.RefreshToken) error {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_InsertRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertRefreshToken'
+type Store_InsertRefreshToken_Call struct {
+	*mock.Call
+}
+
+// InsertRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken *models.RefreshToken
+func (_e *Store_Expecter) InsertRefreshToken(ctx interface{}, refreshToken interface{}) *Store_InsertRefreshToken_Call {
+	return &Store_InsertRefreshToken_Call{Call: _e.mock.On("InsertRefreshToken", ctx, refreshToken)}
+}
+
+func (_c *Store_InsertRefreshToken_Call) Run(run func(ctx context.Context, refreshToken *models.RefreshToken)) *Store_InsertRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.RefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(*models.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_InsertRefreshToken_Call) Return(err error) *Store_InsertRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_InsertRefreshToken_Call) RunAndReturn(run func(ctx context.Context, refreshToken *models.RefreshToken) error) *Store_InsertRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateEmailVerificationTokens provides a mock function for the type Store
+func (_mock *Store) InvalidateEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateEmailVerificationTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_InvalidateEmailVerificationTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateEmailVerificationTokens'
+type Store_InvalidateEmailVerificationTokens_Call struct {
+	*mock.Call
+}
+
+// InvalidateEmailVerificationTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) InvalidateEmailVerificationTokens(ctx interface{}, userID interface{}) *Store_InvalidateEmailVerificationTokens_Call {
+	return &Store_InvalidateEmailVerificationTokens_Call{Call: _e.mock.On("InvalidateEmailVerificationTokens", ctx, userID)}
+}
+
+func (_c *Store_InvalidateEmailVerificationTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_InvalidateEmailVerificationTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_InvalidateEmailVerificationTokens_Call) Return(err error) *Store_InvalidateEmailVerificationTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_InvalidateEmailVerificationTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Store_InvalidateEmailVerificationTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidatePasswordResetTokens provides a mock function for the type Store
+func (_mock *Store) InvalidatePasswordResetTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidatePasswordResetTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_InvalidatePasswordResetTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidatePasswordResetTokens'
+type Store_InvalidatePasswordResetTokens_Call struct {
+	*mock.Call
+}
+
+// InvalidatePasswordResetTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) InvalidatePasswordResetTokens(ctx interface{}, userID interface{}) *Store_InvalidatePasswordResetTokens_Call {
+	return &Store_InvalidatePasswordResetTokens_Call{Call: _e.mock.On("InvalidatePasswordResetTokens", ctx, userID)}
+}
+
+func (_c *Store_InvalidatePasswordResetTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_InvalidatePasswordResetTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_InvalidatePasswordResetTokens_Call) Return(err error) *Store_InvalidatePasswordResetTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_InvalidatePasswordResetTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Store_InvalidatePasswordResetTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function for the type Store
+func (_mock *Store) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 []models.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.APIKey, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.APIKey); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type Store_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) ListAPIKeys(ctx interface{}, userID interface{}) *Store_ListAPIKeys_Call {
+	return &Store_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx, userID)}
+}
+
+func (_c *Store_ListAPIKeys_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ListAPIKeys_Call) Return(aPIKeys []models.APIKey, err error) *Store_ListAPIKeys_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *Store_ListAPIKeys_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error)) *Store_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAdminPredictions provides a mock function for the type Store
+func (_mock *Store) ListAdminPredictions(ctx context.Context, filter models.PredictionFilter, limit int32, offset int32) ([]models.AdminPrediction, error) {
+	ret := _mock.Called(ctx, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAdminPredictions")
+	}
+
+	var r0 []models.AdminPrediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.PredictionFilter, int32, int32) ([]models.AdminPrediction, error)); ok {
+		return returnFunc(ctx, filter, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.PredictionFilter, int32, int32) []models.AdminPrediction); ok {
+		r0 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AdminPrediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.PredictionFilter, int32, int32) error); ok {
+		r1 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_ListAdminPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAdminPredictions'
+type Store_ListAdminPredictions_Call struct {
+	*mock.Call
+}
+
+// ListAdminPredictions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.PredictionFilter
+//   - limit int32
+//   - offset int32
+func (_e *Store_Expecter) ListAdminPredictions(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *Store_ListAdminPredictions_Call {
+	return &Store_ListAdminPredictions_Call{Call: _e.mock.On("ListAdminPredictions", ctx, filter, limit, offset)}
+}
+
+func (_c *Store_ListAdminPredictions_Call) Run(run func(ctx context.Context, filter models.PredictionFilter, limit int32, offset int32)) *Store_ListAdminPredictions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.PredictionFilter
+		if args[1] != nil {
+			arg1 = args[1].(models.PredictionFilter)
+		}
+		var arg2 int32
+		if args[2] != nil {
+			arg2 = args[2].(int32)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ListAdminPredictions_Call) Return(adminPredictions []models.AdminPrediction, err error) *Store_ListAdminPredictions_Call {
+	_c.Call.Return(adminPredictions, err)
+	return _c
+}
+
+func (_c *Store_ListAdminPredictions_Call) RunAndReturn(run func(ctx context.Context, filter models.PredictionFilter, limit int32, offset int32) ([]models.AdminPrediction, error)) *Store_ListAdminPredictions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuditEvents provides a mock function for the type Store
+func (_mock *Store) ListAuditEvents(ctx context.Context, filter models.AuditFilter, limit int32, offset int32) ([]models.AuditEvent, error) {
+	ret := _mock.Called(ctx, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 []models.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.AuditFilter, int32, int32) ([]models.AuditEvent, error)); ok {
+		return returnFunc(ctx, filter, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.AuditFilter, int32, int32) []models.AuditEvent); ok {
+		r0 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.AuditFilter, int32, int32) error); ok {
+		r1 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type Store_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.AuditFilter
+//   - limit int32
+//   - offset int32
+func (_e *Store_Expecter) ListAuditEvents(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *Store_ListAuditEvents_Call {
+	return &Store_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents", ctx, filter, limit, offset)}
+}
+
+func (_c *Store_ListAuditEvents_Call) Run(run func(ctx context.Context, filter models.AuditFilter, limit int32, offset int32)) *Store_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.AuditFilter
+		if args[1] != nil {
+			arg1 = args[1].(models.AuditFilter)
+		}
+		var arg2 int32
+		if args[2] != nil {
+			arg2 = args[2].(int32)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ListAuditEvents_Call) Return(auditEvents []models.AuditEvent, err error) *Store_ListAuditEvents_Call {
+	_c.Call.Return(auditEvents, err)
+	return _c
+}
+
+func (_c *Store_ListAuditEvents_Call) RunAndReturn(run func(ctx context.Context, filter models.AuditFilter, limit int32, offset int32) ([]models.AuditEvent, error)) *Store_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLoginHistory provides a mock function for the type Store
+func (_mock *Store) ListLoginHistory(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter, limit int32, offset int32) ([]models.LoginHistory, error) {
+	ret := _mock.Called(ctx, userID, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLoginHistory")
+	}
+
+	var r0 []models.LoginHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.LoginHistoryFilter, int32, int32) ([]models.LoginHistory, error)); ok {
+		return returnFunc(ctx, userID, filter, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.LoginHistoryFilter, int32, int32) []models.LoginHistory); ok {
+		r0 = returnFunc(ctx, userID, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LoginHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.LoginHistoryFilter, int32, int32) error); ok {
+		r1 = returnFunc(ctx, userID, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_ListLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoginHistory'
+type Store_ListLoginHistory_Call struct {
+	*mock.Call
+}
+
+// ListLoginHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - filter models.LoginHistoryFilter
+//   - limit int32
+//   - offset int32
+func (_e *Store_Expecter) ListLoginHistory(ctx interface{}, userID interface{}, filter interface{}, limit interface{}, offset interface{}) *Store_ListLoginHistory_Call {
+	return &Store_ListLoginHistory_Call{Call: _e.mock.On("ListLoginHistory", ctx, userID, filter, limit, offset)}
+}
+
+func (_c *Store_ListLoginHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter, limit int32, offset int32)) *Store_ListLoginHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 models.LoginHistoryFilter
+		if args[2] != nil {
+			arg2 = args[2].(models.LoginHistoryFilter)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		var arg4 int32
+		if args[4] != nil {
+			arg4 = args[4].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ListLoginHistory_Call) Return(loginHistorys []models.LoginHistory, err error) *Store_ListLoginHistory_Call {
+	_c.Call.Return(loginHistorys, err)
+	return _c
+}
+
+func (_c *Store_ListLoginHistory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter, limit int32, offset int32) ([]models.LoginHistory, error)) *Store_ListLoginHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizationMembers provides a mock function for the type Store
+func (_mock *Store) ListOrganizationMembers(ctx context.Context, orgID uuid.UUID, activeSince time.Time) ([]models.MemberActivity, error) {
+	ret := _mock.Called(ctx, orgID, activeSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationMembers")
+	}
+
+	var r0 []models.MemberActivity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) ([]models.MemberActivity, error)); ok {
+		return returnFunc(ctx, orgID, activeSince)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) []models.MemberActivity); ok {
+		r0 = returnFunc(ctx, orgID, activeSince)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.MemberActivity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, orgID, activeSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_ListOrganizationMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationMembers'
+type Store_ListOrganizationMembers_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - activeSince time.Time
+func (_e *Store_Expecter) ListOrganizationMembers(ctx interface{}, orgID interface{}, activeSince interface{}) *Store_ListOrganizationMembers_Call {
+	return &Store_ListOrganizationMembers_Call{Call: _e.mock.On("ListOrganizationMembers", ctx, orgID, activeSince)}
+}
+
+func (_c *Store_ListOrganizationMembers_Call) Run(run func(ctx context.Context, orgID uuid.UUID, activeSince time.Time)) *Store_ListOrganizationMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ListOrganizationMembers_Call) Return(memberActivitys []models.MemberActivity, err error) *Store_ListOrganizationMembers_Call {
+	_c.Call.Return(memberActivitys, err)
+	return _c
+}
+
+func (_c *Store_ListOrganizationMembers_Call) RunAndReturn(run func(ctx context.Context, orgID uuid.UUID, activeSince time.Time) ([]models.MemberActivity, error)) *Store_ListOrganizationMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserOrganizations provides a mock function for the type Store
+func (_mock *Store) ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]models.UserOrganization, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserOrganizations")
+	}
+
+	var r0 []models.UserOrganization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.UserOrganization, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.UserOrganization); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.UserOrganization)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_ListUserOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserOrganizations'
+type Store_ListUserOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListUserOrganizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) ListUserOrganizations(ctx interface{}, userID interface{}) *Store_ListUserOrganizations_Call {
+	return &Store_ListUserOrganizations_Call{Call: _e.mock.On("ListUserOrganizations", ctx, userID)}
+}
+
+func (_c *Store_ListUserOrganizations_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_ListUserOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ListUserOrganizations_Call) Return(userOrganizations []models.UserOrganization, err error) *Store_ListUserOrganizations_Call {
+	_c.Call.Return(userOrganizations, err)
+	return _c
+}
+
+func (_c *Store_ListUserOrganizations_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.UserOrganization, error)) *Store_ListUserOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockStats provides a mock function for the type Store
+func (_mock *Store) LockStats(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_LockStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockStats'
+type Store_LockStats_Call struct {
+	*mock.Call
+}
+
+// LockStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) LockStats(ctx interface{}, userID interface{}) *Store_LockStats_Call {
+	return &Store_LockStats_Call{Call: _e.mock.On("LockStats", ctx, userID)}
+}
+
+func (_c *Store_LockStats_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_LockStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_LockStats_Call) Return(err error) *Store_LockStats_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_LockStats_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Store_LockStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeUser provides a mock function for the type Store
+func (_mock *Store) PurgeUser(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_PurgeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeUser'
+type Store_PurgeUser_Call struct {
+	*mock.Call
+}
+
+// PurgeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) PurgeUser(ctx interface{}, id interface{}) *Store_PurgeUser_Call {
+	return &Store_PurgeUser_Call{Call: _e.mock.On("PurgeUser", ctx, id)}
+}
+
+func (_c *Store_PurgeUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_PurgeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_PurgeUser_Call) Return(err error) *Store_PurgeUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_PurgeUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_PurgeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordActivity provides a mock function for the type Store
+func (_mock *Store) RecordActivity(ctx context.Context, userID uuid.UUID, day time.Time) error {
+	ret := _mock.Called(ctx, userID, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordActivity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, day)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_RecordActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordActivity'
+type Store_RecordActivity_Call struct {
+	*mock.Call
+}
+
+// RecordActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - day time.Time
+func (_e *Store_Expecter) RecordActivity(ctx interface{}, userID interface{}, day interface{}) *Store_RecordActivity_Call {
+	return &Store_RecordActivity_Call{Call: _e.mock.On("RecordActivity", ctx, userID, day)}
+}
+
+func (_c *Store_RecordActivity_Call) Run(run func(ctx context.Context, userID uuid.UUID, day time.Time)) *Store_RecordActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_RecordActivity_Call) Return(err error) *Store_RecordActivity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_RecordActivity_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, day time.Time) error) *Store_RecordActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveOrganizationMember provides a mock function for the type Store
+func (_mock *Store) RemoveOrganizationMember(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, orgID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOrganizationMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, orgID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_RemoveOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrganizationMember'
+type Store_RemoveOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// RemoveOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+func (_e *Store_Expecter) RemoveOrganizationMember(ctx interface{}, orgID interface{}, userID interface{}) *Store_RemoveOrganizationMember_Call {
+	return &Store_RemoveOrganizationMember_Call{Call: _e.mock.On("RemoveOrganizationMember", ctx, orgID, userID)}
+}
+
+func (_c *Store_RemoveOrganizationMember_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID)) *Store_RemoveOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_RemoveOrganizationMember_Call) Return(err error) *Store_RemoveOrganizationMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_RemoveOrganizationMember_Call) RunAndReturn(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) error) *Store_RemoveOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceRecoveryCodes provides a mock function for the type Store
+func (_mock *Store) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	ret := _mock.Called(ctx, userID, codeHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceRecoveryCodes")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) error); ok {
+		r0 = returnFunc(ctx, userID, codeHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_ReplaceRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceRecoveryCodes'
+type Store_ReplaceRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// ReplaceRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - codeHashes []string
+func (_e *Store_Expecter) ReplaceRecoveryCodes(ctx interface{}, userID interface{}, codeHashes interface{}) *Store_ReplaceRecoveryCodes_Call {
+	return &Store_ReplaceRecoveryCodes_Call{Call: _e.mock.On("ReplaceRecoveryCodes", ctx, userID, codeHashes)}
+}
+
+func (_c *Store_ReplaceRecoveryCodes_Call) Run(run func(ctx context.Context, userID uuid.UUID, codeHashes []string)) *Store_ReplaceRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_ReplaceRecoveryCodes_Call) Return(err error) *Store_ReplaceRecoveryCodes_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_ReplaceRecoveryCodes_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, codeHashes []string) error) *Store_ReplaceRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreUser provides a mock function for the type Store
+func (_mock *Store) RestoreUser(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type Store_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) RestoreUser(ctx interface{}, id interface{}) *Store_RestoreUser_Call {
+	return &Store_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, id)}
+}
+
+func (_c *Store_RestoreUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_RestoreUser_Call) Return(err error) *Store_RestoreUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_RestoreUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_RestoreUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function for the type Store
+func (_mock *Store) RevokeAPIKey(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type Store_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *Store_Expecter) RevokeAPIKey(ctx interface{}, userID interface{}, id interface{}) *Store_RevokeAPIKey_Call {
+	return &Store_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, userID, id)}
+}
+
+func (_c *Store_RevokeAPIKey_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *Store_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_RevokeAPIKey_Call) Return(err error) *Store_RevokeAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_RevokeAPIKey_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *Store_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllUserTokens provides a mock function for the type Store
+func (_mock *Store) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllUserTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_RevokeAllUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllUserTokens'
+type Store_RevokeAllUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeAllUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *Store_Expecter) RevokeAllUserTokens(ctx interface{}, userID interface{}) *Store_RevokeAllUserTokens_Call {
+	return &Store_RevokeAllUserTokens_Call{Call: _e.mock.On("RevokeAllUserTokens", ctx, userID)}
+}
+
+func (_c *Store_RevokeAllUserTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_RevokeAllUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_RevokeAllUserTokens_Call) Return(err error) *Store_RevokeAllUserTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_RevokeAllUserTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Store_RevokeAllUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRefreshToken provides a mock function for the type Store
+func (_mock *Store) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_RevokeRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshToken'
+type Store_RevokeRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RevokeRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Store_Expecter) RevokeRefreshToken(ctx interface{}, tokenHash interface{}) *Store_RevokeRefreshToken_Call {
+	return &Store_RevokeRefreshToken_Call{Call: _e.mock.On("RevokeRefreshToken", ctx, tokenHash)}
+}
+
+func (_c *Store_RevokeRefreshToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Store_RevokeRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_RevokeRefreshToken_Call) Return(err error) *Store_RevokeRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_RevokeRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) error) *Store_RevokeRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type Store
+func (_mock *Store) RevokeSession(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type Store_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *Store_Expecter) RevokeSession(ctx interface{}, userID interface{}, id interface{}) *Store_RevokeSession_Call {
+	return &Store_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, id)}
+}
+
+func (_c *Store_RevokeSession_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *Store_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_RevokeSession_Call) Return(err error) *Store_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *Store_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUserTOTP provides a mock function for the type Store
+func (_mock *Store) SaveUserTOTP(ctx context.Context, userID uuid.UUID, secret string) error {
+	ret := _mock.Called(ctx, userID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUserTOTP")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_SaveUserTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUserTOTP'
+type Store_SaveUserTOTP_Call struct {
+	*mock.Call
+}
+
+// SaveUserTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - secret string
+func (_e *Store_Expecter) SaveUserTOTP(ctx interface{}, userID interface{}, secret interface{}) *Store_SaveUserTOTP_Call {
+	return &Store_SaveUserTOTP_Call{Call: _e.mock.On("SaveUserTOTP", ctx, userID, secret)}
+}
+
+func (_c *Store_SaveUserTOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID, secret string)) *Store_SaveUserTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SaveUserTOTP_Call) Return(err error) *Store_SaveUserTOTP_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_SaveUserTOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, secret string) error) *Store_SaveUserTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLeaderboardHidden provides a mock function for the type Store
+func (_mock *Store) SetLeaderboardHidden(ctx context.Context, userID uuid.UUID, hidden bool) error {
+	ret := _mock.Called(ctx, userID, hidden)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLeaderboardHidden")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, userID, hidden)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_SetLeaderboardHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLeaderboardHidden'
+type Store_SetLeaderboardHidden_Call struct {
+	*mock.Call
+}
+
+// SetLeaderboardHidden is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - hidden bool
+func (_e *Store_Expecter) SetLeaderboardHidden(ctx interface{}, userID interface{}, hidden interface{}) *Store_SetLeaderboardHidden_Call {
+	return &Store_SetLeaderboardHidden_Call{Call: _e.mock.On("SetLeaderboardHidden", ctx, userID, hidden)}
+}
+
+func (_c *Store_SetLeaderboardHidden_Call) Run(run func(ctx context.Context, userID uuid.UUID, hidden bool)) *Store_SetLeaderboardHidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SetLeaderboardHidden_Call) Return(err error) *Store_SetLeaderboardHidden_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_SetLeaderboardHidden_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, hidden bool) error) *Store_SetLeaderboardHidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOrganizationInviteCode provides a mock function for the type Store
+func (_mock *Store) SetOrganizationInviteCode(ctx context.Context, id uuid.UUID, code string) error {
+	ret := _mock.Called(ctx, id, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOrganizationInviteCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_SetOrganizationInviteCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOrganizationInviteCode'
+type Store_SetOrganizationInviteCode_Call struct {
+	*mock.Call
+}
+
+// SetOrganizationInviteCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - code string
+func (_e *Store_Expecter) SetOrganizationInviteCode(ctx interface{}, id interface{}, code interface{}) *Store_SetOrganizationInviteCode_Call {
+	return &Store_SetOrganizationInviteCode_Call{Call: _e.mock.On("SetOrganizationInviteCode", ctx, id, code)}
+}
+
+func (_c *Store_SetOrganizationInviteCode_Call) Run(run func(ctx context.Context, id uuid.UUID, code string)) *Store_SetOrganizationInviteCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SetOrganizationInviteCode_Call) Return(err error) *Store_SetOrganizationInviteCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_SetOrganizationInviteCode_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, code string) error) *Store_SetOrganizationInviteCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOrganizationMemberRole provides a mock function for the type Store
+func (_mock *Store) SetOrganizationMemberRole(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role models.OrgRole) error {
+	ret := _mock.Called(ctx, orgID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOrganizationMemberRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgRole) error); ok {
+		r0 = returnFunc(ctx, orgID, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_SetOrganizationMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOrganizationMemberRole'
+type Store_SetOrganizationMemberRole_Call struct {
+	*mock.Call
+}
+
+// SetOrganizationMemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+//   - role models.OrgRole
+func (_e *Store_Expecter) SetOrganizationMemberRole(ctx interface{}, orgID interface{}, userID interface{}, role interface{}) *Store_SetOrganizationMemberRole_Call {
+	return &Store_SetOrganizationMemberRole_Call{Call: _e.mock.On("SetOrganizationMemberRole", ctx, orgID, userID, role)}
+}
+
+func (_c *Store_SetOrganizationMemberRole_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role models.OrgRole)) *Store_SetOrganizationMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 models.OrgRole
+		if args[3] != nil {
+			arg3 = args[3].(models.OrgRole)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SetOrganizationMemberRole_Call) Return(err error) *Store_SetOrganizationMemberRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_SetOrganizationMemberRole_Call) RunAndReturn(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role models.OrgRole) error) *Store_SetOrganizationMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPredictionFeedback provides a mock function for the type Store
+func (_mock *Store) SetPredictionFeedback(ctx context.Context, id uuid.UUID, userID uuid.UUID, feedback models.PredictionFeedback) (*models.Prediction, error) {
+	ret := _mock.Called(ctx, id, userID, feedback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPredictionFeedback")
+	}
+
+	var r0 *models.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.PredictionFeedback) (*models.Prediction, error)); ok {
+		return returnFunc(ctx, id, userID, feedback)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.PredictionFeedback) *models.Prediction); ok {
+		r0 = returnFunc(ctx, id, userID, feedback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.PredictionFeedback) error); ok {
+		r1 = returnFunc(ctx, id, userID, feedback)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_SetPredictionFeedback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPredictionFeedback'
+type Store_SetPredictionFeedback_Call struct {
+	*mock.Call
+}
+
+// SetPredictionFeedback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userID uuid.UUID
+//   - feedback models.PredictionFeedback
+func (_e *Store_Expecter) SetPredictionFeedback(ctx interface{}, id interface{}, userID interface{}, feedback interface{}) *Store_SetPredictionFeedback_Call {
+	return &Store_SetPredictionFeedback_Call{Call: _e.mock.On("SetPredictionFeedback", ctx, id, userID, feedback)}
+}
+
+func (_c *Store_SetPredictionFeedback_Call) Run(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID, feedback models.PredictionFeedback)) *Store_SetPredictionFeedback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 models.PredictionFeedback
+		if args[3] != nil {
+			arg3 = args[3].(models.PredictionFeedback)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SetPredictionFeedback_Call) Return(prediction *models.Prediction, err error) *Store_SetPredictionFeedback_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Store_SetPredictionFeedback_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID, feedback models.PredictionFeedback) (*models.Prediction, error)) *Store_SetPredictionFeedback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPredictionVerification provides a mock function for the type Store
+func (_mock *Store) SetPredictionVerification(ctx context.Context, id uuid.UUID, label *string, verifiedBy uuid.UUID) (*models.Prediction, error) {
+	ret := _mock.Called(ctx, id, label, verifiedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPredictionVerification")
+	}
+
+	var r0 *models.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, uuid.UUID) (*models.Prediction, error)); ok {
+		return returnFunc(ctx, id, label, verifiedBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, uuid.UUID) *models.Prediction); ok {
+		r0 = returnFunc(ctx, id, label, verifiedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id, label, verifiedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_SetPredictionVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPredictionVerification'
+type Store_SetPredictionVerification_Call struct {
+	*mock.Call
+}
+
+// SetPredictionVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - label *string
+//   - verifiedBy uuid.UUID
+func (_e *Store_Expecter) SetPredictionVerification(ctx interface{}, id interface{}, label interface{}, verifiedBy interface{}) *Store_SetPredictionVerification_Call {
+	return &Store_SetPredictionVerification_Call{Call: _e.mock.On("SetPredictionVerification", ctx, id, label, verifiedBy)}
+}
+
+func (_c *Store_SetPredictionVerification_Call) Run(run func(ctx context.Context, id uuid.UUID, label *string, verifiedBy uuid.UUID)) *Store_SetPredictionVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SetPredictionVerification_Call) Return(prediction *models.Prediction, err error) *Store_SetPredictionVerification_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Store_SetPredictionVerification_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, label *string, verifiedBy uuid.UUID) (*models.Prediction, error)) *Store_SetPredictionVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserTimezone provides a mock function for the type Store
+func (_mock *Store) SetUserTimezone(ctx context.Context, userID uuid.UUID, timezone string) error {
+	ret := _mock.Called(ctx, userID, timezone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserTimezone")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, timezone)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_SetUserTimezone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserTimezone'
+type Store_SetUserTimezone_Call struct {
+	*mock.Call
+}
+
+// SetUserTimezone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - timezone string
+func (_e *Store_Expecter) SetUserTimezone(ctx interface{}, userID interface{}, timezone interface{}) *Store_SetUserTimezone_Call {
+	return &Store_SetUserTimezone_Call{Call: _e.mock.On("SetUserTimezone", ctx, userID, timezone)}
+}
+
+func (_c *Store_SetUserTimezone_Call) Run(run func(ctx context.Context, userID uuid.UUID, timezone string)) *Store_SetUserTimezone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SetUserTimezone_Call) Return(err error) *Store_SetUserTimezone_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_SetUserTimezone_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, timezone string) error) *Store_SetUserTimezone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SnapshotStats provides a mock function for the type Store
+func (_mock *Store) SnapshotStats(ctx context.Context, stat *models.Stat, day time.Time) error {
+	ret := _mock.Called(ctx, stat, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnapshotStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Stat, time.Time) error); ok {
+		r0 = returnFunc(ctx, stat, day)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_SnapshotStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnapshotStats'
+type Store_SnapshotStats_Call struct {
+	*mock.Call
+}
+
+// SnapshotStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stat *models.Stat
+//   - day time.Time
+func (_e *Store_Expecter) SnapshotStats(ctx interface{}, stat interface{}, day interface{}) *Store_SnapshotStats_Call {
+	return &Store_SnapshotStats_Call{Call: _e.mock.On("SnapshotStats", ctx, stat, day)}
+}
+
+func (_c *Store_SnapshotStats_Call) Run(run func(ctx context.Context, stat *models.Stat, day time.Time)) *Store_SnapshotStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.Stat
+		if args[1] != nil {
+			arg1 = args[1].(*models.Stat)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_SnapshotStats_Call) Return(err error) *Store_SnapshotStats_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_SnapshotStats_Call) RunAndReturn(run func(ctx context.Context, stat *models.Stat, day time.Time) error) *Store_SnapshotStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartPrediction provides a mock function for the type Store
+func (_mock *Store) StartPrediction(ctx context.Context, userID uuid.UUID, scanURL string, weight *float64) (*models.Prediction, error) {
+	ret := _mock.Called(ctx, userID, scanURL, weight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartPrediction")
+	}
+
+	var r0 *models.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *float64) (*models.Prediction, error)); ok {
+		return returnFunc(ctx, userID, scanURL, weight)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *float64) *models.Prediction); ok {
+		r0 = returnFunc(ctx, userID, scanURL, weight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *float64) error); ok {
+		r1 = returnFunc(ctx, userID, scanURL, weight)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_StartPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartPrediction'
+type Store_StartPrediction_Call struct {
+	*mock.Call
+}
+
+// StartPrediction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - scanURL string
+//   - weight *float64
+func (_e *Store_Expecter) StartPrediction(ctx interface{}, userID interface{}, scanURL interface{}, weight interface{}) *Store_StartPrediction_Call {
+	return &Store_StartPrediction_Call{Call: _e.mock.On("StartPrediction", ctx, userID, scanURL, weight)}
+}
+
+func (_c *Store_StartPrediction_Call) Run(run func(ctx context.Context, userID uuid.UUID, scanURL string, weight *float64)) *Store_StartPrediction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *float64
+		if args[3] != nil {
+			arg3 = args[3].(*float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_StartPrediction_Call) Return(prediction *models.Prediction, err error) *Store_StartPrediction_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *Store_StartPrediction_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, scanURL string, weight *float64) (*models.Prediction, error)) *Store_StartPrediction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchAPIKey provides a mock function for the type Store
+func (_mock *Store) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_TouchAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAPIKey'
+type Store_TouchAPIKey_Call struct {
+	*mock.Call
+}
+
+// TouchAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) TouchAPIKey(ctx interface{}, id interface{}) *Store_TouchAPIKey_Call {
+	return &Store_TouchAPIKey_Call{Call: _e.mock.On("TouchAPIKey", ctx, id)}
+}
+
+func (_c *Store_TouchAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_TouchAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_TouchAPIKey_Call) Return(err error) *Store_TouchAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_TouchAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_TouchAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchUserIdentity provides a mock function for the type Store
+func (_mock *Store) TouchUserIdentity(ctx context.Context, identity *models.UserIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchUserIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_TouchUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchUserIdentity'
+type Store_TouchUserIdentity_Call struct {
+	*mock.Call
+}
+
+// TouchUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *models.UserIdentity
+func (_e *Store_Expecter) TouchUserIdentity(ctx interface{}, identity interface{}) *Store_TouchUserIdentity_Call {
+	return &Store_TouchUserIdentity_Call{Call: _e.mock.On("TouchUserIdentity", ctx, identity)}
+}
+
+func (_c *Store_TouchUserIdentity_Call) Run(run func(ctx context.Context, identity *models.UserIdentity)) *Store_TouchUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.UserIdentity
+		if args[1] != nil {
+			arg1 = args[1].(*models.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_TouchUserIdentity_Call) Return(err error) *Store_TouchUserIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_TouchUserIdentity_Call) RunAndReturn(run func(ctx context.Context, identity *models.UserIdentity) error) *Store_TouchUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnbanUser provides a mock function for the type Store
+func (_mock *Store) UnbanUser(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnbanUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_UnbanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnbanUser'
+type Store_UnbanUser_Call struct {
+	*mock.Call
+}
+
+// UnbanUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) UnbanUser(ctx interface{}, id interface{}) *Store_UnbanUser_Call {
+	return &Store_UnbanUser_Call{Call: _e.mock.On("UnbanUser", ctx, id)}
+}
+
+func (_c *Store_UnbanUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_UnbanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_UnbanUser_Call) Return(err error) *Store_UnbanUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_UnbanUser_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_UnbanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockUserLogin provides a mock function for the type Store
+func (_mock *Store) UnlockUserLogin(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUserLogin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_UnlockUserLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUserLogin'
+type Store_UnlockUserLogin_Call struct {
+	*mock.Call
+}
+
+// UnlockUserLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *Store_Expecter) UnlockUserLogin(ctx interface{}, id interface{}) *Store_UnlockUserLogin_Call {
+	return &Store_UnlockUserLogin_Call{Call: _e.mock.On("UnlockUserLogin", ctx, id)}
+}
+
+func (_c *Store_UnlockUserLogin_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_UnlockUserLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_UnlockUserLogin_Call) Return(err error) *Store_UnlockUserLogin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_UnlockUserLogin_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *Store_UnlockUserLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAvatar provides a mock function for the type Store
+func (_mock *Store) UpdateAvatar(ctx context.Context, user *models.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAvatar")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_UpdateAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAvatar'
+type Store_UpdateAvatar_Call struct {
+	*mock.Call
+}
+
+// UpdateAvatar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *models.User
+func (_e *Store_Expecter) UpdateAvatar(ctx interface{}, user interface{}) *Store_UpdateAvatar_Call {
+	return &Store_UpdateAvatar_Call{Call: _e.mock.On("UpdateAvatar", ctx, user)}
+}
+
+func (_c *Store_UpdateAvatar_Call) Run(run func(ctx context.Context, user *models.User)) *Store_UpdateAvatar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.User
+		if args[1] != nil {
+			arg1 = args[1].(*models.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_UpdateAvatar_Call) Return(err error) *Store_UpdateAvatar_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_UpdateAvatar_Call) RunAndReturn(run func(ctx context.Context, user *models.User) error) *Store_UpdateAvatar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStats provides a mock function for the type Store
+func (_mock *Store) UpdateStats(ctx context.Context, stat *models.Stat) error {
+	ret := _mock.Called(ctx, stat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Stat) error); ok {
+		r0 = returnFunc(ctx, stat)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Store_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
-type Store_GetUserByLogin_Call struct {
+// Store_UpdateStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStats'
+type Store_UpdateStats_Call struct {
 	*mock.Call
 }
 
-// GetUserByLogin is a helper method to define mock.On call
+// UpdateStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - login string
-func (_e *Store_Expecter) GetUserByLogin(ctx interface{}, login interface{}) *Store_GetUserByLogin_Call {
-	return &Store_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", ctx, login)}
+//   - stat *models.Stat
+func (_e *Store_Expecter) UpdateStats(ctx interface{}, stat interface{}) *Store_UpdateStats_Call {
+	return &Store_UpdateStats_Call{Call: _e.mock.On("UpdateStats", ctx, stat)}
 }
 
-func (_c *Store_GetUserByLogin_Call) Run(run func(ctx context.Context, login string)) *Store_GetUserByLogin_Call {
+func (_c *Store_UpdateStats_Call) Run(run func(ctx context.Context, stat *models.Stat)) *Store_UpdateStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *models.Stat
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*models.Stat)
 		}
 		run(
 			arg0,
@@ -1040,54 +6190,54 @@ func (_c *Store_GetUserByLogin_Call) Run(run func(ctx context.Context, login str
 	return _c
 }
 
-func (_c *Store_GetUserByLogin_Call) Return(user *models.User, err error) *Store_GetUserByLogin_Call {
-	_c.Call.Return(user, err)
+func (_c *Store_UpdateStats_Call) Return(err error) *Store_UpdateStats_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_GetUserByLogin_Call) RunAndReturn(run func(ctx context.Context, login string) (*models.User, error)) *Store_GetUserByLogin_Call {
+func (_c *Store_UpdateStats_Call) RunAndReturn(run func(ctx context.Context, stat *models.Stat) error) *Store_UpdateStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertLoginHistory provides a mock function for the type Store
-func (_mock *Store) InsertLoginHistory(ctx context.Context, loginHistory *models.LoginHistory) error {
-	ret := _mock.Called(ctx, loginHistory)
+// UpdateUser provides a mock function for the type Store
+func (_mock *Store) UpdateUser(ctx context.Context, user *models.User) error {
+	ret := _mock.Called(ctx, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertLoginHistory")
+		panic("no return value specified for UpdateUser")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.LoginHistory) error); ok {
-		r0 = returnFunc(ctx, loginHistory)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.User) error); ok {
+		r0 = returnFunc(ctx, user)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_InsertLoginHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertLoginHistory'
-type Store_InsertLoginHistory_Call struct {
+// Store_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type Store_UpdateUser_Call struct {
 	*mock.Call
 }
 
-// InsertLoginHistory is a helper method to define mock.On call
+// UpdateUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - loginHistory *models.LoginHistory
-func (_e *Store_Expecter) InsertLoginHistory(ctx interface{}, loginHistory interface{}) *Store_InsertLoginHistory_Call {
-	return &Store_InsertLoginHistory_Call{Call: _e.mock.On("InsertLoginHistory", ctx, loginHistory)}
+//   - user *models.User
+func (_e *Store_Expecter) UpdateUser(ctx interface{}, user interface{}) *Store_UpdateUser_Call {
+	return &Store_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, user)}
 }
 
-func (_c *Store_InsertLoginHistory_Call) Run(run func(ctx context.Context, loginHistory *models.LoginHistory)) *Store_InsertLoginHistory_Call {
+func (_c *Store_UpdateUser_Call) Run(run func(ctx context.Context, user *models.User)) *Store_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *models.LoginHistory
+		var arg1 *models.User
 		if args[1] != nil {
-			arg1 = args[1].(*models.LoginHistory)
+			arg1 = args[1].(*models.User)
 		}
 		run(
 			arg0,
@@ -1097,103 +6247,116 @@ func (_c *Store_InsertLoginHistory_Call) Run(run func(ctx context.Context, login
 	return _c
 }
 
-func (_c *Store_InsertLoginHistory_Call) Return(err error) *Store_InsertLoginHistory_Call {
+func (_c *Store_UpdateUser_Call) Return(err error) *Store_UpdateUser_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_InsertLoginHistory_Call) RunAndReturn(run func(ctx context.Context, loginHistory *models.LoginHistory) error) *Store_InsertLoginHistory_Call {
+func (_c *Store_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, user *models.User) error) *Store_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertRefreshToken provides a mock function for the type Store
-func (_mock *Store) InsertRefreshToken(ctx context.Context, refreshToken *models.RefreshToken) error {
-	ret := _mock.Called(ctx, refreshToken)
+// UpdateUserByAdmin provides a mock function for the type Store
+func (_mock *Store) UpdateUserByAdmin(ctx context.Context, id uuid.UUID, name *string, role *models.Role) error {
+	ret := _mock.Called(ctx, id, name, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertRefreshToken")
+		panic("no return value specified for UpdateUserByAdmin")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.RefreshToken) error); ok {
-		r0 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *models.Role) error); ok {
+		r0 = returnFunc(ctx, id, name, role)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_InsertRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertRefreshToken'
-type Store_InsertRefreshToken_Call struct {
+// Store_UpdateUserByAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserByAdmin'
+type Store_UpdateUserByAdmin_Call struct {
 	*mock.Call
 }
 
-// InsertRefreshToken is a helper method to define mock.On call
+// UpdateUserByAdmin is a helper method to define mock.On call
 //   - ctx context.Context
-//   - refreshToken *models.RefreshToken
-func (_e *Store_Expecter) InsertRefreshToken(ctx interface{}, refreshToken interface{}) *Store_InsertRefreshToken_Call {
-	return &Store_InsertRefreshToken_Call{Call: _e.mock.On("InsertRefreshToken", ctx, refreshToken)}
+//   - id uuid.UUID
+//   - name *string
+//   - role *models.Role
+func (_e *Store_Expecter) UpdateUserByAdmin(ctx interface{}, id interface{}, name interface{}, role interface{}) *Store_UpdateUserByAdmin_Call {
+	return &Store_UpdateUserByAdmin_Call{Call: _e.mock.On("UpdateUserByAdmin", ctx, id, name, role)}
 }
 
-func (_c *Store_InsertRefreshToken_Call) Run(run func(ctx context.Context, refreshToken *models.RefreshToken)) *Store_InsertRefreshToken_Call {
+func (_c *Store_UpdateUserByAdmin_Call) Run(run func(ctx context.Context, id uuid.UUID, name *string, role *models.Role)) *Store_UpdateUserByAdmin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *models.RefreshToken
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*models.RefreshToken)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
+		var arg3 *models.Role
+		if args[3] != nil {
+			arg3 = args[3].(*models.Role)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_InsertRefreshToken_Call) Return(err error) *Store_InsertRefreshToken_Call {
+func (_c *Store_UpdateUserByAdmin_Call) Return(err error) *Store_UpdateUserByAdmin_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_InsertRefreshToken_Call) RunAndReturn(run func(ctx context.Context, refreshToken *models.RefreshToken) error) *Store_InsertRefreshToken_Call {
+func (_c *Store_UpdateUserByAdmin_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, name *string, role *models.Role) error) *Store_UpdateUserByAdmin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokeAllUserTokens provides a mock function for the type Store
-func (_mock *Store) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
-	ret := _mock.Called(ctx, userID)
+// UpdateUserEmail provides a mock function for the type Store
+func (_mock *Store) UpdateUserEmail(ctx context.Context, id uuid.UUID, email *string) error {
+	ret := _mock.Called(ctx, id, email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokeAllUserTokens")
+		panic("no return value specified for UpdateUserEmail")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string) error); ok {
+		r0 = returnFunc(ctx, id, email)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_RevokeAllUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllUserTokens'
-type Store_RevokeAllUserTokens_Call struct {
+// Store_UpdateUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserEmail'
+type Store_UpdateUserEmail_Call struct {
 	*mock.Call
 }
 
-// RevokeAllUserTokens is a helper method to define mock.On call
+// UpdateUserEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uuid.UUID
-func (_e *Store_Expecter) RevokeAllUserTokens(ctx interface{}, userID interface{}) *Store_RevokeAllUserTokens_Call {
-	return &Store_RevokeAllUserTokens_Call{Call: _e.mock.On("RevokeAllUserTokens", ctx, userID)}
+//   - id uuid.UUID
+//   - email *string
+func (_e *Store_Expecter) UpdateUserEmail(ctx interface{}, id interface{}, email interface{}) *Store_UpdateUserEmail_Call {
+	return &Store_UpdateUserEmail_Call{Call: _e.mock.On("UpdateUserEmail", ctx, id, email)}
 }
 
-func (_c *Store_RevokeAllUserTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *Store_RevokeAllUserTokens_Call {
+func (_c *Store_UpdateUserEmail_Call) Run(run func(ctx context.Context, id uuid.UUID, email *string)) *Store_UpdateUserEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1203,123 +6366,123 @@ func (_c *Store_RevokeAllUserTokens_Call) Run(run func(ctx context.Context, user
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_RevokeAllUserTokens_Call) Return(err error) *Store_RevokeAllUserTokens_Call {
+func (_c *Store_UpdateUserEmail_Call) Return(err error) *Store_UpdateUserEmail_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_RevokeAllUserTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *Store_RevokeAllUserTokens_Call {
+func (_c *Store_UpdateUserEmail_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, email *string) error) *Store_UpdateUserEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokeRefreshToken provides a mock function for the type Store
-func (_mock *Store) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
-	ret := _mock.Called(ctx, tokenHash)
+// UpdateUserPass provides a mock function for the type Store
+func (_mock *Store) UpdateUserPass(ctx context.Context, id uuid.UUID, newHashedPass string) error {
+	ret := _mock.Called(ctx, id, newHashedPass)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokeRefreshToken")
+		panic("no return value specified for UpdateUserPass")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, newHashedPass)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_RevokeRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshToken'
-type Store_RevokeRefreshToken_Call struct {
+// Store_UpdateUserPass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPass'
+type Store_UpdateUserPass_Call struct {
 	*mock.Call
 }
 
-// RevokeRefreshToken is a helper method to define mock.On call
+// UpdateUserPass is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenHash string
-func (_e *Store_Expecter) RevokeRefreshToken(ctx interface{}, tokenHash interface{}) *Store_RevokeRefreshToken_Call {
-	return &Store_RevokeRefreshToken_Call{Call: _e.mock.On("RevokeRefreshToken", ctx, tokenHash)}
+//   - id uuid.UUID
+//   - newHashedPass string
+func (_e *Store_Expecter) UpdateUserPass(ctx interface{}, id interface{}, newHashedPass interface{}) *Store_UpdateUserPass_Call {
+	return &Store_UpdateUserPass_Call{Call: _e.mock.On("UpdateUserPass", ctx, id, newHashedPass)}
 }
 
-func (_c *Store_RevokeRefreshToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Store_RevokeRefreshToken_Call {
+func (_c *Store_UpdateUserPass_Call) Run(run func(ctx context.Context, id uuid.UUID, newHashedPass string)) *Store_UpdateUserPass_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_RevokeRefreshToken_Call) Return(err error) *Store_RevokeRefreshToken_Call {
+func (_c *Store_UpdateUserPass_Call) Return(err error) *Store_UpdateUserPass_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_RevokeRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) error) *Store_RevokeRefreshToken_Call {
+func (_c *Store_UpdateUserPass_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, newHashedPass string) error) *Store_UpdateUserPass_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StartPrediction provides a mock function for the type Store
-func (_mock *Store) StartPrediction(ctx context.Context, userID uuid.UUID, scanURL string) (*models.Prediction, error) {
-	ret := _mock.Called(ctx, userID, scanURL)
+// UseTOTPStep provides a mock function for the type Store
+func (_mock *Store) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	ret := _mock.Called(ctx, userID, step)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StartPrediction")
+		panic("no return value specified for UseTOTPStep")
 	}
 
-	var r0 *models.Prediction
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*models.Prediction, error)); ok {
-		return returnFunc(ctx, userID, scanURL)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *models.Prediction); ok {
-		r0 = returnFunc(ctx, userID, scanURL)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Prediction)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = returnFunc(ctx, userID, scanURL)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) error); ok {
+		r0 = returnFunc(ctx, userID, step)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Store_StartPrediction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartPrediction'
-type Store_StartPrediction_Call struct {
+// Store_UseTOTPStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseTOTPStep'
+type Store_UseTOTPStep_Call struct {
 	*mock.Call
 }
 
-// StartPrediction is a helper method to define mock.On call
+// UseTOTPStep is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-//   - scanURL string
-func (_e *Store_Expecter) StartPrediction(ctx interface{}, userID interface{}, scanURL interface{}) *Store_StartPrediction_Call {
-	return &Store_StartPrediction_Call{Call: _e.mock.On("StartPrediction", ctx, userID, scanURL)}
+//   - step int64
+func (_e *Store_Expecter) UseTOTPStep(ctx interface{}, userID interface{}, step interface{}) *Store_UseTOTPStep_Call {
+	return &Store_UseTOTPStep_Call{Call: _e.mock.On("UseTOTPStep", ctx, userID, step)}
 }
 
-func (_c *Store_StartPrediction_Call) Run(run func(ctx context.Context, userID uuid.UUID, scanURL string)) *Store_StartPrediction_Call {
+func (_c *Store_UseTOTPStep_Call) Run(run func(ctx context.Context, userID uuid.UUID, step int64)) *Store_UseTOTPStep_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1329,9 +6492,9 @@ func (_c *Store_StartPrediction_Call) Run(run func(ctx context.Context, userID u
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 string
+		var arg2 int64
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(int64)
 		}
 		run(
 			arg0,
@@ -1342,168 +6505,180 @@ func (_c *Store_StartPrediction_Call) Run(run func(ctx context.Context, userID u
 	return _c
 }
 
-func (_c *Store_StartPrediction_Call) Return(prediction *models.Prediction, err error) *Store_StartPrediction_Call {
-	_c.Call.Return(prediction, err)
+func (_c *Store_UseTOTPStep_Call) Return(err error) *Store_UseTOTPStep_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_StartPrediction_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, scanURL string) (*models.Prediction, error)) *Store_StartPrediction_Call {
+func (_c *Store_UseTOTPStep_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, step int64) error) *Store_UseTOTPStep_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAvatar provides a mock function for the type Store
-func (_mock *Store) UpdateAvatar(ctx context.Context, user *models.User) error {
-	ret := _mock.Called(ctx, user)
+// VerifyUserEmail provides a mock function for the type Store
+func (_mock *Store) VerifyUserEmail(ctx context.Context, id uuid.UUID, email string) error {
+	ret := _mock.Called(ctx, id, email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAvatar")
+		panic("no return value specified for VerifyUserEmail")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.User) error); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, email)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_UpdateAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAvatar'
-type Store_UpdateAvatar_Call struct {
+// Store_VerifyUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyUserEmail'
+type Store_VerifyUserEmail_Call struct {
 	*mock.Call
 }
 
-// UpdateAvatar is a helper method to define mock.On call
+// VerifyUserEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *models.User
-func (_e *Store_Expecter) UpdateAvatar(ctx interface{}, user interface{}) *Store_UpdateAvatar_Call {
-	return &Store_UpdateAvatar_Call{Call: _e.mock.On("UpdateAvatar", ctx, user)}
+//   - id uuid.UUID
+//   - email string
+func (_e *Store_Expecter) VerifyUserEmail(ctx interface{}, id interface{}, email interface{}) *Store_VerifyUserEmail_Call {
+	return &Store_VerifyUserEmail_Call{Call: _e.mock.On("VerifyUserEmail", ctx, id, email)}
 }
 
-func (_c *Store_UpdateAvatar_Call) Run(run func(ctx context.Context, user *models.User)) *Store_UpdateAvatar_Call {
+func (_c *Store_VerifyUserEmail_Call) Run(run func(ctx context.Context, id uuid.UUID, email string)) *Store_VerifyUserEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *models.User
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*models.User)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_UpdateAvatar_Call) Return(err error) *Store_UpdateAvatar_Call {
+func (_c *Store_VerifyUserEmail_Call) Return(err error) *Store_VerifyUserEmail_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_UpdateAvatar_Call) RunAndReturn(run func(ctx context.Context, user *models.User) error) *Store_UpdateAvatar_Call {
+func (_c *Store_VerifyUserEmail_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, email string) error) *Store_VerifyUserEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStats provides a mock function for the type Store
-func (_mock *Store) UpdateStats(ctx context.Context, stat *models.Stat) error {
-	ret := _mock.Called(ctx, stat)
+// WalkExpiredGuests provides a mock function for the type Store
+func (_mock *Store) WalkExpiredGuests(ctx context.Context, createdBefore time.Time, fn func(uuid.UUID) error) error {
+	ret := _mock.Called(ctx, createdBefore, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStats")
+		panic("no return value specified for WalkExpiredGuests")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Stat) error); ok {
-		r0 = returnFunc(ctx, stat)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, func(uuid.UUID) error) error); ok {
+		r0 = returnFunc(ctx, createdBefore, fn)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_UpdateStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStats'
-type Store_UpdateStats_Call struct {
+// Store_WalkExpiredGuests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalkExpiredGuests'
+type Store_WalkExpiredGuests_Call struct {
 	*mock.Call
 }
 
-// UpdateStats is a helper method to define mock.On call
+// WalkExpiredGuests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - stat *models.Stat
-func (_e *Store_Expecter) UpdateStats(ctx interface{}, stat interface{}) *Store_UpdateStats_Call {
-	return &Store_UpdateStats_Call{Call: _e.mock.On("UpdateStats", ctx, stat)}
+//   - createdBefore time.Time
+//   - fn func(uuid.UUID) error
+func (_e *Store_Expecter) WalkExpiredGuests(ctx interface{}, createdBefore interface{}, fn interface{}) *Store_WalkExpiredGuests_Call {
+	return &Store_WalkExpiredGuests_Call{Call: _e.mock.On("WalkExpiredGuests", ctx, createdBefore, fn)}
 }
 
-func (_c *Store_UpdateStats_Call) Run(run func(ctx context.Context, stat *models.Stat)) *Store_UpdateStats_Call {
+func (_c *Store_WalkExpiredGuests_Call) Run(run func(ctx context.Context, createdBefore time.Time, fn func(uuid.UUID) error)) *Store_WalkExpiredGuests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *models.Stat
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(*models.Stat)
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 func(uuid.UUID) error
+		if args[2] != nil {
+			arg2 = args[2].(func(uuid.UUID) error)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Store_UpdateStats_Call) Return(err error) *Store_UpdateStats_Call {
+func (_c *Store_WalkExpiredGuests_Call) Return(err error) *Store_WalkExpiredGuests_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_UpdateStats_Call) RunAndReturn(run func(ctx context.Context, stat *models.Stat) error) *Store_UpdateStats_Call {
+func (_c *Store_WalkExpiredGuests_Call) RunAndReturn(run func(ctx context.Context, createdBefore time.Time, fn func(uuid.UUID) error) error) *Store_WalkExpiredGuests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function for the type Store
-func (_mock *Store) UpdateUser(ctx context.Context, user *models.User) error {
-	ret := _mock.Called(ctx, user)
+// WalkStatsUsers provides a mock function for the type Store
+func (_mock *Store) WalkStatsUsers(ctx context.Context, fn func(uuid.UUID) error) error {
+	ret := _mock.Called(ctx, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for WalkStatsUsers")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.User) error); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(uuid.UUID) error) error); ok {
+		r0 = returnFunc(ctx, fn)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type Store_UpdateUser_Call struct {
+// Store_WalkStatsUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalkStatsUsers'
+type Store_WalkStatsUsers_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
+// WalkStatsUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *models.User
-func (_e *Store_Expecter) UpdateUser(ctx interface{}, user interface{}) *Store_UpdateUser_Call {
-	return &Store_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, user)}
+//   - fn func(uuid.UUID) error
+func (_e *Store_Expecter) WalkStatsUsers(ctx interface{}, fn interface{}) *Store_WalkStatsUsers_Call {
+	return &Store_WalkStatsUsers_Call{Call: _e.mock.On("WalkStatsUsers", ctx, fn)}
 }
 
-func (_c *Store_UpdateUser_Call) Run(run func(ctx context.Context, user *models.User)) *Store_UpdateUser_Call {
+func (_c *Store_WalkStatsUsers_Call) Run(run func(ctx context.Context, fn func(uuid.UUID) error)) *Store_WalkStatsUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *models.User
+		var arg1 func(uuid.UUID) error
 		if args[1] != nil {
-			arg1 = args[1].(*models.User)
+			arg1 = args[1].(func(uuid.UUID) error)
 		}
 		run(
 			arg0,
@@ -1513,47 +6688,47 @@ func (_c *Store_UpdateUser_Call) Run(run func(ctx context.Context, user *models.
 	return _c
 }
 
-func (_c *Store_UpdateUser_Call) Return(err error) *Store_UpdateUser_Call {
+func (_c *Store_WalkStatsUsers_Call) Return(err error) *Store_WalkStatsUsers_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, user *models.User) error) *Store_UpdateUser_Call {
+func (_c *Store_WalkStatsUsers_Call) RunAndReturn(run func(ctx context.Context, fn func(uuid.UUID) error) error) *Store_WalkStatsUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserPass provides a mock function for the type Store
-func (_mock *Store) UpdateUserPass(ctx context.Context, id uuid.UUID, newHashedPass string) error {
-	ret := _mock.Called(ctx, id, newHashedPass)
+// WalkUserPredictions provides a mock function for the type Store
+func (_mock *Store) WalkUserPredictions(ctx context.Context, userID uuid.UUID, fn func(models.Prediction) error) error {
+	ret := _mock.Called(ctx, userID, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserPass")
+		panic("no return value specified for WalkUserPredictions")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
-		r0 = returnFunc(ctx, id, newHashedPass)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(models.Prediction) error) error); ok {
+		r0 = returnFunc(ctx, userID, fn)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Store_UpdateUserPass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPass'
-type Store_UpdateUserPass_Call struct {
+// Store_WalkUserPredictions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalkUserPredictions'
+type Store_WalkUserPredictions_Call struct {
 	*mock.Call
 }
 
-// UpdateUserPass is a helper method to define mock.On call
+// WalkUserPredictions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-//   - newHashedPass string
-func (_e *Store_Expecter) UpdateUserPass(ctx interface{}, id interface{}, newHashedPass interface{}) *Store_UpdateUserPass_Call {
-	return &Store_UpdateUserPass_Call{Call: _e.mock.On("UpdateUserPass", ctx, id, newHashedPass)}
+//   - userID uuid.UUID
+//   - fn func(models.Prediction) error
+func (_e *Store_Expecter) WalkUserPredictions(ctx interface{}, userID interface{}, fn interface{}) *Store_WalkUserPredictions_Call {
+	return &Store_WalkUserPredictions_Call{Call: _e.mock.On("WalkUserPredictions", ctx, userID, fn)}
 }
 
-func (_c *Store_UpdateUserPass_Call) Run(run func(ctx context.Context, id uuid.UUID, newHashedPass string)) *Store_UpdateUserPass_Call {
+func (_c *Store_WalkUserPredictions_Call) Run(run func(ctx context.Context, userID uuid.UUID, fn func(models.Prediction) error)) *Store_WalkUserPredictions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1563,9 +6738,9 @@ func (_c *Store_UpdateUserPass_Call) Run(run func(ctx context.Context, id uuid.U
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 string
+		var arg2 func(models.Prediction) error
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(func(models.Prediction) error)
 		}
 		run(
 			arg0,
@@ -1576,12 +6751,12 @@ func (_c *Store_UpdateUserPass_Call) Run(run func(ctx context.Context, id uuid.U
 	return _c
 }
 
-func (_c *Store_UpdateUserPass_Call) Return(err error) *Store_UpdateUserPass_Call {
+func (_c *Store_WalkUserPredictions_Call) Return(err error) *Store_WalkUserPredictions_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Store_UpdateUserPass_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, newHashedPass string) error) *Store_UpdateUserPass_Call {
+func (_c *Store_WalkUserPredictions_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, fn func(models.Prediction) error) error) *Store_WalkUserPredictions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,180 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+// ListAdminPredictions returns a page of the predictions of all users matching
+// filter, newest first.
+func (s *pgStore) ListAdminPredictions(
+	ctx context.Context, filter models.PredictionFilter, limit, offset int32,
+) ([]models.AdminPrediction, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	where := predictionFilterParams(filter)
+	rows, err := s.q.ListAdminPredictions(ctx, db.ListAdminPredictionsParams{
+		UserID:        where.UserID,
+		Status:        where.Status,
+		MaxConfidence: where.MaxConfidence,
+		HasFeedback:   where.HasFeedback,
+		Verified:      where.Verified,
+		CreatedFrom:   where.CreatedFrom,
+		CreatedTo:     where.CreatedTo,
+		Limit:         limit,
+		Offset:        offset,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to list predictions", err.Error(), nil)
+	}
+
+	predictions := make([]models.AdminPrediction, len(rows))
+	for i, row := range rows {
+		predictions[i].UserLogin = row.Login
+		predictions[i].Model(db.Prediction{
+			ID:              row.ID,
+			UserID:          row.UserID,
+			TrashScan:       row.TrashScan,
+			Status:          row.Status,
+			Result:          row.Result,
+			Error:           row.Error,
+			CreatedAt:       row.CreatedAt,
+			UpdatedAt:       row.UpdatedAt,
+			VerifiedLabel:   row.VerifiedLabel,
+			VerifiedBy:      row.VerifiedBy,
+			VerifiedAt:      row.VerifiedAt,
+			FeedbackLabel:   row.FeedbackLabel,
+			FeedbackComment: row.FeedbackComment,
+			FeedbackAt:      row.FeedbackAt,
+			Weight:          row.Weight,
+		})
+	}
+
+	return predictions, nil
+}
+
+// CountAdminPredictions counts the predictions of all users matching filter.
+func (s *pgStore) CountAdminPredictions(ctx context.Context, filter models.PredictionFilter) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	count, err := s.q.CountAdminPredictions(ctx, predictionFilterParams(filter))
+	if err != nil {
+		return 0, errlocal.NewErrInternal("failed to count predictions", err.Error(), nil)
+	}
+
+	return count, nil
+}
+
+func predictionFilterParams(filter models.PredictionFilter) db.CountAdminPredictionsParams {
+	params := db.CountAdminPredictionsParams{
+		MaxConfidence: filter.MaxConfidence,
+		HasFeedback:   filter.HasFeedback,
+		Verified:      filter.Verified,
+	}
+	if filter.UserID != nil {
+		params.UserID = pgtype.UUID{Bytes: *filter.UserID, Valid: true}
+	}
+	if filter.Status != nil {
+		params.Status = utils.Ptr(filter.Status.String())
+	}
+	if filter.CreatedFrom != nil {
+		params.CreatedFrom = pgtype.Timestamptz{Time: *filter.CreatedFrom, Valid: true}
+	}
+	if filter.CreatedTo != nil {
+		params.CreatedTo = pgtype.Timestamptz{Time: *filter.CreatedTo, Valid: true}
+	}
+
+	return params
+}
+
+// GetPredictionForUpdate reads a prediction and locks it until the end of the
+// transaction, so that changes to it and to the stats of its owner don't race.
+func (s *pgStore) GetPredictionForUpdate(ctx context.Context, id uuid.UUID) (*models.Prediction, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	row, err := s.q.GetPredictionForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("prediction not found", err.Error(),
+				map[string]any{"prediction_id": id.String()})
+		}
+		return nil, errlocal.NewErrInternal("database error", err.Error(), nil)
+	}
+
+	prediction := &models.Prediction{}
+	prediction.Model(row)
+
+	return prediction, nil
+}
+
+// SetPredictionVerification sets the label staff confirmed for a prediction;
+// a nil label clears it.
+func (s *pgStore) SetPredictionVerification(
+	ctx context.Context, id uuid.UUID, label *string, verifiedBy uuid.UUID,
+) (*models.Prediction, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	params := db.SetPredictionVerifiedLabelParams{ID: id, VerifiedLabel: label}
+	if label != nil {
+		params.VerifiedBy = pgtype.UUID{Bytes: verifiedBy, Valid: true}
+	}
+
+	row, err := s.q.SetPredictionVerifiedLabel(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("prediction not found", err.Error(),
+				map[string]any{"prediction_id": id.String()})
+		}
+		return nil, errlocal.NewErrInternal("failed to verify prediction", err.Error(),
+			map[string]any{"prediction_id": id.String()})
+	}
+
+	prediction := &models.Prediction{}
+	prediction.Model(row)
+
+	return prediction, nil
+}
+
+// SetPredictionFeedback records what the owner reported about a prediction,
+// replacing earlier feedback. Predictions of other users are not found.
+func (s *pgStore) SetPredictionFeedback(
+	ctx context.Context, id, userID uuid.UUID, feedback models.PredictionFeedback,
+) (*models.Prediction, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	params := db.SetPredictionFeedbackParams{ID: id, UserID: userID}
+	if feedback.Label != "" {
+		params.FeedbackLabel = &feedback.Label
+	}
+	if feedback.Comment != "" {
+		params.FeedbackComment = &feedback.Comment
+	}
+
+	row, err := s.q.SetPredictionFeedback(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("prediction not found", err.Error(),
+				map[string]any{"prediction_id": id.String()})
+		}
+		return nil, errlocal.NewErrInternal("failed to save feedback", err.Error(),
+			map[string]any{"prediction_id": id.String()})
+	}
+
+	prediction := &models.Prediction{}
+	prediction.Model(row)
+
+	return prediction, nil
+}
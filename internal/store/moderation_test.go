@@ -0,0 +1,163 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/utils"
+)
+
+func TestListAdminPredictions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		status := models.PredictionCompletedStatus
+		maxConfidence := 0.5
+		verified := false
+		verifiedBy := uuid.New()
+
+		mockQ.EXPECT().ListAdminPredictions(mock.Anything, db.ListAdminPredictionsParams{
+			Status:        utils.Ptr("completed"),
+			MaxConfidence: &maxConfidence,
+			Verified:      &verified,
+			Limit:         10,
+			Offset:        20,
+		}).Return([]db.ListAdminPredictionsRow{{
+			ID:            uuid.New(),
+			Login:         "user",
+			Status:        "completed",
+			Result:        []byte(`{"glass":0.4}`),
+			VerifiedLabel: utils.Ptr("metal"),
+			VerifiedBy:    pgtype.UUID{Bytes: verifiedBy, Valid: true},
+			VerifiedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		}}, nil).Once()
+
+		predictions, err := store.ListAdminPredictions(context.Background(), models.PredictionFilter{
+			Status: &status, MaxConfidence: &maxConfidence, Verified: &verified,
+		}, 10, 20)
+
+		require.NoError(t, err)
+		require.Len(t, predictions, 1)
+		assert.Equal(t, "user", predictions[0].UserLogin)
+		assert.Equal(t, 0.4, predictions[0].Result["glass"])
+		require.NotNil(t, predictions[0].Verification)
+		assert.Equal(t, "metal", predictions[0].Verification.Label)
+		assert.Equal(t, verifiedBy, *predictions[0].Verification.VerifiedBy)
+		assert.Nil(t, predictions[0].Feedback)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ListAdminPredictions(mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		_, err := store.ListAdminPredictions(context.Background(), models.PredictionFilter{}, 10, 0)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestSetPredictionVerification(t *testing.T) {
+	id := uuid.New()
+	moderatorID := uuid.New()
+
+	t.Run("set label", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		label := "glass"
+
+		mockQ.EXPECT().SetPredictionVerifiedLabel(mock.Anything, db.SetPredictionVerifiedLabelParams{
+			ID: id, VerifiedLabel: &label, VerifiedBy: pgtype.UUID{Bytes: moderatorID, Valid: true},
+		}).Return(db.Prediction{
+			ID: id, Result: []byte(`{}`), VerifiedLabel: &label,
+			VerifiedBy: pgtype.UUID{Bytes: moderatorID, Valid: true},
+			VerifiedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		}, nil).Once()
+
+		prediction, err := store.SetPredictionVerification(context.Background(), id, &label, moderatorID)
+
+		require.NoError(t, err)
+		require.NotNil(t, prediction.Verification)
+		assert.Equal(t, label, prediction.Verification.Label)
+	})
+
+	t.Run("clear label", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().SetPredictionVerifiedLabel(mock.Anything, db.SetPredictionVerifiedLabelParams{ID: id}).
+			Return(db.Prediction{ID: id, Result: []byte(`{}`)}, nil).Once()
+
+		prediction, err := store.SetPredictionVerification(context.Background(), id, nil, moderatorID)
+
+		require.NoError(t, err)
+		assert.Nil(t, prediction.Verification)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().SetPredictionVerifiedLabel(mock.Anything, mock.Anything).
+			Return(db.Prediction{}, pgx.ErrNoRows).Once()
+
+		_, err := store.SetPredictionVerification(context.Background(), id, nil, moderatorID)
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestSetPredictionFeedback(t *testing.T) {
+	id := uuid.New()
+	userID := uuid.New()
+
+	t.Run("comment only", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		comment := "wrong type"
+
+		mockQ.EXPECT().SetPredictionFeedback(mock.Anything, db.SetPredictionFeedbackParams{
+			ID: id, UserID: userID, FeedbackComment: &comment,
+		}).Return(db.Prediction{
+			ID: id, UserID: userID, Result: []byte(`{}`), FeedbackComment: &comment,
+			FeedbackAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		}, nil).Once()
+
+		prediction, err := store.SetPredictionFeedback(context.Background(), id, userID,
+			models.PredictionFeedback{Comment: comment})
+
+		require.NoError(t, err)
+		require.NotNil(t, prediction.Feedback)
+		assert.Equal(t, comment, prediction.Feedback.Comment)
+		assert.Empty(t, prediction.Feedback.Label)
+	})
+
+	t.Run("prediction of another user", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().SetPredictionFeedback(mock.Anything, mock.Anything).
+			Return(db.Prediction{}, pgx.ErrNoRows).Once()
+
+		_, err := store.SetPredictionFeedback(context.Background(), id, userID,
+			models.PredictionFeedback{Label: "glass"})
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
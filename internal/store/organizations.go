@@ -0,0 +1,324 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+// CreateOrganization creates org with ownerID as its first owner.
+func (s *pgStore) CreateOrganization(ctx context.Context, org *models.Organization, ownerID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	row, err := s.q.CreateOrganization(ctx, db.CreateOrganizationParams{
+		Name:       org.Name,
+		InviteCode: org.InviteCode,
+		OwnerID:    ownerID,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to create organization", err.Error(),
+			map[string]any{"owner_id": ownerID.String()})
+	}
+	org.Model(db.Organization(row))
+
+	return nil
+}
+
+func (s *pgStore) GetOrganization(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbOrg, err := s.q.GetOrganization(ctx, id)
+	return organizationResult(dbOrg, err, id)
+}
+
+// GetOrganizationForUpdate returns the organization and locks it until the end
+// of the transaction, so that changes to its members are serialized.
+func (s *pgStore) GetOrganizationForUpdate(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbOrg, err := s.q.GetOrganizationForUpdate(ctx, id)
+	return organizationResult(dbOrg, err, id)
+}
+
+func (s *pgStore) GetOrganizationByInviteCode(ctx context.Context, code string) (*models.Organization, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbOrg, err := s.q.GetOrganizationByInviteCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("organization not found", "no organization with given invite code", nil)
+		}
+		return nil, errlocal.NewErrInternal("failed to get organization", err.Error(), nil)
+	}
+
+	var org models.Organization
+	org.Model(dbOrg)
+
+	return &org, nil
+}
+
+func organizationResult(dbOrg db.Organization, err error, id uuid.UUID) (*models.Organization, error) {
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("organization not found", err.Error(),
+				map[string]any{"organization_id": id.String()})
+		}
+		return nil, errlocal.NewErrInternal("failed to get organization", err.Error(),
+			map[string]any{"organization_id": id.String()})
+	}
+
+	var org models.Organization
+	org.Model(dbOrg)
+
+	return &org, nil
+}
+
+// SetOrganizationInviteCode replaces the invite code of the organization; the
+// old code no longer lets anyone join.
+func (s *pgStore) SetOrganizationInviteCode(ctx context.Context, id uuid.UUID, code string) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.UpdateOrganizationInviteCode(ctx, db.UpdateOrganizationInviteCodeParams{
+		InviteCode: code,
+		ID:         id,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to update invite code", err.Error(),
+			map[string]any{"organization_id": id.String()})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("organization not found", "no organization with given ID",
+			map[string]any{"organization_id": id.String()})
+	}
+
+	return nil
+}
+
+// DeleteOrganization deletes the organization with its memberships. The stats
+// of the members are left as they are.
+func (s *pgStore) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.DeleteOrganization(ctx, id)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to delete organization", err.Error(),
+			map[string]any{"organization_id": id.String()})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("organization not found", "no organization with given ID",
+			map[string]any{"organization_id": id.String()})
+	}
+
+	return nil
+}
+
+// ListUserOrganizations returns the organizations the user is a member of, in
+// the order they joined them.
+func (s *pgStore) ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]models.UserOrganization, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.ListUserOrganizations(ctx, userID)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to list organizations", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+
+	orgs := make([]models.UserOrganization, len(rows))
+	for i, row := range rows {
+		orgs[i].Model(row)
+	}
+
+	return orgs, nil
+}
+
+// AddOrganizationMember adds member to its organization. Users can be members
+// only once.
+func (s *pgStore) AddOrganizationMember(ctx context.Context, member *models.OrganizationMember) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbMember, err := s.q.AddOrganizationMember(ctx, db.AddOrganizationMemberParams{
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Role:           string(member.Role),
+	})
+	if err != nil {
+		details := map[string]any{
+			"organization_id": member.OrganizationID.String(),
+			"user_id":         member.UserID.String(),
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errlocal.NewErrConflict("already a member of the organization", err.Error(), details)
+		}
+		return errlocal.NewErrInternal("failed to add organization member", err.Error(), details)
+	}
+	member.Model(dbMember)
+
+	return nil
+}
+
+func (s *pgStore) GetOrganizationMember(
+	ctx context.Context, orgID, userID uuid.UUID,
+) (*models.OrganizationMember, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbMember, err := s.q.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		details := map[string]any{"organization_id": orgID.String(), "user_id": userID.String()}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("organization member not found", err.Error(), details)
+		}
+		return nil, errlocal.NewErrInternal("failed to get organization member", err.Error(), details)
+	}
+
+	var member models.OrganizationMember
+	member.Model(dbMember)
+
+	return &member, nil
+}
+
+func (s *pgStore) SetOrganizationMemberRole(ctx context.Context, orgID, userID uuid.UUID, role models.OrgRole) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.UpdateOrganizationMemberRole(ctx, db.UpdateOrganizationMemberRoleParams{
+		Role:           string(role),
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	details := map[string]any{"organization_id": orgID.String(), "user_id": userID.String()}
+	if err != nil {
+		return errlocal.NewErrInternal("failed to update organization member", err.Error(), details)
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("organization member not found", "no member with given ID", details)
+	}
+
+	return nil
+}
+
+func (s *pgStore) RemoveOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.DeleteOrganizationMember(ctx, db.DeleteOrganizationMemberParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	details := map[string]any{"organization_id": orgID.String(), "user_id": userID.String()}
+	if err != nil {
+		return errlocal.NewErrInternal("failed to remove organization member", err.Error(), details)
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("organization member not found", "no member with given ID", details)
+	}
+
+	return nil
+}
+
+func (s *pgStore) CountOrganizationOwners(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	count, err := s.q.CountOrganizationOwners(ctx, orgID)
+	if err != nil {
+		return 0, errlocal.NewErrInternal("failed to count organization owners", err.Error(),
+			map[string]any{"organization_id": orgID.String()})
+	}
+
+	return count, nil
+}
+
+// CountSoleOwnedOrganizations returns the number of organizations the user
+// owns with no other owner left.
+func (s *pgStore) CountSoleOwnedOrganizations(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	count, err := s.q.CountSoleOwnedOrganizations(ctx, userID)
+	if err != nil {
+		return 0, errlocal.NewErrInternal("failed to count sole owned organizations", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+
+	return count, nil
+}
+
+// ListOrganizationMembers returns the members of the organization with their
+// stats and the number of scans they made from the calendar day of
+// activeSince on, highest rating first.
+func (s *pgStore) ListOrganizationMembers(
+	ctx context.Context, orgID uuid.UUID, activeSince time.Time,
+) ([]models.MemberActivity, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.ListOrganizationMembers(ctx, db.ListOrganizationMembersParams{
+		ActiveSince:    activityDay(activeSince),
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to list organization members", err.Error(),
+			map[string]any{"organization_id": orgID.String()})
+	}
+
+	members := make([]models.MemberActivity, len(rows))
+	for i, row := range rows {
+		members[i].Model(row)
+	}
+
+	return members, nil
+}
+
+// GetOrganizationStats adds up the stats of the members of the organization.
+func (s *pgStore) GetOrganizationStats(ctx context.Context, orgID uuid.UUID) (*models.OrganizationStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	totals, err := s.q.GetOrganizationStats(ctx, orgID)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get organization stats", err.Error(),
+			map[string]any{"organization_id": orgID.String()})
+	}
+	typeTotals, err := s.q.GetOrganizationTypeTotals(ctx, orgID)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get organization stats", err.Error(),
+			map[string]any{"organization_id": orgID.String()})
+	}
+
+	stats := &models.OrganizationStats{
+		Members:       int(totals.Members),
+		Rating:        int(totals.Rating),
+		FilesScanned:  int(totals.FilesScanned),
+		TotalWeight:   totals.TotalWeight,
+		TrashByTypes:  make(map[string]int),
+		WeightByTypes: make(map[string]float64),
+	}
+	for _, row := range typeTotals {
+		if row.Count > 0 {
+			stats.TrashByTypes[row.TrashType] = int(row.Count)
+		}
+		if row.Weight > 0 {
+			stats.WeightByTypes[row.TrashType] = row.Weight
+		}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,261 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	ownerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		orgID := uuid.New()
+		now := time.Now()
+
+		mockQ.EXPECT().CreateOrganization(mock.Anything, db.CreateOrganizationParams{
+			Name:       "Green School",
+			InviteCode: "code123",
+			OwnerID:    ownerID,
+		}).Return(db.CreateOrganizationRow{
+			ID: orgID, Name: "Green School", InviteCode: "code123", CreatedAt: now, UpdatedAt: now,
+		}, nil).Once()
+
+		org := &models.Organization{Name: "Green School", InviteCode: "code123"}
+		err := store.CreateOrganization(context.Background(), org, ownerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, orgID, org.ID)
+		assert.Equal(t, now, org.CreatedAt)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().CreateOrganization(mock.Anything, mock.Anything).
+			Return(db.CreateOrganizationRow{}, errors.New("db down")).Once()
+
+		err := store.CreateOrganization(context.Background(), &models.Organization{}, ownerID)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestGetOrganizationByInviteCode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		orgID := uuid.New()
+
+		mockQ.EXPECT().GetOrganizationByInviteCode(mock.Anything, "code123").
+			Return(db.Organization{ID: orgID, Name: "Office", InviteCode: "code123"}, nil).Once()
+
+		org, err := store.GetOrganizationByInviteCode(context.Background(), "code123")
+
+		require.NoError(t, err)
+		assert.Equal(t, orgID, org.ID)
+		assert.Equal(t, "code123", org.InviteCode)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationByInviteCode(mock.Anything, "nope").
+			Return(db.Organization{}, pgx.ErrNoRows).Once()
+
+		_, err := store.GetOrganizationByInviteCode(context.Background(), "nope")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestAddOrganizationMember(t *testing.T) {
+	member := &models.OrganizationMember{
+		OrganizationID: uuid.New(),
+		UserID:         uuid.New(),
+		Role:           models.OrgRoleMember,
+	}
+	params := db.AddOrganizationMemberParams{
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Role:           "member",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		joinedAt := time.Now()
+
+		mockQ.EXPECT().AddOrganizationMember(mock.Anything, params).Return(db.OrganizationMember{
+			OrganizationID: member.OrganizationID,
+			UserID:         member.UserID,
+			Role:           "member",
+			JoinedAt:       joinedAt,
+		}, nil).Once()
+
+		added := *member
+		err := store.AddOrganizationMember(context.Background(), &added)
+
+		require.NoError(t, err)
+		assert.Equal(t, joinedAt, added.JoinedAt)
+	})
+
+	t.Run("already a member", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().AddOrganizationMember(mock.Anything, params).
+			Return(db.OrganizationMember{}, pgx.ErrNoRows).Once()
+
+		err := store.AddOrganizationMember(context.Background(), member)
+
+		var conflictErr *errlocal.ErrConflict
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func TestGetOrganizationMember(t *testing.T) {
+	orgID, userID := uuid.New(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationMember(mock.Anything, db.GetOrganizationMemberParams{
+			OrganizationID: orgID,
+			UserID:         userID,
+		}).Return(db.OrganizationMember{OrganizationID: orgID, UserID: userID, Role: "owner"}, nil).Once()
+
+		member, err := store.GetOrganizationMember(context.Background(), orgID, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.OrgRoleOwner, member.Role)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationMember(mock.Anything, mock.Anything).
+			Return(db.OrganizationMember{}, pgx.ErrNoRows).Once()
+
+		_, err := store.GetOrganizationMember(context.Background(), orgID, userID)
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestRemoveOrganizationMember(t *testing.T) {
+	orgID, userID := uuid.New(), uuid.New()
+	params := db.DeleteOrganizationMemberParams{OrganizationID: orgID, UserID: userID}
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().DeleteOrganizationMember(mock.Anything, params).Return(int64(1), nil).Once()
+
+		assert.NoError(t, store.RemoveOrganizationMember(context.Background(), orgID, userID))
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().DeleteOrganizationMember(mock.Anything, params).Return(int64(0), nil).Once()
+
+		err := store.RemoveOrganizationMember(context.Background(), orgID, userID)
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestCountSoleOwnedOrganizations(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().CountSoleOwnedOrganizations(mock.Anything, userID).Return(int64(2), nil).Once()
+
+		count, err := store.CountSoleOwnedOrganizations(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().CountSoleOwnedOrganizations(mock.Anything, userID).
+			Return(int64(0), errors.New("db down")).Once()
+
+		_, err := store.CountSoleOwnedOrganizations(context.Background(), userID)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestGetOrganizationStats(t *testing.T) {
+	orgID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationStats(mock.Anything, orgID).Return(db.GetOrganizationStatsRow{
+			Members: 3, Rating: 420, FilesScanned: 40, TotalWeight: 2.5,
+		}, nil).Once()
+		mockQ.EXPECT().GetOrganizationTypeTotals(mock.Anything, orgID).Return([]db.GetOrganizationTypeTotalsRow{
+			{TrashType: models.TrashTypeGlass, Count: 10, Weight: 2.5},
+			{TrashType: models.TrashTypePlastic, Count: 6},
+		}, nil).Once()
+
+		stats, err := store.GetOrganizationStats(context.Background(), orgID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.OrganizationStats{
+			Members:       3,
+			Rating:        420,
+			FilesScanned:  40,
+			TotalWeight:   2.5,
+			TrashByTypes:  map[string]int{models.TrashTypeGlass: 10, models.TrashTypePlastic: 6},
+			WeightByTypes: map[string]float64{models.TrashTypeGlass: 2.5},
+		}, stats)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetOrganizationStats(mock.Anything, orgID).
+			Return(db.GetOrganizationStatsRow{}, errors.New("db down")).Once()
+
+		_, err := store.GetOrganizationStats(context.Background(), orgID)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+)
+
+func (s *pgStore) InsertPasswordResetToken(ctx context.Context, token *models.PasswordResetToken) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	id, err := s.q.CreatePasswordResetToken(ctx, db.CreatePasswordResetTokenParams{
+		UserID:    token.UserID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to create password reset token", err.Error(),
+			map[string]any{"user_id": token.UserID})
+	}
+	token.ID = id
+
+	return nil
+}
+
+// ConsumePasswordResetToken marks an unused, unexpired token as used and
+// returns the user it was issued for.
+func (s *pgStore) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	userID, err := s.q.ConsumePasswordResetToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, errlocal.NewErrNotFound("password reset token not found",
+				"no usable token with given hash", nil)
+		}
+		return uuid.Nil, errlocal.NewErrInternal("failed to consume password reset token", err.Error(), nil)
+	}
+
+	return userID, nil
+}
+
+func (s *pgStore) InvalidatePasswordResetTokens(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.InvalidateUserPasswordResetTokens(ctx, userID); err != nil {
+		return errlocal.NewErrInternal("failed to invalidate password reset tokens", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	return nil
+}
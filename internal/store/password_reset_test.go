@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
+	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
+	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
+	"github.com/trashscanner/trashscanner_api/internal/testdata"
+)
+
+func TestInsertPasswordResetToken(t *testing.T) {
+	t.Run("Insert token successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		id := uuid.New()
+		token := &models.PasswordResetToken{
+			UserID:    testdata.User1ID,
+			TokenHash: "hash",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mockQ.EXPECT().CreatePasswordResetToken(mock.Anything, db.CreatePasswordResetTokenParams{
+			UserID:    token.UserID,
+			TokenHash: token.TokenHash,
+			ExpiresAt: token.ExpiresAt,
+		}).Return(id, nil).Once()
+
+		err := store.InsertPasswordResetToken(context.Background(), token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, token.ID)
+	})
+
+	t.Run("Insert token fails", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().CreatePasswordResetToken(mock.Anything, mock.Anything).
+			Return(uuid.Nil, assert.AnError).Once()
+
+		err := store.InsertPasswordResetToken(context.Background(), &models.PasswordResetToken{})
+
+		var localErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &localErr)
+	})
+}
+
+func TestConsumePasswordResetToken(t *testing.T) {
+	t.Run("Consume token successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ConsumePasswordResetToken(mock.Anything, "hash").
+			Return(testdata.User1ID, nil).Once()
+
+		userID, err := store.ConsumePasswordResetToken(context.Background(), "hash")
+
+		assert.NoError(t, err)
+		assert.Equal(t, testdata.User1ID, userID)
+	})
+
+	t.Run("Token used or expired", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ConsumePasswordResetToken(mock.Anything, "hash").
+			Return(uuid.Nil, pgx.ErrNoRows).Once()
+
+		_, err := store.ConsumePasswordResetToken(context.Background(), "hash")
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("Consume token fails", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ConsumePasswordResetToken(mock.Anything, "hash").
+			Return(uuid.Nil, assert.AnError).Once()
+
+		_, err := store.ConsumePasswordResetToken(context.Background(), "hash")
+
+		var localErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &localErr)
+	})
+}
+
+func TestInvalidatePasswordResetTokens(t *testing.T) {
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().InvalidateUserPasswordResetTokens(mock.Anything, testdata.User1ID).Return(nil).Once()
+
+	assert.NoError(t, store.InvalidatePasswordResetTokens(context.Background(), testdata.User1ID))
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/trashscanner/trashscanner_api/internal/utils"
 )
 
-func (s *pgStore) StartPrediction(ctx context.Context, userID uuid.UUID, scanURL string) (*models.Prediction, error) {
+func (s *pgStore) StartPrediction(
+	ctx context.Context, userID uuid.UUID, scanURL string, weight *float64,
+) (*models.Prediction, error) {
 	ctx, cancel := context.WithTimeout(ctx, connTimeout)
 	defer cancel()
 
@@ -22,6 +24,7 @@ func (s *pgStore) StartPrediction(ctx context.Context, userID uuid.UUID, scanURL
 		UserID:    userID,
 		TrashScan: scanURL,
 		Status:    models.PredictionProcessingStatus.String(),
+		Weight:    weight,
 	})
 	if err != nil {
 		if strings.Contains(err.Error(), "SQLSTATE 23505") {
@@ -116,3 +119,16 @@ func (s *pgStore) GetPredictionsByUserID(
 
 	return models.NewPredictionsList(predictions), nil
 }
+
+func (s *pgStore) CountPredictions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	count, err := s.q.CountPredictionsByUserID(ctx, userID)
+	if err != nil {
+		return 0, errlocal.NewErrInternal("database error", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+
+	return count, nil
+}
@@ -23,15 +23,18 @@ func TestCreatePredict(t *testing.T) {
 	scanURL := "http://example.com/scan"
 
 	predID := uuid.New()
+	weight := 0.4
 	mockQ.EXPECT().CreateNewPrediction(mock.Anything, db.CreateNewPredictionParams{
 		UserID:    userID,
 		TrashScan: scanURL,
 		Status:    models.PredictionProcessingStatus.String(),
-	}).Return(db.Prediction{ID: predID}, nil).Once()
+		Weight:    &weight,
+	}).Return(db.Prediction{ID: predID, Weight: &weight}, nil).Once()
 
-	res, err := store.StartPrediction(ctx, userID, scanURL)
+	res, err := store.StartPrediction(ctx, userID, scanURL, &weight)
 	assert.NoError(t, err)
 	assert.Equal(t, predID, res.ID)
+	assert.Equal(t, &weight, res.Weight)
 }
 
 func TestCompletePrediction(t *testing.T) {
@@ -135,7 +138,7 @@ func TestStartPrediction_DuplicateError(t *testing.T) {
 		Status:    models.PredictionProcessingStatus.String(),
 	}).Return(db.Prediction{}, errors.New("pq: duplicate key value violates unique constraint \"predictions_user_id_trash_scan_key\" (SQLSTATE 23505)")).Once()
 
-	res, err := store.StartPrediction(ctx, userID, scanURL)
+	res, err := store.StartPrediction(ctx, userID, scanURL, nil)
 	assert.Error(t, err)
 	assert.Nil(t, res)
 	assert.Contains(t, err.Error(), "prediction for this scan already exists")
@@ -155,7 +158,7 @@ func TestStartPrediction_DatabaseError(t *testing.T) {
 		Status:    models.PredictionProcessingStatus.String(),
 	}).Return(db.Prediction{}, errors.New("connection refused")).Once()
 
-	res, err := store.StartPrediction(ctx, userID, scanURL)
+	res, err := store.StartPrediction(ctx, userID, scanURL, nil)
 	assert.Error(t, err)
 	assert.Nil(t, res)
 	assert.Contains(t, err.Error(), "database error")
@@ -249,6 +252,20 @@ func TestGetPredictionsByUserID_DatabaseError(t *testing.T) {
 	assert.Contains(t, err.Error(), "database error")
 }
 
+func TestCountPredictions(t *testing.T) {
+	ctx := context.Background()
+
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+	userID := uuid.New()
+
+	mockQ.EXPECT().CountPredictionsByUserID(mock.Anything, userID).Return(int64(2), nil).Once()
+
+	count, err := store.CountPredictions(ctx, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -3,13 +3,28 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
 	"github.com/trashscanner/trashscanner_api/internal/models"
 )
 
+// LockStats locks the stats of the user until the transaction ends, so that
+// the changes made to them one after another do not overwrite each other.
+func (s *pgStore) LockStats(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	if err := s.q.LockStatsByUserID(ctx, userID); err != nil {
+		return errlocal.NewErrInternal("failed to lock user stats", err.Error(),
+			map[string]any{"user_id": userID.String()})
+	}
+	return nil
+}
+
 func (s *pgStore) UpdateStats(ctx context.Context, stat *models.Stat) error {
 	ctx, cancel := context.WithTimeout(ctx, connTimeout)
 	defer cancel()
@@ -19,6 +34,21 @@ func (s *pgStore) UpdateStats(ctx context.Context, stat *models.Stat) error {
 		return errlocal.NewErrInternal("failed to marshal user stats", err.Error(),
 			map[string]any{"user_id": stat.ID.String()})
 	}
+	var rawAchievements []byte
+	if len(stat.Achievements) > 0 {
+		if rawAchievements, err = json.Marshal(stat.Achievements); err != nil {
+			return errlocal.NewErrInternal("failed to marshal user achievements", err.Error(),
+				map[string]any{"user_id": stat.ID.String()})
+		}
+	}
+
+	var rawWeightByTypes []byte
+	if len(stat.WeightByTypes) > 0 {
+		if rawWeightByTypes, err = json.Marshal(stat.WeightByTypes); err != nil {
+			return errlocal.NewErrInternal("failed to marshal user weights", err.Error(),
+				map[string]any{"user_id": stat.ID.String()})
+		}
+	}
 
 	err = s.q.UpdateStats(ctx, db.UpdateStatsParams{
 		ID:           stat.ID,
@@ -26,11 +56,15 @@ func (s *pgStore) UpdateStats(ctx context.Context, stat *models.Stat) error {
 		Rating:       int32(stat.Rating),
 		FilesScanned: int32(stat.FilesScanned),
 		TotalWeight:  float64(stat.TotalWeight),
+		Achievements: rawAchievements,
 		TrashByTypes: rawTrashByTypes,
 		LastScannedAt: pgtype.Timestamptz{
 			Time:  stat.LastScannedAt,
 			Valid: !stat.LastScannedAt.IsZero(),
 		},
+		CurrentStreak: int32(stat.CurrentStreak),
+		LongestStreak: int32(stat.LongestStreak),
+		WeightByTypes: rawWeightByTypes,
 	})
 	if err != nil {
 		return errlocal.NewErrInternal("failed to update user stats", err.Error(),
@@ -38,3 +72,122 @@ func (s *pgStore) UpdateStats(ctx context.Context, stat *models.Stat) error {
 	}
 	return nil
 }
+
+// WalkStatsUsers calls fn with the ID of every user that has stats, in ID
+// order. Users are read in batches like exports. An error from fn stops the
+// walk and is returned as is.
+func (s *pgStore) WalkStatsUsers(ctx context.Context, fn func(uuid.UUID) error) error {
+	params := db.ListStatsUserIDsAfterParams{Limit: exportBatchSize}
+
+	for {
+		userIDs, err := s.statsUsersBatch(ctx, params)
+		if err != nil {
+			return errlocal.NewErrInternal("failed to list users with stats", err.Error(), nil)
+		}
+		for _, userID := range userIDs {
+			if err := fn(userID); err != nil {
+				return err
+			}
+		}
+		if len(userIDs) < exportBatchSize {
+			return nil
+		}
+		params.AfterID = userIDs[len(userIDs)-1]
+	}
+}
+
+func (s *pgStore) statsUsersBatch(ctx context.Context, params db.ListStatsUserIDsAfterParams) ([]uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	return s.q.ListStatsUserIDsAfter(ctx, params)
+}
+
+// WalkUserPredictions calls fn for every prediction of the user, oldest
+// first. An error from fn stops the walk and is returned as is.
+func (s *pgStore) WalkUserPredictions(ctx context.Context, userID uuid.UUID, fn func(models.Prediction) error) error {
+	params := db.ListUserPredictionsAfterParams{UserID: userID, Limit: exportBatchSize}
+
+	for {
+		rows, err := s.userPredictionsBatch(ctx, params)
+		if err != nil {
+			return errlocal.NewErrInternal("failed to list user predictions", err.Error(),
+				map[string]any{"user_id": userID.String()})
+		}
+		for _, row := range rows {
+			var prediction models.Prediction
+			prediction.Model(row)
+			if err := fn(prediction); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportBatchSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		params.AfterCreatedAt, params.AfterID = last.CreatedAt, last.ID
+	}
+}
+
+func (s *pgStore) userPredictionsBatch(
+	ctx context.Context, params db.ListUserPredictionsAfterParams,
+) ([]db.Prediction, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	return s.q.ListUserPredictionsAfter(ctx, params)
+}
+
+// SnapshotStats records stat as the state of the stats on the calendar day of
+// day, taken in the location of day. Later snapshots of the same day replace
+// earlier ones.
+func (s *pgStore) SnapshotStats(ctx context.Context, stat *models.Stat, day time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rawTrashByTypes, err := json.Marshal(stat.TrashByTypes)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to marshal user stats", err.Error(),
+			map[string]any{"stat_id": stat.ID.String()})
+	}
+	if err := s.q.UpsertStatsSnapshot(ctx, db.UpsertStatsSnapshotParams{
+		StatID:       stat.ID,
+		Day:          activityDay(day),
+		Rating:       int32(stat.Rating),
+		FilesScanned: int32(stat.FilesScanned),
+		TotalWeight:  stat.TotalWeight,
+		TrashByTypes: rawTrashByTypes,
+	}); err != nil {
+		return errlocal.NewErrInternal("failed to snapshot user stats", err.Error(),
+			map[string]any{"stat_id": stat.ID.String()})
+	}
+
+	return nil
+}
+
+// GetStatsHistory returns the snapshots of the stats from from to to, both
+// inclusive, oldest first. The latest snapshot before from, if any, comes
+// first, as the state the range starts from.
+func (s *pgStore) GetStatsHistory(
+	ctx context.Context, statID uuid.UUID, from, to time.Time,
+) ([]models.StatSnapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.GetStatsHistory(ctx, db.GetStatsHistoryParams{
+		StatID:  statID,
+		DayFrom: activityDay(from),
+		DayTo:   activityDay(to),
+	})
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get stats history", err.Error(),
+			map[string]any{"stat_id": statID.String()})
+	}
+
+	history := make([]models.StatSnapshot, len(rows))
+	for i, row := range rows {
+		history[i].Model(row)
+	}
+
+	return history, nil
+}
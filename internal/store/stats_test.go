@@ -3,13 +3,19 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	dbMock "github.com/trashscanner/trashscanner_api/internal/database/mocks"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
 	"github.com/trashscanner/trashscanner_api/internal/errlocal"
+	"github.com/trashscanner/trashscanner_api/internal/models"
 	"github.com/trashscanner/trashscanner_api/internal/testdata"
 )
 
@@ -41,6 +47,27 @@ func TestUpdateStats(t *testing.T) {
 		assert.NoError(t, store.UpdateStats(ctx, &stats))
 	})
 
+	t.Run("with achievements and streaks", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		stats := testdata.Stats1
+		stats.CurrentStreak = 3
+		stats.LongestStreak = 8
+		stats.Achievements = []models.Achievement{
+			{ID: "first_scan", UnlockedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		}
+
+		mockQ.EXPECT().UpdateStats(mock.Anything, mock.MatchedBy(func(params db.UpdateStatsParams) bool {
+			return params.CurrentStreak == 3 && params.LongestStreak == 8 &&
+				string(params.Achievements) == `[{"id":"first_scan","unlocked_at":"2026-01-02T03:04:05Z"}]`
+		})).Return(nil).Once()
+
+		assert.NoError(t, store.UpdateStats(ctx, &stats))
+	})
+
 	t.Run("database error", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -62,3 +89,199 @@ func TestUpdateStats(t *testing.T) {
 		assert.Contains(t, internalErr.System(), dbErr.Error())
 	})
 }
+
+func TestLockStats(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().LockStatsByUserID(mock.Anything, userID).Return(nil).Once()
+
+		assert.NoError(t, store.LockStats(context.Background(), userID))
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().LockStatsByUserID(mock.Anything, userID).Return(errors.New("db down")).Once()
+
+		err := store.LockStats(context.Background(), userID)
+
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestWalkStatsUsers(t *testing.T) {
+	t.Run("pages by user id", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		firstBatch := make([]uuid.UUID, exportBatchSize)
+		for i := range firstBatch {
+			firstBatch[i] = uuid.New()
+		}
+		last := firstBatch[exportBatchSize-1]
+		mockQ.EXPECT().ListStatsUserIDsAfter(mock.Anything, db.ListStatsUserIDsAfterParams{Limit: exportBatchSize}).
+			Return(firstBatch, nil).Once()
+		mockQ.EXPECT().ListStatsUserIDsAfter(mock.Anything, db.ListStatsUserIDsAfterParams{
+			AfterID: last,
+			Limit:   exportBatchSize,
+		}).Return([]uuid.UUID{}, nil).Once()
+
+		var count int
+		require.NoError(t, store.WalkStatsUsers(context.Background(), func(uuid.UUID) error {
+			count++
+			return nil
+		}))
+		assert.Equal(t, exportBatchSize, count)
+	})
+
+	t.Run("error from fn stops the walk", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		fnErr := errors.New("stop")
+
+		mockQ.EXPECT().ListStatsUserIDsAfter(mock.Anything, mock.Anything).
+			Return([]uuid.UUID{uuid.New(), uuid.New()}, nil).Once()
+
+		var count int
+		err := store.WalkStatsUsers(context.Background(), func(uuid.UUID) error {
+			count++
+			return fnErr
+		})
+		assert.ErrorIs(t, err, fnErr)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().ListStatsUserIDsAfter(mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := store.WalkStatsUsers(context.Background(), func(uuid.UUID) error { return nil })
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestWalkUserPredictions(t *testing.T) {
+	userID := uuid.New()
+	label := models.TrashTypeGlass
+	weight := 0.4
+
+	mockQ := dbMock.NewQuerier(t)
+	store := &pgStore{q: mockQ}
+
+	mockQ.EXPECT().ListUserPredictionsAfter(mock.Anything, db.ListUserPredictionsAfterParams{
+		UserID: userID,
+		Limit:  exportBatchSize,
+	}).Return([]db.Prediction{{
+		ID:            uuid.New(),
+		UserID:        userID,
+		Status:        "completed",
+		Result:        []byte(`{"plastic":0.9}`),
+		VerifiedLabel: &label,
+		Weight:        &weight,
+	}}, nil).Once()
+
+	var predictions []models.Prediction
+	require.NoError(t, store.WalkUserPredictions(context.Background(), userID, func(p models.Prediction) error {
+		predictions = append(predictions, p)
+		return nil
+	}))
+	require.Len(t, predictions, 1)
+	require.NotNil(t, predictions[0].Verification)
+	assert.Equal(t, models.TrashTypeGlass, predictions[0].TrashType())
+	assert.Equal(t, &weight, predictions[0].Weight)
+}
+
+func TestSnapshotStats(t *testing.T) {
+	stat := &models.Stat{
+		ID:           uuid.New(),
+		Rating:       120,
+		FilesScanned: 7,
+		TotalWeight:  0.8,
+		TrashByTypes: map[string]int{models.TrashTypeGlass: 4},
+	}
+
+	t.Run("day is taken in the location of the time", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+
+		mockQ.EXPECT().UpsertStatsSnapshot(mock.Anything, db.UpsertStatsSnapshotParams{
+			StatID:       stat.ID,
+			Day:          pgtype.Date{Time: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), Valid: true},
+			Rating:       120,
+			FilesScanned: 7,
+			TotalWeight:  0.8,
+			TrashByTypes: []byte(`{"glass":4}`),
+		}).Return(nil).Once()
+
+		at := time.Date(2026, 3, 10, 20, 0, 0, 0, time.UTC).In(tokyo)
+		require.NoError(t, store.SnapshotStats(context.Background(), stat, at))
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().UpsertStatsSnapshot(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		err := store.SnapshotStats(context.Background(), stat, time.Now())
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestGetStatsHistory(t *testing.T) {
+	statID := uuid.New()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+		day := time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC)
+
+		mockQ.EXPECT().GetStatsHistory(mock.Anything, db.GetStatsHistoryParams{
+			StatID:  statID,
+			DayFrom: pgtype.Date{Time: from, Valid: true},
+			DayTo:   pgtype.Date{Time: to, Valid: true},
+		}).Return([]db.StatsHistory{{
+			StatID:       statID,
+			Day:          pgtype.Date{Time: day, Valid: true},
+			Rating:       40,
+			FilesScanned: 3,
+			TotalWeight:  0.2,
+			TrashByTypes: []byte(`{"metal":3}`),
+		}}, nil).Once()
+
+		history, err := store.GetStatsHistory(context.Background(), statID, from, to)
+		require.NoError(t, err)
+		assert.Equal(t, []models.StatSnapshot{{
+			Day:          day,
+			Rating:       40,
+			FilesScanned: 3,
+			TotalWeight:  0.2,
+			TrashByTypes: map[string]int{models.TrashTypeMetal: 3},
+		}}, history)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetStatsHistory(mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		_, err := store.GetStatsHistory(context.Background(), statID, from, to)
+		var internalErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &internalErr)
+	})
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"net/url"
 	"time"
 
@@ -20,32 +21,127 @@ const (
 )
 
 type Store interface {
-	StartPrediction(ctx context.Context, userID uuid.UUID, scanURL string) (*models.Prediction, error)
+	StartPrediction(ctx context.Context, userID uuid.UUID, scanURL string, weight *float64) (*models.Prediction, error)
 	CompletePrediction(ctx context.Context, id uuid.UUID, result models.PredictionResult, err error) error
 	GetPrediction(ctx context.Context, id uuid.UUID) (*models.Prediction, error)
 	GetPredictionsByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.Prediction, error)
+	CountPredictions(ctx context.Context, userID uuid.UUID) (int64, error)
+	SetPredictionFeedback(ctx context.Context, id, userID uuid.UUID,
+		feedback models.PredictionFeedback) (*models.Prediction, error)
+
+	ListAdminPredictions(ctx context.Context, filter models.PredictionFilter,
+		limit, offset int32) ([]models.AdminPrediction, error)
+	CountAdminPredictions(ctx context.Context, filter models.PredictionFilter) (int64, error)
+	GetPredictionForUpdate(ctx context.Context, id uuid.UUID) (*models.Prediction, error)
+	SetPredictionVerification(ctx context.Context, id uuid.UUID, label *string,
+		verifiedBy uuid.UUID) (*models.Prediction, error)
 
 	CreateUser(ctx context.Context, user *models.User) error
 	GetUser(ctx context.Context, id uuid.UUID, withStats bool) (*models.User, error)
 	GetUserByLogin(ctx context.Context, login string) (*models.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	UpdateUser(ctx context.Context, user *models.User) error
 	UpdateUserPass(ctx context.Context, id uuid.UUID, newHashedPass string) error
+	UpdateUserEmail(ctx context.Context, id uuid.UUID, email *string) error
 	UpdateAvatar(ctx context.Context, user *models.User) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	UnlockUserLogin(ctx context.Context, id uuid.UUID) error
+	ClaimGuest(ctx context.Context, guestID, userID uuid.UUID) error
+	WalkExpiredGuests(ctx context.Context, createdBefore time.Time, fn func(uuid.UUID) error) error
 
 	InsertRefreshToken(ctx context.Context, refreshToken *models.RefreshToken) error
 	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
 	RevokeRefreshToken(ctx context.Context, tokenHash string) error
 	RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error
-
+	GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]models.RefreshToken, error)
+	RevokeSession(ctx context.Context, userID, id uuid.UUID) error
+
+	InsertPasswordResetToken(ctx context.Context, token *models.PasswordResetToken) error
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (uuid.UUID, error)
+	InvalidatePasswordResetTokens(ctx context.Context, userID uuid.UUID) error
+
+	InsertEmailVerificationToken(ctx context.Context, token *models.EmailVerificationToken) error
+	ConsumeEmailVerificationToken(ctx context.Context, tokenHash string) (uuid.UUID, string, error)
+	VerifyUserEmail(ctx context.Context, id uuid.UUID, email string) error
+	InvalidateEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error
+
+	SaveUserTOTP(ctx context.Context, userID uuid.UUID, secret string) error
+	GetUserTOTP(ctx context.Context, userID uuid.UUID) (*models.UserTOTP, error)
+	ConfirmUserTOTP(ctx context.Context, userID uuid.UUID) error
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error
+	DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+
+	GetUserIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, identity *models.UserIdentity) error
+	TouchUserIdentity(ctx context.Context, identity *models.UserIdentity) error
+
+	CreateAPIKey(ctx context.Context, key *models.APIKey) error
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, id uuid.UUID) error
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+
+	LockStats(ctx context.Context, userID uuid.UUID) error
 	UpdateStats(ctx context.Context, stat *models.Stat) error
+	SnapshotStats(ctx context.Context, stat *models.Stat, day time.Time) error
+	GetStatsHistory(ctx context.Context, statID uuid.UUID, from, to time.Time) ([]models.StatSnapshot, error)
+	WalkStatsUsers(ctx context.Context, fn func(uuid.UUID) error) error
+	WalkUserPredictions(ctx context.Context, userID uuid.UUID, fn func(models.Prediction) error) error
+	AddRatingDelta(ctx context.Context, userID uuid.UUID, delta int, at time.Time) error
+	GetLeaderboard(ctx context.Context, period models.LeaderboardPeriod, at time.Time,
+		limit int32) ([]models.LeaderboardEntry, error)
+	GetLeaderboardEntry(ctx context.Context, period models.LeaderboardPeriod, at time.Time,
+		userID uuid.UUID) (*models.LeaderboardEntry, error)
+	GetOrganizationLeaderboard(ctx context.Context, period models.LeaderboardPeriod, at time.Time,
+		limit int32) ([]models.OrganizationLeaderboardEntry, error)
+	SetLeaderboardHidden(ctx context.Context, userID uuid.UUID, hidden bool) error
+	RecordActivity(ctx context.Context, userID uuid.UUID, day time.Time) error
+	GetActivity(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.ActivityDay, error)
+	SetUserTimezone(ctx context.Context, userID uuid.UUID, timezone string) error
+
+	CreateOrganization(ctx context.Context, org *models.Organization, ownerID uuid.UUID) error
+	GetOrganization(ctx context.Context, id uuid.UUID) (*models.Organization, error)
+	GetOrganizationForUpdate(ctx context.Context, id uuid.UUID) (*models.Organization, error)
+	GetOrganizationByInviteCode(ctx context.Context, code string) (*models.Organization, error)
+	SetOrganizationInviteCode(ctx context.Context, id uuid.UUID, code string) error
+	DeleteOrganization(ctx context.Context, id uuid.UUID) error
+	ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]models.UserOrganization, error)
+	AddOrganizationMember(ctx context.Context, member *models.OrganizationMember) error
+	GetOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) (*models.OrganizationMember, error)
+	SetOrganizationMemberRole(ctx context.Context, orgID, userID uuid.UUID, role models.OrgRole) error
+	RemoveOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) error
+	CountOrganizationOwners(ctx context.Context, orgID uuid.UUID) (int64, error)
+	CountSoleOwnedOrganizations(ctx context.Context, userID uuid.UUID) (int64, error)
+	ListOrganizationMembers(ctx context.Context, orgID uuid.UUID, activeSince time.Time) ([]models.MemberActivity, error)
+	GetOrganizationStats(ctx context.Context, orgID uuid.UUID) (*models.OrganizationStats, error)
 
 	InsertLoginHistory(ctx context.Context, loginHistory *models.LoginHistory) error
 	GetLoginHistory(ctx context.Context, userID uuid.UUID) ([]models.LoginHistory, error)
+	ListLoginHistory(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter,
+		limit, offset int32) ([]models.LoginHistory, error)
+	CountLoginHistory(ctx context.Context, userID uuid.UUID, filter models.LoginHistoryFilter) (int64, error)
+	GetLoginFailures(ctx context.Context, userID uuid.UUID, since time.Time) (*models.LoginFailures, error)
+	GetLoginFailuresByIP(ctx context.Context, ip netip.Addr, since time.Time) (*models.LoginFailures, error)
+
+	CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error
+	ListAuditEvents(ctx context.Context, filter models.AuditFilter, limit, offset int32) ([]models.AuditEvent, error)
+	CountAuditEvents(ctx context.Context, filter models.AuditFilter) (int64, error)
+
+	GetAnalytics(ctx context.Context, filter models.AnalyticsFilter) (*models.Analytics, error)
 
-	GetAdminUsers(ctx context.Context, limit, offset int32) ([]models.User, error)
+	GetAdminUsers(ctx context.Context, filter models.UserFilter, limit, offset int32) ([]models.User, error)
 	GetAdminUserByID(ctx context.Context, id uuid.UUID) (*models.User, error)
-	CountUsers(ctx context.Context) (int64, error)
+	CountAdminUsers(ctx context.Context, filter models.UserFilter) (int64, error)
+	UpdateUserByAdmin(ctx context.Context, id uuid.UUID, name *string, role *models.Role) error
+	BanUser(ctx context.Context, id uuid.UUID, until *time.Time, reason string) error
+	UnbanUser(ctx context.Context, id uuid.UUID) error
+	RestoreUser(ctx context.Context, id uuid.UUID) error
+	PurgeUser(ctx context.Context, id uuid.UUID) error
+
+	ExportUsers(ctx context.Context, filter models.UserFilter, fn func(models.User) error) error
+	ExportPredictions(ctx context.Context, filter models.PredictionFilter, fn func(models.AdminPrediction) error) error
 
 	Close()
 	Conn() *pgxpool.Pool
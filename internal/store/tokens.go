@@ -77,3 +77,41 @@ func (s *pgStore) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) err
 	}
 	return nil
 }
+
+// GetActiveSessions returns the refresh tokens of a user that are neither
+// revoked nor expired, newest first.
+func (s *pgStore) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]models.RefreshToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	tokens, err := s.q.GetActiveTokensByUser(ctx, userID)
+	if err != nil {
+		return nil, errlocal.NewErrInternal("failed to get active sessions", err.Error(),
+			map[string]any{"user_id": userID})
+	}
+
+	res := make([]models.RefreshToken, len(tokens))
+	for i := range tokens {
+		res[i] = models.RefreshToken(tokens[i])
+	}
+
+	return res, nil
+}
+
+// RevokeSession revokes one refresh token of a user by its ID.
+func (s *pgStore) RevokeSession(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.RevokeRefreshTokenByID(ctx, db.RevokeRefreshTokenByIDParams{ID: id, UserID: userID})
+	if err != nil {
+		return errlocal.NewErrInternal("failed to revoke session", err.Error(),
+			map[string]any{"user_id": userID, "session_id": id})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("session not found", "no active token with given id",
+			map[string]any{"user_id": userID, "session_id": id})
+	}
+
+	return nil
+}
@@ -230,3 +230,58 @@ func TestRevokeAllUserTokens(t *testing.T) {
 		assert.Contains(t, localErr.System(), dbErr.Error())
 	})
 }
+
+func TestGetActiveSessions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		s := &pgStore{q: mockQ}
+		token := db.RefreshToken{ID: uuid.New(), UserID: testdata.User1ID, ExpiresAt: time.Now().Add(time.Hour)}
+
+		mockQ.EXPECT().GetActiveTokensByUser(mock.Anything, testdata.User1ID).
+			Return([]db.RefreshToken{token}, nil).Once()
+
+		sessions, err := s.GetActiveSessions(context.Background(), testdata.User1ID)
+
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, token.ID, sessions[0].ID)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		s := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().GetActiveTokensByUser(mock.Anything, testdata.User1ID).Return(nil, errors.New("db down")).Once()
+
+		sessions, err := s.GetActiveSessions(context.Background(), testdata.User1ID)
+
+		assert.Nil(t, sessions)
+		var localErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &localErr)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	sessionID := uuid.New()
+	params := db.RevokeRefreshTokenByIDParams{ID: sessionID, UserID: testdata.User1ID}
+
+	t.Run("success", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		s := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().RevokeRefreshTokenByID(mock.Anything, params).Return(int64(1), nil).Once()
+
+		assert.NoError(t, s.RevokeSession(context.Background(), testdata.User1ID, sessionID))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		s := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().RevokeRefreshTokenByID(mock.Anything, params).Return(int64(0), nil).Once()
+
+		err := s.RevokeSession(context.Background(), testdata.User1ID, sessionID)
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -25,6 +26,12 @@ func (s *pgStore) CreateUser(ctx context.Context, user *models.User) error {
 			map[string]any{"login": user.Login})
 	}
 
+	if user.Email != nil {
+		if err := s.checkEmailAvailable(ctx, *user.Email, uuid.Nil); err != nil {
+			return err
+		}
+	}
+
 	role := string(user.Role)
 	if role == "" {
 		role = "user"
@@ -35,8 +42,18 @@ func (s *pgStore) CreateUser(ctx context.Context, user *models.User) error {
 		Login:          user.Login,
 		HashedPassword: user.HashedPassword,
 		Role:           role,
+		Email:          user.Email,
 	})
 	if cErr != nil {
+		// A concurrent registration can take the login or email after the checks above.
+		if strings.Contains(cErr.Error(), "SQLSTATE 23505") {
+			if strings.Contains(cErr.Error(), emailIndexName) {
+				return errlocal.NewErrConflict("user with this email already exists", cErr.Error(),
+					map[string]any{"email": *user.Email})
+			}
+			return errlocal.NewErrConflict("user with this login already exists", cErr.Error(),
+				map[string]any{"login": user.Login})
+		}
 		return errlocal.NewErrInternal("failed to create user", cErr.Error(),
 			map[string]any{"login": user.Login})
 	}
@@ -94,6 +111,26 @@ func (s *pgStore) GetUserByLogin(ctx context.Context, login string) (*models.Use
 	return &user, nil
 }
 
+func (s *pgStore) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	dbUser, err := s.q.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errlocal.NewErrNotFound("user not found", "no user with given email",
+				map[string]any{"email": email})
+		}
+		return nil, errlocal.NewErrInternal("failed to get user by email", err.Error(),
+			map[string]any{"email": email})
+	}
+
+	var user models.User
+	user.Model(dbUser)
+
+	return &user, nil
+}
+
 func (s *pgStore) UpdateUser(ctx context.Context, user *models.User) error {
 	ctx, cancel := context.WithTimeout(ctx, connTimeout)
 	defer cancel()
@@ -150,3 +187,20 @@ func (s *pgStore) DeleteUser(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+func (s *pgStore) UnlockUserLogin(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	rows, err := s.q.UnlockUserLogin(ctx, id)
+	if err != nil {
+		return errlocal.NewErrInternal("failed to unlock user login", err.Error(),
+			map[string]any{"user_id": id})
+	}
+	if rows == 0 {
+		return errlocal.NewErrNotFound("user not found", "no user with given id",
+			map[string]any{"user_id": id})
+	}
+
+	return nil
+}
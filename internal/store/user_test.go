@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -66,6 +67,44 @@ func TestUserCreate(t *testing.T) {
 		assert.Contains(t, err.Error(), "already exists")
 	})
 
+	t.Run("Email already taken", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		email := "Taken@Example.com"
+		user := testdata.NewUser
+		user.Email = &email
+
+		mockQ.EXPECT().GetUserByLogin(mock.Anything, user.Login).Return(db.User{}, pgx.ErrNoRows).Once()
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).Return(db.User{ID: uuid.New()}, nil).Once()
+
+		err := store.CreateUser(context.Background(), &user)
+
+		var conflictErr *errlocal.ErrConflict
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+
+	t.Run("Email taken concurrently", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		email := "new@example.com"
+		user := testdata.NewUser
+		user.Email = &email
+
+		mockQ.EXPECT().GetUserByLogin(mock.Anything, user.Login).Return(db.User{}, pgx.ErrNoRows).Once()
+		mockQ.EXPECT().GetUserByEmail(mock.Anything, email).Return(db.User{}, pgx.ErrNoRows).Once()
+		mockQ.EXPECT().CreateUser(mock.Anything, mock.Anything).
+			Return(uuid.Nil, errors.New(`duplicate key value violates unique constraint "idx_users_email_lower" (SQLSTATE 23505)`)).
+			Once()
+
+		err := store.CreateUser(context.Background(), &user)
+
+		var conflictErr *errlocal.ErrConflict
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.Contains(t, err.Error(), "email")
+	})
+
 	t.Run("Create user successfully with empty role defaults to user", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -519,3 +558,43 @@ func TestDeleteUser(t *testing.T) {
 		assert.Contains(t, localErr.System(), deleteErr.Error())
 	})
 }
+
+func TestUnlockUserLogin(t *testing.T) {
+	t.Run("Unlock user successfully", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().UnlockUserLogin(mock.Anything, testdata.User1ID).
+			Return(int64(1), nil).Once()
+
+		err := store.UnlockUserLogin(context.Background(), testdata.User1ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Unlock missing user", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().UnlockUserLogin(mock.Anything, testdata.User2ID).
+			Return(int64(0), nil).Once()
+
+		err := store.UnlockUserLogin(context.Background(), testdata.User2ID)
+
+		var notFoundErr *errlocal.ErrNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("Unlock user fails", func(t *testing.T) {
+		mockQ := dbMock.NewQuerier(t)
+		store := &pgStore{q: mockQ}
+
+		mockQ.EXPECT().UnlockUserLogin(mock.Anything, testdata.User1ID).
+			Return(int64(0), assert.AnError).Once()
+
+		err := store.UnlockUserLogin(context.Background(), testdata.User1ID)
+
+		var localErr *errlocal.ErrInternal
+		assert.ErrorAs(t, err, &localErr)
+	})
+}
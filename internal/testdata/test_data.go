@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
 	"github.com/trashscanner/trashscanner_api/internal/models"
 )
@@ -71,7 +72,7 @@ var (
 	LoginHistory1ID = uuid.MustParse("54ae379a-31a6-4b32-a6d7-f6cdd844f86f")
 	LoginHistory1   = models.LoginHistory{
 		ID:        LoginHistory1ID,
-		UserID:    User1ID,
+		UserID:    pgtype.UUID{Bytes: User1ID, Valid: true},
 		Success:   true,
 		IpAddress: addrPtr("192.168.1.1"),
 		UserAgent: stringPtr("Mozilla/5.0"),
@@ -81,7 +82,7 @@ var (
 	LoginHistory2ID = uuid.MustParse("64ae379a-31a6-4b32-a6d7-f6cdd844f87a")
 	LoginHistory2   = models.LoginHistory{
 		ID:            LoginHistory2ID,
-		UserID:        User1ID,
+		UserID:        pgtype.UUID{Bytes: User1ID, Valid: true},
 		Success:       false,
 		FailureReason: stringPtr("Invalid password"),
 		IpAddress:     addrPtr("192.168.1.1"),
@@ -92,7 +93,7 @@ var (
 	LoginHistory3ID = uuid.MustParse("74ae379a-31a6-4b32-a6d7-f6cdd844f88b")
 	LoginHistory3   = models.LoginHistory{
 		ID:        LoginHistory3ID,
-		UserID:    User2ID,
+		UserID:    pgtype.UUID{Bytes: User2ID, Valid: true},
 		Success:   true,
 		IpAddress: addrPtr("10.0.0.1"),
 		UserAgent: stringPtr("Chrome/120.0"),
@@ -105,7 +106,7 @@ var (
 
 	DBLoginHistory1 = db.LoginHistory{
 		ID:        LoginHistory1ID,
-		UserID:    User1ID,
+		UserID:    pgtype.UUID{Bytes: User1ID, Valid: true},
 		Success:   true,
 		IpAddress: addrPtr("192.168.1.1"),
 		UserAgent: stringPtr("Mozilla/5.0"),
@@ -115,7 +116,7 @@ var (
 
 	DBLoginHistory2 = db.LoginHistory{
 		ID:            LoginHistory2ID,
-		UserID:        User1ID,
+		UserID:        pgtype.UUID{Bytes: User1ID, Valid: true},
 		Success:       false,
 		FailureReason: stringPtr("Invalid password"),
 		IpAddress:     addrPtr("192.168.1.1"),
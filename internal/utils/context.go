@@ -10,21 +10,27 @@ import (
 type ContextKey string
 
 const (
-	UserCtxKey     ContextKey = "user"
-	RequestBodyKey ContextKey = "request-body"
-	RequestIDKey   ContextKey = "request-id"
-	TimeKey        ContextKey = "time"
-	PathKey        ContextKey = "path"
-	MethodKey      ContextKey = "method"
+	UserCtxKey         ContextKey = "user"
+	RequestBodyKey     ContextKey = "request-body"
+	RequestIDKey       ContextKey = "request-id"
+	TimeKey            ContextKey = "time"
+	PathKey            ContextKey = "path"
+	MethodKey          ContextKey = "method"
+	APIKeyCtxKey       ContextKey = "api-key"
+	ImpersonatorCtxKey ContextKey = "impersonator"
+	OrgMemberCtxKey    ContextKey = "organization-member"
 )
 
 var ContextKeys = map[ContextKey]struct{}{
-	UserCtxKey:     {},
-	RequestBodyKey: {},
-	RequestIDKey:   {},
-	TimeKey:        {},
-	PathKey:        {},
-	MethodKey:      {},
+	UserCtxKey:         {},
+	RequestBodyKey:     {},
+	RequestIDKey:       {},
+	TimeKey:            {},
+	PathKey:            {},
+	MethodKey:          {},
+	APIKeyCtxKey:       {},
+	ImpersonatorCtxKey: {},
+	OrgMemberCtxKey:    {},
 }
 
 func SetUser(ctx context.Context, user *models.User) context.Context {
@@ -35,6 +41,43 @@ func GetUser(ctx context.Context) *models.User {
 	return ctx.Value(UserCtxKey).(*models.User)
 }
 
+// SetAPIKey marks the request as authenticated with key instead of a session.
+func SetAPIKey(ctx context.Context, key *models.APIKey) context.Context {
+	return context.WithValue(ctx, APIKeyCtxKey, key)
+}
+
+// GetAPIKey returns the API key the request was authenticated with, or nil
+// for session authentication.
+func GetAPIKey(ctx context.Context) *models.APIKey {
+	key, _ := ctx.Value(APIKeyCtxKey).(*models.APIKey)
+	return key
+}
+
+// SetImpersonator marks the request as made by admin on behalf of the user.
+func SetImpersonator(ctx context.Context, admin *models.User) context.Context {
+	return context.WithValue(ctx, ImpersonatorCtxKey, admin)
+}
+
+// GetImpersonator returns the admin acting as the user, or nil when the user
+// acts themselves.
+func GetImpersonator(ctx context.Context) *models.User {
+	admin, _ := ctx.Value(ImpersonatorCtxKey).(*models.User)
+	return admin
+}
+
+// SetOrgMember records the membership of the user in the organization the
+// request is about.
+func SetOrgMember(ctx context.Context, member *models.OrganizationMember) context.Context {
+	return context.WithValue(ctx, OrgMemberCtxKey, member)
+}
+
+// GetOrgMember returns the membership of the user in the organization the
+// request is about, or nil outside of organization routes.
+func GetOrgMember(ctx context.Context) *models.OrganizationMember {
+	member, _ := ctx.Value(OrgMemberCtxKey).(*models.OrganizationMember)
+	return member
+}
+
 func SetRequestBody(ctx context.Context, body any) context.Context {
 	return context.WithValue(ctx, RequestBodyKey, body)
 }
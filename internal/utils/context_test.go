@@ -19,6 +19,14 @@ func TestSetAndGetUser(t *testing.T) {
 	assert.Equal(t, &user, GetUser(ctxWithUser))
 }
 
+func TestSetAndGetAPIKey(t *testing.T) {
+	assert.Nil(t, GetAPIKey(context.Background()))
+
+	key := &models.APIKey{ID: uuid.New(), Name: "scanner"}
+	ctx := SetAPIKey(context.Background(), key)
+	assert.Equal(t, key, GetAPIKey(ctx))
+}
+
 func TestSetAndGetRequestBody(t *testing.T) {
 	ctx := context.Background()
 	body := map[string]string{"key": "value"}
@@ -105,6 +113,9 @@ func TestContextKeys(t *testing.T) {
 		TimeKey,
 		PathKey,
 		MethodKey,
+		APIKeyCtxKey,
+		ImpersonatorCtxKey,
+		OrgMemberCtxKey,
 	}
 
 	for _, key := range expectedKeys {
@@ -1,15 +1,20 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/crypto/ssh"
@@ -37,6 +42,15 @@ func CompareTokenHash(storedHash, token string) bool {
 	return storedHash == tokenHash
 }
 
+// GenerateToken returns a URL-safe random token carrying size bytes of entropy.
+func GenerateToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
 func GenerateAndSetKeys() {
 	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
 	if err != nil {
@@ -65,11 +79,33 @@ func GenerateAndSetKeys() {
 }
 
 func GetEdDSAKeysFromEnv() (ed25519.PrivateKey, ed25519.PublicKey, error) {
+	privateKey, publicKey, err := GetSigningKeysFromEnv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ed25519PrivateKey, ok := privateKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("private key is not Ed25519 type")
+	}
+
+	ed25519PublicKey, ok := publicKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("public key is not Ed25519 type")
+	}
+
+	return ed25519PrivateKey, ed25519PublicKey, nil
+}
+
+// GetSigningKeysFromEnv returns the signing key pair stored base64 encoded in
+// AUTH_MANAGER_SECRET_PRIVATE_KEY and AUTH_MANAGER_PUBLIC_KEY. Ed25519, RSA and
+// ECDSA keys are accepted in PEM or SSH format.
+func GetSigningKeysFromEnv() (crypto.PrivateKey, crypto.PublicKey, error) {
 	privateKeyBase64 := os.Getenv("AUTH_MANAGER_SECRET_PRIVATE_KEY")
 	publicKeyBase64 := os.Getenv("AUTH_MANAGER_PUBLIC_KEY")
 
 	if privateKeyBase64 == "" || publicKeyBase64 == "" {
-		return nil, nil, fmt.Errorf("EdDSA keys not found in environment variables")
+		return nil, nil, fmt.Errorf("signing keys not found in environment variables")
 	}
 
 	privateKeyPEM, err := base64.StdEncoding.DecodeString(privateKeyBase64)
@@ -82,27 +118,137 @@ func GetEdDSAKeysFromEnv() (ed25519.PrivateKey, ed25519.PublicKey, error) {
 		return nil, nil, fmt.Errorf("failed to decode public key: %w", err)
 	}
 
-	privateKey, err := ssh.ParseRawPrivateKey(privateKeyPEM)
+	privateKey, err := ParsePrivateKey(privateKeyPEM)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse private key: %w", err)
+		return nil, nil, err
 	}
 
-	ed25519PrivateKey, ok := privateKey.(*ed25519.PrivateKey)
+	publicKey, err := ParsePublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
 	if !ok {
-		return nil, nil, fmt.Errorf("private key is not Ed25519 type")
+		return nil, nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+	if !publicKeysEqual(signer.Public(), publicKey) {
+		return nil, nil, fmt.Errorf("public key does not match private key")
+	}
+
+	return privateKey, publicKey, nil
+}
+
+// GetVerificationKeysFromEnv returns the verification-only public keys listed
+// in AUTH_MANAGER_VERIFICATION_KEYS as comma-separated base64 encoded keys.
+func GetVerificationKeysFromEnv() ([]crypto.PublicKey, error) {
+	rawList := os.Getenv("AUTH_MANAGER_VERIFICATION_KEYS")
+	if rawList == "" {
+		return nil, nil
+	}
+
+	keys := make([]crypto.PublicKey, 0)
+	for _, publicKeyBase64 := range strings.Split(rawList, ",") {
+		publicKeyBase64 = strings.TrimSpace(publicKeyBase64)
+		if publicKeyBase64 == "" {
+			continue
+		}
+
+		publicKeyPEM, err := base64.StdEncoding.DecodeString(publicKeyBase64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode verification key: %w", err)
+		}
+
+		publicKey, err := ParsePublicKey(publicKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, publicKey)
 	}
 
-	sshPublicKey, _, _, _, err := ssh.ParseAuthorizedKey(publicKeyPEM)
+	return keys, nil
+}
+
+// GetHMACSecretFromEnv returns the shared secret for HMAC signing, or nil when
+// AUTH_MANAGER_HMAC_SECRET is not set.
+func GetHMACSecretFromEnv() []byte {
+	secret := os.Getenv("AUTH_MANAGER_HMAC_SECRET")
+	if secret == "" {
+		return nil
+	}
+
+	return []byte(secret)
+}
+
+// ParsePrivateKey parses an Ed25519, RSA or ECDSA private key in PEM
+// (PKCS#1, PKCS#8, SEC 1) or OpenSSH format.
+func ParsePrivateKey(raw []byte) (crypto.PrivateKey, error) {
+	privateKey, err := ssh.ParseRawPrivateKey(raw)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse public key: %w", err)
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	switch key := privateKey.(type) {
+	case *ed25519.PrivateKey:
+		return *key, nil
+	case ed25519.PrivateKey, *rsa.PrivateKey, *ecdsa.PrivateKey:
+		return key, nil
 	}
 
-	cryptoPublicKey := sshPublicKey.(ssh.CryptoPublicKey).CryptoPublicKey()
+	return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+}
+
+// ParsePublicKey parses an Ed25519, RSA or ECDSA public key either in
+// authorized_keys format or as a PEM encoded PKIX / PKCS#1 block.
+func ParsePublicKey(raw []byte) (crypto.PublicKey, error) {
+	if block, _ := pem.Decode(raw); block != nil {
+		return parsePEMPublicKey(block)
+	}
+
+	sshPublicKey, _, _, _, err := ssh.ParseAuthorizedKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
 
-	ed25519PublicKey, ok := cryptoPublicKey.(ed25519.PublicKey)
+	cryptoPublicKey, ok := sshPublicKey.(ssh.CryptoPublicKey)
 	if !ok {
-		return nil, nil, fmt.Errorf("public key is not Ed25519 type")
+		return nil, fmt.Errorf("unsupported public key type %s", sshPublicKey.Type())
+	}
+
+	return checkPublicKeyType(cryptoPublicKey.CryptoPublicKey())
+}
+
+func parsePEMPublicKey(block *pem.Block) (crypto.PublicKey, error) {
+	var (
+		publicKey crypto.PublicKey
+		err       error
+	)
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("failed to parse public key: unexpected PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
 	}
 
-	return *ed25519PrivateKey, ed25519PublicKey, nil
+	return checkPublicKeyType(publicKey)
+}
+
+func checkPublicKeyType(publicKey crypto.PublicKey) (crypto.PublicKey, error) {
+	switch publicKey.(type) {
+	case ed25519.PublicKey, *rsa.PublicKey, *ecdsa.PublicKey:
+		return publicKey, nil
+	}
+
+	return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
 }
@@ -1,12 +1,20 @@
 package utils
 
 import (
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/trashscanner/trashscanner_api/internal/utils/keytest"
 )
 
 func TestHashPass_Success(t *testing.T) {
@@ -130,6 +138,16 @@ func TestCompareTokenHash_EmptyToken(t *testing.T) {
 	assert.False(t, result)
 }
 
+func TestGenerateToken(t *testing.T) {
+	first, err := GenerateToken(32)
+	require.NoError(t, err)
+	second, err := GenerateToken(32)
+	require.NoError(t, err)
+
+	assert.Len(t, first, base64.RawURLEncoding.EncodedLen(32))
+	assert.NotEqual(t, first, second)
+}
+
 func TestGenerateAndSetKeys_Success(t *testing.T) {
 	os.Unsetenv("AUTH_MANAGER_SECRET_PRIVATE_KEY")
 	os.Unsetenv("AUTH_MANAGER_PUBLIC_KEY")
@@ -211,6 +229,115 @@ func TestGetEdDSAKeysFromEnv_InvalidPublicKey(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to decode public key")
 }
 
+func TestGetVerificationKeysFromEnv_Success(t *testing.T) {
+	GenerateAndSetKeys()
+	first := os.Getenv("AUTH_MANAGER_PUBLIC_KEY")
+	GenerateAndSetKeys()
+	second := os.Getenv("AUTH_MANAGER_PUBLIC_KEY")
+	t.Setenv("AUTH_MANAGER_VERIFICATION_KEYS", first+", "+second+",")
+
+	keys, err := GetVerificationKeysFromEnv()
+
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.IsType(t, ed25519.PublicKey{}, keys[0])
+	assert.NotEqual(t, keys[0], keys[1])
+}
+
+func TestGetVerificationKeysFromEnv_Empty(t *testing.T) {
+	t.Setenv("AUTH_MANAGER_VERIFICATION_KEYS", "")
+
+	keys, err := GetVerificationKeysFromEnv()
+
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestGetVerificationKeysFromEnv_InvalidKey(t *testing.T) {
+	t.Setenv("AUTH_MANAGER_VERIFICATION_KEYS", "dGVzdA==")
+
+	_, err := GetVerificationKeysFromEnv()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse public key")
+}
+
+func TestGetSigningKeysFromEnv_RSA(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keytest.SetPEMSigningKeys(t, rsaKey)
+
+	privateKey, publicKey, err := GetSigningKeysFromEnv()
+
+	require.NoError(t, err)
+	assert.IsType(t, &rsa.PrivateKey{}, privateKey)
+	assert.True(t, rsaKey.PublicKey.Equal(publicKey))
+}
+
+func TestGetSigningKeysFromEnv_ECDSA(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keytest.SetPEMSigningKeys(t, ecKey)
+
+	privateKey, publicKey, err := GetSigningKeysFromEnv()
+
+	require.NoError(t, err)
+	assert.IsType(t, &ecdsa.PrivateKey{}, privateKey)
+	assert.True(t, ecKey.PublicKey.Equal(publicKey))
+}
+
+func TestGetSigningKeysFromEnv_Ed25519PEM(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keytest.SetPEMSigningKeys(t, edKey)
+
+	privateKey, publicKey, err := GetEdDSAKeysFromEnv()
+
+	require.NoError(t, err)
+	assert.Equal(t, edKey, privateKey)
+	assert.Equal(t, edKey.Public(), publicKey)
+}
+
+func TestGetSigningKeysFromEnv_MismatchedKeys(t *testing.T) {
+	GenerateAndSetKeys()
+	otherPublicKey := os.Getenv("AUTH_MANAGER_PUBLIC_KEY")
+	GenerateAndSetKeys()
+	t.Setenv("AUTH_MANAGER_PUBLIC_KEY", otherPublicKey)
+
+	_, _, err := GetSigningKeysFromEnv()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match")
+}
+
+func TestParsePublicKey_PKCS1(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	raw := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)})
+
+	publicKey, err := ParsePublicKey(raw)
+
+	require.NoError(t, err)
+	assert.True(t, rsaKey.PublicKey.Equal(publicKey))
+}
+
+func TestParsePublicKey_UnexpectedBlock(t *testing.T) {
+	raw := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("test")})
+
+	_, err := ParsePublicKey(raw)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected PEM block")
+}
+
+func TestGetHMACSecretFromEnv(t *testing.T) {
+	t.Setenv("AUTH_MANAGER_HMAC_SECRET", "")
+	assert.Nil(t, GetHMACSecretFromEnv())
+
+	t.Setenv("AUTH_MANAGER_HMAC_SECRET", "secret")
+	assert.Equal(t, []byte("secret"), GetHMACSecretFromEnv())
+}
+
 func TestEdDSAKeys_RoundTrip(t *testing.T) {
 	GenerateAndSetKeys()
 
@@ -0,0 +1,29 @@
+// Package keytest provides helpers for tests that load signing keys from the
+// environment.
+package keytest
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// SetPEMSigningKeys exports privateKey and its public half as base64 encoded
+// PEM blocks in the signing key environment variables for the test duration.
+func SetPEMSigningKeys(t *testing.T, privateKey crypto.Signer) {
+	t.Helper()
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+	t.Setenv("AUTH_MANAGER_SECRET_PRIVATE_KEY", base64.StdEncoding.EncodeToString(privatePEM))
+	t.Setenv("AUTH_MANAGER_PUBLIC_KEY", base64.StdEncoding.EncodeToString(publicPEM))
+}
@@ -27,11 +27,15 @@ import (
 	"golang.org/x/crypto/ssh"
 
 	"github.com/trashscanner/trashscanner_api/internal/api"
+	"github.com/trashscanner/trashscanner_api/internal/audit"
 	"github.com/trashscanner/trashscanner_api/internal/auth"
 	"github.com/trashscanner/trashscanner_api/internal/config"
 	"github.com/trashscanner/trashscanner_api/internal/database/sqlc/db"
 	"github.com/trashscanner/trashscanner_api/internal/filestore"
 	"github.com/trashscanner/trashscanner_api/internal/logging"
+	"github.com/trashscanner/trashscanner_api/internal/mailer"
+	"github.com/trashscanner/trashscanner_api/internal/rbac"
+	"github.com/trashscanner/trashscanner_api/internal/stats.go"
 	"github.com/trashscanner/trashscanner_api/internal/store"
 )
 
@@ -157,7 +161,9 @@ var _ = BeforeSuite(func() {
 	logger := logging.NewLogger(config.Config{Log: config.LogConfig{Level: "info"}})
 
 	// API Server
-	apiServer = api.NewServer(appConfig, pgStore, minioStore, authManager, nil, logger)
+	apiServer = api.NewServer(appConfig, pgStore, minioStore, authManager, nil,
+		mailer.NewLogMailer(appConfig.Mail, logger), audit.Discard, rbac.DefaultPolicy(),
+		stats.DefaultRules(), logger)
 	tsServer = httptest.NewServer(apiServer.InitRouter())
 
 	fmt.Printf("Test server listening on: %s\n", tsServer.URL)